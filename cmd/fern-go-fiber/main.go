@@ -7,7 +7,7 @@ import (
 	"github.com/fern-api/fern-go/internal/writer"
 )
 
-const usage = `Generate Fiber-compatible Go models from your Fern API definition.
+const usage = `Generate Fiber-compatible Go models and server interfaces from your Fern API definition.
 
 Usage:
   fern-go-fiber <config_file_path>
@@ -25,6 +25,13 @@ func run(config *cmd.Config, coordinator *coordinator.Client) ([]*generator.File
 	generatorConfig, err := generator.NewConfig(
 		config.DryRun,
 		config.EnableExplicitNull,
+		config.EnableForwardCompatibleEnums,
+		config.EnableExtraProperties,
+		config.EnableGetters,
+		config.EnableEqualAndDeepCopy,
+		config.EnableExplicitNullInModels,
+		config.EnableReflectionFreeJSON,
+		config.EnableStreamingRequestBodies,
 		config.IncludeLegacyClientOptions,
 		includeReadme,
 		config.Organization,
@@ -32,6 +39,9 @@ func run(config *cmd.Config, coordinator *coordinator.Client) ([]*generator.File
 		config.IrFilepath,
 		config.ImportPath,
 		config.PackageName,
+		config.Framework,
+		config.BetaEndpoints,
+		config.TypeOverrides,
 		config.Module,
 	)
 	if err != nil {
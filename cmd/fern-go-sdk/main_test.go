@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/fern-api/fern-go/internal/cmd/cmdtest"
 	packages "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures"
+	pathparamsoption "github.com/fern-api/fern-go/internal/testdata/sdk/path-params/fixtures/option"
+	pathparamsuser "github.com/fern-api/fern-go/internal/testdata/sdk/path-params/fixtures/user"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -45,3 +50,45 @@ func TestStringifyJSON(t *testing.T) {
 		valueWithoutUnrecognized.String(),
 	)
 }
+
+// TestPathParameters verifies that path parameters are escaped so that each
+// of them is always a single path segment.
+func TestPathParameters(t *testing.T) {
+	tests := []struct {
+		desc           string
+		giveUserID     string
+		giveInfoID     string
+		wantRequestURI string
+	}{
+		{
+			desc:           "reserved characters",
+			giveUserID:     "a/b?c#d e",
+			giveInfoID:     "fern",
+			wantRequestURI: "/users/get/a%2Fb%3Fc%23d%20e/info/fern",
+		},
+		{
+			desc:           "dot segments",
+			giveUserID:     ".",
+			giveInfoID:     "..",
+			wantRequestURI: "/users/get/%2E/info/%2E%2E",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			requestURIs := make(chan string, 1)
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						requestURIs <- r.RequestURI
+					},
+				),
+			)
+			defer server.Close()
+
+			client := pathparamsuser.NewClient(pathparamsoption.WithBaseURL(server.URL))
+			// Only the path is verified, so the (empty) response is ignored.
+			_, _ = client.GetUserV3(context.Background(), test.giveUserID, test.giveInfoID)
+			assert.Equal(t, test.wantRequestURI, <-requestURIs)
+		})
+	}
+}
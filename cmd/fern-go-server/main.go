@@ -0,0 +1,55 @@
+package main
+
+import (
+	"github.com/fern-api/fern-go/internal/cmd"
+	"github.com/fern-api/fern-go/internal/coordinator"
+	"github.com/fern-api/fern-go/internal/generator"
+	"github.com/fern-api/fern-go/internal/writer"
+)
+
+const usage = `Generate a net/http server interface from your Fern API definition.
+
+Usage:
+  fern-go-server <config_file_path>
+
+Flags:
+  -h, --help     Print this help and exit.
+  -v, --version  Print the version and exit.`
+
+func main() {
+	cmd.Run(usage, run)
+}
+
+func run(config *cmd.Config, coordinator *coordinator.Client) ([]*generator.File, error) {
+	_, includeReadme := config.Writer.Mode.(*writer.GithubConfig)
+	generatorConfig, err := generator.NewConfig(
+		config.DryRun,
+		config.EnableExplicitNull,
+		config.EnableForwardCompatibleEnums,
+		config.EnableExtraProperties,
+		config.EnableGetters,
+		config.EnableEqualAndDeepCopy,
+		config.EnableExplicitNullInModels,
+		config.EnableReflectionFreeJSON,
+		config.EnableStreamingRequestBodies,
+		config.IncludeLegacyClientOptions,
+		includeReadme,
+		config.Organization,
+		config.Version,
+		config.IrFilepath,
+		config.ImportPath,
+		config.PackageName,
+		config.Framework,
+		config.BetaEndpoints,
+		config.TypeOverrides,
+		config.Module,
+	)
+	if err != nil {
+		return nil, err
+	}
+	g, err := generator.New(generatorConfig, coordinator)
+	if err != nil {
+		return nil, err
+	}
+	return g.Generate(generator.ModeServer)
+}
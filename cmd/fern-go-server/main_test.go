@@ -0,0 +1,18 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/fern-api/fern-go/internal/cmd/cmdtest"
+)
+
+const (
+	commandName       = "fern-go-server"
+	configFilename    = "config.json"
+	testdataPath      = "../../internal/testdata/server"
+	fixturesDirectory = "fixtures"
+)
+
+func TestFixtures(t *testing.T) {
+	cmdtest.TestFixtures(t, commandName, testdataPath, usage, run)
+}
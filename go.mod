@@ -6,6 +6,7 @@ require (
 	github.com/fern-api/generator-exec-go v0.0.534
 	github.com/google/uuid v1.6.0
 	github.com/hmdsefi/gograph v0.4.0
+	github.com/klauspost/compress v1.17.0
 	github.com/stretchr/testify v1.8.4
 	go.uber.org/multierr v1.11.0
 	golang.org/x/mod v0.14.0
@@ -51,18 +51,28 @@ var (
 // Config represents the common configuration required from all of
 // the commands (e.g. fern-go-{client,model}).
 type Config struct {
-	DryRun                     bool
-	EnableExplicitNull         bool
-	IncludeLegacyClientOptions bool
-	Organization               string
-	CoordinatorURL             string
-	CoordinatorTaskID          string
-	Version                    string
-	IrFilepath                 string
-	ImportPath                 string
-	PackageName                string
-	Module                     *generator.ModuleConfig
-	Writer                     *writer.Config
+	DryRun                       bool
+	EnableExplicitNull           bool
+	EnableForwardCompatibleEnums bool
+	EnableExtraProperties        bool
+	EnableGetters                bool
+	EnableEqualAndDeepCopy       bool
+	EnableExplicitNullInModels   bool
+	EnableReflectionFreeJSON     bool
+	EnableStreamingRequestBodies bool
+	IncludeLegacyClientOptions   bool
+	Organization                 string
+	CoordinatorURL               string
+	CoordinatorTaskID            string
+	Version                      string
+	IrFilepath                   string
+	ImportPath                   string
+	PackageName                  string
+	Framework                    string
+	BetaEndpoints                string
+	TypeOverrides                map[string]*generator.TypeOverride
+	Module                       *generator.ModuleConfig
+	Writer                       *writer.Config
 }
 
 // GeneratorFunc is a function that generates files.
@@ -187,18 +197,28 @@ func newConfig(configFilename string) (*Config, error) {
 		coordinatorTaskID = config.Environment.Remote.Id
 	}
 	return &Config{
-		DryRun:                     config.DryRun,
-		IncludeLegacyClientOptions: customConfig.IncludeLegacyClientOptions,
-		EnableExplicitNull:         customConfig.EnableExplicitNull,
-		Organization:               config.Organization,
-		CoordinatorURL:             coordinatorURL,
-		CoordinatorTaskID:          coordinatorTaskID,
-		Version:                    outputVersionFromGeneratorConfig(config),
-		IrFilepath:                 config.IrFilepath,
-		ImportPath:                 customConfig.ImportPath,
-		PackageName:                customConfig.PackageName,
-		Module:                     moduleConfig,
-		Writer:                     writerConfig,
+		DryRun:                       config.DryRun,
+		IncludeLegacyClientOptions:   customConfig.IncludeLegacyClientOptions,
+		EnableExplicitNull:           customConfig.EnableExplicitNull,
+		EnableForwardCompatibleEnums: customConfig.EnableForwardCompatibleEnums,
+		EnableExtraProperties:        customConfig.EnableExtraProperties,
+		EnableGetters:                customConfig.EnableGetters,
+		EnableEqualAndDeepCopy:       customConfig.EnableEqualAndDeepCopy,
+		EnableExplicitNullInModels:   customConfig.EnableExplicitNullInModels,
+		EnableReflectionFreeJSON:     customConfig.EnableReflectionFreeJSON,
+		EnableStreamingRequestBodies: customConfig.EnableStreamingRequestBodies,
+		Organization:                 config.Organization,
+		CoordinatorURL:               coordinatorURL,
+		CoordinatorTaskID:            coordinatorTaskID,
+		Version:                      outputVersionFromGeneratorConfig(config),
+		IrFilepath:                   config.IrFilepath,
+		ImportPath:                   customConfig.ImportPath,
+		PackageName:                  customConfig.PackageName,
+		Framework:                    customConfig.Framework,
+		BetaEndpoints:                customConfig.BetaEndpoints,
+		TypeOverrides:                typeOverridesFromCustomConfig(customConfig),
+		Module:                       moduleConfig,
+		Writer:                       writerConfig,
 	}, nil
 }
 
@@ -237,11 +257,26 @@ func readConfig(configFilename string) (*generatorexec.GeneratorConfig, error) {
 }
 
 type customConfig struct {
-	EnableExplicitNull         bool          `json:"enableExplicitNull,omitempty"`
-	IncludeLegacyClientOptions bool          `json:"includeLegacyClientOptions,omitempty"`
-	ImportPath                 string        `json:"importPath,omitempty"`
-	PackageName                string        `json:"packageName,omitempty"`
-	Module                     *moduleConfig `json:"module,omitempty"`
+	EnableExplicitNull           bool                     `json:"enableExplicitNull,omitempty"`
+	EnableForwardCompatibleEnums bool                     `json:"enableForwardCompatibleEnums,omitempty"`
+	EnableExtraProperties        bool                     `json:"enableExtraProperties,omitempty"`
+	EnableGetters                bool                     `json:"enableGetters,omitempty"`
+	EnableEqualAndDeepCopy       bool                     `json:"enableEqualAndDeepCopy,omitempty"`
+	EnableExplicitNullInModels   bool                     `json:"enableExplicitNullInModels,omitempty"`
+	EnableReflectionFreeJSON     bool                     `json:"enableReflectionFreeJSON,omitempty"`
+	EnableStreamingRequestBodies bool                     `json:"enableStreamingRequestBodies,omitempty"`
+	IncludeLegacyClientOptions   bool                     `json:"includeLegacyClientOptions,omitempty"`
+	ImportPath                   string                   `json:"importPath,omitempty"`
+	PackageName                  string                   `json:"packageName,omitempty"`
+	Framework                    string                   `json:"framework,omitempty"`
+	BetaEndpoints                string                   `json:"betaEndpoints,omitempty"`
+	TypeOverrides                map[string]*typeOverride `json:"typeOverrides,omitempty"`
+	Module                       *moduleConfig            `json:"module,omitempty"`
+}
+
+type typeOverride struct {
+	ImportPath string `json:"importPath,omitempty"`
+	Type       string `json:"type,omitempty"`
 }
 
 type moduleConfig struct {
@@ -269,6 +304,25 @@ func customConfigFromConfig(c *generatorexec.GeneratorConfig) (*customConfig, er
 	return config, nil
 }
 
+func typeOverridesFromCustomConfig(customConfig *customConfig) map[string]*generator.TypeOverride {
+	if len(customConfig.TypeOverrides) == 0 {
+		return nil
+	}
+	typeOverrides := make(map[string]*generator.TypeOverride, len(customConfig.TypeOverrides))
+	for key, override := range customConfig.TypeOverrides {
+		if override == nil {
+			// The override is validated by the generator.
+			typeOverrides[key] = nil
+			continue
+		}
+		typeOverrides[key] = &generator.TypeOverride{
+			ImportPath: override.ImportPath,
+			Type:       override.Type,
+		}
+	}
+	return typeOverrides
+}
+
 func moduleConfigFromCustomConfig(customConfig *customConfig, outputMode writer.OutputMode) (*generator.ModuleConfig, error) {
 	githubConfig, ok := outputMode.(*writer.GithubConfig)
 	if !ok && customConfig.Module == nil || customConfig.Module == (&moduleConfig{}) {
@@ -3375,6 +3375,8 @@ type ObjectTypeDeclaration struct {
 	// A list of other types to inherit from
 	Extends    []*DeclaredTypeName `json:"extends,omitempty"`
 	Properties []*ObjectProperty   `json:"properties,omitempty"`
+	// Whether to allow extra properties on the object.
+	ExtraProperties bool `json:"extraProperties"`
 }
 
 func (o *ObjectTypeDeclaration) String() string {
@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	fernir "github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// betaBuildTag is the build tag that gates the beta endpoints
+// when they're generated with the buildTag strategy.
+const betaBuildTag = "beta"
+
+// isDeprecated returns true if the given availability is deprecated.
+func isDeprecated(availability *fernir.Availability) bool {
+	return availability != nil && availability.Status == fernir.AvailabilityStatusDeprecated
+}
+
+// isBeta returns true if the given availability is pre-release or in-development.
+func isBeta(availability *fernir.Availability) bool {
+	if availability == nil {
+		return false
+	}
+	switch availability.Status {
+	case fernir.AvailabilityStatusPreRelease, fernir.AvailabilityStatusInDevelopment:
+		return true
+	}
+	return false
+}
+
+// excludeBetaEndpoints removes all of the beta endpoints from the given IR, so that
+// neither they nor their in-lined request types are generated.
+func excludeBetaEndpoints(ir *fernir.IntermediateRepresentation) {
+	for _, irService := range ir.Services {
+		var endpoints []*fernir.HttpEndpoint
+		for _, irEndpoint := range irService.Endpoints {
+			if isBeta(irEndpoint.Availability) {
+				continue
+			}
+			endpoints = append(endpoints, irEndpoint)
+		}
+		irService.Endpoints = endpoints
+	}
+}
+
+// splitBetaEndpoints separates the given endpoints into those that are generally
+// available and those that are in beta.
+func splitBetaEndpoints(irEndpoints []*fernir.HttpEndpoint) ([]*fernir.HttpEndpoint, []*fernir.HttpEndpoint) {
+	var available, beta []*fernir.HttpEndpoint
+	for _, irEndpoint := range irEndpoints {
+		if isBeta(irEndpoint.Availability) {
+			beta = append(beta, irEndpoint)
+			continue
+		}
+		available = append(available, irEndpoint)
+	}
+	return available, beta
+}
+
+// readmeAvailability returns the README section that lists all of the deprecated
+// and beta endpoints, if any.
+func readmeAvailability(ir *fernir.IntermediateRepresentation, betaEndpoints string) string {
+	var deprecated, beta []string
+	for _, irService := range ir.Services {
+		for _, irEndpoint := range irService.Endpoints {
+			method := clientMethodForReadme(irService, irEndpoint)
+			switch {
+			case isDeprecated(irEndpoint.Availability):
+				item := fmt.Sprintf("- `%s`", method)
+				if message := irEndpoint.Availability.Message; message != nil && len(*message) > 0 {
+					item += ": " + strings.ReplaceAll(*message, "\n", " ")
+				}
+				deprecated = append(deprecated, item)
+			case isBeta(irEndpoint.Availability):
+				beta = append(beta, fmt.Sprintf("- `%s`", method))
+			}
+		}
+	}
+	if len(deprecated) == 0 && len(beta) == 0 {
+		return ""
+	}
+	// The services are stored in a map, so we sort the lists
+	// to produce a deterministic README.
+	sort.Strings(deprecated)
+	sort.Strings(beta)
+	section := "\n### Availability\n"
+	if len(beta) > 0 {
+		section += "\nThe following endpoints are in beta, and may change without notice"
+		if betaEndpoints == BetaEndpointsBuildTag {
+			section += fmt.Sprintf(" (they're only available with the `%s` build tag, e.g. `go build -tags %s`)", betaBuildTag, betaBuildTag)
+		}
+		section += ":\n\n" + strings.Join(beta, "\n") + "\n"
+	}
+	if len(deprecated) > 0 {
+		section += "\nThe following endpoints are deprecated, and may be removed in a future release:\n\n"
+		section += strings.Join(deprecated, "\n") + "\n"
+	}
+	return section
+}
+
+// clientMethodForReadme returns the client method used to call the given endpoint,
+// e.g. client.User.GetUser.
+func clientMethodForReadme(irService *fernir.HttpService, irEndpoint *fernir.HttpEndpoint) string {
+	elements := []string{"client"}
+	for _, part := range irService.Name.FernFilepath.AllParts {
+		elements = append(elements, part.PascalCase.UnsafeName)
+	}
+	elements = append(elements, irEndpoint.Name.PascalCase.UnsafeName)
+	return strings.Join(elements, ".")
+}
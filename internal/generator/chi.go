@@ -0,0 +1,46 @@
+package generator
+
+import "fmt"
+
+// chiImportPath is the import path of the chi router.
+const chiImportPath = "github.com/go-chi/chi/v5"
+
+// chiFramework generates handlers for the chi router. Chi is compatible
+// with net/http, so only the routing differs from the netHTTPFramework.
+type chiFramework struct {
+	netHTTPFramework
+}
+
+func (*chiFramework) imports() []string {
+	return []string{chiImportPath}
+}
+
+func (*chiFramework) reserved() []string {
+	return []string{"w", "r", "router"}
+}
+
+func (*chiFramework) writeRegister(f *fileWriter) {
+	f.P("// NewHandler returns an http.Handler that routes requests to the given Service.")
+	f.P("func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {")
+	f.P("router := chi.NewRouter()")
+	f.P("Register(router, service, opts...)")
+	f.P("return router")
+	f.P("}")
+	f.P()
+	f.P("// Register registers all of the Service's endpoints with the given chi router.")
+	f.P("func Register(router chi.Router, service Service, opts ...core.HandlerOption) {")
+}
+
+func (*chiFramework) route(method string, path string) string {
+	return fmt.Sprintf("router.MethodFunc(%s, %q, func(w http.ResponseWriter, r *http.Request) {", method, path)
+}
+
+func (*chiFramework) pathValue(name string) string {
+	// chi matches the escaped path if it's set, so the value is unescaped
+	// in that case.
+	return fmt.Sprintf("core.PathValue(r, chi.URLParam(r, %q))", name)
+}
+
+func (*chiFramework) escapedPathValues() bool {
+	return false
+}
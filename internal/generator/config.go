@@ -1,16 +1,56 @@
 package generator
 
+import (
+	"fmt"
+	"strings"
+)
+
+// The frameworks supported by the generated server.
+const (
+	FrameworkNetHTTP = "net/http"
+	FrameworkChi     = "chi"
+	FrameworkEcho    = "echo"
+	FrameworkFiber   = "fiber"
+	FrameworkGin     = "gin"
+)
+
+// The ways in which beta (i.e. pre-release and in-development) endpoints are generated.
+const (
+	BetaEndpointsInclude  = "include"
+	BetaEndpointsExclude  = "exclude"
+	BetaEndpointsBuildTag = "buildTag"
+)
+
 // Config represents the Fern generator configuration.
 type Config struct {
-	DryRun                     bool
-	EnableExplicitNull         bool
-	IncludeLegacyClientOptions bool
-	IncludeReadme              bool
-	Organization               string
-	Version                    string
-	IRFilepath                 string
-	ImportPath                 string
-	PackageName                string
+	DryRun                       bool
+	EnableExplicitNull           bool
+	EnableForwardCompatibleEnums bool
+	EnableExtraProperties        bool
+	EnableGetters                bool
+	EnableEqualAndDeepCopy       bool
+	EnableExplicitNullInModels   bool
+	EnableReflectionFreeJSON     bool
+	EnableStreamingRequestBodies bool
+	IncludeLegacyClientOptions   bool
+	IncludeReadme                bool
+	Organization                 string
+	Version                      string
+	IRFilepath                   string
+	ImportPath                   string
+	PackageName                  string
+
+	// The framework targeted by the generated server (e.g. gin).
+	// If not specified, the server uses net/http.
+	Framework string
+
+	// Determines how beta endpoints are generated (e.g. exclude).
+	// If not specified, beta endpoints are included.
+	BetaEndpoints string
+
+	// Map from type ID (or primitive, e.g. uuid) to the existing Go type
+	// used in its place. The overridden types aren't generated.
+	TypeOverrides map[string]*TypeOverride
 
 	// If not specified, a go.mod and go.sum will not be generated.
 	ModuleConfig *ModuleConfig
@@ -29,13 +69,35 @@ type ModuleConfig struct {
 	//  "github.com/google/uuid": "v1.4.0"
 	//  "github.com/testify/stretchr": "v1.7.0"
 	//  "gopkg.in/yaml.v3": "v3.0.1"
+	//
+	// The client additionally requires "github.com/klauspost/compress", which
+	// is added unless it's listed here.
 	Imports map[string]string
 }
 
+// TypeOverride represents an existing Go type that's used in place of
+// a generated type (or primitive).
+type TypeOverride struct {
+	// The import path of the package that defines the type, if any
+	// (e.g. github.com/shopspring/decimal).
+	ImportPath string
+
+	// The name of the type within its package (e.g. Decimal). If an
+	// import path isn't specified, this is a predeclared type (e.g. string).
+	Type string
+}
+
 // NewConfig returns a new *Config for the given values.
 func NewConfig(
 	dryRun bool,
 	enableExplicitNull bool,
+	enableForwardCompatibleEnums bool,
+	enableExtraProperties bool,
+	enableGetters bool,
+	enableEqualAndDeepCopy bool,
+	enableExplicitNullInModels bool,
+	enableReflectionFreeJSON bool,
+	enableStreamingRequestBodies bool,
 	includeLegacyClientOptions bool,
 	includeReadme bool,
 	organization string,
@@ -43,18 +105,57 @@ func NewConfig(
 	irFilepath string,
 	importPath string,
 	packageName string,
+	framework string,
+	betaEndpoints string,
+	typeOverrides map[string]*TypeOverride,
 	moduleConfig *ModuleConfig,
 ) (*Config, error) {
+	if _, err := newServerFramework(framework); err != nil {
+		return nil, err
+	}
+	switch betaEndpoints {
+	case "", BetaEndpointsInclude, BetaEndpointsExclude, BetaEndpointsBuildTag:
+	default:
+		return nil, fmt.Errorf(
+			"unsupported betaEndpoints %q; expected one of %q, %q, or %q",
+			betaEndpoints,
+			BetaEndpointsInclude,
+			BetaEndpointsExclude,
+			BetaEndpointsBuildTag,
+		)
+	}
+	for key, typeOverride := range typeOverrides {
+		if typeOverride == nil || typeOverride.Type == "" {
+			return nil, fmt.Errorf("typeOverrides %q must specify a type", key)
+		}
+		if strings.Contains(typeOverride.Type, ".") {
+			return nil, fmt.Errorf(
+				"typeOverrides %q must specify the type's name without its package (e.g. %q); use importPath to specify its package",
+				key,
+				typeOverride.Type[strings.LastIndex(typeOverride.Type, ".")+1:],
+			)
+		}
+	}
 	return &Config{
-		DryRun:                     dryRun,
-		EnableExplicitNull:         enableExplicitNull,
-		IncludeLegacyClientOptions: includeLegacyClientOptions,
-		IncludeReadme:              includeReadme,
-		Organization:               organization,
-		Version:                    version,
-		IRFilepath:                 irFilepath,
-		ImportPath:                 importPath,
-		PackageName:                packageName,
-		ModuleConfig:               moduleConfig,
+		DryRun:                       dryRun,
+		EnableExplicitNull:           enableExplicitNull,
+		EnableForwardCompatibleEnums: enableForwardCompatibleEnums,
+		EnableExtraProperties:        enableExtraProperties,
+		EnableGetters:                enableGetters,
+		EnableEqualAndDeepCopy:       enableEqualAndDeepCopy,
+		EnableExplicitNullInModels:   enableExplicitNullInModels,
+		EnableReflectionFreeJSON:     enableReflectionFreeJSON,
+		EnableStreamingRequestBodies: enableStreamingRequestBodies,
+		IncludeLegacyClientOptions:   includeLegacyClientOptions,
+		IncludeReadme:                includeReadme,
+		Organization:                 organization,
+		Version:                      version,
+		IRFilepath:                   irFilepath,
+		ImportPath:                   importPath,
+		PackageName:                  packageName,
+		Framework:                    framework,
+		BetaEndpoints:                betaEndpoints,
+		TypeOverrides:                typeOverrides,
+		ModuleConfig:                 moduleConfig,
 	}, nil
 }
@@ -0,0 +1,131 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// echoImportPath is the import path of the Echo web framework.
+const echoImportPath = "github.com/labstack/echo/v4"
+
+// echoFramework generates handlers for the Echo web framework.
+type echoFramework struct{}
+
+func (*echoFramework) imports() []string {
+	return []string{echoImportPath}
+}
+
+func (*echoFramework) reserved() []string {
+	return []string{"router", "c"}
+}
+
+func (*echoFramework) returnsError() bool {
+	return true
+}
+
+func (*echoFramework) headerTag(wireValue string) string {
+	return fmt.Sprintf(`json:"-" header:%q`, wireValue)
+}
+
+func (*echoFramework) queryTag(queryParam *ir.QueryParameter, _ map[ir.TypeId]*ir.TypeDeclaration) string {
+	return fmt.Sprintf(`json:"-" query:%q`, queryParam.Name.WireValue)
+}
+
+func (*echoFramework) writeRegister(f *fileWriter) {
+	// Echo doesn't define a common interface for the *echo.Echo and *echo.Group
+	// types, so we generate one here.
+	f.P("// Router is implemented by both the *echo.Echo and *echo.Group types.")
+	f.P("type Router interface {")
+	f.P("Add(method, path string, handler echo.HandlerFunc, middleware ...echo.MiddlewareFunc) *echo.Route")
+	f.P("}")
+	f.P()
+	f.P("// Register registers all of the Service's endpoints with the given Echo router.")
+	f.P("func Register(router Router, service Service, opts ...core.HandlerOption) {")
+}
+
+func (*echoFramework) route(method string, path string) string {
+	return fmt.Sprintf("router.Add(%s, %q, func(c echo.Context) error {", method, path)
+}
+
+func (*echoFramework) pathParameter(name string) string {
+	return ":" + name
+}
+
+func (*echoFramework) context() string {
+	return "c.Request().Context()"
+}
+
+func (*echoFramework) pathValue(name string) string {
+	// Echo matches the escaped path if it's set, so the value is unescaped
+	// in that case.
+	return fmt.Sprintf("core.PathValue(c.Request(), c.Param(%q))", name)
+}
+
+func (*echoFramework) escapedPathValues() bool {
+	return false
+}
+
+func (*echoFramework) header(name string) string {
+	return fmt.Sprintf("c.Request().Header.Get(%q)", name)
+}
+
+func (*echoFramework) formValue(name string) string {
+	return fmt.Sprintf("c.FormValue(%q)", name)
+}
+
+func (*echoFramework) formFile(name string) string {
+	return fmt.Sprintf("c.FormFile(%q)", name)
+}
+
+func (*echoFramework) body() string {
+	return "c.Request().Body"
+}
+
+func (*echoFramework) writeQuery(f *fileWriter) {
+	f.P("query := c.QueryParams()")
+}
+
+func (*echoFramework) badRequestResponse(format string, args ...string) string {
+	if len(args) == 0 {
+		return fmt.Sprintf("echo.NewHTTPError(http.StatusBadRequest, %s)", format)
+	}
+	return fmt.Sprintf("echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf(%s))", strings.Join(append([]string{format}, args...), ", "))
+}
+
+func (*echoFramework) errorResponse(err string) string {
+	// Echo's HTTPErrorHandler is responsible for all of the undeclared errors.
+	return err
+}
+
+func (*echoFramework) statusResponse(statusCode string) string {
+	return fmt.Sprintf("c.NoContent(%s)", statusCode)
+}
+
+func (*echoFramework) jsonResponse(statusCode string, value string) string {
+	return fmt.Sprintf("c.JSON(%s, %s)", statusCode, value)
+}
+
+func (*echoFramework) writeFile(f *fileWriter, value string) {
+	f.P("if closer, ok := ", value, ".(io.Closer); ok {")
+	f.P("defer closer.Close()")
+	f.P("}")
+	f.P("return c.Stream(http.StatusOK, echo.MIMEOctetStream, ", value, ")")
+}
+
+func (*echoFramework) writeText(f *fileWriter, value string) {
+	f.P("return c.String(http.StatusOK, ", value, ")")
+}
+
+func (*echoFramework) writeStream(f *fileWriter, delimiter string, call string, writeError func()) {
+	f.P("stream := core.NewStreamWriter(c.Response(), ", fmt.Sprintf("%q", delimiter), ", opts...)")
+	f.P("if err := ", call, "; err != nil {")
+	f.P("if stream.Started() {")
+	f.P("stream.Abort(err)")
+	f.P("} else {")
+	writeError()
+	f.P("}")
+	f.P("}")
+	f.P("return nil")
+}
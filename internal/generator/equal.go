@@ -0,0 +1,400 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// extraPropertiesField is the structField used to compare and copy an object's
+// extra properties, if any.
+var extraPropertiesField = &structField{
+	name:   "ExtraProperties",
+	goType: "map[string]interface{}",
+	valueType: &ir.TypeReference{
+		Container: &ir.ContainerType{
+			Map: &ir.MapType{
+				KeyType:   &ir.TypeReference{Primitive: ir.PrimitiveTypeString},
+				ValueType: &ir.TypeReference{},
+			},
+		},
+	},
+}
+
+// rawMessageField returns the structField used to compare and copy the given
+// json.RawMessage field (e.g. a union's _unknown value).
+func rawMessageField(name string) *structField {
+	return &structField{
+		name:      name,
+		goType:    "json.RawMessage",
+		valueType: &ir.TypeReference{Primitive: ir.PrimitiveTypeBase64},
+	}
+}
+
+// writeEqualMethod writes an Equal method that compares each of the given fields.
+// Literals are never compared because they can't be set by the user, and neither
+// is the raw JSON the value was deserialized from (if any).
+func (t *typeVisitor) writeEqualMethod(receiver string, fields []*structField) {
+	t.writer.P("// Equal reports whether the ", t.typeName, " is equal to the other ", t.typeName, ".")
+	t.writer.P("func (", receiver, " *", t.typeName, ") Equal(other *", t.typeName, ") bool {")
+	t.writer.P("if ", receiver, " == nil || other == nil {")
+	t.writer.P("return ", receiver, " == other")
+	t.writer.P("}")
+	for _, field := range fields {
+		var (
+			value      = receiver + "." + field.name
+			otherValue = "other." + field.name
+		)
+		if strings.HasPrefix(field.goType, "*core.Optional[") {
+			t.writeEqualOptional(value, otherValue, field.valueType)
+			continue
+		}
+		t.writeEqualValue(value, otherValue, field.valueType, 0)
+	}
+	t.writer.P("return true")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeEqualOptional writes the statements required to compare the given
+// *core.Optional[T] values.
+func (t *typeVisitor) writeEqualOptional(value string, other string, valueType *ir.TypeReference) {
+	t.writer.P("if (", value, " == nil) != (", other, " == nil) {")
+	t.writer.P("return false")
+	t.writer.P("}")
+	t.writer.P("if ", value, " != nil {")
+	t.writer.P("if ", value, ".Null != ", other, ".Null {")
+	t.writer.P("return false")
+	t.writer.P("}")
+	switch underlying := valueType.Container.Optional; {
+	case underlying.Container != nil && underlying.Container.Optional != nil:
+		t.writer.P("if !core.EqualValue(", value, ".Value, ", other, ".Value) {")
+		t.writer.P("return false")
+		t.writer.P("}")
+	case underlying.Named != nil && isPointer(t.writer.types[underlying.Named.TypeId]):
+		// The optional's value is the object (or union) itself.
+		t.writer.P("if !", value, ".Value.Equal(&", other, ".Value) {")
+		t.writer.P("return false")
+		t.writer.P("}")
+	default:
+		t.writeEqualValue(value+".Value", other+".Value", underlying, 0)
+	}
+	t.writer.P("}")
+}
+
+// writeEqualValue writes the statements required to compare the given values,
+// which recursively compares every element of container types (e.g. lists).
+func (t *typeVisitor) writeEqualValue(value string, other string, valueType *ir.TypeReference, depth int) {
+	if isUnknownTypeReference(valueType) {
+		t.writer.P("if !core.EqualValue(", value, ", ", other, ") {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		return
+	}
+	if valueType.Named != nil {
+		typeDeclaration := t.writer.types[valueType.Named.TypeId]
+		if alias := typeDeclaration.Shape.Alias; alias != nil {
+			t.writeEqualValue(value, other, alias.AliasOf, depth)
+			return
+		}
+		if typeDeclaration.Shape.Enum != nil {
+			t.writer.P("if ", value, " != ", other, " {")
+		} else {
+			t.writer.P("if !", selectorOperand(value), ".Equal(", other, ") {")
+		}
+		t.writer.P("return false")
+		t.writer.P("}")
+		return
+	}
+	if valueType.Primitive != "" {
+		switch valueType.Primitive {
+		case ir.PrimitiveTypeDate, ir.PrimitiveTypeDateTime:
+			t.writer.P("if !", selectorOperand(value), ".Equal(", other, ") {")
+		case ir.PrimitiveTypeBase64:
+			t.writer.P("if !bytes.Equal(", value, ", ", other, ") {")
+		default:
+			t.writer.P("if ", value, " != ", other, " {")
+		}
+		t.writer.P("return false")
+		t.writer.P("}")
+		return
+	}
+	suffix := ""
+	if depth > 0 {
+		suffix = strconv.Itoa(depth)
+	}
+	switch container := valueType.Container; {
+	case container.List != nil, container.Set != nil:
+		elementType := container.List
+		if elementType == nil {
+			elementType = container.Set
+		}
+		var (
+			index   = "index" + suffix
+			element = "value" + suffix
+		)
+		t.writer.P("if len(", value, ") != len(", other, ") {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writer.P("for ", index, ", ", element, " := range ", value, " {")
+		t.writeEqualValue(element, other+"["+index+"]", elementType, depth+1)
+		t.writer.P("}")
+	case container.Map != nil:
+		var (
+			key          = "key" + suffix
+			element      = "value" + suffix
+			otherElement = "otherValue" + suffix
+		)
+		t.writer.P("if len(", value, ") != len(", other, ") {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writer.P("for ", key, ", ", element, " := range ", value, " {")
+		t.writer.P(otherElement, ", ok := ", other, "[", key, "]")
+		t.writer.P("if !ok {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writeEqualValue(element, otherElement, container.Map.ValueType, depth+1)
+		t.writer.P("}")
+	case container.Optional != nil:
+		underlying := optionalValueType(valueType)
+		if !t.isOptionalPointer(valueType) {
+			t.writeEqualValue(value, other, underlying, depth)
+			return
+		}
+		t.writer.P("if (", value, " == nil) != (", other, " == nil) {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writer.P("if ", value, " != nil {")
+		t.writeEqualValue("*"+value, "*"+other, underlying, depth)
+		t.writer.P("}")
+	case container.Literal != nil:
+		t.writer.P("if ", value, " != ", other, " {")
+		t.writer.P("return false")
+		t.writer.P("}")
+	}
+}
+
+// writeDeepCopyMethod writes a DeepCopy method that copies each of the given fields,
+// so that the copy doesn't share any of its (nested) values with the original.
+func (t *typeVisitor) writeDeepCopyMethod(receiver string, fields []*structField) {
+	t.writer.P("// DeepCopy returns a deep copy of the ", t.typeName, ".")
+	t.writer.P("func (", receiver, " *", t.typeName, ") DeepCopy() *", t.typeName, " {")
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P("copied := *", receiver)
+	for _, field := range fields {
+		var (
+			dst = "copied." + field.name
+			src = receiver + "." + field.name
+		)
+		if strings.HasPrefix(field.goType, "*core.Optional[") {
+			t.writeDeepCopyOptional(dst, src, field.goType, field.valueType)
+			continue
+		}
+		if !t.needsDeepCopy(field.valueType) {
+			continue
+		}
+		t.writeDeepCopyValue(dst, src, field.goType, field.valueType, 0)
+	}
+	t.writer.P("return &copied")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeDeepCopyOptional writes the statements required to copy the given
+// *core.Optional[T] value into dst.
+func (t *typeVisitor) writeDeepCopyOptional(dst string, src string, goType string, valueType *ir.TypeReference) {
+	t.writer.P("if ", src, " != nil {")
+	t.writer.P("copiedValue := *", src)
+	switch underlying := valueType.Container.Optional; {
+	case underlying.Container != nil && underlying.Container.Optional != nil:
+		// Nested optionals are copied as-is.
+	case underlying.Named != nil && isPointer(t.writer.types[underlying.Named.TypeId]):
+		// The optional's value is the object (or union) itself.
+		t.writer.P("copiedValue.Value = *", src, ".Value.DeepCopy()")
+	case t.needsDeepCopy(underlying):
+		valueGoType := strings.TrimSuffix(strings.TrimPrefix(goType, "*core.Optional["), "]")
+		t.writeDeepCopyValue("copiedValue.Value", src+".Value", valueGoType, underlying, 1)
+	}
+	t.writer.P(dst, " = &copiedValue")
+	t.writer.P("}")
+}
+
+// writeDeepCopyValue writes the statements required to copy the given src value
+// (of the given Go type) into dst, which recursively copies every element of
+// container types (e.g. lists).
+func (t *typeVisitor) writeDeepCopyValue(dst string, src string, goType string, valueType *ir.TypeReference, depth int) {
+	if isUnknownTypeReference(valueType) {
+		t.writer.P(dst, " = core.DeepCopyValue(", src, ")")
+		return
+	}
+	if valueType.Named != nil {
+		if _, ok := t.writer.typeOverrides[valueType.Named.TypeId]; ok {
+			t.writer.P(dst, " = ", src)
+			return
+		}
+		if alias := t.writer.types[valueType.Named.TypeId].Shape.Alias; alias != nil {
+			t.writeDeepCopyValue(dst, src, goType, alias.AliasOf, depth)
+			return
+		}
+		t.writer.P(dst, " = ", selectorOperand(src), ".DeepCopy()")
+		return
+	}
+	suffix := ""
+	if depth > 0 {
+		suffix = strconv.Itoa(depth)
+	}
+	if valueType.Primitive == ir.PrimitiveTypeBase64 {
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(dst, " = make(", goType, ", len(", src, "))")
+		t.writer.P("copy(", dst, ", ", src, ")")
+		t.writer.P("}")
+		return
+	}
+	switch container := valueType.Container; {
+	case container.List != nil, container.Set != nil:
+		elementType := container.List
+		if elementType == nil {
+			elementType = container.Set
+		}
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(dst, " = make(", goType, ", len(", src, "))")
+		t.writer.P("copy(", dst, ", ", src, ")")
+		if t.needsDeepCopy(elementType) {
+			var (
+				index   = "index" + suffix
+				element = "value" + suffix
+			)
+			t.writer.P("for ", index, ", ", element, " := range ", src, " {")
+			t.writeDeepCopyValue(dst+"["+index+"]", element, t.elementGoType(elementType), elementType, depth+1)
+			t.writer.P("}")
+		}
+		t.writer.P("}")
+	case container.Map != nil:
+		var (
+			key     = "key" + suffix
+			element = "value" + suffix
+		)
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(dst, " = make(", goType, ", len(", src, "))")
+		t.writer.P("for ", key, ", ", element, " := range ", src, " {")
+		switch valueType := container.Map.ValueType; {
+		case !t.needsDeepCopy(valueType):
+			t.writer.P(dst, "[", key, "] = ", element)
+		case t.deepCopyAlwaysAssigns(valueType):
+			t.writeDeepCopyValue(dst+"["+key+"]", element, t.elementGoType(valueType), valueType, depth+1)
+		default:
+			// The copy is only assigned for non-nil values, so it's written to a
+			// local variable first to preserve keys with nil values.
+			copiedElement := "copiedElement" + suffix
+			t.writer.P("var ", copiedElement, " ", t.elementGoType(valueType))
+			t.writeDeepCopyValue(copiedElement, element, t.elementGoType(valueType), valueType, depth+1)
+			t.writer.P(dst, "[", key, "] = ", copiedElement)
+		}
+		t.writer.P("}")
+		t.writer.P("}")
+	case container.Optional != nil:
+		underlying := optionalValueType(valueType)
+		if !t.isOptionalPointer(valueType) {
+			t.writeDeepCopyValue(dst, src, goType, underlying, depth)
+			return
+		}
+		copiedValue := "copiedValue" + suffix
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(copiedValue, " := *", src)
+		if t.needsDeepCopy(underlying) {
+			t.writeDeepCopyValue(copiedValue, "*"+src, strings.TrimPrefix(goType, "*"), underlying, depth+1)
+		}
+		t.writer.P(dst, " = &", copiedValue)
+		t.writer.P("}")
+	}
+}
+
+// needsDeepCopy returns true if values of the given type can't be copied by
+// assignment, i.e. if the type includes any pointers, slices, or maps.
+//
+// Overridden types are always copied by assignment, because their
+// representation is up to the package that defines them.
+func (t *typeVisitor) needsDeepCopy(valueType *ir.TypeReference) bool {
+	if isUnknownTypeReference(valueType) {
+		return true
+	}
+	if valueType.Named != nil {
+		if _, ok := t.writer.typeOverrides[valueType.Named.TypeId]; ok {
+			return false
+		}
+		typeDeclaration := t.writer.types[valueType.Named.TypeId]
+		if alias := typeDeclaration.Shape.Alias; alias != nil {
+			return t.needsDeepCopy(alias.AliasOf)
+		}
+		return typeDeclaration.Shape.Enum == nil
+	}
+	if valueType.Primitive != "" {
+		return valueType.Primitive == ir.PrimitiveTypeBase64
+	}
+	switch container := valueType.Container; {
+	case container.List != nil, container.Set != nil, container.Map != nil:
+		return true
+	case container.Optional != nil:
+		return t.isOptionalPointer(valueType) || t.needsDeepCopy(optionalValueType(valueType))
+	}
+	return false
+}
+
+// deepCopyAlwaysAssigns returns true if the statements written by
+// writeDeepCopyValue for the given type always assign dst, even if the
+// source value is nil.
+func (t *typeVisitor) deepCopyAlwaysAssigns(valueType *ir.TypeReference) bool {
+	if isUnknownTypeReference(valueType) {
+		return true
+	}
+	if valueType.Named != nil {
+		if alias := t.writer.types[valueType.Named.TypeId].Shape.Alias; alias != nil {
+			if _, ok := t.writer.typeOverrides[valueType.Named.TypeId]; !ok {
+				return t.deepCopyAlwaysAssigns(alias.AliasOf)
+			}
+		}
+		return true
+	}
+	if optional := valueType.Container; optional != nil && optional.Optional != nil && !t.isOptionalPointer(valueType) {
+		return t.deepCopyAlwaysAssigns(optionalValueType(valueType))
+	}
+	return false
+}
+
+// isOptionalPointer returns true if the given optional type is represented as a
+// pointer to its underlying value (e.g. *string), rather than the value itself.
+// Optional objects and unions are excluded because they're already pointers.
+func (t *typeVisitor) isOptionalPointer(valueType *ir.TypeReference) bool {
+	underlying := optionalValueType(valueType)
+	if underlying.Named != nil && isPointer(t.writer.types[underlying.Named.TypeId]) {
+		return false
+	}
+	return strings.HasPrefix(t.elementGoType(valueType), "*")
+}
+
+// elementGoType returns the Go type of the given type reference when it's
+// nested in a container (i.e. optionals are always represented as a pointer).
+func (t *typeVisitor) elementGoType(valueType *ir.TypeReference) string {
+	if isUnknownTypeReference(valueType) {
+		return "interface{}"
+	}
+	return typeReferenceToGoType(valueType, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, false)
+}
+
+// isUnknownTypeReference returns true if the given type is unknown, i.e. it's
+// represented as an interface{}.
+func isUnknownTypeReference(valueType *ir.TypeReference) bool {
+	return valueType == nil || (valueType.Named == nil && valueType.Primitive == "" && valueType.Container == nil)
+}
+
+// selectorOperand returns the given expression such that it can be used as the
+// operand of a selector (e.g. a method call), i.e. dereferences are parenthesized.
+func selectorOperand(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// objectWireValues returns the wire values of all of the given object's properties,
+// including the extended and literal properties (if any).
+func objectWireValues(object *ir.ObjectTypeDeclaration, types map[ir.TypeId]*ir.TypeDeclaration) []string {
+	var wireValues []string
+	for _, extend := range object.Extends {
+		wireValues = append(wireValues, objectWireValues(types[extend.TypeId].Shape.Object, types)...)
+	}
+	for _, property := range object.Properties {
+		wireValues = append(wireValues, property.Name.WireValue)
+	}
+	return wireValues
+}
+
+// unionWireValues returns the wire values of the given union's discriminant and
+// base properties, including the extended properties (if any).
+func unionWireValues(union *ir.UnionTypeDeclaration, types map[ir.TypeId]*ir.TypeDeclaration) []string {
+	wireValues := []string{union.Discriminant.WireValue}
+	for _, extend := range union.Extends {
+		wireValues = append(wireValues, objectWireValues(types[extend.TypeId].Shape.Object, types)...)
+	}
+	for _, property := range union.BaseProperties {
+		wireValues = append(wireValues, property.Name.WireValue)
+	}
+	return wireValues
+}
+
+// requiresExtraProperties returns true if any of the given types captures its extra
+// properties, either because they're enabled for every object or because the object
+// declares them.
+func requiresExtraProperties(types map[ir.TypeId]*ir.TypeDeclaration, enableExtraProperties bool) bool {
+	if enableExtraProperties {
+		return true
+	}
+	for _, typeDeclaration := range types {
+		if object := typeDeclaration.Shape.Object; object != nil && object.ExtraProperties {
+			return true
+		}
+	}
+	return false
+}
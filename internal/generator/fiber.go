@@ -1,173 +1,132 @@
 package generator
 
 import (
-	_ "embed"
 	"fmt"
 	"strings"
 
 	"github.com/fern-api/fern-go/internal/fern/ir"
 )
 
-// WriteFiberRequestType writes a Fiber-compatible type dedicated to the in-lined request (if any).
-// This allows QueryParser to correctly interpret generated query parameter types.
-func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoint *ir.HttpEndpoint, includeGenericOptionals bool) error {
-	var (
-		// At this point, we've already verified that the given endpoint's request
-		// is a wrapper, so we can safely access it without any nil-checks.
-		bodyField  = endpoint.SdkRequest.Shape.Wrapper.BodyKey.PascalCase.UnsafeName
-		typeName   = endpoint.SdkRequest.Shape.Wrapper.WrapperName.PascalCase.UnsafeName
-		receiver   = typeNameToReceiver(typeName)
-		importPath = fernFilepathToImportPath(f.baseImportPath, fernFilepath)
-	)
-
-	var literals []*literal
-	f.P("type ", typeName, " struct {")
-	for _, header := range endpoint.Headers {
-		f.WriteDocs(header.Docs)
-		if header.ValueType.Container != nil && header.ValueType.Container.Literal != nil {
-			literals = append(
-				literals,
-				&literal{
-					Name:  header.Name.Name,
-					Value: header.ValueType.Container.Literal,
-				},
-			)
-			continue
-		}
-		goType := typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
-		f.P(header.Name.Name.PascalCase.UnsafeName, " ", goType, " `header:\"", header.Name.Name.OriginalName, "\"`")
-	}
-	for _, queryParam := range endpoint.QueryParameters {
-		value := typeReferenceToGoType(queryParam.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
-		if queryParam.AllowMultiple {
-			value = fmt.Sprintf("[]%s", value)
-		}
-		f.WriteDocs(queryParam.Docs)
-		if queryParam.ValueType.Container != nil && queryParam.ValueType.Container.Literal != nil {
-			literals = append(
-				literals,
-				&literal{
-					Name:  queryParam.Name.Name,
-					Value: queryParam.ValueType.Container.Literal,
-				},
-			)
-			continue
-		}
-		f.P(queryParam.Name.Name.PascalCase.UnsafeName, " ", value, " `query:\"", queryParam.Name.Name.OriginalName, "\"`")
-	}
-	if endpoint.RequestBody == nil {
-		// If the request doesn't have a body, we don't need any custom [de]serialization logic.
-		for _, literal := range literals {
-			f.P(literal.Name.CamelCase.SafeName, " ", literalToGoType(literal.Value))
-		}
-		f.P("}")
-		f.P()
-		for _, literal := range literals {
-			f.P("func (", receiver, " *", typeName, ") ", literal.Name.PascalCase.UnsafeName, "()", literalToGoType(literal.Value), "{")
-			f.P("return ", receiver, ".", literal.Name.CamelCase.SafeName)
-			f.P("}")
-			f.P()
-		}
-		return nil
-	}
-	fieldLiterals, err := requestBodyToFieldDeclaration(endpoint.RequestBody, f, importPath, bodyField, includeGenericOptionals)
-	if err != nil {
-		return err
-	}
-	literals = append(literals, fieldLiterals...)
-	for _, literal := range literals {
-		f.P(literal.Name.CamelCase.SafeName, " ", literalToGoType(literal.Value))
-	}
-	f.P("}")
-	f.P()
-	// Implement the getter methods.
-	for _, literal := range literals {
-		f.P("func (", receiver, " *", typeName, ") ", literal.Name.PascalCase.UnsafeName, "()", literalToGoType(literal.Value), "{")
-		f.P("return ", receiver, ".", literal.Name.CamelCase.SafeName)
-		f.P("}")
-		f.P()
-	}
+const (
+	// fiberImportPath is the import path of the Fiber web framework.
+	fiberImportPath = "github.com/gofiber/fiber/v2"
+	// fiberUtilsImportPath is the import path of Fiber's utilities.
+	fiberUtilsImportPath = "github.com/gofiber/fiber/v2/utils"
+)
 
-	var (
-		referenceType      string
-		referenceIsPointer bool
-		referenceLiteral   string
-	)
-	if reference := endpoint.RequestBody.Reference; reference != nil {
-		referenceType = strings.TrimPrefix(
-			typeReferenceToGoType(reference.RequestBodyType, f.types, f.scope, f.baseImportPath, importPath, false),
-			"*",
-		)
-		referenceIsPointer = reference.RequestBodyType.Named != nil && isPointer(f.types[reference.RequestBodyType.Named.TypeId])
-		if reference.RequestBodyType.Container != nil && reference.RequestBodyType.Container.Literal != nil {
-			referenceLiteral = literalToValue(reference.RequestBodyType.Container.Literal)
-		}
-	}
+// fiberFramework generates handlers for the Fiber web framework.
+type fiberFramework struct{}
 
-	if len(literals) == 0 && len(referenceType) == 0 {
-		// If the request doesn't specify any literals or a reference type,
-		// we don't need to customize the [de]serialization logic at all.
-		return nil
-	}
+func (*fiberFramework) imports() []string {
+	return []string{fiberImportPath, fiberUtilsImportPath}
+}
 
-	// Implement the json.Unmarshaler interface.
-	f.P("func (", receiver, " *", typeName, ") UnmarshalJSON(data []byte) error {")
-	if len(referenceType) > 0 {
-		if referenceIsPointer {
-			f.P("body := new(", referenceType, ")")
-		} else {
-			f.P("var body ", referenceType)
-		}
-	} else {
-		f.P("type unmarshaler ", typeName)
-		f.P("var body unmarshaler")
-	}
-	f.P("if err := json.Unmarshal(data, &body); err != nil {")
-	f.P("return err")
-	f.P("}")
-	if len(referenceType) > 0 {
-		if len(referenceLiteral) > 0 {
-			f.P("if body != ", referenceLiteral, "{")
-			f.P(`return fmt.Errorf("expected literal %q, but found %q", `, referenceLiteral, ", body)")
-			f.P("}")
-		}
-		f.P(receiver, ".", bodyField, " = body")
-	} else {
-		f.P("*", receiver, " = ", typeName, "(body)")
-	}
-	for _, literal := range literals {
-		f.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
-	}
-	f.P("return nil")
-	f.P("}")
-	f.P()
-
-	// Implement the json.Marshaler interface.
-	f.P("func (", receiver, " *", typeName, ") MarshalJSON() ([]byte, error) {")
-	if len(referenceType) > 0 {
-		// If the request body is a reference type, we only need to marshal the body.
-		value := fmt.Sprintf("%s.%s", receiver, bodyField)
-		if len(referenceLiteral) > 0 {
-			value = referenceLiteral
-		}
-		f.P("return json.Marshal(", value, ")")
-	} else {
-		f.P("type embed ", typeName)
-		f.P("var marshaler = struct{")
-		f.P("embed")
-		for _, literal := range literals {
-			f.P(literal.Name.PascalCase.UnsafeName, " ", literalToGoType(literal.Value), " `json:\"", literal.Name.OriginalName, "\"`")
-		}
-		f.P("}{")
-		f.P("embed: embed(*", receiver, "),")
-		for _, literal := range literals {
-			f.P(literal.Name.PascalCase.UnsafeName, ": ", literalToValue(literal.Value), ",")
-		}
-		f.P("}")
-		f.P("return json.Marshal(marshaler)")
+func (*fiberFramework) reserved() []string {
+	return []string{"app", "c"}
+}
+
+func (*fiberFramework) returnsError() bool {
+	return true
+}
+
+func (*fiberFramework) headerTag(wireValue string) string {
+	return fmt.Sprintf("header:%q", wireValue)
+}
+
+func (*fiberFramework) queryTag(queryParam *ir.QueryParameter, _ map[ir.TypeId]*ir.TypeDeclaration) string {
+	return fmt.Sprintf("query:%q", queryParam.Name.WireValue)
+}
+
+func (*fiberFramework) writeRegister(f *fileWriter) {
+	f.P("// Register registers all of the Service's endpoints with the given Fiber router.")
+	f.P("func Register(app fiber.Router, service Service, opts ...core.HandlerOption) {")
+}
+
+func (*fiberFramework) route(method string, path string) string {
+	return fmt.Sprintf("app.Add(%s, %q, func(c *fiber.Ctx) error {", method, path)
+}
+
+func (*fiberFramework) pathParameter(name string) string {
+	return ":" + name
+}
+
+func (*fiberFramework) context() string {
+	return "c.UserContext()"
+}
+
+func (*fiberFramework) pathValue(name string) string {
+	// Fiber's values point into a buffer that's reused once the handler
+	// returns, so they're copied first.
+	return fmt.Sprintf("utils.CopyString(c.Params(%q))", name)
+}
+
+func (*fiberFramework) escapedPathValues() bool {
+	return true
+}
+
+func (*fiberFramework) header(name string) string {
+	return fmt.Sprintf("c.Get(%q)", name)
+}
+
+func (*fiberFramework) formValue(name string) string {
+	return fmt.Sprintf("c.FormValue(%q)", name)
+}
+
+func (*fiberFramework) formFile(name string) string {
+	return fmt.Sprintf("c.FormFile(%q)", name)
+}
+
+func (*fiberFramework) body() string {
+	return "bytes.NewReader(c.Body())"
+}
+
+func (*fiberFramework) writeQuery(f *fileWriter) {
+	f.P("query := make(url.Values)")
+	f.P("c.Context().QueryArgs().VisitAll(func(key, value []byte) {")
+	f.P("query.Add(string(key), string(value))")
+	f.P("})")
+}
+
+func (*fiberFramework) badRequestResponse(format string, args ...string) string {
+	if len(args) == 0 {
+		return fmt.Sprintf("fiber.NewError(fiber.StatusBadRequest, %s)", format)
 	}
-	f.P("}")
-	f.P()
+	return fmt.Sprintf("fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf(%s))", strings.Join(append([]string{format}, args...), ", "))
+}
+
+func (*fiberFramework) errorResponse(err string) string {
+	// Fiber's ErrorHandler is responsible for all of the undeclared errors.
+	return err
+}
+
+func (*fiberFramework) statusResponse(statusCode string) string {
+	return fmt.Sprintf("c.SendStatus(%s)", statusCode)
+}
 
-	return nil
+func (*fiberFramework) jsonResponse(statusCode string, value string) string {
+	return fmt.Sprintf("c.Status(%s).JSON(%s)", statusCode, value)
+}
+
+func (*fiberFramework) writeFile(f *fileWriter, value string) {
+	f.P("c.Set(fiber.HeaderContentType, fiber.MIMEOctetStream)")
+	f.P("return c.SendStream(", value, ")")
+}
+
+func (*fiberFramework) writeText(f *fileWriter, value string) {
+	f.P("c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)")
+	f.P("return c.SendString(", value, ")")
+}
+
+func (*fiberFramework) writeStream(f *fileWriter, delimiter string, call string, writeError func()) {
+	// Fiber writes the response status before the stream, so the stream is
+	// started first, and any errors returned before it starts are written
+	// to the response like all other errors.
+	f.P("response, err := core.StartStream(", fmt.Sprintf("%q", delimiter), ", func(stream *core.StreamWriter) error {")
+	f.P("return ", call)
+	f.P("}, opts...)")
+	f.P("if err != nil {")
+	writeError()
+	f.P("}")
+	f.P("c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)")
+	f.P("return c.SendStream(response)")
 }
@@ -31,6 +31,13 @@ type fileWriter struct {
 	errors         map[ir.ErrorId]*ir.ErrorDeclaration
 	coordinator    *coordinator.Client
 
+	// typeOverrides maps the overridden types (if any) to the
+	// existing Go types used in their place.
+	typeOverrides map[ir.TypeId]*TypeOverride
+
+	// buildTag is the build constraint included in the file, if any.
+	buildTag string
+
 	buffer *bytes.Buffer
 }
 
@@ -39,6 +46,7 @@ func newFileWriter(
 	packageName string,
 	baseImportPath string,
 	types map[ir.TypeId]*ir.TypeDeclaration,
+	typeOverrides map[ir.TypeId]*TypeOverride,
 	errors map[ir.ErrorId]*ir.ErrorDeclaration,
 	coordinator *coordinator.Client,
 ) *fileWriter {
@@ -55,6 +63,7 @@ func newFileWriter(
 	scope.AddImport("io")
 	scope.AddImport("mime/multipart")
 	scope.AddImport("net/http")
+	scope.AddImport("net/http/httptest")
 	scope.AddImport("net/url")
 	scope.AddImport("strconv")
 	scope.AddImport("strings")
@@ -75,6 +84,7 @@ func newFileWriter(
 		baseImportPath: baseImportPath,
 		scope:          scope,
 		types:          types,
+		typeOverrides:  typeOverrides,
 		errors:         errors,
 		coordinator:    coordinator,
 		buffer:         new(bytes.Buffer),
@@ -92,8 +102,12 @@ func (f *fileWriter) P(elements ...any) {
 // File formats and writes the content stored in the writer's buffer into a *File.
 func (f *fileWriter) File() (*File, error) {
 	// Start with the package declaration and import statements.
-	header := newFileWriter(f.filename, f.packageName, f.baseImportPath, f.types, f.errors, f.coordinator)
+	header := newFileWriter(f.filename, f.packageName, f.baseImportPath, f.types, f.typeOverrides, f.errors, f.coordinator)
 	header.P(fileHeader)
+	if f.buildTag != "" {
+		header.P("//go:build ", f.buildTag)
+		header.P()
+	}
 	header.P("package ", f.packageName)
 	header.P("import (")
 	for importDecl, importAlias := range f.scope.Imports.Values {
@@ -128,6 +142,36 @@ func (f *fileWriter) WriteDocs(docs *string) {
 	}
 }
 
+// WriteDocsWithAvailability acts like WriteDocs, but also writes a standard
+// "Deprecated:" paragraph if the given availability is deprecated, so that
+// tools like staticcheck can flag its usage.
+func (f *fileWriter) WriteDocsWithAvailability(docs *string, availability *ir.Availability) {
+	f.WriteDocs(docs)
+	f.writeDeprecated(availability, docs != nil && len(*docs) > 0)
+}
+
+// writeDeprecated writes the "Deprecated:" paragraph for the given availability,
+// if it's deprecated. The paragraph is separated from the preceding documentation,
+// if any.
+func (f *fileWriter) writeDeprecated(availability *ir.Availability, hasDocs bool) {
+	if !isDeprecated(availability) {
+		return
+	}
+	if hasDocs {
+		f.P("//")
+	}
+	message := "This may be removed in a future release."
+	if availability.Message != nil && len(*availability.Message) > 0 {
+		message = *availability.Message
+	}
+	for i, line := range strings.Split(message, "\n") {
+		if i == 0 {
+			line = "Deprecated: " + line
+		}
+		f.P("// " + line)
+	}
+}
+
 // WriteRaw writes the raw string into the file.
 func (f *fileWriter) WriteRaw(s string) {
 	fmt.Fprint(f.buffer, s)
@@ -27,6 +27,7 @@ const (
 	ModeModel = iota + 1
 	ModeClient
 	ModeFiber
+	ModeServer
 )
 
 // Generator represents the Go code generator.
@@ -121,7 +122,7 @@ func (g *Generator) Generate(mode Mode) ([]*File, error) {
 }
 
 func (g *Generator) generateModelTypes(ir *fernir.IntermediateRepresentation, mode Mode, rootPackageName string) ([]*File, error) {
-	fileInfoToTypes, err := fileInfoToTypes(rootPackageName, ir.Types, ir.Services, ir.ServiceTypeReferenceInfo)
+	fileInfoToTypes, err := fileInfoToTypes(rootPackageName, ir.Types, ir.Services, ir.ServiceTypeReferenceInfo, g.config.TypeOverrides)
 	if err != nil {
 		return nil, err
 	}
@@ -132,18 +133,38 @@ func (g *Generator) generateModelTypes(ir *fernir.IntermediateRepresentation, mo
 			fileInfo.packageName,
 			g.config.ImportPath,
 			ir.Types,
+			g.config.TypeOverrides,
 			ir.Errors,
 			g.coordinator,
 		)
 		for _, typeToGenerate := range typesToGenerate {
 			switch {
 			case typeToGenerate.TypeDeclaration != nil:
-				if err := writer.WriteType(typeToGenerate.TypeDeclaration, mode == ModeClient); err != nil {
+				if err := writer.WriteType(
+					typeToGenerate.TypeDeclaration,
+					mode == ModeClient,
+					g.config.EnableForwardCompatibleEnums,
+					g.config.EnableExtraProperties,
+					g.config.EnableGetters,
+					g.equalAndDeepCopy(),
+					g.config.EnableExplicitNullInModels,
+					g.config.EnableReflectionFreeJSON,
+				); err != nil {
 					return nil, err
 				}
 			case typeToGenerate.Endpoint != nil:
-				if mode == ModeFiber {
-					if err := writer.WriteFiberRequestType(typeToGenerate.FernFilepath, typeToGenerate.Endpoint, g.config.EnableExplicitNull); err != nil {
+				if mode == ModeFiber || mode == ModeServer {
+					framework, err := g.serverFramework(mode)
+					if err != nil {
+						return nil, err
+					}
+					if err := writer.WriteServerRequestType(
+						framework,
+						typeToGenerate.FernFilepath,
+						typeToGenerate.Endpoint,
+						g.config.EnableExplicitNull,
+						g.config.EnableGetters,
+					); err != nil {
 						return nil, err
 					}
 				} else if mode == ModeClient {
@@ -152,6 +173,7 @@ func (g *Generator) generateModelTypes(ir *fernir.IntermediateRepresentation, mo
 						typeToGenerate.Endpoint,
 						ir.IdempotencyHeaders,
 						g.config.EnableExplicitNull,
+						g.config.EnableGetters,
 					); err != nil {
 						return nil, err
 					}
@@ -163,6 +185,17 @@ func (g *Generator) generateModelTypes(ir *fernir.IntermediateRepresentation, mo
 			return nil, err
 		}
 		files = append(files, file)
+		if g.config.EnableReflectionFreeJSON {
+			// Verify the reflection-free JSON methods against the
+			// reflection-based implementation.
+			testFile, err := g.generateModelJSONTests(ir, mode, fileInfo, typesToGenerate)
+			if err != nil {
+				return nil, err
+			}
+			if testFile != nil {
+				files = append(files, testFile)
+			}
+		}
 	}
 	return files, nil
 }
@@ -185,6 +218,12 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			}
 		}
 	}
+	if g.config.BetaEndpoints == BetaEndpointsExclude {
+		excludeBetaEndpoints(ir)
+	}
+	if err := applyTypeOverrides(ir, g.config.TypeOverrides); err != nil {
+		return nil, err
+	}
 	rootPackageName := getRootPackageName(ir, g.config.PackageName)
 	cycleInfo, err := cycleInfoFromIR(ir, g.config.ImportPath)
 	if err != nil {
@@ -222,14 +261,14 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 	// First determine what types will be generated so that we can determine whether or not there will
 	// be any conflicts.
 	var (
-		generatedNames    = generatedNamesFromIR(ir)
+		generatedNames    = generatedNamesFromIR(ir, g.config.TypeOverrides)
 		generatedPackages = generatedPackagesFromIR(ir)
 	)
 	var files []*File
 	// Write all of the package-level documentation, if any (i.e. in a doc.go file).
 	if ir.RootPackage != nil && ir.RootPackage.Docs != nil && len(*ir.RootPackage.Docs) > 0 {
 		fileInfo := fileInfoForPackage(rootPackageName, ir.RootPackage.FernFilepath)
-		writer := newFileWriter(fileInfo.filename, fileInfo.packageName, "", nil, nil, g.coordinator)
+		writer := newFileWriter(fileInfo.filename, fileInfo.packageName, "", nil, nil, nil, g.coordinator)
 		writer.WriteDocs(ir.RootPackage.Docs)
 		files = append(files, writer.DocsFile())
 	}
@@ -238,7 +277,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			continue
 		}
 		fileInfo := fileInfoForPackage(rootPackageName, subpackage.FernFilepath)
-		writer := newFileWriter(fileInfo.filename, fileInfo.packageName, "", nil, nil, g.coordinator)
+		writer := newFileWriter(fileInfo.filename, fileInfo.packageName, "", nil, nil, nil, g.coordinator)
 		writer.WriteDocs(subpackage.Docs)
 		files = append(files, writer.DocsFile())
 	}
@@ -250,11 +289,40 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 	}
 	files = append(files, modelFiles...)
 	files = append(files, newStringerFile(g.coordinator))
+	files = append(files, newValidationFile(g.coordinator))
+	files = append(files, newTimeFile(g.coordinator))
+	files = append(files, newJSONFile(g.coordinator))
+	files = append(files, newUnionFile(g.coordinator))
+	if requiresExtraProperties(ir.Types, g.config.EnableExtraProperties) {
+		files = append(files, newExtraPropertiesFile(g.coordinator))
+	}
+	if g.equalAndDeepCopy() {
+		files = append(files, newEqualFile(g.coordinator))
+	}
+	if g.config.EnableReflectionFreeJSON {
+		files = append(files, newJSONReaderFile(g.coordinator))
+		files = append(files, newJSONReaderTestFile(g.coordinator))
+		files = append(files, newJSONWriterFile(g.coordinator))
+		files = append(files, newJSONWriterTestFile(g.coordinator))
+	}
+	// Generate the Optional[T] constructors, which are used by the request types
+	// (and models) with explicit null support.
+	if g.config.EnableExplicitNullInModels || (g.config.EnableExplicitNull && mode != ModeModel) {
+		optionalFiles, err := g.generateOptionalFiles(ir, rootPackageName, generatedNames, generatedPackages)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, optionalFiles...)
+	}
 	// Then handle mode-specific generation tasks.
 	var generatedClient *GeneratedClient
 	switch mode {
-	case ModeFiber:
-		break
+	case ModeFiber, ModeServer:
+		serverFiles, err := g.generateServer(ir, mode, rootPackageName, generatedNames, generatedPackages)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, serverFiles...)
 	case ModeClient:
 		var (
 			generatedAuth        *GeneratedAuth
@@ -267,12 +335,14 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			fileInfo.packageName,
 			g.config.ImportPath,
 			ir.Types,
+			g.config.TypeOverrides,
 			ir.Errors,
 			g.coordinator,
 		)
 		if err := writer.WriteRequestOptionsDefinition(
 			ir.Auth,
 			ir.Headers,
+			clientPathParametersFromIR(ir),
 			ir.IdempotencyHeaders,
 			ir.SdkConfig,
 			g.config.ModuleConfig,
@@ -293,6 +363,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				fileInfo.packageName,
 				g.config.ImportPath,
 				ir.Types,
+				g.config.TypeOverrides,
 				ir.Errors,
 				g.coordinator,
 			)
@@ -313,10 +384,11 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			fileInfo.packageName,
 			g.config.ImportPath,
 			ir.Types,
+			g.config.TypeOverrides,
 			ir.Errors,
 			g.coordinator,
 		)
-		generatedAuth, err = writer.WriteRequestOptions(ir.Auth, ir.Headers)
+		generatedAuth, err = writer.WriteRequestOptions(ir.Auth, ir.Headers, clientPathParametersFromIR(ir))
 		if err != nil {
 			return nil, err
 		}
@@ -332,6 +404,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				fileInfo.packageName,
 				g.config.ImportPath,
 				ir.Types,
+				g.config.TypeOverrides,
 				ir.Errors,
 				g.coordinator,
 			)
@@ -349,6 +422,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				fileInfo.packageName,
 				g.config.ImportPath,
 				ir.Types,
+				g.config.TypeOverrides,
 				ir.Errors,
 				g.coordinator,
 			)
@@ -369,6 +443,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				fileInfo.packageName,
 				g.config.ImportPath,
 				ir.Types,
+				g.config.TypeOverrides,
 				ir.Errors,
 				g.coordinator,
 			)
@@ -381,34 +456,26 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			}
 			files = append(files, file)
 		}
-		// Generate the Optional[T] constructors.
-		fileInfo, useCore := fileInfoForOptionalHelpers(rootPackageName, generatedNames, generatedPackages)
-		writer = newFileWriter(
-			fileInfo.filename,
-			fileInfo.packageName,
-			g.config.ImportPath,
-			ir.Types,
-			ir.Errors,
-			g.coordinator,
-		)
-		if g.config.EnableExplicitNull {
-			if err := writer.WriteOptionalHelpers(useCore); err != nil {
-				return nil, err
-			}
-			file, err = writer.File()
-			if err != nil {
-				return nil, err
-			}
-			files = append(files, file)
-			files = append(files, newOptionalFile(g.coordinator))
-			files = append(files, newOptionalTestFile(g.coordinator))
-		}
+		files = append(files, newAPIErrorFile(g.coordinator))
 		files = append(files, newCoreFile(g.coordinator))
 		files = append(files, newCoreTestFile(g.coordinator))
+		files = append(files, newCompressionFile(g.coordinator))
+		files = append(files, newCompressionTestFile(g.coordinator))
 		files = append(files, newPointerFile(g.coordinator, rootPackageName, generatedNames))
+		files = append(files, newQueryFile(g.coordinator))
+		files = append(files, newQueryTestFile(g.coordinator))
 		files = append(files, newRetrierFile(g.coordinator))
+		files = append(files, newTimeoutFile(g.coordinator))
+		files = append(files, newTimeoutTestFile(g.coordinator))
+		files = append(files, newCacheFile(g.coordinator))
+		files = append(files, newCacheTestFile(g.coordinator))
 		if ir.SdkConfig.HasStreamingEndpoints {
 			files = append(files, newStreamFile(g.coordinator))
+			files = append(files, newStreamTestFile(g.coordinator))
+		}
+		if g.config.EnableStreamingRequestBodies {
+			files = append(files, newRequestStreamFile(g.coordinator))
+			files = append(files, newRequestStreamTestFile(g.coordinator))
 		}
 		clientTestFile, err := newClientTestFile(g.config.ImportPath, g.coordinator)
 		if err != nil {
@@ -422,6 +489,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				fileInfo.packageName,
 				g.config.ImportPath,
 				ir.Types,
+				g.config.TypeOverrides,
 				ir.Errors,
 				g.coordinator,
 			)
@@ -449,7 +517,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				rootSubpackages = append(rootSubpackages, subpackage)
 			}
 			if ir.RootPackage.Service != nil {
-				file, generatedClient, err = g.generateService(
+				serviceFiles, client, err := g.generateService(
 					ir,
 					ir.Services[*ir.RootPackage.Service],
 					rootSubpackages,
@@ -460,7 +528,8 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				if err != nil {
 					return nil, err
 				}
-				files = append(files, file)
+				files = append(files, serviceFiles...)
+				generatedClient = client
 			} else {
 				file, generatedClient, err = g.generateRootServiceWithoutEndpoints(
 					ir,
@@ -475,6 +544,15 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				files = append(files, file)
 			}
 		}
+		if ir.RootPackage != nil {
+			// The root client can also call endpoints that aren't in the API
+			// definition yet.
+			file, err := g.generateRootClientDo(ir)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, file)
+		}
 		// Then generate the client for all of the subpackages.
 		for _, subpackageToGenerate := range subpackagesToGenerate {
 			irSubpackage := subpackageToGenerate.Subpackage
@@ -511,7 +589,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				continue
 			}
 			// This service has endpoints, so we proceed with the normal flow.
-			file, _, err := g.generateService(
+			serviceFiles, _, err := g.generateService(
 				ir,
 				ir.Services[*irSubpackage.Service],
 				subpackages,
@@ -522,7 +600,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			if err != nil {
 				return nil, err
 			}
-			files = append(files, file)
+			files = append(files, serviceFiles...)
 		}
 	}
 	// Finally, generate the go.mod file, if needed.
@@ -530,15 +608,17 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 	// The go.sum file will be generated after the
 	// go.mod file is written to disk.
 	if g.config.ModuleConfig != nil {
-		requiresGenerics := g.config.EnableExplicitNull || ir.SdkConfig.HasStreamingEndpoints
-		file, generatedGoVersion, err := NewModFile(g.coordinator, g.config.ModuleConfig, requiresGenerics)
+		requiresGenerics := g.config.EnableExplicitNull || g.config.EnableExplicitNullInModels || ir.SdkConfig.HasStreamingEndpoints || g.config.EnableStreamingRequestBodies
+		// Only the client core depends on the compression module.
+		requiresCompression := mode == ModeClient
+		file, generatedGoVersion, err := NewModFile(g.coordinator, g.config.ModuleConfig, requiresGenerics, requiresCompression)
 		if err != nil {
 			return nil, err
 		}
 		files = append(files, file)
 
 		if g.config.IncludeReadme {
-			if err := g.generateReadme(generatedClient, generatedGoVersion); err != nil {
+			if err := g.generateReadme(ir, generatedClient, generatedGoVersion); err != nil {
 				return nil, err
 			}
 			files = append(files, file)
@@ -547,13 +627,91 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 	return files, nil
 }
 
+// equalAndDeepCopy returns true if the model types include Equal and DeepCopy
+// methods. The reflection-free JSON tests compare values with Equal, so they're
+// always included with reflection-free JSON.
+func (g *Generator) equalAndDeepCopy() bool {
+	return g.config.EnableEqualAndDeepCopy || g.config.EnableReflectionFreeJSON
+}
+
+// generateModelJSONTests generates the JSON tests for the given file's types, if any.
+func (g *Generator) generateModelJSONTests(
+	ir *fernir.IntermediateRepresentation,
+	mode Mode,
+	fileInfo fileInfo,
+	typesToGenerate []*typeToGenerate,
+) (*File, error) {
+	writer := newFileWriter(
+		strings.TrimSuffix(fileInfo.filename, ".go")+"_json_test.go",
+		fileInfo.packageName,
+		g.config.ImportPath,
+		ir.Types,
+		g.config.TypeOverrides,
+		ir.Errors,
+		g.coordinator,
+	)
+	var hasTests bool
+	for _, typeToGenerate := range typesToGenerate {
+		if typeToGenerate.TypeDeclaration == nil {
+			continue
+		}
+		if writer.WriteTypeJSONTests(
+			typeToGenerate.TypeDeclaration,
+			mode == ModeClient,
+			g.config.EnableForwardCompatibleEnums,
+			g.config.EnableExtraProperties,
+			g.config.EnableExplicitNullInModels,
+		) {
+			hasTests = true
+		}
+	}
+	if !hasTests {
+		return nil, nil
+	}
+	return writer.File()
+}
+
+// generateOptionalFiles generates the Optional[T] constructors, as well as the
+// core.Optional type they depend on.
+func (g *Generator) generateOptionalFiles(
+	ir *fernir.IntermediateRepresentation,
+	rootPackageName string,
+	generatedNames map[string]struct{},
+	generatedPackages map[string]struct{},
+) ([]*File, error) {
+	fileInfo, useCore := fileInfoForOptionalHelpers(rootPackageName, generatedNames, generatedPackages)
+	writer := newFileWriter(
+		fileInfo.filename,
+		fileInfo.packageName,
+		g.config.ImportPath,
+		ir.Types,
+		g.config.TypeOverrides,
+		ir.Errors,
+		g.coordinator,
+	)
+	if err := writer.WriteOptionalHelpers(useCore); err != nil {
+		return nil, err
+	}
+	file, err := writer.File()
+	if err != nil {
+		return nil, err
+	}
+	return []*File{
+		file,
+		newOptionalFile(g.coordinator),
+		newOptionalTestFile(g.coordinator),
+	}, nil
+}
+
 // generateReadme generates a README.md file for a generated Go module, called
 // if a module config was provided.
 //
 // Parameters:
+//   - ir: The IR used to list the deprecated and beta endpoints, if any.
 //   - generatedClient: The generated client, if any.
 //   - generatedGoVersion: The Go version that the generated client supports.
 func (g *Generator) generateReadme(
+	ir *fernir.IntermediateRepresentation,
 	generatedClient *GeneratedClient,
 	generatedGoVersion string,
 ) (err error) {
@@ -573,6 +731,7 @@ func (g *Generator) generateReadme(
 		}
 		usage = "```go\n" + usage + "\n```\n"
 	}
+	usage += readmeAvailability(ir, g.config.BetaEndpoints)
 
 	return g.coordinator.GenerateReadme(
 		&generatorexec.GenerateReadmeRequest{
@@ -599,19 +758,27 @@ func (g *Generator) generateService(
 	generatedAuth *GeneratedAuth,
 	generatedEnvironment *GeneratedEnvironment,
 	originalFernFilepath *fernir.FernFilepath,
-) (*File, *GeneratedClient, error) {
+) ([]*File, *GeneratedClient, error) {
+	irEndpoints := irService.Endpoints
+	var betaEndpoints []*fernir.HttpEndpoint
+	if g.config.BetaEndpoints == BetaEndpointsBuildTag {
+		irEndpoints, betaEndpoints = splitBetaEndpoints(irEndpoints)
+	}
 	fileInfo := fileInfoForService(irService.Name.FernFilepath)
 	writer := newFileWriter(
 		fileInfo.filename,
 		fileInfo.packageName,
 		g.config.ImportPath,
 		ir.Types,
+		g.config.TypeOverrides,
 		ir.Errors,
 		g.coordinator,
 	)
 	generatedClient, err := writer.WriteClient(
-		irService.Endpoints,
+		irEndpoints,
 		ir.IdempotencyHeaders,
+		clientPathParametersFromIR(ir),
+		g.config.EnableStreamingRequestBodies,
 		irSubpackages,
 		ir.Environments,
 		ir.ErrorDiscriminationStrategy,
@@ -626,7 +793,140 @@ func (g *Generator) generateService(
 	if err != nil {
 		return nil, nil, err
 	}
-	return file, generatedClient, nil
+	files := []*File{file}
+	if len(betaEndpoints) > 0 {
+		// The beta endpoints are written in a separate file (e.g. client/client_beta.go)
+		// so that they're only compiled with the beta build tag.
+		betaWriter := newFileWriter(
+			strings.TrimSuffix(fileInfo.filename, ".go")+"_beta.go",
+			fileInfo.packageName,
+			g.config.ImportPath,
+			ir.Types,
+			g.config.TypeOverrides,
+			ir.Errors,
+			g.coordinator,
+		)
+		betaWriter.buildTag = betaBuildTag
+		if err := betaWriter.WriteClientEndpoints(
+			betaEndpoints,
+			ir.IdempotencyHeaders,
+			clientPathParametersFromIR(ir),
+			g.config.EnableStreamingRequestBodies,
+			ir.Environments,
+			ir.ErrorDiscriminationStrategy,
+			originalFernFilepath,
+		); err != nil {
+			return nil, nil, err
+		}
+		betaFile, err := betaWriter.File()
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, betaFile)
+	}
+	return files, generatedClient, nil
+}
+
+// serverFramework returns the framework targeted by the server generated in the given mode.
+func (g *Generator) serverFramework(mode Mode) (serverFramework, error) {
+	if mode == ModeFiber {
+		return new(fiberFramework), nil
+	}
+	return newServerFramework(g.config.Framework)
+}
+
+// generateServer generates the server interfaces and handlers for every service,
+// as well as the core utilities and error types they depend on.
+func (g *Generator) generateServer(
+	ir *fernir.IntermediateRepresentation,
+	mode Mode,
+	rootPackageName string,
+	generatedNames map[string]struct{},
+	generatedPackages map[string]struct{},
+) ([]*File, error) {
+	var files []*File
+	framework, err := g.serverFramework(mode)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := framework.(*netHTTPFramework); ok {
+		// The net/http server is routed with the generated core.Router.
+		files = append(files, newRouterFile(g.coordinator))
+		files = append(files, newRouterTestFile(g.coordinator))
+	}
+	// The server only depends on the handler helpers, not the client's core.
+	files = append(files, newAPIErrorFile(g.coordinator))
+	files = append(files, newHandlerFile(g.coordinator))
+	files = append(files, newHandlerTestFile(g.coordinator))
+	files = append(files, newPointerFile(g.coordinator, rootPackageName, generatedNames))
+	// Generate the error types, if any.
+	for fileInfo, irErrors := range fileInfoToErrors(rootPackageName, ir.Errors) {
+		writer := newFileWriter(
+			fileInfo.filename,
+			fileInfo.packageName,
+			g.config.ImportPath,
+			ir.Types,
+			g.config.TypeOverrides,
+			ir.Errors,
+			g.coordinator,
+		)
+		for _, irError := range irErrors {
+			if err := writer.WriteError(irError); err != nil {
+				return nil, err
+			}
+		}
+		file, err := writer.File()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	// Nested root packages deposit their server in a server subpackage
+	// (e.g. user/server), just like the client.
+	nestedRootServices := make(map[fernir.ServiceId]struct{})
+	for _, irSubpackage := range ir.Subpackages {
+		if len(irSubpackage.Subpackages) > 0 && irSubpackage.FernFilepath.File != nil && irSubpackage.Service != nil {
+			nestedRootServices[*irSubpackage.Service] = struct{}{}
+		}
+	}
+	// Generate a server for every service that defines endpoints.
+	for serviceID, irService := range ir.Services {
+		if len(irService.Endpoints) == 0 {
+			continue
+		}
+		serverFilepath := irService.Name.FernFilepath
+		if _, ok := nestedRootServices[serviceID]; ok {
+			serverFilepath = &fernir.FernFilepath{
+				AllParts:    serverFilepath.AllParts,
+				PackagePath: append(serverFilepath.PackagePath, serverFilepath.File),
+				File:        nil,
+			}
+		}
+		fileInfo := fileInfoForServer(serverFilepath)
+		writer := newFileWriter(
+			fileInfo.filename,
+			fileInfo.packageName,
+			g.config.ImportPath,
+			ir.Types,
+			g.config.TypeOverrides,
+			ir.Errors,
+			g.coordinator,
+		)
+		if err := writer.WriteServer(
+			framework,
+			irService.Endpoints,
+			ir.ErrorDiscriminationStrategy,
+			irService.Name.FernFilepath,
+		); err != nil {
+			return nil, err
+		}
+		file, err := writer.File()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
 }
 
 // generateServiceWithoutEndpoints is behaviorally similar to g.generateService, but
@@ -646,12 +946,15 @@ func (g *Generator) generateServiceWithoutEndpoints(
 		fileInfo.packageName,
 		g.config.ImportPath,
 		ir.Types,
+		g.config.TypeOverrides,
 		ir.Errors,
 		g.coordinator,
 	)
 	if _, err := writer.WriteClient(
 		nil,
 		ir.IdempotencyHeaders,
+		clientPathParametersFromIR(ir),
+		g.config.EnableStreamingRequestBodies,
 		irSubpackages,
 		nil,
 		ir.ErrorDiscriminationStrategy,
@@ -664,6 +967,25 @@ func (g *Generator) generateServiceWithoutEndpoints(
 	return writer.File()
 }
 
+// generateRootClientDo generates the root client's Do methods in a separate
+// file (i.e. client/do.go), alongside the root client itself.
+func (g *Generator) generateRootClientDo(ir *fernir.IntermediateRepresentation) (*File, error) {
+	fileInfo := fileInfoForService(ir.RootPackage.FernFilepath)
+	writer := newFileWriter(
+		filepath.Join(filepath.Dir(fileInfo.filename), "do.go"),
+		fileInfo.packageName,
+		g.config.ImportPath,
+		ir.Types,
+		g.config.TypeOverrides,
+		ir.Errors,
+		g.coordinator,
+	)
+	if err := writer.WriteClientDo(ir.Environments, ir.SdkConfig.HasStreamingEndpoints); err != nil {
+		return nil, err
+	}
+	return writer.File()
+}
+
 // generateRootServiceWithoutEndpoints is behaviorally similar to g.generateService, but
 // it's suited to write purely intermediary services (i.e. those that don't include
 // any endpoints) for the root package.
@@ -680,12 +1002,15 @@ func (g *Generator) generateRootServiceWithoutEndpoints(
 		fileInfo.packageName,
 		g.config.ImportPath,
 		ir.Types,
+		g.config.TypeOverrides,
 		ir.Errors,
 		g.coordinator,
 	)
 	generatedClient, err := writer.WriteClient(
 		nil,
 		ir.IdempotencyHeaders,
+		clientPathParametersFromIR(ir),
+		g.config.EnableStreamingRequestBodies,
 		irSubpackages,
 		nil,
 		ir.ErrorDiscriminationStrategy,
@@ -782,6 +1107,7 @@ func newClientTestFile(
 		baseImportPath,
 		nil,
 		nil,
+		nil,
 		coordinator,
 	)
 	f.WriteRaw(clientTestFile)
@@ -796,6 +1122,14 @@ func newCoreFile(coordinator *coordinator.Client) *File {
 	)
 }
 
+func newAPIErrorFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/api_error.go",
+		[]byte(apiErrorFile),
+	)
+}
+
 func newCoreTestFile(coordinator *coordinator.Client) *File {
 	return NewFile(
 		coordinator,
@@ -804,6 +1138,70 @@ func newCoreTestFile(coordinator *coordinator.Client) *File {
 	)
 }
 
+func newCompressionFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/compression.go",
+		[]byte(compressionFile),
+	)
+}
+
+func newCompressionTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/compression_test.go",
+		[]byte(compressionTestFile),
+	)
+}
+
+func newRequestStreamFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/request_stream.go",
+		[]byte(requestStreamFile),
+	)
+}
+
+func newRequestStreamTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/request_stream_test.go",
+		[]byte(requestStreamTestFile),
+	)
+}
+
+func newHandlerFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/handler.go",
+		[]byte(handlerFile),
+	)
+}
+
+func newHandlerTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/handler_test.go",
+		[]byte(handlerTestFile),
+	)
+}
+
+func newRouterFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/router.go",
+		[]byte(routerFile),
+	)
+}
+
+func newRouterTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/router_test.go",
+		[]byte(routerTestFile),
+	)
+}
+
 func newOptionalFile(coordinator *coordinator.Client) *File {
 	return NewFile(
 		coordinator,
@@ -820,6 +1218,22 @@ func newOptionalTestFile(coordinator *coordinator.Client) *File {
 	)
 }
 
+func newQueryFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/query.go",
+		[]byte(queryFile),
+	)
+}
+
+func newQueryTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/query_test.go",
+		[]byte(queryTestFile),
+	)
+}
+
 func newStreamFile(coordinator *coordinator.Client) *File {
 	return NewFile(
 		coordinator,
@@ -828,6 +1242,46 @@ func newStreamFile(coordinator *coordinator.Client) *File {
 	)
 }
 
+func newStreamTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/stream_test.go",
+		[]byte(streamTestFile),
+	)
+}
+
+func newTimeoutFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/timeout.go",
+		[]byte(timeoutFile),
+	)
+}
+
+func newTimeoutTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/timeout_test.go",
+		[]byte(timeoutTestFile),
+	)
+}
+
+func newCacheFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/cache.go",
+		[]byte(cacheFile),
+	)
+}
+
+func newCacheTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/cache_test.go",
+		[]byte(cacheTestFile),
+	)
+}
+
 func newRetrierFile(coordinator *coordinator.Client) *File {
 	return NewFile(
 		coordinator,
@@ -844,6 +1298,86 @@ func newStringerFile(coordinator *coordinator.Client) *File {
 	)
 }
 
+func newValidationFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/validation.go",
+		[]byte(validationFile),
+	)
+}
+
+func newTimeFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/time.go",
+		[]byte(timeFile),
+	)
+}
+
+func newJSONFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/json.go",
+		[]byte(jsonFile),
+	)
+}
+
+func newUnionFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/union.go",
+		[]byte(unionFile),
+	)
+}
+
+func newExtraPropertiesFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/extra_properties.go",
+		[]byte(extraPropertiesFile),
+	)
+}
+
+func newEqualFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/equal.go",
+		[]byte(equalFile),
+	)
+}
+
+func newJSONReaderFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/json_reader.go",
+		[]byte(jsonReaderFile),
+	)
+}
+
+func newJSONReaderTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/json_reader_test.go",
+		[]byte(jsonReaderTestFile),
+	)
+}
+
+func newJSONWriterFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/json_writer.go",
+		[]byte(jsonWriterFile),
+	)
+}
+
+func newJSONWriterTestFile(coordinator *coordinator.Client) *File {
+	return NewFile(
+		coordinator,
+		"core/json_writer_test.go",
+		[]byte(jsonWriterTestFile),
+	)
+}
+
 type fileInfo struct {
 	filename    string
 	packageName string
@@ -972,9 +1506,12 @@ func fileInfoForPackage(rootPackageName string, fernFilepath *fernir.FernFilepat
 	}
 }
 
-func generatedNamesFromIR(ir *fernir.IntermediateRepresentation) map[string]struct{} {
+func generatedNamesFromIR(ir *fernir.IntermediateRepresentation, typeOverrides map[fernir.TypeId]*TypeOverride) map[string]struct{} {
 	generatedNames := make(map[string]struct{})
 	for _, irType := range ir.Types {
+		if _, ok := typeOverrides[irType.Name.TypeId]; ok {
+			continue
+		}
 		generatedNames[irType.Name.Name.PascalCase.UnsafeName] = struct{}{}
 	}
 	for _, irError := range ir.Errors {
@@ -1047,11 +1584,13 @@ type typeToGenerate struct {
 }
 
 // fileInfoToTypes consolidates all of the given types based on the file they will be generated into.
+// The overridden types are skipped because they're already defined elsewhere.
 func fileInfoToTypes(
 	rootPackageName string,
 	irTypes map[fernir.TypeId]*fernir.TypeDeclaration,
 	irServices map[fernir.ServiceId]*fernir.HttpService,
 	irServiceTypeReferenceInfo *fernir.ServiceTypeReferenceInfo,
+	typeOverrides map[fernir.TypeId]*TypeOverride,
 ) (map[fileInfo][]*typeToGenerate, error) {
 	result := make(map[fileInfo][]*typeToGenerate)
 	for _, irService := range irServices {
@@ -1067,6 +1606,9 @@ func fileInfoToTypes(
 		// If the service type reference info isn't provided, default
 		// to the file-per-type naming convention.
 		for _, irType := range irTypes {
+			if _, ok := typeOverrides[irType.Name.TypeId]; ok {
+				continue
+			}
 			fileInfo := fileInfoForType(rootPackageName, irType.Name.FernFilepath)
 			result[fileInfo] = append(result[fileInfo], &typeToGenerate{ID: irType.Name.TypeId, FernFilepath: irType.Name.FernFilepath, TypeDeclaration: irType})
 		}
@@ -1085,6 +1627,9 @@ func fileInfoToTypes(
 			sharedTypes = append(sharedTypes, typeIds...)
 		}
 		for _, sharedTypeId := range sharedTypes {
+			if _, ok := typeOverrides[sharedTypeId]; ok {
+				continue
+			}
 			typeDeclaration, ok := irTypes[sharedTypeId]
 			if !ok {
 				// Should be unreachable.
@@ -1137,6 +1682,9 @@ func fileInfoToTypes(
 				packageName: servicePackageName,
 			}
 			for _, typeId := range typeIds {
+				if _, ok := typeOverrides[typeId]; ok {
+					continue
+				}
 				typeDeclaration, ok := irTypes[typeId]
 				if !ok {
 					// Should be unreachable.
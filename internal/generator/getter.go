@@ -0,0 +1,299 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// structField is a single field of a generated struct, which is used to write its
+// methods (e.g. a nil-safe Get<Field> method, similar to the getters generated for
+// protobuf messages).
+type structField struct {
+	name      string // e.g. "Name"
+	wireValue string // e.g. "name"
+	goType    string // e.g. "*string"
+	valueType *ir.TypeReference
+}
+
+// structFieldsForObject returns the structFields for all of the given object's
+// properties, including the extended properties (if any). Literals are excluded
+// because they already have their own getter.
+func (f *fileWriter) structFieldsForObject(
+	object *ir.ObjectTypeDeclaration,
+	importPath string,
+	includeOptionals bool,
+) []*structField {
+	var fields []*structField
+	for _, extend := range object.Extends {
+		fields = append(fields, f.structFieldsForObject(f.types[extend.TypeId].Shape.Object, importPath, includeOptionals)...)
+	}
+	for _, property := range object.Properties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(
+			fields,
+			&structField{
+				name:      property.Name.Name.PascalCase.UnsafeName,
+				wireValue: property.Name.WireValue,
+				goType:    typeReferenceToGoType(property.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, includeOptionals),
+				valueType: property.ValueType,
+			},
+		)
+	}
+	return fields
+}
+
+// structFieldsForUnion returns the structFields for all of the given union's fields,
+// i.e. its discriminant, extended and base properties, and each of its types.
+// Literals are excluded because they already have their own getter.
+func (t *typeVisitor) structFieldsForUnion(union *ir.UnionTypeDeclaration) []*structField {
+	discriminantName := union.Discriminant.Name.PascalCase.UnsafeName
+	fields := []*structField{
+		{
+			name:      discriminantName,
+			wireValue: union.Discriminant.WireValue,
+			goType:    "string",
+			valueType: &ir.TypeReference{Primitive: ir.PrimitiveTypeString},
+		},
+	}
+	fields = append(fields, t.unionBaseFields(union)...)
+	for _, unionType := range union.Types {
+		var valueType *ir.TypeReference
+		switch unionType.Shape.PropertiesType {
+		case "samePropertiesAsObject":
+			valueType = &ir.TypeReference{Named: unionType.Shape.SamePropertiesAsObject}
+		case "singleProperty":
+			if unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
+				continue
+			}
+			valueType = unionType.Shape.SingleProperty.Type
+		}
+		fields = append(
+			fields,
+			&structField{
+				name:      unionType.DiscriminantValue.Name.PascalCase.UnsafeName,
+				goType:    singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath),
+				valueType: valueType,
+			},
+		)
+	}
+	return fields
+}
+
+// unionBaseFields returns the structFields for all of the given union's extended
+// and base properties. Literals are excluded because they're always set.
+func (t *typeVisitor) unionBaseFields(union *ir.UnionTypeDeclaration) []*structField {
+	var fields []*structField
+	for _, extend := range union.Extends {
+		fields = append(fields, t.writer.structFieldsForObject(t.writer.types[extend.TypeId].Shape.Object, t.importPath, t.includeOptionals)...)
+	}
+	for _, property := range union.BaseProperties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(
+			fields,
+			&structField{
+				name:      property.Name.Name.PascalCase.UnsafeName,
+				wireValue: property.Name.WireValue,
+				goType:    typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, t.includeOptionals),
+				valueType: property.ValueType,
+			},
+		)
+	}
+	return fields
+}
+
+// structFieldsForRequest returns the structFields for all of the given endpoint's
+// in-lined request fields, i.e. its headers, query parameters, and body.
+func (f *fileWriter) structFieldsForRequest(
+	endpoint *ir.HttpEndpoint,
+	importPath string,
+	bodyField string,
+	includeOptionals bool,
+) []*structField {
+	var fields []*structField
+	for _, header := range endpoint.Headers {
+		if header.ValueType.Container != nil && header.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(
+			fields,
+			&structField{
+				name:      header.Name.Name.PascalCase.UnsafeName,
+				goType:    typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false),
+				valueType: header.ValueType,
+			},
+		)
+	}
+	for _, queryParam := range endpoint.QueryParameters {
+		if queryParam.ValueType.Container != nil && queryParam.ValueType.Container.Literal != nil {
+			continue
+		}
+		goType := typeReferenceToGoType(queryParam.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
+		if queryParam.AllowMultiple {
+			goType = "[]" + goType
+		}
+		fields = append(
+			fields,
+			&structField{
+				name:      queryParam.Name.Name.PascalCase.UnsafeName,
+				goType:    goType,
+				valueType: queryParam.ValueType,
+			},
+		)
+	}
+	requestBody := endpoint.RequestBody
+	if requestBody == nil {
+		return fields
+	}
+	switch {
+	case requestBody.InlinedRequestBody != nil:
+		object := inlinedRequestBodyToObjectTypeDeclaration(requestBody.InlinedRequestBody)
+		fields = append(fields, f.structFieldsForObject(object, importPath, includeOptionals)...)
+	case requestBody.Reference != nil:
+		fields = append(
+			fields,
+			&structField{
+				name:      bodyField,
+				goType:    typeReferenceToGoType(requestBody.Reference.RequestBodyType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false),
+				valueType: requestBody.Reference.RequestBodyType,
+			},
+		)
+	case requestBody.FileUpload != nil:
+		var bodyProperties []*ir.InlinedRequestBodyProperty
+		for _, property := range requestBody.FileUpload.Properties {
+			if bodyProperty := property.BodyProperty; bodyProperty != nil {
+				bodyProperties = append(bodyProperties, bodyProperty)
+			}
+		}
+		object := inlinedRequestBodyPropertiesToObjectTypeDeclaration(bodyProperties)
+		fields = append(fields, f.structFieldsForObject(object, importPath, includeOptionals)...)
+	case requestBody.Bytes != nil:
+		fields = append(
+			fields,
+			&structField{
+				name:   bodyField,
+				goType: "[]byte",
+			},
+		)
+	}
+	return fields
+}
+
+// writeGetters writes a Get<Field> method for each of the given fields. Every
+// getter returns the field's zero value for a nil receiver, and optional values
+// are dereferenced (unless they're an object or union, in which case the getters
+// can be chained instead), e.g.
+//
+//	movie.GetDirector().GetName()
+func (f *fileWriter) writeGetters(typeName string, receiver string, fields []*structField) {
+	for _, field := range fields {
+		f.writeGetter(typeName, receiver, field)
+	}
+}
+
+// writeGetter writes the Get<Field> method for the given field.
+func (f *fileWriter) writeGetter(typeName string, receiver string, field *structField) {
+	var (
+		fieldAccessor = receiver + "." + field.name
+		returnType    = field.goType
+		condition     = receiver + " == nil"
+		zeroValue     = zeroValueForGoType(field.valueType, field.goType, f.types, f.typeOverrides)
+		value         = fieldAccessor
+	)
+	switch underlying := optionalValueType(field.valueType); {
+	case strings.HasPrefix(field.goType, "*core.Optional["):
+		returnType = strings.TrimSuffix(strings.TrimPrefix(field.goType, "*core.Optional["), "]")
+		condition += " || " + fieldAccessor + " == nil"
+		value = fieldAccessor + ".Value"
+		zeroValue = zeroValueForGoType(underlying, returnType, f.types, f.typeOverrides)
+		if underlying.Named != nil && isPointer(f.types[underlying.Named.TypeId]) {
+			// The optional's value is the object (or union) itself, so we
+			// return a pointer to it.
+			returnType = "*" + returnType
+			condition += " || " + fieldAccessor + ".Null"
+			value = "&" + value
+			zeroValue = "nil"
+		}
+	case strings.HasPrefix(field.goType, "*") && !isPointerTypeReference(underlying, f.types):
+		returnType = strings.TrimPrefix(field.goType, "*")
+		condition += " || " + fieldAccessor + " == nil"
+		value = "*" + fieldAccessor
+		zeroValue = zeroValueForGoType(underlying, returnType, f.types, f.typeOverrides)
+	}
+	f.P("func (", receiver, " *", typeName, ") Get", field.name, "() ", returnType, " {")
+	f.P("if ", condition, " {")
+	f.P("return ", zeroValue)
+	f.P("}")
+	f.P("return ", value)
+	f.P("}")
+	f.P()
+}
+
+// optionalValueType returns the type wrapped by the given optional type, if any.
+// Nested optionals are unwrapped altogether.
+func optionalValueType(valueType *ir.TypeReference) *ir.TypeReference {
+	for valueType != nil && valueType.Container != nil && valueType.Container.Optional != nil {
+		valueType = valueType.Container.Optional
+	}
+	return valueType
+}
+
+// isPointerTypeReference returns true if the given type is an object or union,
+// or an alias to one, which are always represented by a pointer.
+func isPointerTypeReference(valueType *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) bool {
+	if valueType == nil || valueType.Named == nil {
+		return false
+	}
+	typeDeclaration := types[valueType.Named.TypeId]
+	if alias := typeDeclaration.Shape.Alias; alias != nil {
+		return isPointerTypeReference(alias.AliasOf, types)
+	}
+	return isPointer(typeDeclaration)
+}
+
+// zeroValueForGoType returns the zero value of the given Go type, which
+// represents the given type reference (if any).
+func zeroValueForGoType(
+	valueType *ir.TypeReference,
+	goType string,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+	typeOverrides map[ir.TypeId]*TypeOverride,
+) string {
+	for _, prefix := range []string{"*", "[]", "map["} {
+		if strings.HasPrefix(goType, prefix) {
+			return "nil"
+		}
+	}
+	if valueType == nil {
+		return "nil"
+	}
+	switch {
+	case valueType.Container != nil:
+		if literal := valueType.Container.Literal; literal != nil {
+			if literalToGoType(literal) == "bool" {
+				return "false"
+			}
+			return `""`
+		}
+		return "nil"
+	case valueType.Named != nil:
+		if _, ok := typeOverrides[valueType.Named.TypeId]; ok {
+			return typeOverrideZeroValue(goType)
+		}
+		typeDeclaration := types[valueType.Named.TypeId]
+		if alias := typeDeclaration.Shape.Alias; alias != nil {
+			return zeroValueForGoType(alias.AliasOf, goType, types, typeOverrides)
+		}
+		if typeDeclaration.Shape.Enum != nil {
+			return `""`
+		}
+		return "nil"
+	case valueType.Primitive != "":
+		return defaultValueForPrimitiveType(valueType.Primitive)
+	}
+	return "nil"
+}
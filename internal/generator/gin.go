@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// ginImportPath is the import path of the Gin web framework.
+const ginImportPath = "github.com/gin-gonic/gin"
+
+// ginFramework generates handlers for the Gin web framework.
+type ginFramework struct{}
+
+func (*ginFramework) imports() []string {
+	return []string{ginImportPath}
+}
+
+func (*ginFramework) reserved() []string {
+	return []string{"router", "c"}
+}
+
+func (*ginFramework) returnsError() bool {
+	return false
+}
+
+func (*ginFramework) headerTag(wireValue string) string {
+	return fmt.Sprintf(`json:"-" header:%q`, wireValue)
+}
+
+func (*ginFramework) queryTag(queryParam *ir.QueryParameter, _ map[ir.TypeId]*ir.TypeDeclaration) string {
+	return fmt.Sprintf(`json:"-" form:%q`, queryParam.Name.WireValue)
+}
+
+func (*ginFramework) writeRegister(f *fileWriter) {
+	f.P("// Register registers all of the Service's endpoints with the given Gin router.")
+	f.P("//")
+	f.P("// Path parameters that contain escaped slashes (e.g. a%2Fb) are only matched if")
+	f.P("// the engine routes on the request's escaped path, so that's enabled here if the")
+	f.P("// router is a *gin.Engine. Otherwise, the engine's UseRawPath option must be set.")
+	f.P("func Register(router gin.IRoutes, service Service, opts ...core.HandlerOption) {")
+	f.P("if engine, ok := router.(*gin.Engine); ok {")
+	f.P("engine.UseRawPath = true")
+	f.P("engine.UnescapePathValues = true")
+	f.P("}")
+}
+
+func (*ginFramework) route(method string, path string) string {
+	return fmt.Sprintf("router.Handle(%s, %q, func(c *gin.Context) {", method, path)
+}
+
+func (*ginFramework) pathParameter(name string) string {
+	return ":" + name
+}
+
+func (*ginFramework) context() string {
+	return "c.Request.Context()"
+}
+
+func (*ginFramework) pathValue(name string) string {
+	return fmt.Sprintf("c.Param(%q)", name)
+}
+
+func (*ginFramework) escapedPathValues() bool {
+	return false
+}
+
+func (*ginFramework) header(name string) string {
+	return fmt.Sprintf("c.GetHeader(%q)", name)
+}
+
+func (*ginFramework) formValue(name string) string {
+	return fmt.Sprintf("c.PostForm(%q)", name)
+}
+
+func (*ginFramework) formFile(name string) string {
+	return fmt.Sprintf("c.FormFile(%q)", name)
+}
+
+func (*ginFramework) body() string {
+	return "c.Request.Body"
+}
+
+func (*ginFramework) writeQuery(f *fileWriter) {
+	f.P("query := c.Request.URL.Query()")
+}
+
+func (*ginFramework) badRequestResponse(format string, args ...string) string {
+	return fmt.Sprintf("c.String(http.StatusBadRequest, %s)", strings.Join(append([]string{format}, args...), ", "))
+}
+
+func (*ginFramework) errorResponse(err string) string {
+	// The error is attached to the context so that it's available to Gin's middleware.
+	return fmt.Sprintf("_ = c.AbortWithError(core.ErrorStatusCode(%s), %s)", err, err)
+}
+
+func (*ginFramework) statusResponse(statusCode string) string {
+	return fmt.Sprintf("c.Status(%s)", statusCode)
+}
+
+func (*ginFramework) jsonResponse(statusCode string, value string) string {
+	return fmt.Sprintf("c.JSON(%s, %s)", statusCode, value)
+}
+
+func (*ginFramework) writeFile(f *fileWriter, value string) {
+	f.P("if closer, ok := ", value, ".(io.Closer); ok {")
+	f.P("defer closer.Close()")
+	f.P("}")
+	f.P(`c.DataFromReader(http.StatusOK, -1, "application/octet-stream", `, value, ", nil)")
+}
+
+func (*ginFramework) writeText(f *fileWriter, value string) {
+	f.P(`c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(`, value, "))")
+}
+
+func (*ginFramework) writeStream(f *fileWriter, delimiter string, call string, writeError func()) {
+	f.P("stream := core.NewStreamWriter(c.Writer, ", fmt.Sprintf("%q", delimiter), ", opts...)")
+	f.P("if err := ", call, "; err != nil {")
+	f.P("if stream.Started() {")
+	f.P("stream.Abort(err)")
+	f.P("} else {")
+	writeError()
+	f.P("}")
+	f.P("}")
+}
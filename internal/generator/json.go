@@ -0,0 +1,745 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// writeJSONUnmarshaler writes the type's json.Unmarshaler implementation,
+// which reads the value with its ReadJSON method. Just like encoding/json,
+// the value is only set if the JSON is read successfully.
+func (t *typeVisitor) writeJSONUnmarshaler(receiver string) {
+	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
+	t.writer.P("reader := core.NewJSONReader(data)")
+	t.writer.P("value := *", receiver)
+	t.writer.P("value.ReadJSON(reader)")
+	t.writer.P("if err := reader.End(); err != nil {")
+	t.writer.P("return err")
+	t.writer.P("}")
+	t.writer.P("*", receiver, " = value")
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeJSONMarshaler writes the type's json.Marshaler implementation, which
+// writes the value with its WriteJSON method. Unions are marshaled with a value
+// receiver, just like their reflection-based equivalent.
+func (t *typeVisitor) writeJSONMarshaler(receiver string, valueReceiver bool) {
+	receiverType := " *" + t.typeName
+	if valueReceiver {
+		receiverType = " " + t.typeName
+	}
+	t.writer.P("func (", receiver, receiverType, ") MarshalJSON() ([]byte, error) {")
+	t.writer.P("writer := core.NewJSONWriter()")
+	t.writer.P(receiver, ".WriteJSON(writer)")
+	t.writer.P("return writer.Bytes()")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeObjectJSONMethods writes the object's reflection-free ReadJSON and WriteJSON
+// methods, as well as the json.Unmarshaler and json.Marshaler implementations
+// that call them.
+func (t *typeVisitor) writeObjectJSONMethods(object *ir.ObjectTypeDeclaration, receiver string, literals []*literal) {
+	var (
+		fields          = t.writer.structFieldsForObject(object, t.importPath, t.includeOptionals)
+		extraProperties = t.hasExtraProperties(object)
+	)
+	t.writeJSONUnmarshaler(receiver)
+	t.writeJSONMarshaler(receiver, false)
+
+	t.writer.P("// ReadJSON reads the ", t.typeName, " from the given core.JSONReader.")
+	t.writer.P("func (", receiver, " *", t.typeName, ") ReadJSON(reader *core.JSONReader) {")
+	t.writer.P("if reader.ReadNull() {")
+	t.writer.P("return")
+	t.writer.P("}")
+	if t.includeRawJSON {
+		t.writer.P("offset := reader.Offset()")
+	}
+	t.writer.P("*", receiver, " = ", t.typeName, "{}")
+	t.writer.P("reader.BeginObject()")
+	var keys []string
+	for _, field := range fields {
+		keys = append(keys, field.wireValue)
+	}
+	keys = append(keys, literalWireValues(literals)...)
+	t.writer.P("for reader.NextKey() {")
+	t.writer.P("switch ", matchKey(keys), " {")
+	for _, field := range fields {
+		t.writer.P("case ", strconv.Quote(field.wireValue), ":")
+		t.writeReadJSONField(receiver, field)
+	}
+	if len(literals) > 0 {
+		// Literals are always set to their value, regardless of the JSON.
+		t.writer.P("case ", quoteStrings(literalWireValues(literals)), ":")
+		t.writer.P("reader.Skip()")
+	}
+	t.writer.P("default:")
+	if extraProperties {
+		t.writer.P("if ", receiver, ".ExtraProperties == nil {")
+		t.writer.P(receiver, ".ExtraProperties = make(map[string]interface{})")
+		t.writer.P("}")
+		t.writer.P(receiver, ".ExtraProperties[reader.Key()] = reader.ReadValueUseNumber()")
+	} else {
+		t.writer.P("reader.Skip()")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	for _, literal := range literals {
+		t.writer.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
+	}
+	if t.includeRawJSON {
+		t.writer.P(receiver, "._rawJSON = reader.Since(offset)")
+	}
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("// WriteJSON writes the ", t.typeName, " to the given core.JSONWriter.")
+	t.writer.P("func (", receiver, " *", t.typeName, ") WriteJSON(writer *core.JSONWriter) {")
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("writer.Null()")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("writer.BeginObject()")
+	for _, field := range fields {
+		t.writeWriteJSONField(receiver, field)
+	}
+	t.writeJSONLiterals(literals)
+	if extraProperties {
+		args := []string{receiver + ".ExtraProperties"}
+		if wireValues := objectWireValues(object, t.writer.types); len(wireValues) > 0 {
+			args = append(args, quoteStrings(wireValues))
+		}
+		t.writer.P("writer.ExtraProperties(", strings.Join(args, ", "), ")")
+	}
+	t.writer.P("writer.EndObject()")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeUnionJSONMethods writes the union's reflection-free ReadJSON and WriteJSON
+// methods, as well as the json.Unmarshaler and json.Marshaler implementations
+// that call them.
+//
+// The union's discriminant and base properties are read first, and the JSON object
+// is then read again for the union's current type (its properties can be specified
+// in any order, including before the discriminant).
+func (t *typeVisitor) writeUnionJSONMethods(union *ir.UnionTypeDeclaration, receiver string, literals []*literal) {
+	var (
+		discriminantName = union.Discriminant.Name.PascalCase.UnsafeName
+		baseFields       = t.unionBaseFields(union)
+	)
+	t.writeJSONUnmarshaler(receiver)
+	t.writeJSONMarshaler(receiver, true)
+
+	t.writer.P("// ReadJSON reads the ", t.typeName, " from the given core.JSONReader.")
+	t.writer.P("func (", receiver, " *", t.typeName, ") ReadJSON(reader *core.JSONReader) {")
+	t.writer.P("if reader.ReadNull() {")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("offset := reader.Offset()")
+	t.writer.P("*", receiver, " = ", t.typeName, "{}")
+	t.writer.P("reader.BeginObject()")
+	keys := []string{union.Discriminant.WireValue}
+	for _, field := range baseFields {
+		keys = append(keys, field.wireValue)
+	}
+	t.writer.P("for reader.NextKey() {")
+	t.writer.P("switch ", matchKey(keys), " {")
+	t.writer.P("case ", strconv.Quote(union.Discriminant.WireValue), ":")
+	t.writer.P(receiver, ".", discriminantName, " = reader.ReadString()")
+	for _, field := range baseFields {
+		t.writer.P("case ", strconv.Quote(field.wireValue), ":")
+		t.writeReadJSONField(receiver, field)
+	}
+	t.writer.P("default:")
+	t.writer.P("reader.Skip()")
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P("data := reader.Since(offset)")
+	t.writer.P("if reader.Err() != nil {")
+	t.writer.P("return")
+	t.writer.P("}")
+	for _, literal := range literals {
+		t.writer.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
+	}
+	t.writer.P("switch ", receiver, ".", discriminantName, " {")
+	for _, unionType := range union.Types {
+		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
+		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		switch unionType.Shape.PropertiesType {
+		case "samePropertiesAsObject":
+			typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath)
+			t.writer.P("value := new(", strings.TrimLeft(typeName, "*"), ")")
+			t.writer.P("reader.Fail(value.UnmarshalJSON(data))")
+			if t.hasExtraProperties(t.writer.types[unionType.Shape.SamePropertiesAsObject.TypeId].Shape.Object) {
+				// The union's own properties aren't extra properties of the object.
+				t.writer.P("core.DeleteExtraProperties(value.ExtraProperties, ", quoteStrings(unionWireValues(union, t.writer.types)), ")")
+			}
+			t.writer.P(receiver, ".", fieldName, " = value")
+		case "singleProperty":
+			property := unionType.Shape.SingleProperty
+			if property.Type.Container != nil && property.Type.Container.Literal != nil {
+				t.writer.P(receiver, ".", unionType.DiscriminantValue.Name.CamelCase.SafeName, " = ", literalToValue(property.Type.Container.Literal))
+				continue
+			}
+			t.writer.P("reader.Fail(core.ReadJSONProperty(data, ", strconv.Quote(property.Name.WireValue), ", func(reader *core.JSONReader) {")
+			t.writeReadJSONValue(receiver+"."+fieldName, property.Type, 0)
+			t.writer.P("}))")
+		case "noProperties":
+			// Just like the reflection-based implementation, the value is
+			// the union's entire JSON object.
+			t.writer.P("valueReader := core.NewJSONReader(data)")
+			t.writer.P(receiver, ".", fieldName, " = valueReader.ReadValue()")
+			t.writer.P("reader.Fail(valueReader.End())")
+		}
+	}
+	// Unknown types are preserved as-is, so that they can be marshaled
+	// without losing any data.
+	t.writer.P("default:")
+	t.writer.P(receiver, "._unknown = append(json.RawMessage(nil), data...)")
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("// WriteJSON writes the ", t.typeName, " to the given core.JSONWriter.")
+	t.writer.P("func (", receiver, " *", t.typeName, ") WriteJSON(writer *core.JSONWriter) {")
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("writer.Null()")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("switch ", receiver, ".", discriminantName, " {")
+	for i, unionType := range union.Types {
+		if i == 0 {
+			// Implement the default case first.
+			t.writer.P("default:")
+			t.writer.P("if ", receiver, "._unknown != nil {")
+			t.writer.P("writer.Raw(", receiver, "._unknown)")
+			t.writer.P("return")
+			t.writer.P("}")
+			t.writer.P("writer.Fail(fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", *", receiver, "))")
+		}
+		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
+		t.writer.P("writer.BeginObject()")
+		t.writer.P("writer.Key(", strconv.Quote(union.Discriminant.WireValue), ")")
+		t.writer.P("writer.String(", receiver, ".", discriminantName, ")")
+		for _, field := range baseFields {
+			t.writeWriteJSONField(receiver, field)
+		}
+		t.writeJSONLiterals(literals)
+		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		switch unionType.Shape.PropertiesType {
+		case "samePropertiesAsObject":
+			t.writer.P("writer.Inline()")
+			t.writer.P(receiver, ".", fieldName, ".WriteJSON(writer)")
+		case "singleProperty":
+			property := unionType.Shape.SingleProperty
+			if property.Type.Container != nil && property.Type.Container.Literal != nil {
+				t.writer.P("writer.Key(", strconv.Quote(property.Name.WireValue), ")")
+				t.writeJSONLiteral(property.Type.Container.Literal)
+				break
+			}
+			t.writeWriteJSONField(
+				receiver,
+				&structField{
+					name:      fieldName,
+					wireValue: property.Name.WireValue,
+					goType:    singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath),
+					valueType: property.Type,
+				},
+			)
+		case "noProperties":
+			// The value is unknown, so it's omitted unless it's set.
+			t.writeWriteJSONField(
+				receiver,
+				&structField{
+					name:      fieldName,
+					wireValue: unionType.DiscriminantValue.WireValue,
+					goType:    unknownToGoType(nil),
+				},
+			)
+		}
+		t.writer.P("writer.EndObject()")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeUndiscriminatedUnionJSONMethods writes the union's reflection-free ReadJSON
+// and WriteJSON methods, as well as its json.Unmarshaler and json.Marshaler
+// implementations. Each of the members is matched with a separate core.JSONReader,
+// in the same way as the reflection-based implementation.
+func (t *typeVisitor) writeUndiscriminatedUnionJSONMethods(receiver string, members []*undiscriminatedUnionMember) {
+	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
+	t.writer.P("decoder := core.NewUnionDecoder(data)")
+	for _, member := range members {
+		target := "&" + member.variable
+		if member.typeName != "" && isPointer(t.writer.types[member.typeName]) {
+			t.writer.P(member.variable, " := new(", strings.TrimLeft(member.value, "*"), ")")
+			target = member.variable
+		} else {
+			t.writer.P("var ", member.variable, " ", member.value)
+		}
+		t.writer.P("if decoder.DecodeWith(")
+		t.writer.writeUnionMember(member.field, member.literal, t.unionObjectForTypeReference(member.valueType))
+		t.writer.P(target, ",")
+		t.writer.P("func(reader *core.JSONReader) {")
+		if target == member.variable {
+			t.writer.P(member.variable, ".ReadJSON(reader)")
+		} else {
+			t.writeReadJSONValue(member.variable, member.valueType, 0)
+		}
+		t.writer.P("},")
+		t.writer.P("func() {")
+		t.writer.P(receiver, ".typeName = \"", member.caseName, "\"")
+		t.writer.P(receiver, ".", member.field, " = ", member.variable)
+		t.writer.P("},")
+		t.writer.P(") {")
+		t.writer.P("return nil")
+		t.writer.P("}")
+	}
+	t.writer.P("return decoder.Fallback(", receiver, ")")
+	t.writer.P("}")
+	t.writer.P()
+	t.writeJSONMarshaler(receiver, true)
+
+	t.writer.P("// ReadJSON reads the ", t.typeName, " from the given core.JSONReader.")
+	t.writer.P("func (", receiver, " *", t.typeName, ") ReadJSON(reader *core.JSONReader) {")
+	t.writer.P("if reader.ReadNull() {")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("data := reader.ReadRaw()")
+	t.writer.P("if reader.Err() != nil {")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("reader.Fail(", receiver, ".UnmarshalJSON(data))")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("// WriteJSON writes the ", t.typeName, " to the given core.JSONWriter.")
+	t.writer.P("func (", receiver, " *", t.typeName, ") WriteJSON(writer *core.JSONWriter) {")
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("writer.Null()")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("switch ", receiver, ".typeName {")
+	for i, member := range members {
+		if i == 0 {
+			// Implement the default case first.
+			t.writer.P("default:")
+			t.writer.P("writer.Fail(fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".typeName, *", receiver, "))")
+		}
+		t.writer.P("case \"", member.caseName, "\":")
+		if member.isLiteral {
+			t.writeJSONLiteral(member.valueType.Container.Literal)
+			continue
+		}
+		t.writeWriteJSONValue(receiver+"."+member.field, member.valueType, 0)
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// matchKey returns the expression that matches the current property's key
+// against the given keys, which falls back to a case-insensitive match
+// (just like encoding/json).
+func matchKey(keys []string) string {
+	if len(keys) == 0 {
+		return "reader.Key()"
+	}
+	return "reader.MatchKey(" + quoteStrings(keys) + ")"
+}
+
+// writeReadJSONField writes the statements required to read the given field's
+// value from the reader.
+func (t *typeVisitor) writeReadJSONField(receiver string, field *structField) {
+	value := receiver + "." + field.name
+	if !strings.HasPrefix(field.goType, "*core.Optional[") {
+		t.writeReadJSONValue(value, field.valueType, 0)
+		return
+	}
+	optionalType := strings.TrimPrefix(field.goType, "*")
+	t.writer.P("if reader.ReadNull() {")
+	t.writer.P(value, " = &", optionalType, "{Null: true}")
+	t.writer.P("} else {")
+	t.writer.P(value, " = new(", optionalType, ")")
+	switch underlying := field.valueType.Container.Optional; {
+	case underlying.Container != nil && underlying.Container.Optional != nil:
+		t.writer.P("reader.ReadInto(&", value, ".Value)")
+	case underlying.Named != nil && isPointer(t.writer.types[underlying.Named.TypeId]):
+		// The optional's value is the object (or union) itself.
+		t.writer.P(value, ".Value.ReadJSON(reader)")
+	default:
+		t.writeReadJSONValue(value+".Value", underlying, 0)
+	}
+	t.writer.P("}")
+}
+
+// writeWriteJSONField writes the statements required to write the given field's
+// key and value, unless the field is omitted (i.e. with the omitempty option).
+func (t *typeVisitor) writeWriteJSONField(receiver string, field *structField) {
+	value := receiver + "." + field.name
+	key := "writer.Key(" + strconv.Quote(field.wireValue) + ")"
+	if strings.HasPrefix(field.goType, "*core.Optional[") {
+		t.writer.P("if ", value, " != nil {")
+		t.writer.P(key)
+		t.writer.P("if ", value, ".Null {")
+		t.writer.P("writer.Null()")
+		t.writer.P("} else {")
+		switch underlying := field.valueType.Container.Optional; {
+		case underlying.Container != nil && underlying.Container.Optional != nil:
+			t.writer.P("writer.Value(", value, ".Value)")
+		case underlying.Named != nil && isPointer(t.writer.types[underlying.Named.TypeId]):
+			// The optional's value is the object (or union) itself.
+			t.writer.P(value, ".Value.WriteJSON(writer)")
+		default:
+			t.writeWriteJSONValue(value+".Value", underlying, 0)
+		}
+		t.writer.P("}")
+		t.writer.P("}")
+		return
+	}
+	var condition string
+	if strings.HasSuffix(jsonTagForType(field.wireValue, field.valueType, t.writer.types, t.writer.typeOverrides), ",omitempty\"`") {
+		condition = t.jsonNonEmptyCondition(value, field.valueType)
+	}
+	if condition == "" {
+		t.writer.P(key)
+		t.writeWriteJSONValue(value, field.valueType, 0)
+		return
+	}
+	t.writer.P("if ", condition, " {")
+	t.writer.P(key)
+	t.writeWriteJSONValue(value, field.valueType, 0)
+	t.writer.P("}")
+}
+
+// writeJSONLiterals writes the key and value of each of the given literals.
+func (t *typeVisitor) writeJSONLiterals(literals []*literal) {
+	for _, literal := range literals {
+		t.writer.P("writer.Key(", strconv.Quote(literal.Name.OriginalName), ")")
+		t.writeJSONLiteral(literal.Value)
+	}
+}
+
+// writeJSONLiteral writes the statement required to write the given literal.
+func (t *typeVisitor) writeJSONLiteral(literal *ir.Literal) {
+	if literalToGoType(literal) == "bool" {
+		t.writer.P("writer.Bool(", literalToValue(literal), ")")
+		return
+	}
+	t.writer.P("writer.String(", literalToValue(literal), ")")
+}
+
+// writeReadJSONValue writes the statements required to read the given value,
+// which recursively reads every element of container types (e.g. lists).
+func (t *typeVisitor) writeReadJSONValue(value string, valueType *ir.TypeReference, depth int) {
+	if isUnknownTypeReference(valueType) {
+		t.writer.P(value, " = reader.ReadValue()")
+		return
+	}
+	if valueType.Named != nil {
+		if _, ok := t.writer.typeOverrides[valueType.Named.TypeId]; ok {
+			// The overridden type's JSON representation is up to the
+			// package that defines it.
+			t.writer.P("reader.ReadInto(&", value, ")")
+			return
+		}
+		typeDeclaration := t.writer.types[valueType.Named.TypeId]
+		if alias := typeDeclaration.Shape.Alias; alias != nil {
+			t.writeReadJSONValue(value, alias.AliasOf, depth)
+			return
+		}
+		goType := t.elementGoType(valueType)
+		if typeDeclaration.Shape.Enum != nil {
+			t.writer.P(value, " = ", goType, "(reader.ReadString())")
+			return
+		}
+		t.writer.P("if !reader.ReadNull() {")
+		t.writer.P(value, " = new(", strings.TrimPrefix(goType, "*"), ")")
+		t.writer.P(value, ".ReadJSON(reader)")
+		t.writer.P("}")
+		return
+	}
+	if valueType.Primitive != "" {
+		switch valueType.Primitive {
+		case ir.PrimitiveTypeInteger:
+			t.writer.P(value, " = reader.ReadInt()")
+		case ir.PrimitiveTypeLong:
+			t.writer.P(value, " = reader.ReadInt64()")
+		case ir.PrimitiveTypeDouble:
+			t.writer.P(value, " = reader.ReadFloat64()")
+		case ir.PrimitiveTypeBoolean:
+			t.writer.P(value, " = reader.ReadBool()")
+		case ir.PrimitiveTypeDate:
+			t.writer.P(value, " = reader.ReadDate()")
+		case ir.PrimitiveTypeDateTime:
+			t.writer.P(value, " = reader.ReadDateTime()")
+		case ir.PrimitiveTypeUuid:
+			t.writer.P("reader.ReadText(&", value, ")")
+		case ir.PrimitiveTypeBase64:
+			t.writer.P(value, " = reader.ReadBytes()")
+		default:
+			t.writer.P(value, " = reader.ReadString()")
+		}
+		return
+	}
+	suffix := ""
+	if depth > 0 {
+		suffix = strconv.Itoa(depth)
+	}
+	switch container := valueType.Container; {
+	case container.List != nil, container.Set != nil:
+		elementType := container.List
+		if elementType == nil {
+			elementType = container.Set
+		}
+		element := "value" + suffix
+		t.writer.P("if !reader.ReadNull() {")
+		t.writer.P(value, " = make(", t.elementGoType(valueType), ", 0)")
+		t.writer.P("reader.BeginArray()")
+		t.writer.P("for reader.NextElement() {")
+		t.writer.P("var ", element, " ", t.elementGoType(elementType))
+		t.writeReadJSONValue(element, elementType, depth+1)
+		t.writer.P(value, " = append(", value, ", ", element, ")")
+		t.writer.P("}")
+		t.writer.P("}")
+	case container.Map != nil:
+		if !t.isStringTypeReference(container.Map.KeyType) {
+			t.writer.P("reader.ReadInto(&", value, ")")
+			return
+		}
+		var (
+			key     = "key" + suffix
+			element = "value" + suffix
+		)
+		t.writer.P("if !reader.ReadNull() {")
+		t.writer.P(value, " = make(", t.elementGoType(valueType), ")")
+		t.writer.P("reader.BeginObject()")
+		t.writer.P("for reader.NextKey() {")
+		t.writer.P(key, " := reader.Key()")
+		t.writer.P("var ", element, " ", t.elementGoType(container.Map.ValueType))
+		t.writeReadJSONValue(element, container.Map.ValueType, depth+1)
+		t.writer.P(selectorOperand(value), "[", t.mapKey(key, container.Map.KeyType), "] = ", element)
+		t.writer.P("}")
+		t.writer.P("}")
+	case container.Optional != nil:
+		underlying := optionalValueType(valueType)
+		if !t.isOptionalPointer(valueType) {
+			t.writeReadJSONValue(value, underlying, depth)
+			return
+		}
+		element := "value" + suffix
+		t.writer.P("if !reader.ReadNull() {")
+		t.writer.P("var ", element, " ", strings.TrimPrefix(t.elementGoType(valueType), "*"))
+		t.writeReadJSONValue(element, underlying, depth)
+		t.writer.P(value, " = &", element)
+		t.writer.P("}")
+	case container.Literal != nil:
+		if literalToGoType(container.Literal) == "bool" {
+			t.writer.P(value, " = reader.ReadBool()")
+			return
+		}
+		t.writer.P(value, " = reader.ReadString()")
+	}
+}
+
+// writeWriteJSONValue writes the statements required to write the given value,
+// which recursively writes every element of container types (e.g. lists).
+func (t *typeVisitor) writeWriteJSONValue(value string, valueType *ir.TypeReference, depth int) {
+	if isUnknownTypeReference(valueType) {
+		t.writer.P("writer.Value(", value, ")")
+		return
+	}
+	if valueType.Named != nil {
+		typeDeclaration := t.writer.types[valueType.Named.TypeId]
+		if alias := typeDeclaration.Shape.Alias; alias != nil {
+			t.writeWriteJSONValue(value, alias.AliasOf, depth)
+			return
+		}
+		if typeDeclaration.Shape.Enum != nil {
+			t.writer.P("writer.String(string(", value, "))")
+			return
+		}
+		t.writer.P(selectorOperand(value), ".WriteJSON(writer)")
+		return
+	}
+	if valueType.Primitive != "" {
+		switch valueType.Primitive {
+		case ir.PrimitiveTypeInteger:
+			t.writer.P("writer.Int(", value, ")")
+		case ir.PrimitiveTypeLong:
+			t.writer.P("writer.Int64(", value, ")")
+		case ir.PrimitiveTypeDouble:
+			t.writer.P("writer.Float64(", value, ")")
+		case ir.PrimitiveTypeBoolean:
+			t.writer.P("writer.Bool(", value, ")")
+		case ir.PrimitiveTypeDate:
+			t.writer.P("writer.Date(", value, ")")
+		case ir.PrimitiveTypeDateTime:
+			t.writer.P("writer.DateTime(", value, ")")
+		case ir.PrimitiveTypeUuid:
+			t.writer.P("writer.String(", selectorOperand(value), ".String())")
+		case ir.PrimitiveTypeBase64:
+			t.writer.P("writer.Base64(", value, ")")
+		default:
+			t.writer.P("writer.String(", value, ")")
+		}
+		return
+	}
+	suffix := ""
+	if depth > 0 {
+		suffix = strconv.Itoa(depth)
+	}
+	switch container := valueType.Container; {
+	case container.List != nil, container.Set != nil:
+		elementType := container.List
+		if elementType == nil {
+			elementType = container.Set
+		}
+		element := "value" + suffix
+		t.writer.P("if ", value, " == nil {")
+		t.writer.P("writer.Null()")
+		t.writer.P("} else {")
+		t.writer.P("writer.BeginArray()")
+		t.writer.P("for _, ", element, " := range ", value, " {")
+		t.writeWriteJSONValue(element, elementType, depth+1)
+		t.writer.P("}")
+		t.writer.P("writer.EndArray()")
+		t.writer.P("}")
+	case container.Map != nil:
+		if !t.isStringTypeReference(container.Map.KeyType) {
+			t.writer.P("writer.Value(", value, ")")
+			return
+		}
+		// The keys are sorted (without reflection), just like encoding/json.
+		t.writer.scope.AddImport("sort")
+		var (
+			keys = "keys" + suffix
+			key  = "key" + suffix
+		)
+		t.writer.P("if ", value, " == nil {")
+		t.writer.P("writer.Null()")
+		t.writer.P("} else {")
+		t.writer.P(keys, " := make([]string, 0, len(", value, "))")
+		t.writer.P("for ", key, " := range ", value, " {")
+		t.writer.P(keys, " = append(", keys, ", ", t.mapKeyString(key, container.Map.KeyType), ")")
+		t.writer.P("}")
+		t.writer.P("sort.Strings(", keys, ")")
+		t.writer.P("writer.BeginObject()")
+		t.writer.P("for _, ", key, " := range ", keys, " {")
+		t.writer.P("writer.Key(", key, ")")
+		t.writeWriteJSONValue(selectorOperand(value)+"["+t.mapKey(key, container.Map.KeyType)+"]", container.Map.ValueType, depth+1)
+		t.writer.P("}")
+		t.writer.P("writer.EndObject()")
+		t.writer.P("}")
+	case container.Optional != nil:
+		underlying := optionalValueType(valueType)
+		if !t.isOptionalPointer(valueType) {
+			t.writeWriteJSONValue(value, underlying, depth)
+			return
+		}
+		t.writer.P("if ", value, " == nil {")
+		t.writer.P("writer.Null()")
+		t.writer.P("} else {")
+		t.writeWriteJSONValue("*"+value, underlying, depth)
+		t.writer.P("}")
+	case container.Literal != nil:
+		t.writeJSONLiteral(container.Literal)
+	}
+}
+
+// jsonNonEmptyCondition returns the condition that's true if the given value isn't
+// omitted by encoding/json's omitempty option, or an empty string if it's never
+// omitted (e.g. a time.Time).
+func (t *typeVisitor) jsonNonEmptyCondition(value string, valueType *ir.TypeReference) string {
+	if isUnknownTypeReference(valueType) {
+		return value + " != nil"
+	}
+	if valueType.Named != nil {
+		if _, ok := t.writer.typeOverrides[valueType.Named.TypeId]; ok {
+			return ""
+		}
+		typeDeclaration := t.writer.types[valueType.Named.TypeId]
+		if alias := typeDeclaration.Shape.Alias; alias != nil {
+			return t.jsonNonEmptyCondition(value, alias.AliasOf)
+		}
+		if typeDeclaration.Shape.Enum != nil {
+			return value + ` != ""`
+		}
+		return value + " != nil"
+	}
+	if valueType.Primitive != "" {
+		switch valueType.Primitive {
+		case ir.PrimitiveTypeInteger, ir.PrimitiveTypeLong, ir.PrimitiveTypeDouble:
+			return value + " != 0"
+		case ir.PrimitiveTypeBoolean:
+			return value
+		case ir.PrimitiveTypeBase64:
+			return "len(" + value + ") > 0"
+		case ir.PrimitiveTypeDate, ir.PrimitiveTypeDateTime, ir.PrimitiveTypeUuid:
+			return ""
+		}
+		return value + ` != ""`
+	}
+	switch container := valueType.Container; {
+	case container.List != nil, container.Set != nil, container.Map != nil:
+		return "len(" + value + ") > 0"
+	case container.Optional != nil:
+		if t.isOptionalPointer(valueType) {
+			return value + " != nil"
+		}
+		return t.jsonNonEmptyCondition(value, optionalValueType(valueType))
+	case container.Literal != nil:
+		if literalToGoType(container.Literal) == "bool" {
+			return value
+		}
+		return value + ` != ""`
+	}
+	return ""
+}
+
+// isStringTypeReference returns true if the given type is represented as a string
+// (e.g. an enum), which can be used as a JSON object key without reflection.
+func (t *typeVisitor) isStringTypeReference(valueType *ir.TypeReference) bool {
+	if valueType.Named != nil {
+		typeDeclaration := t.writer.types[valueType.Named.TypeId]
+		if alias := typeDeclaration.Shape.Alias; alias != nil {
+			return t.isStringTypeReference(alias.AliasOf)
+		}
+		return typeDeclaration.Shape.Enum != nil
+	}
+	return valueType.Primitive == ir.PrimitiveTypeString
+}
+
+// mapKey returns the expression that converts the given string into a key of the
+// given (string) type.
+func (t *typeVisitor) mapKey(key string, keyType *ir.TypeReference) string {
+	if goType := t.elementGoType(keyType); goType != "string" {
+		return goType + "(" + key + ")"
+	}
+	return key
+}
+
+// mapKeyString returns the expression that converts the given key of the given
+// (string) type into a string.
+func (t *typeVisitor) mapKeyString(key string, keyType *ir.TypeReference) string {
+	if t.elementGoType(keyType) != "string" {
+		return "string(" + key + ")"
+	}
+	return key
+}
+
+// literalWireValues returns the JSON keys of the given literals.
+func literalWireValues(literals []*literal) []string {
+	wireValues := make([]string, len(literals))
+	for i, literal := range literals {
+		wireValues[i] = literal.Name.OriginalName
+	}
+	return wireValues
+}
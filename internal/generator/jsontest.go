@@ -0,0 +1,407 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// maxJSONTestDepth is the maximum depth of the sample values generated for
+// the JSON tests, which prevents recursive types from recursing forever.
+const maxJSONTestDepth = 2
+
+// WriteTypeJSONTests writes the tests and benchmarks that compare the given type's
+// reflection-free JSON methods with the reflection-based implementation (which is
+// written alongside the tests), and returns false if there's nothing to test (e.g.
+// enums and aliases).
+func (f *fileWriter) WriteTypeJSONTests(
+	typeDeclaration *ir.TypeDeclaration,
+	includeRawJSON bool,
+	forwardCompatibleEnums bool,
+	extraProperties bool,
+	includeOptionals bool,
+) bool {
+	visitor := &typeVisitor{
+		typeName:               typeDeclaration.Name.Name.PascalCase.UnsafeName,
+		baseImportPath:         f.baseImportPath,
+		importPath:             fernFilepathToImportPath(f.baseImportPath, typeDeclaration.Name.FernFilepath),
+		writer:                 f,
+		includeRawJSON:         includeRawJSON,
+		forwardCompatibleEnums: forwardCompatibleEnums,
+		extraProperties:        extraProperties,
+		includeOptionals:       includeOptionals,
+		reflectionFreeJSON:     true,
+	}
+	receiver := typeNameToReceiver(visitor.typeName)
+	switch shape := typeDeclaration.Shape; {
+	case shape.Object != nil:
+		literals := objectLiterals(shape.Object, f.types)
+		visitor.writeObjectUnmarshalJSON(shape.Object, receiver, "reflectUnmarshalJSON", literals, true)
+		visitor.writeObjectMarshalJSON(shape.Object, receiver, "reflectMarshalJSON", literals, true)
+		visitor.writeObjectJSONTestValues(shape.Object)
+	case shape.Union != nil && len(shape.Union.Types) > 0:
+		literals := unionLiterals(shape.Union, f.types)
+		visitor.writeUnionUnmarshalJSON(shape.Union, receiver, "reflectUnmarshalJSON", literals)
+		visitor.writeUnionMarshalJSON(shape.Union, receiver, "reflectMarshalJSON", literals)
+		visitor.writeUnionJSONTestValues(shape.Union)
+		visitor.writeUnionUnknownTest(shape.Union)
+	case shape.UndiscriminatedUnion != nil && len(shape.UndiscriminatedUnion.Members) > 0:
+		var members []*undiscriminatedUnionMember
+		for _, unionMember := range shape.UndiscriminatedUnion.Members {
+			field := typeReferenceToUndiscriminatedUnionField(unionMember.Type, f.types)
+			member := &undiscriminatedUnionMember{
+				field:     field,
+				variable:  "value" + strings.Title(field),
+				caseName:  firstLetterToLower(field),
+				value:     typeReferenceToGoType(unionMember.Type, f.types, f.typeOverrides, f.scope, f.baseImportPath, visitor.importPath, false),
+				valueType: unionMember.Type,
+			}
+			if unionMember.Type.Named != nil {
+				member.typeName = unionMember.Type.Named.TypeId
+			}
+			if unionMember.Type.Container != nil && unionMember.Type.Container.Literal != nil {
+				member.literal = literalToValue(unionMember.Type.Container.Literal)
+				member.isLiteral = true
+			}
+			members = append(members, member)
+		}
+		visitor.writeUndiscriminatedUnionUnmarshalJSON(receiver, "reflectUnmarshalJSON", members)
+		visitor.writeUndiscriminatedUnionMarshalJSON(receiver, "reflectMarshalJSON", members)
+		visitor.writeUndiscriminatedUnionJSONTestValues(members)
+	default:
+		return false
+	}
+	visitor.writeJSONTest(typeDeclaration.Shape.UndiscriminatedUnion == nil)
+	visitor.writeJSONBenchmark()
+	return true
+}
+
+// writeJSONTest writes the test that marshals and unmarshals each of the type's
+// sample values with both implementations, which must be equivalent. Each value
+// is also read with a trailing comma (which must not change the value), and with
+// upper case keys if it's always an object.
+func (t *typeVisitor) writeJSONTest(isObject bool) {
+	t.writer.P("func Test", t.typeName, "JSON(t *testing.T) {")
+	t.writer.P("for _, value := range test", t.typeName, "Values(0) {")
+	t.writer.P("data, err := value.MarshalJSON()")
+	t.writer.P("require.NoError(t, err)")
+	t.writer.P("expected, err := value.reflectMarshalJSON()")
+	t.writer.P("require.NoError(t, err)")
+	t.writer.P("assert.JSONEq(t, string(expected), string(data))")
+	t.writer.P()
+	t.writer.P("decoded := new(", t.typeName, ")")
+	t.writer.P("require.NoError(t, decoded.UnmarshalJSON(data))")
+	t.writer.P("expectedDecoded := new(", t.typeName, ")")
+	t.writer.P("require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))")
+	t.writer.P("assert.True(t, expectedDecoded.Equal(decoded), string(data))")
+	t.writer.P()
+	t.writer.P("invalid := append(data[:len(data):len(data)], ',')")
+	t.writer.P("if last := data[len(data)-1]; last == '}' || last == ']' {")
+	t.writer.P("invalid = append(data[:len(data)-1:len(data)-1], ',', last)")
+	t.writer.P("}")
+	t.writer.P("invalidDecoded := new(", t.typeName, ")")
+	t.writer.P("assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))")
+	t.writer.P("assert.Error(t, new(", t.typeName, ").reflectUnmarshalJSON(invalid))")
+	t.writer.P("assert.True(t, new(", t.typeName, ").Equal(invalidDecoded), string(invalid))")
+	if isObject {
+		t.writer.P()
+		t.writer.P("var properties map[string]json.RawMessage")
+		t.writer.P("require.NoError(t, json.Unmarshal(data, &properties))")
+		t.writer.P("upperCase := make(map[string]json.RawMessage, len(properties))")
+		t.writer.P("for key, property := range properties {")
+		t.writer.P("upperCase[strings.ToUpper(key)] = property")
+		t.writer.P("}")
+		t.writer.P("upperCaseData, err := json.Marshal(upperCase)")
+		t.writer.P("require.NoError(t, err)")
+		t.writer.P("decoded = new(", t.typeName, ")")
+		t.writer.P("require.NoError(t, decoded.UnmarshalJSON(upperCaseData))")
+		t.writer.P("expectedDecoded = new(", t.typeName, ")")
+		t.writer.P("require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))")
+		t.writer.P("assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeJSONBenchmark writes the benchmark that compares both implementations
+// with the type's first sample value.
+func (t *typeVisitor) writeJSONBenchmark() {
+	t.writer.P("func Benchmark", t.typeName, "JSON(b *testing.B) {")
+	t.writer.P("value := test", t.typeName, "Values(0)[0]")
+	t.writer.P("data, err := value.MarshalJSON()")
+	t.writer.P("require.NoError(b, err)")
+	benchmarks := []struct {
+		name string
+		call string
+	}{
+		{name: "Marshal", call: "_, _ = value.MarshalJSON()"},
+		{name: "MarshalReflect", call: "_, _ = value.reflectMarshalJSON()"},
+		{name: "Unmarshal", call: "_ = new(" + t.typeName + ").UnmarshalJSON(data)"},
+		{name: "UnmarshalReflect", call: "_ = new(" + t.typeName + ").reflectUnmarshalJSON(data)"},
+	}
+	for _, benchmark := range benchmarks {
+		t.writer.P("b.Run(", strconv.Quote(benchmark.name), ", func(b *testing.B) {")
+		t.writer.P("b.ReportAllocs()")
+		t.writer.P("for i := 0; i < b.N; i++ {")
+		t.writer.P(benchmark.call)
+		t.writer.P("}")
+		t.writer.P("})")
+	}
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeObjectJSONTestValues writes the function that returns the object's sample
+// values, i.e. with every field set, with none of them set, and with every explicit
+// null set (if any).
+func (t *typeVisitor) writeObjectJSONTestValues(object *ir.ObjectTypeDeclaration) {
+	fields := t.writer.structFieldsForObject(object, t.importPath, t.includeOptionals)
+	t.writeJSONTestValuesHeader("{}")
+	t.writer.P("{")
+	for _, field := range fields {
+		t.writer.P(field.name, ": ", t.jsonTestFieldValue(field), ",")
+	}
+	if t.hasExtraProperties(object) {
+		t.writer.P("ExtraProperties: map[string]interface{}{", strconv.Quote(extraPropertyKey(objectWireValues(object, t.writer.types))), ": \"value\"},")
+	}
+	t.writer.P("},")
+	t.writer.P("{},")
+	var nullFields []*structField
+	for _, field := range fields {
+		if strings.HasPrefix(field.goType, "*core.Optional[") {
+			nullFields = append(nullFields, field)
+		}
+	}
+	if len(nullFields) > 0 {
+		t.writer.P("{")
+		for _, field := range nullFields {
+			t.writer.P(field.name, ": &", strings.TrimPrefix(field.goType, "*"), "{Null: true},")
+		}
+		t.writer.P("},")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeUnionJSONTestValues writes the function that returns the union's sample
+// values, i.e. one for each of its types.
+func (t *typeVisitor) writeUnionJSONTestValues(union *ir.UnionTypeDeclaration) {
+	discriminantName := union.Discriminant.Name.PascalCase.UnsafeName
+	baseFields := t.unionBaseFields(union)
+	t.writeJSONTestValuesHeader("nil")
+	for _, unionType := range union.Types {
+		t.writer.P("{")
+		t.writer.P(discriminantName, ": ", strconv.Quote(unionType.DiscriminantValue.Name.OriginalName), ",")
+		for _, field := range baseFields {
+			t.writer.P(field.name, ": ", t.jsonTestFieldValue(field), ",")
+		}
+		switch unionType.Shape.PropertiesType {
+		case "samePropertiesAsObject":
+			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, ": ", t.jsonTestValue(&ir.TypeReference{Named: unionType.Shape.SamePropertiesAsObject}), ",")
+		case "singleProperty":
+			property := unionType.Shape.SingleProperty
+			if property.Type.Container != nil && property.Type.Container.Literal != nil {
+				t.writer.P(unionType.DiscriminantValue.Name.CamelCase.SafeName, ": ", literalToValue(property.Type.Container.Literal), ",")
+				break
+			}
+			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, ": ", t.jsonTestValue(property.Type), ",")
+		}
+		t.writer.P("},")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeUnionUnknownTest writes the test that reads a type that was added to the
+// API after this code was generated, which must be written back as-is and must
+// not be reported as invalid.
+func (t *typeVisitor) writeUnionUnknownTest(union *ir.UnionTypeDeclaration) {
+	data := `{"` + union.Discriminant.WireValue + `":"fern-unknown-type"}`
+	t.writer.P("func Test", t.typeName, "Unknown(t *testing.T) {")
+	t.writer.P("data := []byte(", strconv.Quote(data), ")")
+	t.writer.P("value := new(", t.typeName, ")")
+	t.writer.P("require.NoError(t, value.UnmarshalJSON(data))")
+	t.writer.P("if err := value.Validate(); err != nil {")
+	t.writer.P("assert.NotContains(t, err.Error(), \"invalid type\")")
+	t.writer.P("}")
+	t.writer.P("marshaled, err := value.MarshalJSON()")
+	t.writer.P("require.NoError(t, err)")
+	t.writer.P("assert.JSONEq(t, string(data), string(marshaled))")
+	t.writer.P()
+	t.writer.P("// A type that was never set is still invalid.")
+	t.writer.P("err = new(", t.typeName, ").Validate()")
+	t.writer.P("require.Error(t, err)")
+	t.writer.P("assert.Contains(t, err.Error(), \"invalid type\")")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeUndiscriminatedUnionJSONTestValues writes the function that returns the
+// union's sample values, i.e. one for each of its members.
+func (t *typeVisitor) writeUndiscriminatedUnionJSONTestValues(members []*undiscriminatedUnionMember) {
+	t.writeJSONTestValuesHeader("nil")
+	for _, member := range members {
+		value := member.literal
+		if !member.isLiteral {
+			value = t.jsonTestValue(member.valueType)
+		}
+		t.writer.P("{typeName: ", strconv.Quote(member.caseName), ", ", member.field, ": ", value, "},")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeJSONTestValuesHeader writes the beginning of the function that returns the
+// type's sample values, which only returns the given value once the maximum depth
+// is exceeded.
+func (t *typeVisitor) writeJSONTestValuesHeader(maxDepthValue string) {
+	t.writer.P("// test", t.typeName, "Values returns the sample ", t.typeName, " values used by the JSON")
+	t.writer.P("// tests, whose nested values are generated at the given depth.")
+	t.writer.P("func test", t.typeName, "Values(depth int) []*", t.typeName, " {")
+	t.writer.P("if depth > ", strconv.Itoa(maxJSONTestDepth), " {")
+	t.writer.P("return []*", t.typeName, "{", maxDepthValue, "}")
+	t.writer.P("}")
+	t.writer.P("return []*", t.typeName, "{")
+}
+
+// jsonTestFieldValue returns the sample value of the given field.
+func (t *typeVisitor) jsonTestFieldValue(field *structField) string {
+	if !strings.HasPrefix(field.goType, "*core.Optional[") {
+		return t.jsonTestValue(field.valueType)
+	}
+	optionalType := strings.TrimPrefix(field.goType, "*")
+	switch underlying := field.valueType.Container.Optional; {
+	case underlying.Container != nil && underlying.Container.Optional != nil:
+		return "&" + optionalType + "{Null: true}"
+	case underlying.Named != nil && isPointer(t.writer.types[underlying.Named.TypeId]):
+		// The optional's value is the object (or union) itself, but the
+		// sample union values are nil once the maximum depth is exceeded.
+		if t.writer.types[underlying.Named.TypeId].Shape.Object == nil {
+			return "&" + optionalType + "{Null: true}"
+		}
+		value := t.jsonTestValue(underlying)
+		if strings.HasPrefix(value, "&") {
+			return "&" + optionalType + "{Value: " + strings.TrimPrefix(value, "&") + "}"
+		}
+		return "&" + optionalType + "{Value: *" + value + "}"
+	}
+	return "&" + optionalType + "{Value: " + t.jsonTestValue(field.valueType.Container.Optional) + "}"
+}
+
+// jsonTestValue returns a sample value of the given type. The objects and unions
+// defined in the same package are generated by their own sample function at the
+// next depth, whereas the other packages' objects are empty (and their unions
+// are nil).
+func (t *typeVisitor) jsonTestValue(valueType *ir.TypeReference) string {
+	if isUnknownTypeReference(valueType) {
+		return `map[string]interface{}{"key": "value"}`
+	}
+	if valueType.Named != nil {
+		if _, ok := t.writer.typeOverrides[valueType.Named.TypeId]; ok {
+			return typeOverrideZeroValue(t.elementGoType(valueType))
+		}
+		typeDeclaration := t.writer.types[valueType.Named.TypeId]
+		if alias := typeDeclaration.Shape.Alias; alias != nil {
+			return t.jsonTestValue(alias.AliasOf)
+		}
+		goType := t.elementGoType(valueType)
+		if enum := typeDeclaration.Shape.Enum; enum != nil {
+			var wireValue string
+			if len(enum.Values) > 0 {
+				wireValue = enum.Values[0].Name.WireValue
+			}
+			return goType + "(" + strconv.Quote(wireValue) + ")"
+		}
+		if fernFilepathToImportPath(t.baseImportPath, valueType.Named.FernFilepath) == t.importPath {
+			return "test" + valueType.Named.Name.PascalCase.UnsafeName + "Values(depth + 1)[0]"
+		}
+		if typeDeclaration.Shape.Object != nil {
+			return "&" + strings.TrimPrefix(goType, "*") + "{}"
+		}
+		return "nil"
+	}
+	if valueType.Primitive != "" {
+		switch valueType.Primitive {
+		case ir.PrimitiveTypeInteger:
+			return "1"
+		case ir.PrimitiveTypeLong:
+			return "1234567890123"
+		case ir.PrimitiveTypeDouble:
+			return "1.5"
+		case ir.PrimitiveTypeBoolean:
+			return "true"
+		case ir.PrimitiveTypeDate:
+			return "time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)"
+		case ir.PrimitiveTypeDateTime:
+			return "time.Date(2024, time.January, 2, 3, 4, 5, 6000, time.UTC)"
+		case ir.PrimitiveTypeUuid:
+			return `uuid.MustParse("7f5c4b1e-3a2d-4c6b-9e8f-0a1b2c3d4e5f")`
+		case ir.PrimitiveTypeBase64:
+			return `[]byte("fern")`
+		}
+		return strconv.Quote(`fern <&> "json" é 🌿`)
+	}
+	switch container := valueType.Container; {
+	case container.List != nil:
+		return t.elementGoType(valueType) + "{" + t.jsonTestValue(container.List) + "}"
+	case container.Set != nil:
+		return t.elementGoType(valueType) + "{" + t.jsonTestValue(container.Set) + "}"
+	case container.Map != nil:
+		return t.elementGoType(valueType) + "{" + t.jsonTestValue(container.Map.KeyType) + ": " + t.jsonTestValue(container.Map.ValueType) + "}"
+	case container.Optional != nil:
+		underlying := optionalValueType(valueType)
+		if !t.isOptionalPointer(valueType) {
+			return t.jsonTestValue(underlying)
+		}
+		return "&[]" + strings.TrimPrefix(t.elementGoType(valueType), "*") + "{" + t.jsonTestValue(underlying) + "}[0]"
+	case container.Literal != nil:
+		return literalToValue(container.Literal)
+	}
+	return "nil"
+}
+
+// extraPropertyKey returns the key of the sample extra property, which must
+// not be one of the given wire values.
+func extraPropertyKey(wireValues []string) string {
+	key := "extraProperty"
+	for _, wireValue := range wireValues {
+		if wireValue == key {
+			return key + "Value"
+		}
+	}
+	return key
+}
+
+// objectLiterals returns all of the given object's literal properties,
+// including the extended properties (if any).
+func objectLiterals(object *ir.ObjectTypeDeclaration, types map[ir.TypeId]*ir.TypeDeclaration) []*literal {
+	var literals []*literal
+	for _, extend := range object.Extends {
+		literals = append(literals, objectLiterals(types[extend.TypeId].Shape.Object, types)...)
+	}
+	for _, property := range object.Properties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			literals = append(literals, &literal{Name: property.Name.Name, Value: property.ValueType.Container.Literal})
+		}
+	}
+	return literals
+}
+
+// unionLiterals returns all of the given union's extended and base literal properties.
+func unionLiterals(union *ir.UnionTypeDeclaration, types map[ir.TypeId]*ir.TypeDeclaration) []*literal {
+	var literals []*literal
+	for _, extend := range union.Extends {
+		literals = append(literals, objectLiterals(types[extend.TypeId].Shape.Object, types)...)
+	}
+	for _, property := range union.BaseProperties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			literals = append(literals, &literal{Name: property.Name.Name, Value: property.ValueType.Container.Literal})
+		}
+	}
+	return literals
+}
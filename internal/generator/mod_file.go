@@ -19,6 +19,11 @@ const (
 
 	// modFilename is the default name of a Go module file.
 	modFilename = "go.mod"
+
+	// compressionImportPath and compressionVersion specify the module
+	// required by the client's request compression (i.e. core/compression.go).
+	compressionImportPath = "github.com/klauspost/compress"
+	compressionVersion    = "v1.17.0"
 )
 
 // NewModFile returns a new *File for a go.mod.
@@ -30,7 +35,10 @@ const (
 // go 1.13
 //
 // require github.com/google/uuid v1.4.0
-func NewModFile(coordinator *coordinator.Client, c *ModuleConfig, requiresGenerics bool) (*File, string, error) {
+//
+// The compression module is only required if the client core is generated,
+// unless it's already listed in the configured imports.
+func NewModFile(coordinator *coordinator.Client, c *ModuleConfig, requiresGenerics bool, requiresCompression bool) (*File, string, error) {
 	if c.Path == "" {
 		return nil, "", fmt.Errorf("module path is required")
 	}
@@ -58,6 +66,9 @@ func NewModFile(coordinator *coordinator.Client, c *ModuleConfig, requiresGeneri
 	for path, version := range c.Imports {
 		fmt.Fprintf(buffer, "\t%s %s\n", path, version)
 	}
+	if _, ok := c.Imports[compressionImportPath]; requiresCompression && !ok {
+		fmt.Fprintf(buffer, "\t%s %s\n", compressionImportPath, compressionVersion)
+	}
 	fmt.Fprint(buffer, ")\n")
 	fmt.Fprintln(buffer)
 
@@ -15,18 +15,63 @@ import (
 var (
 	//go:embed model/core/stringer.go
 	stringerFile string
+
+	//go:embed model/core/validation.go
+	validationFile string
+
+	//go:embed model/core/time.go
+	timeFile string
+
+	//go:embed model/core/json.go
+	jsonFile string
+
+	//go:embed model/core/union.go
+	unionFile string
+
+	//go:embed model/core/extra_properties.go
+	extraPropertiesFile string
+
+	//go:embed model/core/equal.go
+	equalFile string
+
+	//go:embed model/core/json_reader.go
+	jsonReaderFile string
+
+	//go:embed model/core/json_reader_test.go
+	jsonReaderTestFile string
+
+	//go:embed model/core/json_writer.go
+	jsonWriterFile string
+
+	//go:embed model/core/json_writer_test.go
+	jsonWriterTestFile string
 )
 
 // WriteType writes a complete type, including all of its properties.
-func (f *fileWriter) WriteType(typeDeclaration *ir.TypeDeclaration, includeRawJSON bool) error {
+func (f *fileWriter) WriteType(
+	typeDeclaration *ir.TypeDeclaration,
+	includeRawJSON bool,
+	forwardCompatibleEnums bool,
+	extraProperties bool,
+	getters bool,
+	equalAndDeepCopy bool,
+	includeOptionals bool,
+	reflectionFreeJSON bool,
+) error {
 	visitor := &typeVisitor{
-		typeName:       typeDeclaration.Name.Name.PascalCase.UnsafeName,
-		baseImportPath: f.baseImportPath,
-		importPath:     fernFilepathToImportPath(f.baseImportPath, typeDeclaration.Name.FernFilepath),
-		writer:         f,
-		includeRawJSON: includeRawJSON,
-	}
-	f.WriteDocs(typeDeclaration.Docs)
+		typeName:               typeDeclaration.Name.Name.PascalCase.UnsafeName,
+		baseImportPath:         f.baseImportPath,
+		importPath:             fernFilepathToImportPath(f.baseImportPath, typeDeclaration.Name.FernFilepath),
+		writer:                 f,
+		includeRawJSON:         includeRawJSON,
+		forwardCompatibleEnums: forwardCompatibleEnums,
+		extraProperties:        extraProperties,
+		getters:                getters,
+		equalAndDeepCopy:       equalAndDeepCopy,
+		includeOptionals:       includeOptionals,
+		reflectionFreeJSON:     reflectionFreeJSON,
+	}
+	f.WriteDocsWithAvailability(typeDeclaration.Docs, typeDeclaration.Availability)
 	return typeDeclaration.Shape.Accept(visitor)
 }
 
@@ -38,13 +83,46 @@ type typeVisitor struct {
 	writer         *fileWriter
 
 	includeRawJSON bool
+
+	// forwardCompatibleEnums is set if enums should preserve the values
+	// that aren't known to this version of the API.
+	forwardCompatibleEnums bool
+
+	// extraProperties is set if every object should capture the properties
+	// that aren't known to this version of the API (as opposed to only the
+	// objects that declare them).
+	extraProperties bool
+
+	// getters is set if objects and unions should include a nil-safe
+	// Get<Field> method for each of their fields.
+	getters bool
+
+	// equalAndDeepCopy is set if objects and unions should include Equal
+	// and DeepCopy methods.
+	equalAndDeepCopy bool
+
+	// includeOptionals is set if optional object and union fields should
+	// be represented as a *core.Optional[T], which distinguishes null
+	// properties from omitted ones.
+	includeOptionals bool
+
+	// reflectionFreeJSON is set if every type should be (de)serialized with
+	// generated code (i.e. a core.JSONWriter and core.JSONReader) rather
+	// than with reflection.
+	reflectionFreeJSON bool
+}
+
+// hasExtraProperties returns true if the given object captures its extra
+// properties in an ExtraProperties field.
+func (t *typeVisitor) hasExtraProperties(object *ir.ObjectTypeDeclaration) bool {
+	return t.extraProperties || object.ExtraProperties
 }
 
 // Compile-time assertion.
 var _ ir.TypeVisitor = (*typeVisitor)(nil)
 
 func (t *typeVisitor) VisitAlias(alias *ir.AliasTypeDeclaration) error {
-	t.writer.P("type ", t.typeName, " = ", typeReferenceToGoType(alias.AliasOf, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false))
+	t.writer.P("type ", t.typeName, " = ", typeReferenceToGoType(alias.AliasOf, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, false))
 	t.writer.P()
 	return nil
 }
@@ -77,35 +155,46 @@ func (t *typeVisitor) VisitEnum(enum *ir.EnumTypeDeclaration) error {
 		enumNames[enumName] = struct{}{}
 	}
 
-	// Write all of the supported enum values in a single const block.
-	t.writer.P("const (")
-	for _, enumValue := range enum.Values {
-		t.writer.WriteDocs(enumValue.Docs)
-		enumName := t.typeName + enumValue.Name.Name.PascalCase.UnsafeName
+	valueNames := make([]string, len(enum.Values))
+	for i, enumValue := range enum.Values {
+		valueNames[i] = t.typeName + enumValue.Name.Name.PascalCase.UnsafeName
 		if useEnumWireValue {
-			enumName = t.typeName + enumValue.Name.WireValue
+			valueNames[i] = t.typeName + enumValue.Name.WireValue
 		}
-		t.writer.P(enumName, " ", t.typeName, fmt.Sprintf(" = %q", enumValue.Name.WireValue))
+	}
+
+	// Write all of the supported enum values in a single const block.
+	t.writer.P("const (")
+	for i, enumValue := range enum.Values {
+		t.writer.WriteDocsWithAvailability(enumValue.Docs, enumValue.Availability)
+		t.writer.P(valueNames[i], " ", t.typeName, fmt.Sprintf(" = %q", enumValue.Name.WireValue))
 	}
 	t.writer.P(")")
 	t.writer.P()
 
 	// Generate a constructor that can be used to validate the string value.
-	t.writer.P("func New", t.typeName, "FromString(s string) (", t.typeName, ", error) {")
-	t.writer.P("switch s {")
-	for _, enumValue := range enum.Values {
-		enumName := t.typeName + enumValue.Name.Name.PascalCase.UnsafeName
-		if useEnumWireValue {
-			enumName = t.typeName + enumValue.Name.WireValue
+	if t.forwardCompatibleEnums {
+		// Forward-compatible enums preserve any value that isn't known
+		// to this version of the API.
+		t.writer.P("// New", t.typeName, "FromString returns the ", t.typeName, " for the given string, which")
+		t.writer.P("// might not be one of its known values (see IsKnown).")
+		t.writer.P("func New", t.typeName, "FromString(s string) (", t.typeName, ", error) {")
+		t.writer.P("return ", t.typeName, "(s), nil")
+		t.writer.P("}")
+		t.writer.P()
+	} else {
+		t.writer.P("func New", t.typeName, "FromString(s string) (", t.typeName, ", error) {")
+		t.writer.P("switch s {")
+		for i, enumValue := range enum.Values {
+			t.writer.P("case \"", enumValue.Name.WireValue, "\":")
+			t.writer.P("return ", valueNames[i], ", nil")
 		}
-		t.writer.P("case \"", enumValue.Name.WireValue, "\":")
-		t.writer.P("return ", enumName, ", nil")
+		t.writer.P("}")
+		t.writer.P("var t ", t.typeName)
+		t.writer.P(`return "", fmt.Errorf("%s is not a valid %T", s, t)`)
+		t.writer.P("}")
+		t.writer.P()
 	}
-	t.writer.P("}")
-	t.writer.P("var t ", t.typeName)
-	t.writer.P(`return "", fmt.Errorf("%s is not a valid %T", s, t)`)
-	t.writer.P("}")
-	t.writer.P()
 
 	// Generate a pointer method so that it's easier to use the enum as
 	// an optional value.
@@ -115,12 +204,107 @@ func (t *typeVisitor) VisitEnum(enum *ir.EnumTypeDeclaration) error {
 	t.writer.P("}")
 	t.writer.P()
 
+	if t.forwardCompatibleEnums {
+		t.writeForwardCompatibleEnumMethods(enum, valueNames)
+		t.writer.P("// Validate returns an error if the ", t.typeName, " isn't one of its known values.")
+		t.writer.P("func (", receiver, " ", t.typeName, ") Validate() error {")
+		t.writer.P("if !", receiver, ".IsKnown() {")
+		t.writer.P(`return fmt.Errorf("%s is not a valid %T", string(`, receiver, "), ", receiver, ")")
+		t.writer.P("}")
+		t.writer.P("return nil")
+		t.writer.P("}")
+		t.writer.P()
+		return nil
+	}
+
+	// Generate a Validate method so that enums can be validated alongside
+	// the other generated types.
+	t.writer.P("// Validate returns an error if the ", t.typeName, " isn't one of its known values.")
+	t.writer.P("func (", receiver, " ", t.typeName, ") Validate() error {")
+	t.writer.P("_, err := New", t.typeName, "FromString(string(", receiver, "))")
+	t.writer.P("return err")
+	t.writer.P("}")
+	t.writer.P()
+
 	return nil
 }
 
+// writeForwardCompatibleEnumMethods writes the methods used to distinguish the
+// enum's known values from the unknown values that were added to the API after
+// the code was generated.
+func (t *typeVisitor) writeForwardCompatibleEnumMethods(enum *ir.EnumTypeDeclaration, valueNames []string) {
+	receiver := typeNameToReceiver(t.typeName)
+
+	t.writer.P("// IsKnown returns true if the ", t.typeName, " is one of its known values.")
+	t.writer.P("func (", receiver, " ", t.typeName, ") IsKnown() bool {")
+	if len(valueNames) == 0 {
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writer.P()
+	} else {
+		t.writer.P("switch ", receiver, " {")
+		t.writer.P("case ", strings.Join(valueNames, ", "), ":")
+		t.writer.P("return true")
+		t.writer.P("}")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writer.P()
+	}
+
+	t.writer.P("// Values returns all of the ", t.typeName, "'s known values.")
+	t.writer.P("func (", receiver, " ", t.typeName, ") Values() []", t.typeName, " {")
+	t.writer.P("return []", t.typeName, "{")
+	for _, valueName := range valueNames {
+		t.writer.P(valueName, ",")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+
+	visitNames := make([]string, len(enum.Values))
+	for i := range enum.Values {
+		visitNames[i] = "Visit" + strings.TrimPrefix(valueNames[i], t.typeName)
+	}
+	visitUnknown := visitUnknownMethodName(visitNames)
+
+	t.writer.P("// ", t.typeName, "Visitor visits each of the ", t.typeName, "'s known values, as well as")
+	t.writer.P("// any unknown value that was added to the API after this code was generated.")
+	t.writer.P("type ", t.typeName, "Visitor interface {")
+	for _, visitName := range visitNames {
+		t.writer.P(visitName, "() error")
+	}
+	t.writer.P(visitUnknown, "(value string) error")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("func (", receiver, " ", t.typeName, ") Accept(visitor ", t.typeName, "Visitor) error {")
+	if len(valueNames) > 0 {
+		t.writer.P("switch ", receiver, " {")
+		for i, valueName := range valueNames {
+			t.writer.P("case ", valueName, ":")
+			t.writer.P("return visitor.", visitNames[i], "()")
+		}
+		t.writer.P("}")
+	}
+	t.writer.P("return visitor.", visitUnknown, "(string(", receiver, "))")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// visitUnknownMethodName returns the name of the visitor method used for unknown
+// values, i.e. VisitUnknown, unless it conflicts with one of the given methods.
+func visitUnknownMethodName(visitNames []string) string {
+	for _, visitName := range visitNames {
+		if visitName == "VisitUnknown" {
+			return "VisitUnknownValue"
+		}
+	}
+	return "VisitUnknown"
+}
+
 func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 	t.writer.P("type ", t.typeName, " struct {")
-	_, literals := t.visitObjectProperties(object, true /* includeTags */, false /* includeOptionals */)
+	_, literals := t.visitObjectProperties(object, true /* includeTags */, true /* includeURLTags */, t.includeOptionals)
 
 	// If the object has a literal, it needs custom [de]serialization logic,
 	// and a getter method to access the field so that it's impossible for
@@ -131,6 +315,13 @@ func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 	for _, literal := range literals {
 		t.writer.P(literal.Name.CamelCase.SafeName, " ", literalToGoType(literal.Value))
 	}
+	extraProperties := t.hasExtraProperties(object)
+	if extraProperties {
+		t.writer.P()
+		t.writer.P("// ExtraProperties contains the properties that aren't recognized")
+		t.writer.P("// by the ", t.typeName, ", which are included when it's marshaled.")
+		t.writer.P("ExtraProperties map[string]interface{} `json:\"-\"`")
+	}
 	if t.includeRawJSON {
 		t.writer.P()
 		t.writer.P("_rawJSON json.RawMessage")
@@ -140,6 +331,22 @@ func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 
 	receiver := typeNameToReceiver(t.typeName)
 
+	// Implement the nil-safe getter methods.
+	structFields := t.writer.structFieldsForObject(object, t.importPath, t.includeOptionals)
+	if t.getters {
+		t.writer.writeGetters(t.typeName, receiver, structFields)
+	}
+
+	if extraProperties {
+		t.writer.P("func (", receiver, " *", t.typeName, ") GetExtraProperties() map[string]interface{} {")
+		t.writer.P("if ", receiver, " == nil {")
+		t.writer.P("return nil")
+		t.writer.P("}")
+		t.writer.P("return ", receiver, ".ExtraProperties")
+		t.writer.P("}")
+		t.writer.P()
+	}
+
 	// Implement the getter methods.
 	for _, literal := range literals {
 		t.writer.P("func (", receiver, " *", t.typeName, ") ", literal.Name.PascalCase.UnsafeName, "()", literalToGoType(literal.Value), "{")
@@ -148,44 +355,24 @@ func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 		t.writer.P()
 	}
 
-	// Implement the json.Unmarshaler interface.
-	if t.includeRawJSON || len(literals) > 0 {
-		t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
-		t.writer.P("type unmarshaler ", t.typeName)
-		t.writer.P("var value unmarshaler")
-		t.writer.P("if err := json.Unmarshal(data, &value); err != nil {")
-		t.writer.P("return err")
-		t.writer.P("}")
-		t.writer.P("*", receiver, " = ", t.typeName, "(value)")
-		for _, literal := range literals {
-			t.writer.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
+	// Implement the Equal and DeepCopy methods.
+	if t.equalAndDeepCopy {
+		if extraProperties {
+			structFields = append(structFields, extraPropertiesField)
 		}
+		t.writeEqualMethod(receiver, structFields)
 		if t.includeRawJSON {
-			t.writer.P(receiver, "._rawJSON = json.RawMessage(data)")
+			structFields = append(structFields, rawMessageField("_rawJSON"))
 		}
-		t.writer.P("return nil")
-		t.writer.P("}")
-		t.writer.P()
+		t.writeDeepCopyMethod(receiver, structFields)
 	}
 
-	// Implement the json.Marshaler interface (if we have any literals).
-	if len(literals) > 0 {
-		t.writer.P("func (", receiver, " *", t.typeName, ") MarshalJSON() ([]byte, error) {")
-		t.writer.P("type embed ", t.typeName)
-		t.writer.P("var marshaler = struct{")
-		t.writer.P("embed")
-		for _, literal := range literals {
-			t.writer.P(literal.Name.PascalCase.UnsafeName, " ", literalToGoType(literal.Value), " `json:\"", literal.Name.OriginalName, "\"`")
-		}
-		t.writer.P("}{")
-		t.writer.P("embed: embed(*", receiver, "),")
-		for _, literal := range literals {
-			t.writer.P(literal.Name.PascalCase.UnsafeName, ": ", literalToValue(literal.Value), ",")
-		}
-		t.writer.P("}")
-		t.writer.P("return json.Marshal(marshaler)")
-		t.writer.P("}")
-		t.writer.P()
+	// Implement the json.Unmarshaler and json.Marshaler interfaces.
+	if t.reflectionFreeJSON {
+		t.writeObjectJSONMethods(object, receiver, literals)
+	} else {
+		t.writeObjectUnmarshalJSON(object, receiver, "UnmarshalJSON", literals, false)
+		t.writeObjectMarshalJSON(object, receiver, "MarshalJSON", literals, false)
 	}
 
 	// Implement fmt.Stringer.
@@ -204,9 +391,123 @@ func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 	t.writer.P("}")
 	t.writer.P()
 
+	// Implement the core.Validator interface.
+	t.writer.writeValidateMethod(t.typeName, receiver, validationFieldsForObject(object, t.writer.types, t.includeOptionals))
+
 	return nil
 }
 
+// writeObjectUnmarshalJSON writes the object's reflection-based json.Unmarshaler
+// implementation (with the given method name), which is only required if the object
+// has any literal, date, optional, or extra properties (unless always is set).
+func (t *typeVisitor) writeObjectUnmarshalJSON(
+	object *ir.ObjectTypeDeclaration,
+	receiver string,
+	methodName string,
+	literals []*literal,
+	always bool,
+) {
+	extraProperties := t.hasExtraProperties(object)
+
+	// Date and date-time properties are (de)serialized with the core.Date
+	// and core.DateTime types, which override the embedded time.Time fields.
+	dates := datePropertiesForObject(object, t.writer.types, t.includeOptionals)
+
+	// Null optional properties aren't distinguishable from omitted ones unless
+	// they're set explicitly.
+	optionals := t.optionalPropertiesForObject(object)
+
+	if !always && !t.includeRawJSON && len(literals) == 0 && len(dates) == 0 && len(optionals) == 0 && !extraProperties {
+		return
+	}
+	t.writer.P("func (", receiver, " *", t.typeName, ") ", methodName, "(data []byte) error {")
+	if len(dates) > 0 {
+		t.writer.P("type embed ", t.typeName)
+		t.writer.P("var unmarshaler = struct{")
+		t.writer.P("embed")
+		for _, date := range dates {
+			t.writer.P(date.field())
+		}
+		t.writer.P("}{")
+		t.writer.P("embed: embed(*", receiver, "),")
+		t.writer.P("}")
+		t.writer.P("if err := json.Unmarshal(data, &unmarshaler); err != nil {")
+		t.writer.P("return err")
+		t.writer.P("}")
+		t.writer.P("*", receiver, " = ", t.typeName, "(unmarshaler.embed)")
+		for _, date := range dates {
+			t.writer.writeDateAccessor(date, receiver+"."+date.name, "unmarshaler."+date.name)
+		}
+	} else {
+		t.writer.P("type unmarshaler ", t.typeName)
+		t.writer.P("var value unmarshaler")
+		t.writer.P("if err := json.Unmarshal(data, &value); err != nil {")
+		t.writer.P("return err")
+		t.writer.P("}")
+		t.writer.P("*", receiver, " = ", t.typeName, "(value)")
+	}
+	t.writer.writeNullProperties(receiver, optionals)
+	for _, literal := range literals {
+		t.writer.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
+	}
+	if extraProperties {
+		t.writer.P("extraProperties, err := core.ExtractExtraProperties(data, ", quoteStrings(objectWireValues(object, t.writer.types)), ")")
+		t.writer.P("if err != nil {")
+		t.writer.P("return err")
+		t.writer.P("}")
+		t.writer.P(receiver, ".ExtraProperties = extraProperties")
+	}
+	if t.includeRawJSON {
+		t.writer.P(receiver, "._rawJSON = json.RawMessage(data)")
+	}
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeObjectMarshalJSON writes the object's reflection-based json.Marshaler
+// implementation (with the given method name), which is only required if the object
+// has any literal, date, or extra properties (unless always is set).
+func (t *typeVisitor) writeObjectMarshalJSON(
+	object *ir.ObjectTypeDeclaration,
+	receiver string,
+	methodName string,
+	literals []*literal,
+	always bool,
+) {
+	extraProperties := t.hasExtraProperties(object)
+	dates := datePropertiesForObject(object, t.writer.types, t.includeOptionals)
+	if !always && len(literals) == 0 && len(dates) == 0 && !extraProperties {
+		return
+	}
+	t.writer.P("func (", receiver, " *", t.typeName, ") ", methodName, "() ([]byte, error) {")
+	t.writer.P("type embed ", t.typeName)
+	t.writer.P("var marshaler = struct{")
+	t.writer.P("embed")
+	for _, literal := range literals {
+		t.writer.P(literal.Name.PascalCase.UnsafeName, " ", literalToGoType(literal.Value), " `json:\"", literal.Name.OriginalName, "\"`")
+	}
+	for _, date := range dates {
+		t.writer.P(date.field())
+	}
+	t.writer.P("}{")
+	t.writer.P("embed: embed(*", receiver, "),")
+	for _, literal := range literals {
+		t.writer.P(literal.Name.PascalCase.UnsafeName, ": ", literalToValue(literal.Value), ",")
+	}
+	for _, date := range dates {
+		t.writer.P(date.name, ": ", date.constructor(receiver+"."+date.name), ",")
+	}
+	t.writer.P("}")
+	if extraProperties {
+		t.writer.P("return core.MarshalJSONWithExtraProperties(marshaler, ", receiver, ".ExtraProperties)")
+	} else {
+		t.writer.P("return json.Marshal(marshaler)")
+	}
+	t.writer.P("}")
+	t.writer.P()
+}
+
 func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 	// Write the union type definition.
 	discriminantName := union.Discriminant.Name.PascalCase.UnsafeName
@@ -214,7 +515,7 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 	t.writer.P(discriminantName, " string")
 	var literals []*literal
 	for _, extend := range union.Extends {
-		_, extendedLiterals := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, false /* includeTags */, false /* includeOptionals */)
+		_, extendedLiterals := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, false /* includeTags */, false /* includeURLTags */, t.includeOptionals)
 		literals = append(literals, extendedLiterals...)
 	}
 	for _, property := range union.BaseProperties {
@@ -222,14 +523,14 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			literals = append(literals, &literal{Name: property.Name.Name, Value: property.ValueType.Container.Literal})
 			continue
 		}
-		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false))
+		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, t.includeOptionals))
 	}
 	// We handle the union's literals separate from the extended and base
 	// literals because we only want to set them if they were actually
 	// specified by the user.
 	var unionLiterals []*literal
 	for _, unionType := range union.Types {
-		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath)
+		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath)
 		if typeName == "" {
 			// If the union has no properties, there's nothing for us to do.
 			continue
@@ -254,6 +555,8 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 	for _, literal := range literals {
 		t.writer.P(literal.Name.CamelCase.SafeName, " ", literalToGoType(literal.Value))
 	}
+	t.writer.P()
+	t.writer.P("_unknown json.RawMessage")
 	t.writer.P("}")
 	t.writer.P()
 
@@ -267,7 +570,7 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			t.writer.P("func New", t.typeName, "With", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "() *", t.typeName, "{")
 			t.writer.P("return &", t.typeName, "{", discriminantName, ": \"", unionType.DiscriminantValue.Name.OriginalName, "\", ", fieldName, ": ", literalToValue(literal), "}")
 		} else {
-			t.writer.P("func New", t.typeName, "From", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(value ", singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath), ") *", t.typeName, "{")
+			t.writer.P("func New", t.typeName, "From", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(value ", singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath), ") *", t.typeName, "{")
 			t.writer.P("return &", t.typeName, "{", discriminantName, ": \"", unionType.DiscriminantValue.Name.OriginalName, "\", ", fieldName, ": value}")
 		}
 		t.writer.P("}")
@@ -276,6 +579,12 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 
 	receiver := typeNameToReceiver(t.typeName)
 
+	// Implement the nil-safe getter methods.
+	structFields := t.structFieldsForUnion(union)
+	if t.getters {
+		t.writer.writeGetters(t.typeName, receiver, structFields)
+	}
+
 	// Implement the getter methods.
 	for _, literal := range append(literals, unionLiterals...) {
 		t.writer.P("func (", receiver, " *", t.typeName, ") ", literal.Name.PascalCase.UnsafeName, "()", literalToGoType(literal.Value), "{")
@@ -284,13 +593,140 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 		t.writer.P()
 	}
 
-	// Implement the json.Unmarshaler interface.
-	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
+	// Implement the Equal and DeepCopy methods.
+	if t.equalAndDeepCopy {
+		structFields = append(structFields, rawMessageField("_unknown"))
+		t.writeEqualMethod(receiver, structFields)
+		t.writeDeepCopyMethod(receiver, structFields)
+	}
+
+	// Implement the json.Unmarshaler and json.Marshaler interfaces.
+	if t.reflectionFreeJSON {
+		t.writeUnionJSONMethods(union, receiver, literals)
+	} else {
+		t.writeUnionUnmarshalJSON(union, receiver, "UnmarshalJSON", literals)
+		t.writeUnionMarshalJSON(union, receiver, "MarshalJSON", literals)
+	}
+
+	// Generate the Visitor interface.
+	visitNames := make([]string, len(union.Types))
+	for i, unionType := range union.Types {
+		visitNames[i] = "Visit" + unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+	}
+	visitUnknown := visitUnknownMethodName(visitNames)
+	t.writer.P("type ", t.typeName, "Visitor interface {")
+	for i, unionType := range union.Types {
+		t.writer.P(visitNames[i], "(", singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath), ") error")
+	}
+	t.writer.P("// ", visitUnknown, " is called with the raw JSON of any type that was added")
+	t.writer.P("// to the API after this code was generated.")
+	t.writer.P(visitUnknown, "(discriminant string, data json.RawMessage) error")
+	t.writer.P("}")
+	t.writer.P()
+
+	// Generate the Accept method.
+	t.writer.P("func (", receiver, " *", t.typeName, ") Accept(visitor ", t.typeName, "Visitor) error {")
+	t.writer.P("switch ", receiver, ".", discriminantName, "{")
+	for i, unionType := range union.Types {
+		if i == 0 {
+			// Implement the default case first.
+			t.writer.P("default:")
+			t.writer.P("if ", receiver, "._unknown != nil {")
+			t.writer.P("return visitor.", visitUnknown, "(", receiver, ".", discriminantName, ", ", receiver, "._unknown)")
+			t.writer.P("}")
+			t.writer.P("return fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", ", receiver, ")")
+		}
+		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
+		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		if unionType.Shape.SingleProperty != nil && unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
+			fieldName = unionType.DiscriminantValue.Name.CamelCase.SafeName
+		}
+		t.writer.P("return visitor.Visit", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(", receiver, ".", fieldName, ")")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+
+	// Implement the core.Validator interface, which validates the base
+	// properties and the union's current type.
+	var fields []*validationField
+	for _, extend := range union.Extends {
+		fields = append(fields, validationFieldsForObject(t.writer.types[extend.TypeId].Shape.Object, t.writer.types, t.includeOptionals)...)
+	}
+	for _, property := range union.BaseProperties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(
+			fields,
+			&validationField{
+				name:             property.Name.Name.PascalCase.UnsafeName,
+				wireValue:        property.Name.WireValue,
+				valueType:        property.ValueType,
+				includeOptionals: t.includeOptionals,
+			},
+		)
+	}
+	t.writer.writeValidateMethodDocs(t.typeName)
+	t.writer.P("func (", receiver, " *", t.typeName, ") Validate() error {")
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P("var validation core.Validation")
+	t.writer.writeValidateFields(receiver, fields)
+	t.writer.P("switch ", receiver, ".", discriminantName, " {")
+	for _, unionType := range union.Types {
+		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
+		switch unionType.Shape.PropertiesType {
+		case "samePropertiesAsObject":
+			// The object's properties are in-lined alongside the discriminant,
+			// so its errors aren't nested under another field.
+			t.writer.P("validation.Add(\"\", ", receiver, ".", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, ".Validate())")
+		case "singleProperty":
+			property := unionType.Shape.SingleProperty
+			if property.Type.Container != nil && property.Type.Container.Literal != nil {
+				continue
+			}
+			t.writer.writeValidateFields(
+				receiver,
+				[]*validationField{
+					{
+						name:      unionType.DiscriminantValue.Name.PascalCase.UnsafeName,
+						wireValue: property.Name.WireValue,
+						valueType: property.Type,
+					},
+				},
+			)
+		}
+	}
+	t.writer.P("default:")
+	// Types added to the API after this code was generated are kept as-is.
+	t.writer.P("if ", receiver, "._unknown == nil {")
+	t.writer.P("validation.Add(\"", union.Discriminant.WireValue, "\", fmt.Errorf(\"invalid type %q\", ", receiver, ".", discriminantName, "))")
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P("return validation.Err()")
+	t.writer.P("}")
+	t.writer.P()
+
+	return nil
+}
+
+// writeUnionUnmarshalJSON writes the union's reflection-based json.Unmarshaler
+// implementation (with the given method name).
+func (t *typeVisitor) writeUnionUnmarshalJSON(
+	union *ir.UnionTypeDeclaration,
+	receiver string,
+	methodName string,
+	literals []*literal,
+) {
+	discriminantName := union.Discriminant.Name.PascalCase.UnsafeName
+	t.writer.P("func (", receiver, " *", t.typeName, ") ", methodName, "(data []byte) error {")
 	t.writer.P("var unmarshaler struct {")
 	t.writer.P(discriminantName, " string `json:\"", union.Discriminant.WireValue, "\"`")
 	var propertyNames []string
 	for _, extend := range union.Extends {
-		extendedProperties, _ := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, true /* includeTags */, false /* includeOptionals */)
+		extendedProperties, _ := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, true /* includeTags */, false /* includeURLTags */, t.includeOptionals)
 		propertyNames = append(propertyNames, extendedProperties...)
 	}
 	for _, property := range union.BaseProperties {
@@ -298,7 +734,7 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			continue
 		}
 		propertyNames = append(propertyNames, property.Name.Name.PascalCase.UnsafeName)
-		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
+		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, t.includeOptionals), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types, t.writer.typeOverrides))
 	}
 	t.writer.P("}")
 	t.writer.P("if err := json.Unmarshal(data, &unmarshaler); err != nil {")
@@ -311,6 +747,7 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 	for _, propertyName := range propertyNames {
 		t.writer.P(receiver, ".", propertyName, " = unmarshaler.", propertyName)
 	}
+	t.writer.writeNullProperties(receiver, t.optionalPropertiesForUnion(union))
 	for _, literal := range literals {
 		t.writer.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
 	}
@@ -335,8 +772,17 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			//    Boolean bool  `json:"value"`
 			//  }
 			t.writer.P("var valueUnmarshaler struct {")
-			typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath)
-			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " ", typeName, jsonTagForType(unionType.Shape.SingleProperty.Name.WireValue, unionType.Shape.SingleProperty.Type, t.writer.types))
+			if date := singlePropertyDate(unionType, t.writer.types); date != nil {
+				t.writer.P(date.field())
+				t.writer.P("}")
+				t.writer.P("if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {")
+				t.writer.P("return err")
+				t.writer.P("}")
+				t.writer.writeDateAccessor(date, receiver+"."+date.name, "valueUnmarshaler."+date.name)
+				continue
+			}
+			typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath)
+			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " ", typeName, jsonTagForType(unionType.Shape.SingleProperty.Name.WireValue, unionType.Shape.SingleProperty.Type, t.writer.types, t.writer.typeOverrides))
 			t.writer.P("}")
 			t.writer.P("if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {")
 			t.writer.P("return err")
@@ -348,43 +794,80 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 		t.writer.P("if err := json.Unmarshal(data, &value); err != nil {")
 		t.writer.P("return err")
 		t.writer.P("}")
+		if unionType.Shape.PropertiesType == "samePropertiesAsObject" &&
+			t.hasExtraProperties(t.writer.types[unionType.Shape.SamePropertiesAsObject.TypeId].Shape.Object) {
+			// The union's own properties aren't extra properties of the object.
+			t.writer.P("core.DeleteExtraProperties(value.ExtraProperties, ", quoteStrings(unionWireValues(union, t.writer.types)), ")")
+		}
 		t.writer.P(receiver, ".", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " = value")
 	}
+	// Unknown types are preserved as-is, so that they can be marshaled
+	// without losing any data.
+	t.writer.P("default:")
+	t.writer.P(receiver, "._unknown = append(json.RawMessage(nil), data...)")
 	t.writer.P("}")
 	t.writer.P("return nil")
 	t.writer.P("}")
 	t.writer.P()
+}
 
-	// Implement the json.Marshaler interface.
-	t.writer.P("func (", receiver, " ", t.typeName, ") MarshalJSON() ([]byte, error) {")
+// writeUnionMarshalJSON writes the union's reflection-based json.Marshaler
+// implementation (with the given method name).
+func (t *typeVisitor) writeUnionMarshalJSON(
+	union *ir.UnionTypeDeclaration,
+	receiver string,
+	methodName string,
+	literals []*literal,
+) {
+	discriminantName := union.Discriminant.Name.PascalCase.UnsafeName
+	t.writer.P("func (", receiver, " ", t.typeName, ") ", methodName, "() ([]byte, error) {")
 	t.writer.P("switch ", receiver, ".", discriminantName, " {")
 	for i, unionType := range union.Types {
 		if i == 0 {
 			// Implement the default case first.
 			t.writer.P("default:")
+			t.writer.P("if ", receiver, "._unknown != nil {")
+			t.writer.P("return ", receiver, "._unknown, nil")
+			t.writer.P("}")
 			t.writer.P("return nil, fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", ", receiver, ")")
 		}
 		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
 		t.writer.P("var marshaler = struct {")
 		t.writer.P(discriminantName, " string `json:\"", union.Discriminant.WireValue, "\"`")
 		// Include all of the extended and base properties.
+		var propertyNames []string
 		for _, extend := range union.Extends {
-			_, _ = t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, true /* includeTags */, false /* includeOptionals */)
+			extendedProperties, _ := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, true /* includeTags */, false /* includeURLTags */, t.includeOptionals)
+			propertyNames = append(propertyNames, extendedProperties...)
 		}
 		for _, property := range union.BaseProperties {
 			if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
 				continue
 			}
-			t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
+			propertyNames = append(propertyNames, property.Name.Name.PascalCase.UnsafeName)
+			t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, t.includeOptionals), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types, t.writer.typeOverrides))
 		}
 		for _, literal := range literals {
 			t.writer.P(literal.Name.PascalCase.UnsafeName, " ", literalToGoType(literal.Value), " `json:\"", literal.Name.OriginalName, "\"`")
 		}
-		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath)
+		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath)
+		date := singlePropertyDate(unionType, t.writer.types)
+		// If the object has its own json.Marshaler implementation, it can't be embedded
+		// in the marshaler (it would replace the marshaler's implementation altogether),
+		// so its properties are merged with the marshaler's instead.
+		mergeObject := unionType.Shape.PropertiesType == "samePropertiesAsObject" &&
+			t.hasCustomMarshaler(t.writer.types[unionType.Shape.SamePropertiesAsObject.TypeId].Shape.Object)
 		switch unionType.Shape.PropertiesType {
 		case "singleProperty":
-			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " ", typeName, jsonTagForType(unionType.Shape.SingleProperty.Name.WireValue, unionType.Shape.SingleProperty.Type, t.writer.types))
+			if date != nil {
+				t.writer.P(date.field())
+				break
+			}
+			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " ", typeName, jsonTagForType(unionType.Shape.SingleProperty.Name.WireValue, unionType.Shape.SingleProperty.Type, t.writer.types, t.writer.typeOverrides))
 		case "samePropertiesAsObject":
+			if mergeObject {
+				break
+			}
 			t.writer.P(typeName)
 		case "noProperties":
 			// For no properties, we always include the omitempty tag.
@@ -402,7 +885,9 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 		if unionType.Shape.SingleProperty != nil && unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
 			literal := unionType.Shape.SingleProperty.Type.Container.Literal
 			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, ": ", literalToValue(literal), ",")
-		} else {
+		} else if date != nil {
+			t.writer.P(date.name, ": ", date.constructor(receiver+"."+date.name), ",")
+		} else if !mergeObject {
 			marshalerFieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
 			if unionType.Shape.PropertiesType == "samePropertiesAsObject" {
 				// If the object is embedded, the field name is equivalent to
@@ -412,60 +897,36 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			t.writer.P(marshalerFieldName, ": ", receiver, ".", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, ",")
 		}
 		t.writer.P("}")
-		t.writer.P("return json.Marshal(marshaler)")
-	}
-	t.writer.P("}")
-	t.writer.P("}")
-	t.writer.P()
-
-	// Generate the Visitor interface.
-	t.writer.P("type ", t.typeName, "Visitor interface {")
-	for _, unionType := range union.Types {
-		t.writer.P("Visit", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(", singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath), ") error")
-	}
-	t.writer.P("}")
-	t.writer.P()
-
-	// Generate the Accept method.
-	t.writer.P("func (", receiver, " *", t.typeName, ") Accept(visitor ", t.typeName, "Visitor) error {")
-	t.writer.P("switch ", receiver, ".", discriminantName, "{")
-	for i, unionType := range union.Types {
-		if i == 0 {
-			// Implement the default case first.
-			t.writer.P("default:")
-			t.writer.P("return fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", ", receiver, ")")
-		}
-		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
-		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
-		if unionType.Shape.SingleProperty != nil && unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
-			fieldName = unionType.DiscriminantValue.Name.CamelCase.SafeName
+		if mergeObject {
+			t.writer.P("return core.MergeJSON(marshaler, ", receiver, ".", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, ")")
+			continue
 		}
-		t.writer.P("return visitor.Visit", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(", receiver, ".", fieldName, ")")
+		t.writer.P("return json.Marshal(marshaler)")
 	}
 	t.writer.P("}")
 	t.writer.P("}")
 	t.writer.P()
+}
 
-	return nil
+// undiscriminatedUnionMember represents a single undiscriminated union member.
+//
+// We define a separate struct here so that we can collect all of the relevant
+// information upfront, and traverse it multiple times seamlessly (and in the
+// order they're specified).
+type undiscriminatedUnionMember struct {
+	typeName  string
+	caseName  string
+	field     string
+	variable  string
+	value     string
+	docs      *string
+	literal   string
+	isLiteral bool
+	valueType *ir.TypeReference
 }
 
 func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTypeDeclaration) error {
-	// member represents a single undiscriminated union member.
-	//
-	// We define a separate struct here so that we can collect
-	// all of the relevant information upfront, and traverse it
-	// multiple times seamlessly (and in the order they're specified).
-	type member struct {
-		typeName  string
-		caseName  string
-		field     string
-		variable  string
-		value     string
-		docs      *string
-		literal   string
-		isLiteral bool
-	}
-	var members []*member
+	var members []*undiscriminatedUnionMember
 	var hasLiteral bool
 	for _, unionMember := range union.Members {
 		field := typeReferenceToUndiscriminatedUnionField(unionMember.Type, t.writer.types)
@@ -481,15 +942,16 @@ func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTy
 		hasLiteral = hasLiteral || isLiteral
 		members = append(
 			members,
-			&member{
+			&undiscriminatedUnionMember{
 				typeName:  typeName,
 				field:     field,
 				variable:  fmt.Sprintf("value%s", strings.Title(field)),
 				caseName:  firstLetterToLower(field),
-				value:     typeReferenceToGoType(unionMember.Type, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false),
+				value:     typeReferenceToGoType(unionMember.Type, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, false),
 				docs:      unionMember.Docs,
 				literal:   literal,
 				isLiteral: isLiteral,
+				valueType: unionMember.Type,
 			},
 		)
 	}
@@ -530,82 +992,174 @@ func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTy
 		t.writer.P()
 	}
 
-	// Implement the json.Unmarshaler interface.
-	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
-	for _, member := range members {
-		value := member.value
-		format := "var " + member.variable + " %s"
-		if member.typeName != "" && isPointer(t.writer.types[member.typeName]) {
-			format = member.variable + " := new(%s)"
-			value = strings.TrimLeft(value, "*")
+	// Implement the Equal and DeepCopy methods. Literal members are equal
+	// as long as the same member is set.
+	if t.equalAndDeepCopy {
+		structFields := []*structField{
+			{
+				name:      "typeName",
+				goType:    "string",
+				valueType: &ir.TypeReference{Primitive: ir.PrimitiveTypeString},
+			},
 		}
-		t.writer.P(fmt.Sprintf(format, value))
-		t.writer.P("if err := json.Unmarshal(data, &", member.variable, "); err == nil {")
-		if member.isLiteral {
-			// If the undiscriminated union specifies a literal, it will only
-			// succeed if the literal matches exactly.
-			t.writer.P("if ", member.variable, "== ", member.literal, " {")
-			t.writer.P(receiver, ".typeName = \"", member.caseName, "\"")
-			t.writer.P(receiver, ".", member.field, " = ", member.variable)
-			t.writer.P("return nil")
-			t.writer.P("}")
-			t.writer.P("}")
-			continue
+		for _, member := range members {
+			if member.isLiteral {
+				continue
+			}
+			structFields = append(
+				structFields,
+				&structField{
+					name:      member.field,
+					goType:    member.value,
+					valueType: member.valueType,
+				},
+			)
 		}
-		t.writer.P(receiver, ".typeName = \"", member.caseName, "\"")
-		t.writer.P(receiver, ".", member.field, " = ", member.variable)
-		t.writer.P("return nil")
-		t.writer.P("}")
+		t.writeEqualMethod(receiver, structFields)
+		t.writeDeepCopyMethod(receiver, structFields)
+	}
+
+	// Implement the json.Unmarshaler and json.Marshaler interfaces.
+	if t.reflectionFreeJSON {
+		t.writeUndiscriminatedUnionJSONMethods(receiver, members)
+	} else {
+		t.writeUndiscriminatedUnionUnmarshalJSON(receiver, "UnmarshalJSON", members)
+		t.writeUndiscriminatedUnionMarshalJSON(receiver, "MarshalJSON", members)
+	}
+
+	// Generate the Visitor interface.
+	t.writer.P("type ", t.typeName, "Visitor interface {")
+	for _, member := range members {
+		t.writer.P("Visit", strings.Title(member.field), "(", member.value, ") error")
 	}
-	t.writer.P(`return fmt.Errorf("%s cannot be deserialized as a %T", data, `, receiver, ")")
 	t.writer.P("}")
 	t.writer.P()
 
-	t.writer.P("func (", receiver, " ", t.typeName, ") MarshalJSON() ([]byte, error) {")
+	// Generate the Accept method.
+	t.writer.P("func (", receiver, " *", t.typeName, ") Accept(visitor ", t.typeName, "Visitor) error {")
 	t.writer.P("switch ", receiver, ".typeName {")
 	for i, member := range members {
 		if i == 0 {
 			// Implement the default case first.
 			t.writer.P("default:")
-			t.writer.P("return nil, fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".typeName, ", receiver, ")")
+			t.writer.P("return fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".typeName, ", receiver, ")")
 		}
 		t.writer.P("case \"", member.caseName, "\":")
-		if member.isLiteral {
-			// If we have a literal, we need to marshal it directly.
-			t.writer.P("return json.Marshal(", member.literal, ")")
-			continue
+		t.writer.P("return visitor.Visit", strings.Title(member.field), "(", receiver, ".", member.field, ")")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+
+	// Implement the core.Validator interface, which validates the union's current type.
+	var validatedMembers []*undiscriminatedUnionMember
+	for _, member := range members {
+		if !member.isLiteral && t.writer.isValidated(member.valueType) {
+			validatedMembers = append(validatedMembers, member)
 		}
-		t.writer.P("return json.Marshal(", receiver, ".", member.field, ")")
+	}
+	t.writer.writeValidateMethodDocs(t.typeName)
+	t.writer.P("func (", receiver, " *", t.typeName, ") Validate() error {")
+	if len(validatedMembers) == 0 {
+		t.writer.P("return nil")
+		t.writer.P("}")
+		t.writer.P()
+		return nil
+	}
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P("var validation core.Validation")
+	t.writer.P("switch ", receiver, ".typeName {")
+	for _, member := range validatedMembers {
+		t.writer.P("case \"", member.caseName, "\":")
+		t.writer.writeValidateValue(receiver+"."+member.field, newValidationPath(""), member.valueType, 0)
 	}
 	t.writer.P("}")
+	t.writer.P("return validation.Err()")
 	t.writer.P("}")
 	t.writer.P()
 
-	// Generate the Visitor interface.
-	t.writer.P("type ", t.typeName, "Visitor interface {")
+	return nil
+}
+
+// writeUndiscriminatedUnionUnmarshalJSON writes the union's reflection-based
+// json.Unmarshaler implementation (with the given method name), which matches
+// each of the members strictly (in order) before falling back to the best match.
+func (t *typeVisitor) writeUndiscriminatedUnionUnmarshalJSON(
+	receiver string,
+	methodName string,
+	members []*undiscriminatedUnionMember,
+) {
+	t.writer.P("func (", receiver, " *", t.typeName, ") ", methodName, "(data []byte) error {")
+	t.writer.P("decoder := core.NewUnionDecoder(data)")
 	for _, member := range members {
-		t.writer.P("Visit", strings.Title(member.field), "(", member.value, ") error")
+		value := member.value
+		target := "&" + member.variable
+		accessor := member.variable
+		if date := undiscriminatedUnionMemberDate(member.field, member.valueType, t.writer.types); date != nil && date.container {
+			// Containers of dates are deserialized through a core.Times so that
+			// they're only matched by values in the correct format, too.
+			t.writer.P("var ", member.variable, " ", value)
+			target = "core.New" + date.coreType + "s(&" + member.variable + ")"
+		} else if date != nil {
+			// Dates and date-times are deserialized with their core type so
+			// that they're only matched by values in the correct format.
+			t.writer.P(member.variable, " := new(core.", date.coreType, ")")
+			target = member.variable
+			accessor = date.accessor(member.variable)
+		} else if member.typeName != "" && isPointer(t.writer.types[member.typeName]) {
+			t.writer.P(member.variable, " := new(", strings.TrimLeft(value, "*"), ")")
+			target = member.variable
+		} else {
+			t.writer.P("var ", member.variable, " ", value)
+		}
+		t.writer.P("if decoder.Decode(")
+		t.writer.writeUnionMember(member.field, member.literal, t.unionObjectForTypeReference(member.valueType))
+		t.writer.P(target, ",")
+		t.writer.P("func() {")
+		t.writer.P(receiver, ".typeName = \"", member.caseName, "\"")
+		t.writer.P(receiver, ".", member.field, " = ", accessor)
+		t.writer.P("},")
+		t.writer.P(") {")
+		t.writer.P("return nil")
+		t.writer.P("}")
 	}
+	t.writer.P("return decoder.Fallback(", receiver, ")")
 	t.writer.P("}")
 	t.writer.P()
+}
 
-	// Generate the Accept method.
-	t.writer.P("func (", receiver, " *", t.typeName, ") Accept(visitor ", t.typeName, "Visitor) error {")
+// writeUndiscriminatedUnionMarshalJSON writes the union's reflection-based
+// json.Marshaler implementation (with the given method name).
+func (t *typeVisitor) writeUndiscriminatedUnionMarshalJSON(
+	receiver string,
+	methodName string,
+	members []*undiscriminatedUnionMember,
+) {
+	t.writer.P("func (", receiver, " ", t.typeName, ") ", methodName, "() ([]byte, error) {")
 	t.writer.P("switch ", receiver, ".typeName {")
 	for i, member := range members {
 		if i == 0 {
 			// Implement the default case first.
 			t.writer.P("default:")
-			t.writer.P("return fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".typeName, ", receiver, ")")
+			t.writer.P("return nil, fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".typeName, ", receiver, ")")
 		}
 		t.writer.P("case \"", member.caseName, "\":")
-		t.writer.P("return visitor.Visit", strings.Title(member.field), "(", receiver, ".", member.field, ")")
+		if member.isLiteral {
+			// If we have a literal, we need to marshal it directly.
+			t.writer.P("return json.Marshal(", member.literal, ")")
+			continue
+		}
+		if date := undiscriminatedUnionMemberDate(member.field, member.valueType, t.writer.types); date != nil {
+			t.writer.P("return json.Marshal(", date.constructor(receiver+"."+member.field), ")")
+			continue
+		}
+		t.writer.P("return json.Marshal(", receiver, ".", member.field, ")")
 	}
 	t.writer.P("}")
 	t.writer.P("}")
 	t.writer.P()
-
-	return nil
 }
 
 // undiscriminatedUnionTypeReferenceVisitor retrieves the string representation of type references
@@ -685,33 +1239,40 @@ type literal struct {
 
 // visitObjectProperties writes all of this object's properties, and recursively calls itself with
 // the object's extended properties (if any). The 'includeTags' parameter controls whether or not
-// to generate JSON struct tags, which is only relevant for object types (not unions).
+// to generate JSON struct tags, which is only relevant for object types (not unions). Similarly,
+// the 'includeURLTags' parameter controls whether or not to generate the url struct tags used to
+// encode the object as a query parameter, which is only relevant for the object's own type.
 //
 // A slice of all the transitive property names, as well as a sentinel value that signals whether
 // any of the properties are a literal value, are returned.
 func (t *typeVisitor) visitObjectProperties(
 	object *ir.ObjectTypeDeclaration,
 	includeTags bool,
+	includeURLTags bool,
 	includeOptionals bool,
 ) ([]string, []*literal) {
 	var names []string
 	var literals []*literal
 	for _, extend := range object.Extends {
 		// You can only extend other objects.
-		extendedNames, extendedLiterals := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, includeTags, includeOptionals)
+		extendedNames, extendedLiterals := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, includeTags, includeURLTags, includeOptionals)
 		names = append(names, extendedNames...)
 		literals = append(literals, extendedLiterals...)
 	}
 	for _, property := range object.Properties {
-		t.writer.WriteDocs(property.Docs)
+		t.writer.WriteDocsWithAvailability(property.Docs, property.Availability)
 		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
 			literals = append(literals, &literal{Name: property.Name.Name, Value: property.ValueType.Container.Literal})
 			continue
 		}
 		names = append(names, property.Name.Name.PascalCase.UnsafeName)
-		goType := typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, includeOptionals)
+		goType := typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, includeOptionals)
 		if includeTags {
-			t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", goType, jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
+			tags := jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types, t.writer.typeOverrides)
+			if includeURLTags {
+				tags = strings.TrimSuffix(tags, "`") + " " + urlTagForType(property.Name.WireValue, property.ValueType, t.writer.types, "") + "`"
+			}
+			t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", goType, tags)
 			continue
 		}
 		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", goType)
@@ -727,6 +1288,7 @@ type typeReferenceVisitor struct {
 	importPath       string
 	scope            *gospec.Scope
 	types            map[ir.TypeId]*ir.TypeDeclaration
+	typeOverrides    map[ir.TypeId]*TypeOverride
 	includeOptionals bool
 }
 
@@ -734,11 +1296,15 @@ type typeReferenceVisitor struct {
 var _ ir.TypeReferenceVisitor = (*typeReferenceVisitor)(nil)
 
 func (t *typeReferenceVisitor) VisitContainer(container *ir.ContainerType) error {
-	t.value = containerTypeToGoType(container, t.types, t.scope, t.baseImportPath, t.importPath, t.includeOptionals)
+	t.value = containerTypeToGoType(container, t.types, t.typeOverrides, t.scope, t.baseImportPath, t.importPath, t.includeOptionals)
 	return nil
 }
 
 func (t *typeReferenceVisitor) VisitNamed(named *ir.DeclaredTypeName) error {
+	if typeOverride, ok := t.typeOverrides[named.TypeId]; ok {
+		t.value = typeOverrideToGoType(typeOverride, t.scope, t.importPath)
+		return nil
+	}
 	format := "%s"
 	if isPointer(t.types[named.TypeId]) {
 		format = "*%s"
@@ -769,6 +1335,7 @@ type containerTypeVisitor struct {
 	importPath       string
 	scope            *gospec.Scope
 	types            map[ir.TypeId]*ir.TypeDeclaration
+	typeOverrides    map[ir.TypeId]*TypeOverride
 	includeOptionals bool
 }
 
@@ -776,12 +1343,12 @@ type containerTypeVisitor struct {
 var _ ir.ContainerTypeVisitor = (*containerTypeVisitor)(nil)
 
 func (c *containerTypeVisitor) VisitList(list *ir.TypeReference) error {
-	c.value = fmt.Sprintf("[]%s", typeReferenceToGoType(list, c.types, c.scope, c.baseImportPath, c.importPath, false))
+	c.value = fmt.Sprintf("[]%s", typeReferenceToGoType(list, c.types, c.typeOverrides, c.scope, c.baseImportPath, c.importPath, false))
 	return nil
 }
 
 func (c *containerTypeVisitor) VisitMap(mapType *ir.MapType) error {
-	c.value = fmt.Sprintf("map[%s]%s", typeReferenceToGoType(mapType.KeyType, c.types, c.scope, c.baseImportPath, c.importPath, false), typeReferenceToGoType(mapType.ValueType, c.types, c.scope, c.baseImportPath, c.importPath, false))
+	c.value = fmt.Sprintf("map[%s]%s", typeReferenceToGoType(mapType.KeyType, c.types, c.typeOverrides, c.scope, c.baseImportPath, c.importPath, false), typeReferenceToGoType(mapType.ValueType, c.types, c.typeOverrides, c.scope, c.baseImportPath, c.importPath, false))
 	return nil
 }
 
@@ -791,7 +1358,7 @@ func (c *containerTypeVisitor) VisitOptional(optional *ir.TypeReference) error {
 	//
 	// We also don't want to specify pointers for any container types because those
 	// values are already nil-able.
-	value := strings.TrimLeft(typeReferenceToGoType(optional, c.types, c.scope, c.baseImportPath, c.importPath, c.includeOptionals), "*")
+	value := strings.TrimLeft(typeReferenceToGoType(optional, c.types, c.typeOverrides, c.scope, c.baseImportPath, c.importPath, c.includeOptionals), "*")
 	if c.includeOptionals {
 		c.value = fmt.Sprintf("*core.Optional[%s]", value)
 		return nil
@@ -805,7 +1372,7 @@ func (c *containerTypeVisitor) VisitOptional(optional *ir.TypeReference) error {
 }
 
 func (c *containerTypeVisitor) VisitSet(set *ir.TypeReference) error {
-	c.value = fmt.Sprintf("[]%s", typeReferenceToGoType(set, c.types, c.scope, c.baseImportPath, c.importPath, false))
+	c.value = fmt.Sprintf("[]%s", typeReferenceToGoType(set, c.types, c.typeOverrides, c.scope, c.baseImportPath, c.importPath, false))
 	return nil
 }
 
@@ -822,6 +1389,7 @@ type singleUnionTypePropertiesVisitor struct {
 	importPath     string
 	scope          *gospec.Scope
 	types          map[ir.TypeId]*ir.TypeDeclaration
+	typeOverrides  map[ir.TypeId]*TypeOverride
 }
 
 // Compile-time assertion.
@@ -841,7 +1409,7 @@ func (c *singleUnionTypePropertiesVisitor) VisitSamePropertiesAsObject(named *ir
 }
 
 func (c *singleUnionTypePropertiesVisitor) VisitSingleProperty(property *ir.SingleUnionTypeProperty) error {
-	c.value = typeReferenceToGoType(property.Type, c.types, c.scope, c.baseImportPath, c.importPath, false)
+	c.value = typeReferenceToGoType(property.Type, c.types, c.typeOverrides, c.scope, c.baseImportPath, c.importPath, false)
 	return nil
 }
 
@@ -933,6 +1501,7 @@ func (l *literalTypeVisitor) VisitString(_ string) error {
 func typeReferenceToGoType(
 	typeReference *ir.TypeReference,
 	types map[ir.TypeId]*ir.TypeDeclaration,
+	typeOverrides map[ir.TypeId]*TypeOverride,
 	scope *gospec.Scope,
 	baseImportPath string,
 	importPath string,
@@ -943,6 +1512,7 @@ func typeReferenceToGoType(
 		importPath:       importPath,
 		scope:            scope,
 		types:            types,
+		typeOverrides:    typeOverrides,
 		includeOptionals: includeOptionals,
 	}
 	_ = typeReference.Accept(visitor)
@@ -953,6 +1523,7 @@ func typeReferenceToGoType(
 func containerTypeToGoType(
 	containerType *ir.ContainerType,
 	types map[ir.TypeId]*ir.TypeDeclaration,
+	typeOverrides map[ir.TypeId]*TypeOverride,
 	scope *gospec.Scope,
 	baseImportPath string,
 	importPath string,
@@ -963,6 +1534,7 @@ func containerTypeToGoType(
 		importPath:       importPath,
 		scope:            scope,
 		types:            types,
+		typeOverrides:    typeOverrides,
 		includeOptionals: includeOptionals,
 	}
 	_ = containerType.Accept(visitor)
@@ -973,6 +1545,7 @@ func containerTypeToGoType(
 func singleUnionTypePropertiesToGoType(
 	singleUnionTypeProperties *ir.SingleUnionTypeProperties,
 	types map[ir.TypeId]*ir.TypeDeclaration,
+	typeOverrides map[ir.TypeId]*TypeOverride,
 	scope *gospec.Scope,
 	baseImportPath string,
 	importPath string,
@@ -982,6 +1555,7 @@ func singleUnionTypePropertiesToGoType(
 		importPath:     importPath,
 		scope:          scope,
 		types:          types,
+		typeOverrides:  typeOverrides,
 	}
 	_ = singleUnionTypeProperties.Accept(visitor)
 	return visitor.value
@@ -1097,12 +1671,21 @@ func firstLetterToLower(s string) string {
 }
 
 // jsonTagForType returns the JSON tag for the given type. If the value type
-// is a required primitive, then we don't include the omitempty tag so that
-// the default values can be explicitly sent (e.g. "false" for bools).
-func jsonTagForType(wireValue string, valueType *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) string {
+// is a required primitive (or overridden type), then we don't include the
+// omitempty tag so that the default values can be explicitly sent (e.g.
+// "false" for bools).
+func jsonTagForType(
+	wireValue string,
+	valueType *ir.TypeReference,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+	typeOverrides map[ir.TypeId]*TypeOverride,
+) string {
 	if valueType != nil {
 		primitive := valueType.Primitive
 		if valueType.Named != nil {
+			if _, ok := typeOverrides[valueType.Named.TypeId]; ok {
+				return fmt.Sprintf(" `json:%q`", wireValue)
+			}
 			// If the type is an alias, we need to check if it's an alias to a primitive.
 			if typeDeclaration := types[valueType.Named.TypeId]; typeDeclaration.Shape.Alias != nil {
 				primitive = typeDeclaration.Shape.Alias.AliasOf.Primitive
@@ -1115,6 +1698,27 @@ func jsonTagForType(wireValue string, valueType *ir.TypeReference, types map[ir.
 	return fmt.Sprintf(" `json:\"%s,omitempty\"`", wireValue)
 }
 
+// urlTagForType returns the url tag used to encode a field of the given type as a
+// query parameter (see core.QueryValues), including the given style (if any).
+func urlTagForType(
+	wireValue string,
+	valueType *ir.TypeReference,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+	style string,
+) string {
+	options := []string{wireValue}
+	if valueType.Container != nil && valueType.Container.Optional != nil {
+		options = append(options, "omitempty")
+	}
+	if coreType, _ := dateCoreType(valueType, types); coreType == "Date" {
+		options = append(options, "date")
+	}
+	if style != "" {
+		options = append(options, style)
+	}
+	return fmt.Sprintf("url:%q", strings.Join(options, ","))
+}
+
 // unknownToGoType maps the given unknown into its Go-equivalent.
 func unknownToGoType(_ any) string {
 	return "interface{}"
@@ -1195,35 +1799,6 @@ func typeNameToFieldName(typeName string) string {
 	return strings.TrimLeft(typeName, "*")
 }
 
-// defaultValueForTypeReference returns the default value associated with the given *ir.TypeReference.
-// For named types and built-ins, this will just be nil, otherwise it will be the associated primitive
-// value.
-func defaultValueForTypeReference(typeReference *ir.TypeReference, types map[string]*ir.TypeDeclaration) string {
-	if typeReference.Container != nil {
-		if typeReference.Container.Literal != nil {
-			return ""
-		}
-		return "nil"
-	}
-	if typeReference.Named != nil {
-		return defaultValueForTypeDeclaration(types[typeReference.Named.TypeId], types)
-	}
-	if typeReference.Primitive != "" {
-		return defaultValueForPrimitiveType(typeReference.Primitive)
-	}
-	return "nil"
-}
-
-func defaultValueForTypeDeclaration(typeDeclaration *ir.TypeDeclaration, types map[string]*ir.TypeDeclaration) string {
-	if typeDeclaration.Shape.Alias != nil {
-		return defaultValueForTypeReference(typeDeclaration.Shape.Alias.AliasOf, types)
-	}
-	if typeDeclaration.Shape.Enum != nil {
-		return ""
-	}
-	return "nil"
-}
-
 func defaultValueForPrimitiveType(primitiveType ir.PrimitiveType) string {
 	switch primitiveType {
 	case ir.PrimitiveTypeInteger:
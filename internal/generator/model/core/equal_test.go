@@ -0,0 +1,36 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqualValue(t *testing.T) {
+	assert.True(t, EqualValue(nil, nil))
+	assert.True(t, EqualValue(map[string]interface{}{"tags": []interface{}{"a"}}, map[string]interface{}{"tags": []interface{}{"a"}}))
+	assert.False(t, EqualValue(map[string]interface{}{"tags": []interface{}{"a"}}, map[string]interface{}{"tags": []interface{}{"b"}}))
+	assert.False(t, EqualValue(json.Number("1"), "1"))
+	assert.False(t, EqualValue(nil, map[string]interface{}{}))
+}
+
+func TestDeepCopyValue(t *testing.T) {
+	value := map[string]interface{}{
+		"id":   json.Number("1"),
+		"tags": []interface{}{"a", map[string]interface{}{"name": "fern"}},
+		"raw":  json.RawMessage(`{"a":1}`),
+	}
+	copied := DeepCopyValue(value)
+	assert.Equal(t, value, copied)
+
+	copiedValue := copied.(map[string]interface{})
+	copiedValue["tags"].([]interface{})[1].(map[string]interface{})["name"] = "copy"
+	copiedValue["raw"].(json.RawMessage)[0] = '['
+	assert.Equal(t, "fern", value["tags"].([]interface{})[1].(map[string]interface{})["name"])
+	assert.Equal(t, json.RawMessage(`{"a":1}`), value["raw"])
+
+	assert.Nil(t, DeepCopyValue(nil))
+	assert.Equal(t, "fern", DeepCopyValue("fern"))
+	assert.Equal(t, []interface{}(nil), DeepCopyValue([]interface{}(nil)))
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractExtraProperties(t *testing.T) {
+	extraProperties, err := ExtractExtraProperties([]byte(`{"name":"fern","id":12345678901234567890,"tags":["a"]}`), "name")
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		map[string]interface{}{
+			"id":   json.Number("12345678901234567890"),
+			"tags": []interface{}{"a"},
+		},
+		extraProperties,
+	)
+
+	extraProperties, err = ExtractExtraProperties([]byte(`{"Name":"fern"}`), "name")
+	require.NoError(t, err)
+	assert.Nil(t, extraProperties)
+
+	_, err = ExtractExtraProperties([]byte(`"fern"`))
+	assert.Error(t, err)
+}
+
+func TestMarshalJSONWithExtraProperties(t *testing.T) {
+	type user struct {
+		Name string `json:"name"`
+	}
+
+	bytes, err := MarshalJSONWithExtraProperties(&user{Name: "fern"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"fern"}`, string(bytes))
+
+	bytes, err = MarshalJSONWithExtraProperties(
+		&user{Name: "fern"},
+		map[string]interface{}{
+			"id": json.Number("12345678901234567890"),
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"fern","id":12345678901234567890}`, string(bytes))
+
+	_, err = MarshalJSONWithExtraProperties(&user{Name: "fern"}, map[string]interface{}{"name": "other"})
+	assert.EqualError(t, err, `cannot add extra property "name": it's already defined`)
+}
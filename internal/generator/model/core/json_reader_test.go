@@ -0,0 +1,193 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONReader(t *testing.T) {
+	reader := NewJSONReader([]byte(` {"name": "fern", "count": 3, "tags": ["a", null], "nested": {"skip": [1, {"a": true}]}, "empty": {}} `))
+	var (
+		name   string
+		count  int
+		tags   []string
+		nested json.RawMessage
+	)
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.Key() {
+		case "name":
+			name = reader.ReadString()
+		case "count":
+			count = reader.ReadInt()
+		case "tags":
+			reader.BeginArray()
+			for reader.NextElement() {
+				tags = append(tags, reader.ReadString())
+			}
+		case "nested":
+			nested = reader.ReadRaw()
+		default:
+			reader.Skip()
+		}
+	}
+	require.NoError(t, reader.End())
+	assert.Equal(t, "fern", name)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []string{"a", ""}, tags)
+	assert.Equal(t, `{"skip": [1, {"a": true}]}`, string(nested))
+}
+
+func TestJSONReaderMatchKey(t *testing.T) {
+	reader := NewJSONReader([]byte(`{"Name": "a", "NAME": "b", "name": "c", "other": "d"}`))
+	var keys []string
+	reader.BeginObject()
+	for reader.NextKey() {
+		keys = append(keys, reader.MatchKey("NAME", "name"))
+		reader.Skip()
+	}
+	require.NoError(t, reader.End())
+	assert.Equal(t, []string{"NAME", "NAME", "name", "other"}, keys)
+}
+
+func TestJSONReaderString(t *testing.T) {
+	values := []string{
+		`""`,
+		`"fern"`,
+		`"\"quoted\" and \\escaped\\ \/"`,
+		`"<html> &"`,
+		`"control \b\f\n\r\t\u0000\u001f"`,
+		`"unicode: é 🌿 é 🌿"`,
+		`"surrogates: \ud83c \udf3f \ud83cx"`,
+		"\"invalid: \xff\xfe\"",
+	}
+	for _, value := range values {
+		var expected string
+		require.NoError(t, json.Unmarshal([]byte(value), &expected))
+		reader := NewJSONReader([]byte(value))
+		actual := reader.ReadString()
+		require.NoError(t, reader.End(), value)
+		assert.Equal(t, expected, actual, value)
+	}
+}
+
+func TestJSONReaderNumbers(t *testing.T) {
+	for _, value := range []string{"0", "-1", "42", "9223372036854775807", "-9223372036854775808"} {
+		var expected int64
+		require.NoError(t, json.Unmarshal([]byte(value), &expected))
+		reader := NewJSONReader([]byte(value))
+		actual := reader.ReadInt64()
+		require.NoError(t, reader.End(), value)
+		assert.Equal(t, expected, actual, value)
+	}
+	for _, value := range []string{"0", "-1.5", "3.14159", "1e-7", "1E+21", "12345678901234567890", "0.1"} {
+		var expected float64
+		require.NoError(t, json.Unmarshal([]byte(value), &expected))
+		reader := NewJSONReader([]byte(value))
+		actual := reader.ReadFloat64()
+		require.NoError(t, reader.End(), value)
+		assert.Equal(t, expected, actual, value)
+	}
+	for _, value := range []string{"1.5", "1e2", "9223372036854775808", `"1"`} {
+		reader := NewJSONReader([]byte(value))
+		reader.ReadInt64()
+		assert.Error(t, reader.End(), value)
+	}
+}
+
+func TestJSONReaderValues(t *testing.T) {
+	id := uuid.New()
+	reader := NewJSONReader([]byte(`["2024-01-02", "2024-01-02T03:04:05.000006Z", "ZmVybg==", null, "` + id.String() + `", true, null]`))
+	var (
+		date     time.Time
+		dateTime time.Time
+		bytes    []byte
+		null     []byte
+		actualID uuid.UUID
+		boolean  bool
+		missing  time.Time
+	)
+	reader.BeginArray()
+	reader.NextElement()
+	date = reader.ReadDate()
+	reader.NextElement()
+	dateTime = reader.ReadDateTime()
+	reader.NextElement()
+	bytes = reader.ReadBytes()
+	reader.NextElement()
+	null = reader.ReadBytes()
+	reader.NextElement()
+	reader.ReadText(&actualID)
+	reader.NextElement()
+	boolean = reader.ReadBool()
+	reader.NextElement()
+	missing = reader.ReadDateTime()
+	assert.False(t, reader.NextElement())
+	require.NoError(t, reader.End())
+
+	assert.Equal(t, time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC), date)
+	assert.Equal(t, time.Date(2024, time.January, 2, 3, 4, 5, 6000, time.UTC), dateTime)
+	assert.Equal(t, []byte("fern"), bytes)
+	assert.Nil(t, null)
+	assert.Equal(t, id, actualID)
+	assert.True(t, boolean)
+	assert.True(t, missing.IsZero())
+}
+
+func TestJSONReaderValue(t *testing.T) {
+	data := []byte(`{"a": [1, 2.5, "three", true, null, {}], "b": {"c": 12345678901234567890}}`)
+
+	var expected interface{}
+	require.NoError(t, json.Unmarshal(data, &expected))
+	reader := NewJSONReader(data)
+	actual := reader.ReadValue()
+	require.NoError(t, reader.End())
+	assert.Equal(t, expected, actual)
+
+	reader = NewJSONReader(data)
+	actual = reader.ReadValueUseNumber()
+	require.NoError(t, reader.End())
+	assert.Equal(t, json.Number("12345678901234567890"), actual.(map[string]interface{})["b"].(map[string]interface{})["c"])
+}
+
+func TestJSONReaderErrors(t *testing.T) {
+	tests := []struct {
+		data string
+		read func(reader *JSONReader)
+		err  string
+	}{
+		{`1`, func(reader *JSONReader) { reader.ReadString() }, "json: cannot unmarshal number into Go value of type string"},
+		{`"a"`, func(reader *JSONReader) { reader.BeginObject() }, "json: cannot unmarshal string into Go value of type object"},
+		{`{"a" 1}`, func(reader *JSONReader) { reader.Skip() }, "invalid character '1' after object key (at offset 5)"},
+		{`[1,]`, func(reader *JSONReader) { reader.Skip() }, "invalid character ']' looking for beginning of value (at offset 3)"},
+		{`{"a":1,}`, func(reader *JSONReader) { reader.Skip() }, "invalid character '}' looking for beginning of object key string (at offset 7)"},
+		{`[1 2]`, func(reader *JSONReader) { reader.Skip() }, "invalid character '2' after object key:value pair or array element (at offset 3)"},
+		{`"abc`, func(reader *JSONReader) { reader.Skip() }, "unexpected end of JSON input"},
+		{`1 2`, func(reader *JSONReader) { reader.Skip() }, "invalid character '2' after top-level value (at offset 2)"},
+		{`tru`, func(reader *JSONReader) { reader.Skip() }, "unexpected end of JSON input"},
+		{`"2024-13-01"`, func(reader *JSONReader) { reader.ReadDate() }, `"2024-13-01" is not a valid date: expected the format "2006-01-02"`},
+	}
+	for _, test := range tests {
+		reader := NewJSONReader([]byte(test.data))
+		test.read(reader)
+		assert.EqualError(t, reader.End(), test.err, test.data)
+	}
+}
+
+func TestReadJSONProperty(t *testing.T) {
+	var value string
+	require.NoError(t, ReadJSONProperty([]byte(`{"type": "user", "value": "fern"}`), "value", func(reader *JSONReader) {
+		value = reader.ReadString()
+	}))
+	assert.Equal(t, "fern", value)
+
+	require.NoError(t, ReadJSONProperty([]byte(`{"type": "user", "Value": "other"}`), "value", func(reader *JSONReader) {
+		value = reader.ReadString()
+	}))
+	assert.Equal(t, "other", value)
+}
@@ -0,0 +1,29 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeJSON(t *testing.T) {
+	type name struct {
+		Name string `json:"name"`
+	}
+	type empty struct{}
+
+	bytes, err := MergeJSON(
+		struct {
+			Type string `json:"type"`
+		}{Type: "user"},
+		&name{Name: "fern"},
+		empty{},
+		(*name)(nil),
+	)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"user","name":"fern"}`, string(bytes))
+
+	_, err = MergeJSON("fern")
+	assert.EqualError(t, err, `cannot merge "fern" into a JSON object`)
+}
@@ -0,0 +1,195 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		bytes, err := json.Marshal(NewDate(time.Date(2024, time.February, 29, 12, 30, 0, 0, time.UTC)))
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-02-29"`, string(bytes))
+
+		bytes, err = json.Marshal(NewOptionalDate(nil))
+		require.NoError(t, err)
+		assert.Equal(t, "null", string(bytes))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var date *Date
+		require.NoError(t, json.Unmarshal([]byte(`"2024-02-29"`), &date))
+		assert.Equal(t, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), date.Time())
+
+		require.NoError(t, json.Unmarshal([]byte("null"), &date))
+		assert.Nil(t, date.TimePtr())
+		assert.True(t, date.Time().IsZero())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var date Date
+		assert.EqualError(
+			t,
+			json.Unmarshal([]byte(`"2024-02-29T12:30:00Z"`), &date),
+			`"2024-02-29T12:30:00Z" is not a valid date: expected the format "2006-01-02"`,
+		)
+		assert.Error(t, json.Unmarshal([]byte("20240229"), &date))
+	})
+}
+
+func TestDateTime(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		bytes, err := json.Marshal(NewDateTime(time.Date(2024, time.February, 29, 12, 30, 0, 0, time.UTC)))
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-02-29T12:30:00Z"`, string(bytes))
+
+		bytes, err = json.Marshal(NewDateTime(time.Date(2024, time.February, 29, 12, 30, 0, 500000000, time.FixedZone("", -5*60*60))))
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-02-29T12:30:00.5-05:00"`, string(bytes))
+
+		bytes, err = json.Marshal(NewOptionalDateTime(nil))
+		require.NoError(t, err)
+		assert.Equal(t, "null", string(bytes))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		expected := time.Date(2024, time.February, 29, 12, 30, 0, 0, time.UTC)
+		tests := []string{
+			`"2024-02-29T12:30:00Z"`,
+			`"2024-02-29T12:30:00.000Z"`,
+			`"2024-02-29T14:30:00+02:00"`,
+			`"2024-02-29T14:30:00+0200"`,
+			`"2024-02-29 12:30:00Z"`,
+			`"2024-02-29T12:30:00"`,
+		}
+		for _, test := range tests {
+			t.Run(test, func(t *testing.T) {
+				var dateTime DateTime
+				require.NoError(t, json.Unmarshal([]byte(test), &dateTime))
+				assert.True(t, expected.Equal(dateTime.Time()), "expected %v, but found %v", expected, dateTime.Time())
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var dateTime DateTime
+		assert.EqualError(
+			t,
+			json.Unmarshal([]byte(`"2024-02-29"`), &dateTime),
+			`"2024-02-29" is not a valid date-time: expected an RFC 3339 date-time (e.g. "2006-01-02T15:04:05Z07:00")`,
+		)
+	})
+}
+
+func TestTimes(t *testing.T) {
+	var (
+		first  = time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)
+		second = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("marshal", func(t *testing.T) {
+		tests := []struct {
+			desc string
+			give *Times
+			want string
+		}{
+			{
+				desc: "list",
+				give: NewDates([]time.Time{first, second}),
+				want: `["2024-02-29","2024-03-01"]`,
+			},
+			{
+				desc: "nested",
+				give: NewDates([][]*time.Time{{&first, nil}, nil}),
+				want: `[["2024-02-29",null],null]`,
+			},
+			{
+				desc: "map",
+				give: NewDates(map[string]time.Time{"first": first}),
+				want: `{"first":"2024-02-29"}`,
+			},
+			{
+				desc: "date-times",
+				give: NewDateTimes(map[string][]time.Time{"first": {first}}),
+				want: `{"first":["2024-02-29T00:00:00Z"]}`,
+			},
+			{
+				desc: "single",
+				give: NewDates(&first),
+				want: `"2024-02-29"`,
+			},
+			{
+				desc: "nil list",
+				give: NewDates([]time.Time(nil)),
+				want: "null",
+			},
+			{
+				desc: "empty list",
+				give: NewDates([]time.Time{}),
+				want: "[]",
+			},
+		}
+		for _, test := range tests {
+			t.Run(test.desc, func(t *testing.T) {
+				bytes, err := json.Marshal(test.give)
+				require.NoError(t, err)
+				assert.Equal(t, test.want, string(bytes))
+			})
+		}
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		assert.Nil(t, NewOptionalDates([]time.Time(nil)))
+		assert.Nil(t, NewOptionalDates(map[string]time.Time{}))
+		assert.NotNil(t, NewOptionalDateTimes([]time.Time{first}))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var dates [][]*time.Time
+		require.NoError(t, json.Unmarshal([]byte(`[["2024-02-29",null],null]`), NewDates(&dates)))
+		assert.Equal(t, [][]*time.Time{{&first, nil}, nil}, dates)
+
+		var dateTimes map[string]time.Time
+		require.NoError(t, json.Unmarshal([]byte(`{"first":"2024-02-29T00:00:00"}`), NewDateTimes(&dateTimes)))
+		assert.Equal(t, map[string]time.Time{"first": first}, dateTimes)
+
+		var date time.Time
+		require.NoError(t, json.Unmarshal([]byte(`"2024-03-01"`), NewDates(&date)))
+		assert.Equal(t, second, date)
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		var value struct {
+			Dates *Times `json:"dates"`
+			Other *Times `json:"other"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(`{"dates":["2024-02-29"]}`), &value))
+
+		dates := []time.Time{second}
+		require.NoError(t, value.Dates.DecodeDates(&dates))
+		assert.Equal(t, []time.Time{first}, dates)
+
+		// An omitted property is decoded as the zero value.
+		require.NoError(t, value.Other.DecodeDates(&dates))
+		assert.Nil(t, dates)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var dates []time.Time
+		assert.EqualError(
+			t,
+			json.Unmarshal([]byte(`["2024-02-29T12:30:00Z"]`), NewDates(&dates)),
+			`"2024-02-29T12:30:00Z" is not a valid date: expected the format "2006-01-02"`,
+		)
+		assert.EqualError(
+			t,
+			json.Unmarshal([]byte(`{"first":"2024-02-29"}`), NewDates(&dates)),
+			"json: cannot unmarshal object into Go value of type []time.Time",
+		)
+		assert.Error(t, json.Unmarshal([]byte(`[]`), NewDates(dates)))
+	})
+}
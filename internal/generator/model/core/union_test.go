@@ -0,0 +1,184 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type unionUser struct {
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+}
+
+type unionOrganization struct {
+	Name    string `json:"name"`
+	Website string `json:"website"`
+}
+
+type unionStatus string
+
+func (u unionStatus) Validate() error {
+	if u != "active" {
+		return errors.New("invalid status")
+	}
+	return nil
+}
+
+// testUnion is equivalent to the generated code for an undiscriminated
+// union of a user, an organization, a status, a string, and a literal.
+type testUnion struct {
+	typeName     string
+	User         *unionUser
+	Organization *unionOrganization
+	Status       unionStatus
+	String       string
+	Literal      bool
+}
+
+func (t *testUnion) UnmarshalJSON(data []byte) error {
+	decoder := NewUnionDecoder(data)
+	valueUser := new(unionUser)
+	if decoder.Decode(
+		&UnionMember{
+			Name: "User",
+			Object: &UnionObject{
+				Required: []string{"name"},
+				Optional: []string{"email"},
+			},
+		},
+		valueUser,
+		func() {
+			t.typeName = "user"
+			t.User = valueUser
+		},
+	) {
+		return nil
+	}
+	valueOrganization := new(unionOrganization)
+	if decoder.Decode(
+		&UnionMember{
+			Name: "Organization",
+			Object: &UnionObject{
+				Required: []string{"name", "website"},
+				Literals: map[string]interface{}{"type": "organization"},
+			},
+		},
+		valueOrganization,
+		func() {
+			t.typeName = "organization"
+			t.Organization = valueOrganization
+		},
+	) {
+		return nil
+	}
+	var valueStatus unionStatus
+	if decoder.Decode(
+		&UnionMember{Name: "Status"},
+		&valueStatus,
+		func() {
+			t.typeName = "status"
+			t.Status = valueStatus
+		},
+	) {
+		return nil
+	}
+	var valueString string
+	if decoder.Decode(
+		&UnionMember{Name: "String"},
+		&valueString,
+		func() {
+			t.typeName = "string"
+			t.String = valueString
+		},
+	) {
+		return nil
+	}
+	var valueLiteral bool
+	if decoder.Decode(
+		&UnionMember{Name: "Literal", Literal: true},
+		&valueLiteral,
+		func() {
+			t.typeName = "literal"
+			t.Literal = valueLiteral
+		},
+	) {
+		return nil
+	}
+	return decoder.Fallback(t)
+}
+
+func TestUnionDecoder(t *testing.T) {
+	tests := []struct {
+		desc     string
+		giveData string
+		wantType string
+		wantErr  string
+	}{
+		{
+			desc:     "object",
+			giveData: `{"name":"fern","email":"hello@buildwithfern.com"}`,
+			wantType: "user",
+		},
+		{
+			desc:     "unknown property rejects the first object",
+			giveData: `{"name":"fern","website":"buildwithfern.com","type":"organization"}`,
+			wantType: "organization",
+		},
+		{
+			desc:     "missing literal property",
+			giveData: `{"name":"fern","website":"buildwithfern.com"}`,
+			wantType: "user",
+		},
+		{
+			desc:     "best match",
+			giveData: `{"name":"fern","website":"buildwithfern.com","type":"organization","unknown":true}`,
+			wantType: "organization",
+		},
+		{
+			desc:     "missing required property",
+			giveData: `{"email":"hello@buildwithfern.com"}`,
+			wantType: "user",
+		},
+		{
+			desc:     "valid enum",
+			giveData: `"active"`,
+			wantType: "status",
+		},
+		{
+			desc:     "invalid enum",
+			giveData: `"inactive"`,
+			wantType: "string",
+		},
+		{
+			desc:     "literal",
+			giveData: `true`,
+			wantType: "literal",
+		},
+		{
+			desc:     "no match",
+			giveData: `false`,
+			wantErr: "false cannot be deserialized as a *core.testUnion: " +
+				"User: expected an object; " +
+				"Organization: expected an object; " +
+				"Status: json: cannot unmarshal bool into Go value of type core.unionStatus; " +
+				"String: json: cannot unmarshal bool into Go value of type string; " +
+				"Literal: expected literal true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var union testUnion
+			err := json.Unmarshal([]byte(tt.giveData), &union)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, union.typeName)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testValidator struct {
+	err error
+}
+
+func (t *testValidator) Validate() error {
+	return t.err
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("fern"))
+	assert.NoError(t, Validate(&testValidator{}))
+	assert.EqualError(t, Validate(&testValidator{err: errors.New("invalid")}), "invalid")
+}
+
+func TestValidation(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		var validation Validation
+		validation.Add("name", nil)
+		assert.NoError(t, validation.Err())
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		var nested Validation
+		nested.Required("name")
+		nested.Add("", errors.New("invalid type"))
+
+		var validation Validation
+		validation.Required("id")
+		validation.Add("users[0]", nested.Err())
+		validation.Add("color", errors.New("purple is not a valid Color"))
+
+		err := validation.Err()
+		var validationErrors ValidationErrors
+		require.True(t, errors.As(err, &validationErrors))
+		require.Len(t, validationErrors, 4)
+		assert.Equal(t, "users[0].name", validationErrors[1].Field)
+		assert.Equal(t, "users[0]", validationErrors[2].Field)
+		assert.EqualError(
+			t,
+			err,
+			"id: is required; users[0].name: is required; users[0]: invalid type; color: purple is not a valid Color",
+		)
+	})
+}
+
+func TestJoinValidationField(t *testing.T) {
+	assert.Equal(t, "name", joinValidationField("", "name"))
+	assert.Equal(t, "user", joinValidationField("user", ""))
+	assert.Equal(t, "user.name", joinValidationField("user", "name"))
+	assert.Equal(t, "users[0].name", joinValidationField("users", "[0].name"))
+}
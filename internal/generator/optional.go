@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// optionalProperty is an optional property that's represented as a *core.Optional[T]
+// (i.e. when includeOptionals is set), which must be set to an explicit null when
+// it's unmarshaled from a null JSON value.
+type optionalProperty struct {
+	name      string // e.g. "Name"
+	wireValue string // e.g. "name"
+	goType    string // e.g. "core.Optional[string]"
+}
+
+// optionalPropertiesForObject returns the optionalProperties for all of the given
+// object's properties, including the extended properties (if any).
+func (t *typeVisitor) optionalPropertiesForObject(object *ir.ObjectTypeDeclaration) []*optionalProperty {
+	var properties []*optionalProperty
+	for _, extend := range object.Extends {
+		properties = append(properties, t.optionalPropertiesForObject(t.writer.types[extend.TypeId].Shape.Object)...)
+	}
+	for _, property := range object.Properties {
+		if optional := t.optionalProperty(property.Name, property.ValueType); optional != nil {
+			properties = append(properties, optional)
+		}
+	}
+	return properties
+}
+
+// optionalPropertiesForUnion returns the optionalProperties for all of the given
+// union's extended and base properties.
+func (t *typeVisitor) optionalPropertiesForUnion(union *ir.UnionTypeDeclaration) []*optionalProperty {
+	var properties []*optionalProperty
+	for _, extend := range union.Extends {
+		properties = append(properties, t.optionalPropertiesForObject(t.writer.types[extend.TypeId].Shape.Object)...)
+	}
+	for _, property := range union.BaseProperties {
+		if optional := t.optionalProperty(property.Name, property.ValueType); optional != nil {
+			properties = append(properties, optional)
+		}
+	}
+	return properties
+}
+
+// optionalProperty returns the optionalProperty for the given property, or nil
+// if it isn't represented as a *core.Optional[T].
+func (t *typeVisitor) optionalProperty(name *ir.NameAndWireValue, valueType *ir.TypeReference) *optionalProperty {
+	if !t.includeOptionals || valueType.Container == nil || valueType.Container.Optional == nil {
+		return nil
+	}
+	goType := typeReferenceToGoType(valueType, t.writer.types, t.writer.typeOverrides, t.writer.scope, t.baseImportPath, t.importPath, true)
+	return &optionalProperty{
+		name:      name.Name.PascalCase.UnsafeName,
+		wireValue: name.WireValue,
+		goType:    strings.TrimPrefix(goType, "*"),
+	}
+}
+
+// writeNullProperties writes the statements that set each of the given optional
+// properties to an explicit null if it's null in the JSON object, which is
+// otherwise indistinguishable from an omitted property.
+func (f *fileWriter) writeNullProperties(receiver string, properties []*optionalProperty) {
+	if len(properties) == 0 {
+		return
+	}
+	wireValues := make([]string, len(properties))
+	for i, property := range properties {
+		wireValues[i] = property.wireValue
+	}
+	f.P("nullProperties, err := core.NullProperties(data, ", quoteStrings(wireValues), ")")
+	f.P("if err != nil {")
+	f.P("return err")
+	f.P("}")
+	for _, property := range properties {
+		f.P("if nullProperties[", strconv.Quote(property.wireValue), "] {")
+		f.P(receiver, ".", property.name, " = &", property.goType, "{Null: true}")
+		f.P("}")
+	}
+}
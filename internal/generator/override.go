@@ -0,0 +1,313 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	fernir "github.com/fern-api/fern-go/internal/fern/ir"
+	"github.com/fern-api/fern-go/internal/gospec"
+)
+
+// primitiveTypeOverrides maps the primitives that can be overridden (named as they
+// are in the Fern definition) to their IR equivalent.
+var primitiveTypeOverrides = map[string]fernir.PrimitiveType{
+	"integer":  fernir.PrimitiveTypeInteger,
+	"double":   fernir.PrimitiveTypeDouble,
+	"string":   fernir.PrimitiveTypeString,
+	"boolean":  fernir.PrimitiveTypeBoolean,
+	"long":     fernir.PrimitiveTypeLong,
+	"datetime": fernir.PrimitiveTypeDateTime,
+	"date":     fernir.PrimitiveTypeDate,
+	"uuid":     fernir.PrimitiveTypeUuid,
+	"base64":   fernir.PrimitiveTypeBase64,
+}
+
+// applyTypeOverrides updates the given IR so that every overridden type is opaque,
+// i.e. it's represented as an alias to an unknown type, which no longer references
+// any other types.
+//
+// Every overridden primitive is replaced with a reference to an equivalent opaque
+// type, whose ID is the primitive's name (e.g. uuid). This way, the overrides are
+// always keyed by type ID, and both are skipped when the types are generated.
+func applyTypeOverrides(ir *fernir.IntermediateRepresentation, typeOverrides map[string]*TypeOverride) error {
+	if len(typeOverrides) == 0 {
+		return nil
+	}
+	for _, key := range sortedTypeOverrideKeys(typeOverrides) {
+		if _, ok := ir.Types[key]; ok {
+			if err := validateTypeOverride(ir, key); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := primitiveTypeOverrides[key]; !ok {
+			return fmt.Errorf("typeOverrides %q doesn't refer to a type or primitive", key)
+		}
+	}
+	primitives := make(map[fernir.PrimitiveType]*fernir.DeclaredTypeName)
+	for key, primitive := range primitiveTypeOverrides {
+		if _, ok := typeOverrides[key]; !ok {
+			continue
+		}
+		declaredTypeName := &fernir.DeclaredTypeName{
+			TypeId:       key,
+			FernFilepath: new(fernir.FernFilepath),
+			Name:         typeOverrideName(key, primitiveToUndiscriminatedUnionField(primitive)),
+		}
+		primitives[primitive] = declaredTypeName
+		ir.Types[key] = &fernir.TypeDeclaration{Name: declaredTypeName}
+	}
+	if len(primitives) > 0 {
+		visitTypeReferencesInIR(
+			ir,
+			func(typeReference *fernir.TypeReference) {
+				if declaredTypeName, ok := primitives[typeReference.Primitive]; ok {
+					*typeReference = *fernir.NewTypeReferenceFromNamed(declaredTypeName)
+				}
+			},
+		)
+	}
+	for _, irType := range ir.Types {
+		if _, ok := typeOverrides[irType.Name.TypeId]; ok {
+			irType.Shape = fernir.NewTypeFromAlias(
+				&fernir.AliasTypeDeclaration{
+					AliasOf:      fernir.NewTypeReferenceFromUnknown(nil),
+					ResolvedType: fernir.NewResolvedTypeReferenceFromUnknown(nil),
+				},
+			)
+			irType.ReferencedTypes = nil
+			continue
+		}
+		var referencedTypes []fernir.TypeId
+		for _, referencedType := range irType.ReferencedTypes {
+			if _, ok := typeOverrides[referencedType]; ok {
+				continue
+			}
+			referencedTypes = append(referencedTypes, referencedType)
+		}
+		irType.ReferencedTypes = referencedTypes
+	}
+	return nil
+}
+
+// validateTypeOverride returns an error if the type with the given ID can't be
+// overridden, i.e. if its properties are included in another type.
+func validateTypeOverride(ir *fernir.IntermediateRepresentation, typeId fernir.TypeId) error {
+	for _, irType := range ir.Types {
+		var extends []*fernir.DeclaredTypeName
+		if object := irType.Shape.Object; object != nil {
+			extends = object.Extends
+		}
+		if union := irType.Shape.Union; union != nil {
+			extends = union.Extends
+			for _, singleUnionType := range union.Types {
+				if named := singleUnionType.Shape.SamePropertiesAsObject; named != nil && named.TypeId == typeId {
+					return fmt.Errorf("typeOverrides %q can't be overridden because its properties are used by %q", typeId, irType.Name.TypeId)
+				}
+			}
+		}
+		for _, extend := range extends {
+			if extend.TypeId == typeId {
+				return fmt.Errorf("typeOverrides %q can't be overridden because it's extended by %q", typeId, irType.Name.TypeId)
+			}
+		}
+	}
+	for _, irService := range ir.Services {
+		for _, irEndpoint := range irService.Endpoints {
+			if irEndpoint.RequestBody == nil || irEndpoint.RequestBody.InlinedRequestBody == nil {
+				continue
+			}
+			for _, extend := range irEndpoint.RequestBody.InlinedRequestBody.Extends {
+				if extend.TypeId == typeId {
+					return fmt.Errorf("typeOverrides %q can't be overridden because it's extended by %q", typeId, irEndpoint.Name.OriginalName)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// visitTypeReferencesInIR calls the given function for every type reference in the
+// given IR, including the type references nested in containers.
+func visitTypeReferencesInIR(ir *fernir.IntermediateRepresentation, visit func(*fernir.TypeReference)) {
+	var headers []*fernir.HttpHeader
+	headers = append(headers, ir.Headers...)
+	headers = append(headers, ir.IdempotencyHeaders...)
+	for _, irType := range ir.Types {
+		if irType.Shape != nil {
+			visitTypeReferencesInType(irType.Shape, visit)
+		}
+	}
+	for _, irService := range ir.Services {
+		headers = append(headers, irService.Headers...)
+		for _, irPathParameter := range irService.PathParameters {
+			visitTypeReference(irPathParameter.ValueType, visit)
+		}
+		for _, irEndpoint := range irService.Endpoints {
+			headers = append(headers, irEndpoint.Headers...)
+			for _, irPathParameter := range irEndpoint.PathParameters {
+				visitTypeReference(irPathParameter.ValueType, visit)
+			}
+			for _, irPathParameter := range irEndpoint.AllPathParameters {
+				visitTypeReference(irPathParameter.ValueType, visit)
+			}
+			for _, irQueryParameter := range irEndpoint.QueryParameters {
+				visitTypeReference(irQueryParameter.ValueType, visit)
+			}
+			if irEndpoint.RequestBody != nil {
+				visitTypeReferencesInHttpRequestBody(irEndpoint.RequestBody, visit)
+			}
+			if irEndpoint.SdkRequest != nil && irEndpoint.SdkRequest.Shape != nil && irEndpoint.SdkRequest.Shape.JustRequestBody != nil {
+				if reference := irEndpoint.SdkRequest.Shape.JustRequestBody.TypeReference; reference != nil {
+					visitTypeReference(reference.RequestBodyType, visit)
+				}
+			}
+			if irEndpoint.Response != nil {
+				if irEndpoint.Response.Json != nil {
+					visitTypeReference(typeReferenceFromJsonResponse(irEndpoint.Response.Json), visit)
+				}
+				if irEndpoint.Response.Streaming != nil && irEndpoint.Response.Streaming.DataEventType != nil {
+					visitTypeReference(irEndpoint.Response.Streaming.DataEventType.Json, visit)
+				}
+			}
+		}
+	}
+	for _, irHeader := range headers {
+		visitTypeReference(irHeader.ValueType, visit)
+	}
+	if ir.Auth != nil {
+		for _, authScheme := range ir.Auth.Schemes {
+			if authScheme.Header != nil {
+				visitTypeReference(authScheme.Header.ValueType, visit)
+			}
+		}
+	}
+	for _, irError := range ir.Errors {
+		visitTypeReference(irError.Type, visit)
+	}
+	for _, irPathParameter := range ir.PathParameters {
+		visitTypeReference(irPathParameter.ValueType, visit)
+	}
+	for _, irVariable := range ir.Variables {
+		visitTypeReference(irVariable.Type, visit)
+	}
+}
+
+func visitTypeReferencesInHttpRequestBody(httpRequestBody *fernir.HttpRequestBody, visit func(*fernir.TypeReference)) {
+	if httpRequestBody.InlinedRequestBody != nil {
+		for _, property := range httpRequestBody.InlinedRequestBody.Properties {
+			visitTypeReference(property.ValueType, visit)
+		}
+	}
+	if httpRequestBody.Reference != nil {
+		visitTypeReference(httpRequestBody.Reference.RequestBodyType, visit)
+	}
+	if httpRequestBody.FileUpload != nil {
+		for _, property := range httpRequestBody.FileUpload.Properties {
+			if property.BodyProperty != nil {
+				visitTypeReference(property.BodyProperty.ValueType, visit)
+			}
+		}
+	}
+}
+
+func visitTypeReferencesInType(irType *fernir.Type, visit func(*fernir.TypeReference)) {
+	if alias := irType.Alias; alias != nil {
+		visitTypeReference(alias.AliasOf, visit)
+	}
+	if object := irType.Object; object != nil {
+		for _, property := range object.Properties {
+			visitTypeReference(property.ValueType, visit)
+		}
+	}
+	if union := irType.Union; union != nil {
+		for _, singleUnionType := range union.Types {
+			if property := singleUnionType.Shape.SingleProperty; property != nil {
+				visitTypeReference(property.Type, visit)
+			}
+		}
+		for _, property := range union.BaseProperties {
+			visitTypeReference(property.ValueType, visit)
+		}
+	}
+	if undiscriminatedUnion := irType.UndiscriminatedUnion; undiscriminatedUnion != nil {
+		for _, member := range undiscriminatedUnion.Members {
+			visitTypeReference(member.Type, visit)
+		}
+	}
+}
+
+// visitTypeReference calls the given function for the given type reference, as well
+// as every type reference nested within it (e.g. a list's element type).
+func visitTypeReference(typeReference *fernir.TypeReference, visit func(*fernir.TypeReference)) {
+	if typeReference == nil {
+		return
+	}
+	if container := typeReference.Container; container != nil {
+		switch {
+		case container.List != nil:
+			visitTypeReference(container.List, visit)
+		case container.Map != nil:
+			visitTypeReference(container.Map.KeyType, visit)
+			visitTypeReference(container.Map.ValueType, visit)
+		case container.Optional != nil:
+			visitTypeReference(container.Optional, visit)
+		case container.Set != nil:
+			visitTypeReference(container.Set, visit)
+		}
+	}
+	visit(typeReference)
+}
+
+// typeOverrideName returns the name of the opaque type that replaces the given
+// primitive, which is the same as the name of its undiscriminated union field
+// (e.g. Uuid).
+func typeOverrideName(originalName string, pascalCase string) *fernir.Name {
+	camelCase := firstLetterToLower(pascalCase)
+	return &fernir.Name{
+		OriginalName: originalName,
+		CamelCase: &fernir.SafeAndUnsafeString{
+			UnsafeName: camelCase,
+			SafeName:   camelCase,
+		},
+		PascalCase: &fernir.SafeAndUnsafeString{
+			UnsafeName: pascalCase,
+			SafeName:   pascalCase,
+		},
+		SnakeCase: &fernir.SafeAndUnsafeString{
+			UnsafeName: originalName,
+			SafeName:   originalName,
+		},
+		ScreamingSnakeCase: &fernir.SafeAndUnsafeString{
+			UnsafeName: strings.ToUpper(originalName),
+			SafeName:   strings.ToUpper(originalName),
+		},
+	}
+}
+
+// sortedTypeOverrideKeys returns the keys of the given overrides in sorted order,
+// so that any errors are deterministic.
+func sortedTypeOverrideKeys(typeOverrides map[string]*TypeOverride) []string {
+	keys := make([]string, 0, len(typeOverrides))
+	for key := range typeOverrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// typeOverrideToGoType returns the Go type of the given override, which is
+// qualified by its package unless it's the given import path.
+func typeOverrideToGoType(typeOverride *TypeOverride, scope *gospec.Scope, importPath string) string {
+	if typeOverride.ImportPath == "" || typeOverride.ImportPath == importPath {
+		return typeOverride.Type
+	}
+	return scope.AddImport(typeOverride.ImportPath) + "." + typeOverride.Type
+}
+
+// typeOverrideZeroValue returns the zero value of the given overridden Go type,
+// which isn't necessarily a struct.
+func typeOverrideZeroValue(goType string) string {
+	return "*new(" + goType + ")"
+}
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"sort"
+
+	fernir "github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// clientPathParameter is a path parameter that's configured on the client (e.g.
+// option.WithTenantId) rather than passed to every method that uses it.
+//
+// Every variable, root path parameter, and service path parameter is configured
+// on the client, and each of them can be overridden for an individual request.
+type clientPathParameter struct {
+	Name      *fernir.Name
+	Docs      *string
+	ValueType *fernir.TypeReference
+	Variable  fernir.VariableId
+}
+
+// clientPathParametersFromIR returns all of the client path parameters declared
+// in the given IR, in a deterministic order. Path parameters that share the same
+// name (e.g. a root and service path parameter) are configured with the same
+// option.
+func clientPathParametersFromIR(ir *fernir.IntermediateRepresentation) []*clientPathParameter {
+	var (
+		clientPathParameters []*clientPathParameter
+		names                = make(map[string]struct{})
+	)
+	add := func(clientPathParameter *clientPathParameter) {
+		name := clientPathParameter.Name.PascalCase.UnsafeName
+		if _, ok := names[name]; ok {
+			return
+		}
+		names[name] = struct{}{}
+		clientPathParameters = append(clientPathParameters, clientPathParameter)
+	}
+	for _, variable := range ir.Variables {
+		add(
+			&clientPathParameter{
+				Name:      variable.Name,
+				Docs:      variable.Docs,
+				ValueType: variable.Type,
+				Variable:  variable.Id,
+			},
+		)
+	}
+	addPathParameters := func(pathParameters []*fernir.PathParameter) {
+		for _, pathParameter := range pathParameters {
+			if pathParameter.Variable != nil {
+				// Path parameters that reference a variable are configured by
+				// the variable's option.
+				continue
+			}
+			add(
+				&clientPathParameter{
+					Name:      pathParameter.Name,
+					Docs:      pathParameter.Docs,
+					ValueType: pathParameter.ValueType,
+				},
+			)
+		}
+	}
+	addPathParameters(ir.PathParameters)
+	serviceIDs := make([]string, 0, len(ir.Services))
+	for serviceID := range ir.Services {
+		serviceIDs = append(serviceIDs, serviceID)
+	}
+	sort.Strings(serviceIDs)
+	for _, serviceID := range serviceIDs {
+		addPathParameters(ir.Services[serviceID].PathParameters)
+	}
+	return clientPathParameters
+}
+
+// clientPathParameterFor returns the client path parameter that configures the
+// given path parameter, if any. Endpoint path parameters are always passed to the
+// method, unless they reference a variable.
+func clientPathParameterFor(
+	pathParameter *fernir.PathParameter,
+	clientPathParameters []*clientPathParameter,
+) *clientPathParameter {
+	if pathParameter.Variable == nil &&
+		pathParameter.Location != fernir.PathParameterLocationRoot &&
+		pathParameter.Location != fernir.PathParameterLocationService {
+		return nil
+	}
+	for _, clientPathParameter := range clientPathParameters {
+		if pathParameter.Variable != nil {
+			if clientPathParameter.Variable == *pathParameter.Variable {
+				return clientPathParameter
+			}
+			continue
+		}
+		if clientPathParameter.Name.PascalCase.UnsafeName == pathParameter.Name.PascalCase.UnsafeName {
+			return clientPathParameter
+		}
+	}
+	return nil
+}
+
+// clientPathParameterFieldType returns the Go type used to store the given client
+// path parameter, which is always a pointer so that an unset value can be
+// distinguished from the zero value.
+func (f *fileWriter) clientPathParameterFieldType(clientPathParameter *clientPathParameter, importPath string) string {
+	return "*" + typeReferenceToGoType(clientPathParameter.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
+}
@@ -22,6 +22,15 @@ var (
 	//go:embed sdk/core/core.go
 	coreFile string
 
+	//go:embed sdk/core/api_error.go
+	apiErrorFile string
+
+	//go:embed sdk/core/compression.go
+	compressionFile string
+
+	//go:embed sdk/core/compression_test.go
+	compressionTestFile string
+
 	//go:embed sdk/core/core_test.go
 	coreTestFile string
 
@@ -34,9 +43,36 @@ var (
 	//go:embed sdk/core/pointer.go
 	pointerFile string
 
+	//go:embed sdk/core/query.go
+	queryFile string
+
+	//go:embed sdk/core/query_test.go
+	queryTestFile string
+
+	//go:embed sdk/core/request_stream.go
+	requestStreamFile string
+
+	//go:embed sdk/core/request_stream_test.go
+	requestStreamTestFile string
+
 	//go:embed sdk/core/stream.go
 	streamFile string
 
+	//go:embed sdk/core/stream_test.go
+	streamTestFile string
+
+	//go:embed sdk/core/timeout.go
+	timeoutFile string
+
+	//go:embed sdk/core/timeout_test.go
+	timeoutTestFile string
+
+	//go:embed sdk/core/cache.go
+	cacheFile string
+
+	//go:embed sdk/core/cache_test.go
+	cacheTestFile string
+
 	//go:embed sdk/core/retrier.go
 	retrierFile string
 )
@@ -101,6 +137,12 @@ func (f *fileWriter) WriteLegacyClientOptions(
 	f.P("return option.WithMaxAttempts(attempts)")
 	f.P("}")
 	f.P()
+	f.P("// WithValidation validates the request (e.g. its required fields and")
+	f.P("// enum values) before it's sent.")
+	f.P("func WithValidation() *core.ValidationOption {")
+	f.P("return option.WithValidation()")
+	f.P("}")
+	f.P()
 
 	includeCustomAuthDocs := auth.Docs != nil && len(*auth.Docs) > 0
 
@@ -136,7 +178,7 @@ func (f *fileWriter) WriteLegacyClientOptions(
 				pascalCase = authScheme.Header.Name.Name.PascalCase.UnsafeName
 				camelCase  = authScheme.Header.Name.Name.CamelCase.SafeName
 				optionName = fmt.Sprintf("With%s", pascalCase)
-				goType     = typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.scope, f.baseImportPath, "", false)
+				goType     = typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, "", false)
 				typeName   = "*core." + pascalCase + "Option"
 			)
 			f.P("// ", optionName, " sets the ", camelCase, " auth request header.")
@@ -161,7 +203,7 @@ func (f *fileWriter) WriteLegacyClientOptions(
 			pascalCase = header.Name.Name.PascalCase.UnsafeName
 			camelCase  = header.Name.Name.CamelCase.SafeName
 			optionName = fmt.Sprintf("With%s", pascalCase)
-			goType     = typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, "", false)
+			goType     = typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, "", false)
 			typeName   = "*core." + pascalCase + "Option"
 		)
 		f.P("// ", optionName, " sets the ", camelCase, " request header.")
@@ -171,6 +213,7 @@ func (f *fileWriter) WriteLegacyClientOptions(
 			f.P("//")
 			f.WriteDocs(header.Docs)
 		}
+		f.writeDeprecated(header.Availability, true)
 		f.P("func ", optionName, "(", camelCase, " ", goType, ") ", typeName, " {")
 		f.P("return option.", optionName, "(", camelCase, ")")
 		f.P("}")
@@ -203,7 +246,7 @@ func (f *fileWriter) WriteIdempotentRequestOptionsDefinition(idempotencyHeaders
 		f.P(
 			header.Name.Name.PascalCase.UnsafeName,
 			" ",
-			typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false),
+			typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false),
 		)
 	}
 	// Add rate limiter
@@ -234,7 +277,7 @@ func (f *fileWriter) WriteIdempotentRequestOptionsDefinition(idempotencyHeaders
 	for _, header := range idempotencyHeaders {
 		var (
 			pascalCase = header.Name.Name.PascalCase.UnsafeName
-			goType     = typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+			goType     = typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
 		)
 		if err := f.writeOptionStruct(pascalCase, goType, false, true); err != nil {
 			return err
@@ -247,10 +290,10 @@ func (f *fileWriter) WriteIdempotentRequestOptionsDefinition(idempotencyHeaders
 	f.P("func (i *IdempotentRequestOptions) ToHeader() http.Header {")
 	f.P("header := i.RequestOptions.ToHeader()")
 	for _, header := range idempotencyHeaders {
-		valueTypeFormat := formatForValueType(header.ValueType)
+		valueTypeFormat := formatForValueTypeInCore(header.ValueType)
 		value := valueTypeFormat.Prefix + "i." + header.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
 		f.P("if i.", header.Name.Name.PascalCase.UnsafeName, " != ", valueTypeFormat.ZeroValue, " {")
-		f.P(`header.Set("`, header.Name.WireValue, `", fmt.Sprintf("`, valueTypeFormat.Prefix, `%v",`, value, "))")
+		f.P(`header.Set("`, header.Name.WireValue, `", fmt.Sprintf("%v",`, value, "))")
 		f.P("}")
 	}
 	f.P("return header")
@@ -265,6 +308,7 @@ func (f *fileWriter) WriteIdempotentRequestOptionsDefinition(idempotencyHeaders
 func (f *fileWriter) WriteRequestOptionsDefinition(
 	auth *ir.ApiAuth,
 	headers []*ir.HttpHeader,
+	clientPathParameters []*clientPathParameter,
 	idempotencyHeaders []*ir.HttpHeader,
 	sdkConfig *ir.SdkConfig,
 	moduleConfig *ModuleConfig,
@@ -286,6 +330,13 @@ func (f *fileWriter) WriteRequestOptionsDefinition(
 	f.P("HTTPClient HTTPClient")
 	f.P("HTTPHeader http.Header")
 	f.P("MaxAttempts uint")
+	f.P("RequestCompression string")
+	f.P("Timeout time.Duration")
+	f.P("AttemptTimeout time.Duration")
+	f.P("QueryParameters url.Values")
+	f.P("BodyProperties map[string]interface{}")
+	f.P("Cache Cache")
+	f.P("Validation bool")
 
 	// Generate the exported RequestOptions type that all clients can act upon.
 	for _, authScheme := range auth.Schemes {
@@ -304,7 +355,7 @@ func (f *fileWriter) WriteRequestOptionsDefinition(
 			f.P(
 				authScheme.Header.Name.Name.PascalCase.UnsafeName,
 				" ",
-				typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false),
+				typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false),
 			)
 		}
 	}
@@ -316,7 +367,16 @@ func (f *fileWriter) WriteRequestOptionsDefinition(
 		f.P(
 			header.Name.Name.PascalCase.UnsafeName,
 			" ",
-			typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false),
+			typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false),
+		)
+	}
+	for _, clientPathParameter := range clientPathParameters {
+		// The path parameters are stored as pointers so that a request option
+		// only overrides the client's value if it's set.
+		f.P(
+			clientPathParameter.Name.PascalCase.UnsafeName,
+			" ",
+			f.clientPathParameterFieldType(clientPathParameter, importPath),
 		)
 	}
 	// Add rate limiter
@@ -350,7 +410,7 @@ func (f *fileWriter) WriteRequestOptionsDefinition(
 			return err
 		}
 		f.P()
-		return f.writeRequestOptionStructs(auth, headers, len(idempotencyHeaders) > 0)
+		return f.writeRequestOptionStructs(auth, headers, clientPathParameters, len(idempotencyHeaders) > 0)
 	}
 
 	// Generate the ToHeader method.
@@ -378,7 +438,7 @@ func (f *fileWriter) WriteRequestOptionsDefinition(
 				f.P(`header.Set("`, header.Name.WireValue, `", fmt.Sprintf("`, prefix, `%v",`, literalToValue(header.ValueType.Container.Literal), "))")
 				continue
 			}
-			valueTypeFormat := formatForValueType(header.ValueType)
+			valueTypeFormat := formatForValueTypeInCore(header.ValueType)
 			value := valueTypeFormat.Prefix + "r." + header.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
 			if valueTypeFormat.IsOptional {
 				f.P("if r.", header.Name.Name.PascalCase.UnsafeName, " != nil {")
@@ -394,7 +454,7 @@ func (f *fileWriter) WriteRequestOptionsDefinition(
 			f.P(`header.Set("`, header.Name.WireValue, `", fmt.Sprintf("%v",`, literalToValue(header.ValueType.Container.Literal), "))")
 			continue
 		}
-		valueTypeFormat := formatForValueType(header.ValueType)
+		valueTypeFormat := formatForValueTypeInCore(header.ValueType)
 		value := valueTypeFormat.Prefix + "r." + header.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
 		if valueTypeFormat.IsOptional {
 			f.P("if r.", header.Name.Name.PascalCase.UnsafeName, " != nil {")
@@ -414,7 +474,7 @@ func (f *fileWriter) WriteRequestOptionsDefinition(
 
 	f.P()
 
-	if err := f.writeRequestOptionStructs(auth, headers, len(idempotencyHeaders) > 0); err != nil {
+	if err := f.writeRequestOptionStructs(auth, headers, clientPathParameters, len(idempotencyHeaders) > 0); err != nil {
 		return err
 	}
 
@@ -448,6 +508,7 @@ func (f *fileWriter) writePlatformHeaders(
 func (f *fileWriter) writeRequestOptionStructs(
 	auth *ir.ApiAuth,
 	headers []*ir.HttpHeader,
+	clientPathParameters []*clientPathParameter,
 	asIdempotentRequestOption bool,
 ) error {
 	if err := f.writeOptionStruct("BaseURL", "string", true, asIdempotentRequestOption); err != nil {
@@ -462,6 +523,27 @@ func (f *fileWriter) writeRequestOptionStructs(
 	if err := f.writeOptionStruct("MaxAttempts", "uint", true, asIdempotentRequestOption); err != nil {
 		return err
 	}
+	if err := f.writeOptionStruct("RequestCompression", "string", true, asIdempotentRequestOption); err != nil {
+		return err
+	}
+	if err := f.writeOptionStruct("Timeout", "time.Duration", true, asIdempotentRequestOption); err != nil {
+		return err
+	}
+	if err := f.writeOptionStruct("AttemptTimeout", "time.Duration", true, asIdempotentRequestOption); err != nil {
+		return err
+	}
+	if err := f.writeOptionStruct("QueryParameters", "url.Values", true, asIdempotentRequestOption); err != nil {
+		return err
+	}
+	if err := f.writeOptionStruct("BodyProperties", "map[string]interface{}", true, asIdempotentRequestOption); err != nil {
+		return err
+	}
+	if err := f.writeOptionStruct("Cache", "Cache", true, asIdempotentRequestOption); err != nil {
+		return err
+	}
+	if err := f.writeOptionStruct("Validation", "bool", true, asIdempotentRequestOption); err != nil {
+		return err
+	}
 
 	if auth != nil {
 		for _, authScheme := range auth.Schemes {
@@ -497,7 +579,7 @@ func (f *fileWriter) writeRequestOptionStructs(
 				}
 				var (
 					pascalCase = authScheme.Header.Name.Name.PascalCase.UnsafeName
-					goType     = typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.scope, f.baseImportPath, "" /* The type is always imported */, false)
+					goType     = typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, "" /* The type is always imported */, false)
 				)
 				if err := f.writeOptionStruct(pascalCase, goType, true, asIdempotentRequestOption); err != nil {
 					return err
@@ -513,7 +595,17 @@ func (f *fileWriter) writeRequestOptionStructs(
 		}
 		var (
 			pascalCase = header.Name.Name.PascalCase.UnsafeName
-			goType     = typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, "" /* The type is always imported */, false)
+			goType     = typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, "" /* The type is always imported */, false)
+		)
+		if err := f.writeOptionStruct(pascalCase, goType, true, asIdempotentRequestOption); err != nil {
+			return err
+		}
+	}
+
+	for _, clientPathParameter := range clientPathParameters {
+		var (
+			pascalCase = clientPathParameter.Name.PascalCase.UnsafeName
+			goType     = f.clientPathParameterFieldType(clientPathParameter, "" /* The type is always imported */)
 		)
 		if err := f.writeOptionStruct(pascalCase, goType, true, asIdempotentRequestOption); err != nil {
 			return err
@@ -585,7 +677,7 @@ func (f *fileWriter) WriteIdempotentRequestOptions(
 			pascalCase = header.Name.Name.PascalCase.UnsafeName
 			camelCase  = header.Name.Name.CamelCase.SafeName
 			optionName = fmt.Sprintf("With%s", pascalCase)
-			goType     = typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+			goType     = typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
 		)
 		f.P("// ", optionName, " sets the ", camelCase, " request header.")
 		if header.Docs != nil && len(*header.Docs) > 0 {
@@ -594,6 +686,7 @@ func (f *fileWriter) WriteIdempotentRequestOptions(
 			f.P("//")
 			f.WriteDocs(header.Docs)
 		}
+		f.writeDeprecated(header.Availability, true)
 		typeName := "core." + pascalCase + "Option"
 		f.P("func ", optionName, "(", camelCase, " ", goType, ") *", typeName, " {")
 		f.P("return &", typeName, "{")
@@ -609,6 +702,7 @@ func (f *fileWriter) WriteIdempotentRequestOptions(
 func (f *fileWriter) WriteRequestOptions(
 	auth *ir.ApiAuth,
 	headers []*ir.HttpHeader,
+	clientPathParameters []*clientPathParameter,
 ) (*GeneratedAuth, error) {
 	// Now that we know where the types will be generated, format the generated type names as needed.
 	var (
@@ -651,6 +745,80 @@ func (f *fileWriter) WriteRequestOptions(
 	f.P("}")
 	f.P("}")
 	f.P()
+	f.P("// WithRequestCompression compresses the request body with the given encoding")
+	f.P("// (i.e. gzip, deflate, or zstd) if it's larger than 1KB, and sets the")
+	f.P("// Content-Encoding header accordingly.")
+	f.P("func WithRequestCompression(encoding string) *core.RequestCompressionOption {")
+	f.P("return &core.RequestCompressionOption{")
+	f.P("RequestCompression: encoding,")
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("// WithTimeout bounds the request by the given timeout, which covers every")
+	f.P("// retry attempt and reading the response. Streaming requests are only")
+	f.P("// bounded until the stream is established. A *core.TimeoutError is")
+	f.P("// returned if the timeout is exceeded.")
+	f.P("func WithTimeout(timeout time.Duration) *core.TimeoutOption {")
+	f.P("return &core.TimeoutOption{")
+	f.P("Timeout: timeout,")
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("// WithAttemptTimeout bounds each of the request's attempts by the given")
+	f.P("// timeout. An attempt that exceeds it is retried, as long as the maximum")
+	f.P("// number of attempts hasn't been reached.")
+	f.P("func WithAttemptTimeout(timeout time.Duration) *core.AttemptTimeoutOption {")
+	f.P("return &core.AttemptTimeoutOption{")
+	f.P("AttemptTimeout: timeout,")
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("// WithQueryParameters adds the given query parameters to the request's URL,")
+	f.P("// overriding any parameters of the same name. This is useful for parameters")
+	f.P("// that aren't supported by the SDK yet.")
+	f.P("func WithQueryParameters(queryParameters url.Values) *core.QueryParametersOption {")
+	f.P("// Copy the parameters so they can't be modified after the option call.")
+	f.P("copied := make(url.Values, len(queryParameters))")
+	f.P("for key, values := range queryParameters {")
+	f.P("copied[key] = append([]string(nil), values...)")
+	f.P("}")
+	f.P("return &core.QueryParametersOption{")
+	f.P("QueryParameters: copied,")
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("// WithBodyProperties adds the given properties to the request's JSON body,")
+	f.P("// overriding any properties of the same name. This is useful for properties")
+	f.P("// that aren't supported by the SDK yet.")
+	f.P("func WithBodyProperties(bodyProperties map[string]interface{}) *core.BodyPropertiesOption {")
+	f.P("// Copy the properties so they can't be modified after the option call.")
+	f.P("copied := make(map[string]interface{}, len(bodyProperties))")
+	f.P("for key, value := range bodyProperties {")
+	f.P("copied[key] = value")
+	f.P("}")
+	f.P("return &core.BodyPropertiesOption{")
+	f.P("BodyProperties: copied,")
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("// WithCache stores the responses of GET endpoints in the given cache (e.g.")
+	f.P("// core.NewLRUCache). A fresh response is served without contacting the server,")
+	f.P("// and a stale one is revalidated with the If-None-Match and If-Modified-Since")
+	f.P("// headers, so that a 304 (Not Modified) response is served from the cache.")
+	f.P("func WithCache(cache core.Cache) *core.CacheOption {")
+	f.P("return &core.CacheOption{")
+	f.P("Cache: cache,")
+	f.P("}")
+	f.P("}")
+	f.P()
+	f.P("// WithValidation validates the request (e.g. its required fields and")
+	f.P("// enum values) before it's sent.")
+	f.P("func WithValidation() *core.ValidationOption {")
+	f.P("return &core.ValidationOption{")
+	f.P("Validation: true,")
+	f.P("}")
+	f.P("}")
+	f.P()
 
 	// Generate the auth functional options.
 	includeCustomAuthDocs := auth.Docs != nil && len(*auth.Docs) > 0
@@ -724,7 +892,7 @@ func (f *fileWriter) WriteRequestOptions(
 				optionName = fmt.Sprintf("With%s", pascalCase)
 				field      = authScheme.Header.Name.Name.PascalCase.UnsafeName
 				param      = authScheme.Header.Name.Name.CamelCase.SafeName
-				value      = typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+				value      = typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
 			)
 			if i == 0 {
 				option = ast.NewCallExpr(
@@ -762,7 +930,7 @@ func (f *fileWriter) WriteRequestOptions(
 			optionName = fmt.Sprintf("With%s", pascalCase)
 			field      = header.Name.Name.PascalCase.UnsafeName
 			param      = header.Name.Name.CamelCase.SafeName
-			value      = typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+			value      = typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
 		)
 		f.P("// ", optionName, " sets the ", param, " request header.")
 		if header.Docs != nil && len(*header.Docs) > 0 {
@@ -771,6 +939,7 @@ func (f *fileWriter) WriteRequestOptions(
 			f.P("//")
 			f.WriteDocs(header.Docs)
 		}
+		f.writeDeprecated(header.Availability, true)
 		typeName := "core." + pascalCase + "Option"
 		f.P("func ", optionName, "(", param, " ", value, ") *", typeName, " {")
 		f.P("return &", typeName, "{")
@@ -780,6 +949,28 @@ func (f *fileWriter) WriteRequestOptions(
 		f.P()
 	}
 
+	for _, clientPathParameter := range clientPathParameters {
+		var (
+			pascalCase = clientPathParameter.Name.PascalCase.UnsafeName
+			optionName = fmt.Sprintf("With%s", pascalCase)
+			param      = clientPathParameter.Name.CamelCase.SafeName
+			value      = typeReferenceToGoType(clientPathParameter.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
+		)
+		f.P("// ", optionName, " sets the ", param, " path parameter, which is used by")
+		f.P("// every endpoint that includes it in its path.")
+		if clientPathParameter.Docs != nil && len(*clientPathParameter.Docs) > 0 {
+			f.P("//")
+			f.WriteDocs(clientPathParameter.Docs)
+		}
+		typeName := "core." + pascalCase + "Option"
+		f.P("func ", optionName, "(", param, " ", value, ") *", typeName, " {")
+		f.P("return &", typeName, "{")
+		f.P(pascalCase, ": &", param, ",")
+		f.P("}")
+		f.P("}")
+		f.P()
+	}
+
 	f.P("// WithRateLimiter will provide a rate limiter for the client.")
 	f.P("func WithRateLimiter(rateLimiter *core.RateLimiter) *core.RateLimiterOption {")
 	f.P("return &core.RateLimiterOption{")
@@ -804,6 +995,8 @@ type GeneratedClient struct {
 func (f *fileWriter) WriteClient(
 	irEndpoints []*ir.HttpEndpoint,
 	idempotencyHeaders []*ir.HttpHeader,
+	clientPathParameters []*clientPathParameter,
+	streamingRequestBodies bool,
 	subpackages []*ir.Subpackage,
 	environmentsConfig *ir.EnvironmentsConfig,
 	errorDiscriminationStrategy *ir.ErrorDiscriminationStrategy,
@@ -811,30 +1004,17 @@ func (f *fileWriter) WriteClient(
 	generatedAuth *GeneratedAuth,
 	generatedEnvironment *GeneratedEnvironment,
 ) (*GeneratedClient, error) {
-	var (
-		clientName = "Client"
-		receiver   = "c"
-	)
-	var errorDiscriminationByPropertyStrategy *ir.ErrorDiscriminationByPropertyStrategy
-	if errorDiscriminationStrategy != nil && errorDiscriminationStrategy.Property != nil {
-		errorDiscriminationByPropertyStrategy = errorDiscriminationStrategy.Property
-	}
-
-	// Reformat the endpoint data into a structure that's suitable for code generation.
-	var endpoints []*endpoint
-	for _, irEndpoint := range irEndpoints {
-		endpoint, err := f.endpointFromIR(fernFilepath, irEndpoint, environmentsConfig, idempotencyHeaders, receiver)
-		if err != nil {
-			return nil, err
-		}
-		endpoints = append(endpoints, endpoint)
-	}
+	clientName := "Client"
 
 	// Generate the client implementation.
 	f.P("type ", clientName, " struct {")
 	f.P("baseURL string")
 	f.P("caller *core.Caller")
 	f.P("header http.Header")
+	f.P("validation bool")
+	for _, clientPathParameter := range clientPathParameters {
+		f.P(clientPathParameter.Name.CamelCase.UnsafeName, " ", f.clientPathParameterFieldType(clientPathParameter, "" /* The type is always imported */))
+	}
 	f.P()
 	for _, subpackage := range subpackages {
 		var (
@@ -855,10 +1035,20 @@ func (f *fileWriter) WriteClient(
 	f.P("&core.CallerParams{")
 	f.P("Client: options.HTTPClient,")
 	f.P("MaxAttempts: options.MaxAttempts,")
+	f.P("RequestCompression: options.RequestCompression,")
+	f.P("Timeout: options.Timeout,")
+	f.P("AttemptTimeout: options.AttemptTimeout,")
+	f.P("QueryParameters: options.QueryParameters,")
+	f.P("BodyProperties: options.BodyProperties,")
+	f.P("Cache: options.Cache,")
 	f.P("},")
 	f.P("options.RateLimiter,")
 	f.P("),")
 	f.P("header: options.ToHeader(),")
+	f.P("validation: options.Validation,")
+	for _, clientPathParameter := range clientPathParameters {
+		f.P(clientPathParameter.Name.CamelCase.UnsafeName, ": options.", clientPathParameter.Name.PascalCase.UnsafeName, ",")
+	}
 	for _, subpackage := range subpackages {
 		var (
 			importPath        = packagePathToImportPath(f.baseImportPath, packagePathForClient(subpackage.FernFilepath))
@@ -871,306 +1061,507 @@ func (f *fileWriter) WriteClient(
 	f.P()
 
 	// Implement this service's methods.
+	if err := f.WriteClientEndpoints(
+		irEndpoints,
+		idempotencyHeaders,
+		clientPathParameters,
+		streamingRequestBodies,
+		environmentsConfig,
+		errorDiscriminationStrategy,
+		fernFilepath,
+	); err != nil {
+		return nil, err
+	}
+	var parameters []ast.Expr
+	if generatedAuth != nil {
+		parameters = append(parameters, generatedAuth.Option)
+	}
+	if generatedEnvironment != nil {
+		parameters = append(parameters, generatedEnvironment.Example)
+	}
+	return &GeneratedClient{
+		Instantiation: &ast.AssignStmt{
+			Left: []ast.Expr{
+				ast.NewLocalObject("client"),
+			},
+			Right: []ast.Expr{
+				ast.NewCallExpr(
+					ast.NewImportedObject(
+						"NewClient",
+						packagePathToImportPath(f.baseImportPath, packagePathForClient(fernFilepath)),
+					),
+					parameters,
+				),
+			},
+		},
+	}, nil
+}
+
+// WriteClientEndpoints writes the client methods for the given endpoints, which
+// is used on its own to write the beta endpoints in a separate file.
+func (f *fileWriter) WriteClientEndpoints(
+	irEndpoints []*ir.HttpEndpoint,
+	idempotencyHeaders []*ir.HttpHeader,
+	clientPathParameters []*clientPathParameter,
+	streamingRequestBodies bool,
+	environmentsConfig *ir.EnvironmentsConfig,
+	errorDiscriminationStrategy *ir.ErrorDiscriminationStrategy,
+	fernFilepath *ir.FernFilepath,
+) error {
+	var (
+		clientName = "Client"
+		receiver   = "c"
+	)
+	var errorDiscriminationByPropertyStrategy *ir.ErrorDiscriminationByPropertyStrategy
+	if errorDiscriminationStrategy != nil && errorDiscriminationStrategy.Property != nil {
+		errorDiscriminationByPropertyStrategy = errorDiscriminationStrategy.Property
+	}
+
+	// Reformat the endpoint data into a structure that's suitable for code generation.
+	var endpoints []*endpoint
+	for _, irEndpoint := range irEndpoints {
+		endpoint, err := f.endpointFromIR(fernFilepath, irEndpoint, environmentsConfig, idempotencyHeaders, clientPathParameters, receiver, false)
+		if err != nil {
+			return err
+		}
+		endpoints = append(endpoints, endpoint)
+		if streamingRequestBodies && channelRequestItemType(irEndpoint, f.types) != nil {
+			// Endpoints that send a list can also send the list's items from a
+			// channel, which are encoded incrementally as the request is sent.
+			channelEndpoint, err := f.endpointFromIR(fernFilepath, irEndpoint, environmentsConfig, idempotencyHeaders, clientPathParameters, receiver, true)
+			if err != nil {
+				return err
+			}
+			endpoints = append(endpoints, channelEndpoint)
+		}
+	}
 	for _, endpoint := range endpoints {
-		f.WriteDocs(endpoint.Docs)
-		f.P("func (", receiver, " *", clientName, ") ", endpoint.Name.PascalCase.UnsafeName, "(")
-		for _, signatureParameter := range endpoint.SignatureParameters {
-			f.WriteDocs(signatureParameter.docs)
-			f.P(signatureParameter.parameter, ",")
-		}
-		f.P(") ", endpoint.ReturnValues, " {")
-		// Compose all the request options.
-		f.P("options := ", endpoint.OptionConstructor)
+		f.writeEndpoint(clientName, receiver, endpoint, errorDiscriminationByPropertyStrategy)
+	}
+	return nil
+}
+
+// WriteClientDo writes the root client's Do method, which calls an arbitrary
+// endpoint (e.g. one that isn't in the API definition yet) with the client's
+// base URL, headers, and retries. The DoStream variant is only written if the
+// API has streaming endpoints, since it depends on the core.Stream.
+func (f *fileWriter) WriteClientDo(
+	environmentsConfig *ir.EnvironmentsConfig,
+	hasStreamingEndpoints bool,
+) error {
+	var environmentID string
+	if environmentsConfig != nil && environmentsConfig.DefaultEnvironment != nil {
+		environmentID = *environmentsConfig.DefaultEnvironment
+	}
+	baseURL, err := environmentURLFromID(environmentsConfig, environmentID)
+	if err != nil {
+		return err
+	}
+
+	f.P("// Do calls the endpoint at the given path (relative to the base URL) with the")
+	f.P("// client's headers, authentication, and retries. This is useful for endpoints")
+	f.P("// that aren't supported by the SDK yet.")
+	f.P("//")
+	f.P("// The request, if any, is encoded as JSON unless it's an io.Reader, and the")
+	f.P("// response, if any, is decoded from JSON unless it's an io.Writer. A non-2xx")
+	f.P("// response is returned as a *core.APIError.")
+	f.P("func (c *Client) Do(")
+	f.P("ctx context.Context,")
+	f.P("method string,")
+	f.P("path string,")
+	f.P("request interface{},")
+	f.P("response interface{},")
+	f.P("opts ...option.RequestOption,")
+	f.P(") error {")
+	f.writeClientDoURL(string(baseURL), "err")
+	f.P("return c.caller.Call(")
+	f.P("ctx,")
+	f.P("&core.CallParams{")
+	f.P("URL: endpointURL,")
+	f.P("Method: method,")
+	f.P("MaxAttempts: options.MaxAttempts,")
+	f.P("Timeout: options.Timeout,")
+	f.P("AttemptTimeout: options.AttemptTimeout,")
+	f.P("Headers: headers,")
+	f.P("Client: options.HTTPClient,")
+	f.P("QueryParameters: options.QueryParameters,")
+	f.P("Request: request,")
+	f.P("RequestCompression: options.RequestCompression,")
+	f.P("BodyProperties: options.BodyProperties,")
+	f.P("Response: response,")
+	f.P("ResponseIsOptional: true,")
+	f.P("},")
+	f.P(")")
+	f.P("}")
+	f.P()
+
+	if !hasStreamingEndpoints {
+		return nil
+	}
+	f.P("// DoStream is equivalent to Do, but it streams the response's newline-delimited")
+	f.P("// messages, each of which is received as raw JSON.")
+	f.P("func (c *Client) DoStream(")
+	f.P("ctx context.Context,")
+	f.P("method string,")
+	f.P("path string,")
+	f.P("request interface{},")
+	f.P("opts ...option.RequestOption,")
+	f.P(") (*core.Stream[json.RawMessage], error) {")
+	f.writeClientDoURL(string(baseURL), "nil, err")
+	f.P("streamer := core.NewStreamer[json.RawMessage](c.caller)")
+	f.P("return streamer.Stream(")
+	f.P("ctx,")
+	f.P("&core.StreamParams{")
+	f.P("URL: endpointURL,")
+	f.P("Method: method,")
+	f.P("MaxAttempts: options.MaxAttempts,")
+	f.P("Timeout: options.Timeout,")
+	f.P("AttemptTimeout: options.AttemptTimeout,")
+	f.P("Headers: headers,")
+	f.P("Client: options.HTTPClient,")
+	f.P("QueryParameters: options.QueryParameters,")
+	f.P("Request: request,")
+	f.P("RequestCompression: options.RequestCompression,")
+	f.P("BodyProperties: options.BodyProperties,")
+	f.P("},")
+	f.P(")")
+	f.P("}")
+	f.P()
+	return nil
+}
+
+// writeClientDoURL writes the preamble shared by the Do methods, which resolves
+// the request options, the endpoint's URL, and its headers.
+func (f *fileWriter) writeClientDoURL(baseURL string, errorReturnValues string) {
+	f.P("options := core.NewRequestOptions(opts...)")
+	f.P()
+	f.P(fmt.Sprintf("baseURL := %q", baseURL))
+	f.P(`if c.baseURL != "" {`)
+	f.P("baseURL = c.baseURL")
+	f.P("}")
+	f.P(`if options.BaseURL != "" {`)
+	f.P("baseURL = options.BaseURL")
+	f.P("}")
+	if baseURL == "" {
+		// There isn't a default environment, so the base URL must be set.
+		f.P(`if baseURL == "" {`)
+		f.P(fmt.Sprintf("err := errors.New(%q)", "missing the base URL; set it with option.WithBaseURL"))
+		f.P("return ", errorReturnValues)
+		f.P("}")
+	}
+	f.P(`endpointURL := strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(path, "/")`)
+	f.P()
+	f.P("headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())")
+	f.P()
+}
+
+// writeEndpoint writes the client method that calls the given endpoint.
+func (f *fileWriter) writeEndpoint(
+	clientName string,
+	receiver string,
+	endpoint *endpoint,
+	errorDiscriminationByPropertyStrategy *ir.ErrorDiscriminationByPropertyStrategy,
+) {
+	f.WriteDocsWithAvailability(endpoint.Docs, endpoint.Availability)
+	f.P("func (", receiver, " *", clientName, ") ", endpoint.Name.PascalCase.UnsafeName, "(")
+	for _, signatureParameter := range endpoint.SignatureParameters {
+		f.WriteDocs(signatureParameter.docs)
+		f.P(signatureParameter.parameter, ",")
+	}
+	f.P(") ", endpoint.ReturnValues, " {")
+	// Compose all the request options.
+	f.P("options := ", endpoint.OptionConstructor)
+	f.P()
+	if endpoint.RequestIsValidated {
+		f.P("if ", receiver, ".validation || options.Validation {")
+		f.P("if err := core.Validate(", endpoint.RequestParameterName, "); err != nil {")
+		f.P("return ", endpoint.ErrorReturnValues)
+		f.P("}")
+		f.P("}")
 		f.P()
-		// Compose the URL, including any query parameters.
-		f.P(fmt.Sprintf("baseURL := %q", endpoint.BaseURL))
-		f.P("if ", fmt.Sprintf("%s.baseURL", receiver), ` != "" {`)
-		f.P("baseURL = ", fmt.Sprintf("%s.baseURL", receiver))
+	}
+	// Resolve the client path parameters, which can be overridden by the request options.
+	for _, pathParameter := range endpoint.ResolvedPathParameters {
+		f.P(pathParameter.Name, " := ", receiver, ".", pathParameter.Field)
+		f.P("if options.", pathParameter.Option, " != nil {")
+		f.P(pathParameter.Name, " = options.", pathParameter.Option)
 		f.P("}")
-		f.P(`if options.BaseURL != "" {`)
-		f.P("baseURL = options.BaseURL")
+		f.P("if ", pathParameter.Name, " == nil {")
+		f.P(fmt.Sprintf("err := errors.New(%q)", "missing the "+pathParameter.Field+" path parameter; set it with option.With"+pathParameter.Option))
+		f.P("return ", endpoint.ErrorReturnValues)
 		f.P("}")
-		baseURLVariable := "baseURL"
-		if len(endpoint.PathSuffix) > 0 {
-			baseURLVariable = `baseURL + "/" + ` + fmt.Sprintf("%q", endpoint.PathSuffix)
-		}
-		urlStatement := fmt.Sprintf("endpointURL := %s", baseURLVariable)
-		if len(endpoint.PathParameterNames) > 0 {
-			urlStatement = "endpointURL := fmt.Sprintf(" + baseURLVariable + ", " + endpoint.PathParameterNames + ")"
-		}
-		f.P(urlStatement)
-		if len(endpoint.QueryParameters) > 0 {
-			f.P()
-			f.P("queryParams := make(url.Values)")
-			for _, queryParameter := range endpoint.QueryParameters {
-				valueTypeFormat := formatForValueType(queryParameter.ValueType)
-				if queryParameter.AllowMultiple {
-					requestField := valueTypeFormat.Prefix + "value" + valueTypeFormat.Suffix
-					f.P("for _, value := range ", endpoint.RequestParameterName, ".", queryParameter.Name.Name.PascalCase.UnsafeName, "{")
-					f.P(`queryParams.Add("`, queryParameter.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, "))")
-					f.P("}")
-				} else if isLiteral := (queryParameter.ValueType.Container != nil && queryParameter.ValueType.Container.Literal != nil); isLiteral {
-					f.P(`queryParams.Add("`, queryParameter.Name.WireValue, `", fmt.Sprintf("%v", `, literalToValue(queryParameter.ValueType.Container.Literal), "))")
-				} else {
-					requestField := valueTypeFormat.Prefix + endpoint.RequestParameterName + "." + queryParameter.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
-					if valueTypeFormat.IsOptional {
-						// The only query parameter that can't use the default value approach is base64 (aka a []byte).
-						f.P("if ", endpoint.RequestParameterName, ".", queryParameter.Name.Name.PascalCase.UnsafeName, "!= nil {")
-						f.P(`queryParams.Add("`, queryParameter.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, "))")
-						f.P("}")
-					} else {
-						f.P(`queryParams.Add("`, queryParameter.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, "))")
-					}
-				}
+	}
+	if len(endpoint.ResolvedPathParameters) > 0 {
+		f.P()
+	}
+	// Compose the URL, including any query parameters.
+	f.P(fmt.Sprintf("baseURL := %q", endpoint.BaseURL))
+	f.P("if ", fmt.Sprintf("%s.baseURL", receiver), ` != "" {`)
+	f.P("baseURL = ", fmt.Sprintf("%s.baseURL", receiver))
+	f.P("}")
+	f.P(`if options.BaseURL != "" {`)
+	f.P("baseURL = options.BaseURL")
+	f.P("}")
+	baseURLVariable := "baseURL"
+	if len(endpoint.PathSuffix) > 0 {
+		baseURLVariable = `baseURL + "/" + ` + fmt.Sprintf("%q", endpoint.PathSuffix)
+	}
+	urlStatement := fmt.Sprintf("endpointURL := %s", baseURLVariable)
+	if len(endpoint.PathParameterNames) > 0 {
+		urlStatement = "endpointURL := core.EncodeURL(" + baseURLVariable + ", " + endpoint.PathParameterNames + ")"
+	}
+	f.P(urlStatement)
+	if len(endpoint.QueryParameters) > 0 {
+		f.P()
+		// The query parameters are encoded by their url tags, except for the literals,
+		// which aren't exported.
+		f.P("queryParams, err := core.QueryValues(", endpoint.RequestParameterName, ")")
+		f.P("if err != nil {")
+		f.P("return ", endpoint.ErrorReturnValues)
+		f.P("}")
+		for _, queryParameter := range endpoint.QueryParameters {
+			if queryParameter.ValueType.Container != nil && queryParameter.ValueType.Container.Literal != nil {
+				f.P(`queryParams.Add("`, queryParameter.Name.WireValue, `", fmt.Sprintf("%v", `, literalToValue(queryParameter.ValueType.Container.Literal), "))")
 			}
-			f.P("if len(queryParams) > 0 {")
-			f.P(`endpointURL += "?" + queryParams.Encode()`)
-			f.P("}")
 		}
+		f.P("if len(queryParams) > 0 {")
+		f.P(`endpointURL += "?" + queryParams.Encode()`)
+		f.P("}")
+	}
 
-		headersParameter := "headers"
-		f.P()
-		f.P(headersParameter, " := core.MergeHeaders(", receiver, ".header.Clone(), options.ToHeader())")
-		if len(endpoint.Headers) > 0 {
-			// Add endpoint-specific headers from the request, if any.
-			for _, header := range endpoint.Headers {
-				valueTypeFormat := formatForValueType(header.ValueType)
-				requestField := valueTypeFormat.Prefix + endpoint.RequestParameterName + "." + header.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
-				if valueTypeFormat.IsOptional {
-					f.P("if ", endpoint.RequestParameterName, ".", header.Name.Name.PascalCase.UnsafeName, "!= nil {")
-					f.P(`headers.Add("`, header.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, "))")
-					f.P("}")
-				} else if isLiteral := (header.ValueType.Container != nil && header.ValueType.Container.Literal != nil); isLiteral {
-					f.P(`headers.Add("`, header.Name.WireValue, `", fmt.Sprintf("%v", `, literalToValue(header.ValueType.Container.Literal), "))")
-				} else {
-					f.P(`headers.Add("`, header.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, "))")
-				}
+	headersParameter := "headers"
+	f.P()
+	f.P(headersParameter, " := core.MergeHeaders(", receiver, ".header.Clone(), options.ToHeader())")
+	if len(endpoint.Headers) > 0 {
+		// Add endpoint-specific headers from the request, if any.
+		for _, header := range endpoint.Headers {
+			valueTypeFormat := formatForValueType(header.ValueType)
+			requestField := valueTypeFormat.Prefix + endpoint.RequestParameterName + "." + header.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
+			if valueTypeFormat.IsOptional {
+				f.P("if ", endpoint.RequestParameterName, ".", header.Name.Name.PascalCase.UnsafeName, "!= nil {")
+				f.P(`headers.Add("`, header.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, "))")
+				f.P("}")
+			} else if isLiteral := (header.ValueType.Container != nil && header.ValueType.Container.Literal != nil); isLiteral {
+				f.P(`headers.Add("`, header.Name.WireValue, `", fmt.Sprintf("%v", `, literalToValue(header.ValueType.Container.Literal), "))")
+			} else {
+				f.P(`headers.Add("`, header.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, "))")
 			}
 		}
-		if endpoint.ContentType != "" {
-			f.P(fmt.Sprintf(`%s.Set("Content-Type", %q)`, headersParameter, endpoint.ContentType))
-		}
-		f.P()
+	}
+	if endpoint.ContentType != "" {
+		f.P(fmt.Sprintf(`%s.Set("Content-Type", %q)`, headersParameter, endpoint.ContentType))
+	}
+	f.P()
 
-		// Include the error decoder, if any.
-		if len(endpoint.Errors) > 0 {
-			f.P("errorDecoder := func(statusCode int, body io.Reader) error {")
-			f.P("raw, err := io.ReadAll(body)")
-			f.P("if err != nil {")
+	// Include the error decoder, if any.
+	if len(endpoint.Errors) > 0 {
+		f.P("errorDecoder := func(statusCode int, body io.Reader) error {")
+		f.P("raw, err := io.ReadAll(body)")
+		f.P("if err != nil {")
+		f.P("return err")
+		f.P("}")
+		f.P("apiError := core.NewAPIError(statusCode, errors.New(string(raw)))")
+		f.P("decoder := json.NewDecoder(bytes.NewReader(raw))")
+		var (
+			switchValue              = "statusCode"
+			discriminantContentField = ""
+		)
+		if errorDiscriminationByPropertyStrategy != nil {
+			var (
+				discriminant = errorDiscriminationByPropertyStrategy.Discriminant
+				content      = errorDiscriminationByPropertyStrategy.ContentProperty
+			)
+			switchValue = fmt.Sprintf("discriminant.%s", discriminant.Name.PascalCase.UnsafeName)
+			discriminantContentField = fmt.Sprintf("discriminant.%s", content.Name.PascalCase.UnsafeName)
+			f.P("var discriminant struct {")
+			f.P(discriminant.Name.PascalCase.UnsafeName, " string `json:\"", discriminant.WireValue, "\"`")
+			f.P(content.Name.PascalCase.UnsafeName, " json.RawMessage `json:\"", content.WireValue, "\"`")
+			f.P("}")
+			f.P("if err := decoder.Decode(&discriminant); err != nil {")
 			f.P("return err")
 			f.P("}")
-			f.P("apiError := core.NewAPIError(statusCode, errors.New(string(raw)))")
-			f.P("decoder := json.NewDecoder(bytes.NewReader(raw))")
+		}
+		f.P("switch ", switchValue, " {")
+		for _, responseError := range endpoint.Errors {
 			var (
-				switchValue              = "statusCode"
-				discriminantContentField = ""
+				errorDeclaration = f.errors[responseError.Error.ErrorId]
+				errorImportPath  = fernFilepathToImportPath(f.baseImportPath, errorDeclaration.Name.FernFilepath)
+				errorType        = f.scope.AddImport(errorImportPath) + "." + errorDeclaration.Name.Name.PascalCase.UnsafeName
 			)
 			if errorDiscriminationByPropertyStrategy != nil {
-				var (
-					discriminant = errorDiscriminationByPropertyStrategy.Discriminant
-					content      = errorDiscriminationByPropertyStrategy.ContentProperty
-				)
-				switchValue = fmt.Sprintf("discriminant.%s", discriminant.Name.PascalCase.UnsafeName)
-				discriminantContentField = fmt.Sprintf("discriminant.%s", content.Name.PascalCase.UnsafeName)
-				f.P("var discriminant struct {")
-				f.P(discriminant.Name.PascalCase.UnsafeName, " string `json:\"", discriminant.WireValue, "\"`")
-				f.P(content.Name.PascalCase.UnsafeName, " json.RawMessage `json:\"", content.WireValue, "\"`")
-				f.P("}")
-				f.P("if err := decoder.Decode(&discriminant); err != nil {")
-				f.P("return err")
-				f.P("}")
+				f.P(`case "`, errorDeclaration.DiscriminantValue.WireValue, `":`)
+			} else {
+				f.P("case ", errorDeclaration.StatusCode, ":")
 			}
-			f.P("switch ", switchValue, " {")
-			for _, responseError := range endpoint.Errors {
-				var (
-					errorDeclaration = f.errors[responseError.Error.ErrorId]
-					errorImportPath  = fernFilepathToImportPath(f.baseImportPath, errorDeclaration.Name.FernFilepath)
-					errorType        = f.scope.AddImport(errorImportPath) + "." + errorDeclaration.Name.Name.PascalCase.UnsafeName
-				)
-				if errorDiscriminationByPropertyStrategy != nil {
-					f.P(`case "`, errorDeclaration.DiscriminantValue.WireValue, `":`)
-				} else {
-					f.P("case ", errorDeclaration.StatusCode, ":")
-				}
-				f.P("value := new(", errorType, ")")
-				f.P("value.APIError = apiError")
-				if discriminantContentField != "" {
-					f.P("if err := json.Unmarshal(", discriminantContentField, ", value); err != nil {")
-				} else {
-					f.P("if err := decoder.Decode(value); err != nil {")
-				}
-				f.P("return apiError")
-				f.P("}")
-				f.P("return value")
+			f.P("value := new(", errorType, ")")
+			f.P("value.APIError = apiError")
+			if discriminantContentField != "" {
+				f.P("if err := json.Unmarshal(", discriminantContentField, ", value); err != nil {")
+			} else {
+				f.P("if err := decoder.Decode(value); err != nil {")
 			}
-			// Close the switch statement.
-			f.P("}")
 			f.P("return apiError")
 			f.P("}")
-			f.P()
+			f.P("return value")
 		}
+		// Close the switch statement.
+		f.P("}")
+		f.P("return apiError")
+		f.P("}")
+		f.P()
+	}
 
-		if endpoint.RequestIsBytes {
-			if endpoint.RequestIsOptional {
-				f.P("var requestBuffer io.Reader")
-				f.P("if ", endpoint.RequestBytesParameterName, " != nil {")
-				f.P("requestBuffer = bytes.NewBuffer(", endpoint.RequestBytesParameterName, ")")
-				f.P("}")
-			} else {
-				f.P("requestBuffer := bytes.NewBuffer(", endpoint.RequestBytesParameterName, ")")
-			}
-			f.P()
+	if endpoint.RequestIsBytes {
+		if endpoint.RequestIsOptional {
+			f.P("var requestBuffer io.Reader")
+			f.P("if ", endpoint.RequestBytesParameterName, " != nil {")
+			f.P("requestBuffer = bytes.NewBuffer(", endpoint.RequestBytesParameterName, ")")
+			f.P("}")
+		} else {
+			f.P("requestBuffer := bytes.NewBuffer(", endpoint.RequestBytesParameterName, ")")
 		}
+		f.P()
+	}
 
-		// Prepare a response variable.
-		if endpoint.ResponseType != "" && !endpoint.IsStreaming {
-			f.P(fmt.Sprintf(endpoint.ResponseInitializerFormat, endpoint.ResponseType))
-		}
+	// Prepare a response variable.
+	if endpoint.ResponseType != "" && !endpoint.IsStreaming {
+		f.P(fmt.Sprintf(endpoint.ResponseInitializerFormat, endpoint.ResponseType))
+	}
 
-		if len(endpoint.FileProperties) > 0 || len(endpoint.FileBodyProperties) > 0 {
-			f.P("requestBuffer := bytes.NewBuffer(nil)")
-			f.P("writer := multipart.NewWriter(requestBuffer)")
-			for _, fileProperty := range endpoint.FileProperties {
-				var (
-					fileVariable     = fileProperty.Key.Name.CamelCase.SafeName
-					filenameVariable = fileProperty.Key.Name.CamelCase.UnsafeName + "Filename"
-					filenameValue    = fileProperty.Key.Name.CamelCase.UnsafeName + "_filename"
-					partVariable     = fileProperty.Key.Name.CamelCase.UnsafeName + "Part"
-				)
-				if fileProperty.IsOptional {
-					f.P("if ", fileVariable, " != nil {")
-				}
-				f.P(fmt.Sprintf("%s := %q", filenameVariable, filenameValue))
-				f.P("if named, ok := ", fileVariable, ".(interface{ Name() string }); ok {")
-				f.P(fmt.Sprintf("%s = named.Name()", filenameVariable))
-				f.P("}")
-				f.P(partVariable, `, err := writer.CreateFormFile("`, fileProperty.Key.WireValue, `", `, filenameVariable, ")")
-				f.P("if err != nil {")
-				f.P("return ", endpoint.ErrorReturnValues)
+	if len(endpoint.FileProperties) > 0 || len(endpoint.FileBodyProperties) > 0 {
+		f.P("requestBuffer := bytes.NewBuffer(nil)")
+		f.P("writer := multipart.NewWriter(requestBuffer)")
+		for _, fileProperty := range endpoint.FileProperties {
+			var (
+				fileVariable     = fileProperty.Key.Name.CamelCase.SafeName
+				filenameVariable = fileProperty.Key.Name.CamelCase.UnsafeName + "Filename"
+				filenameValue    = fileProperty.Key.Name.CamelCase.UnsafeName + "_filename"
+				partVariable     = fileProperty.Key.Name.CamelCase.UnsafeName + "Part"
+			)
+			if fileProperty.IsOptional {
+				f.P("if ", fileVariable, " != nil {")
+			}
+			f.P(fmt.Sprintf("%s := %q", filenameVariable, filenameValue))
+			f.P("if named, ok := ", fileVariable, ".(interface{ Name() string }); ok {")
+			f.P(fmt.Sprintf("%s = named.Name()", filenameVariable))
+			f.P("}")
+			f.P(partVariable, `, err := writer.CreateFormFile("`, fileProperty.Key.WireValue, `", `, filenameVariable, ")")
+			f.P("if err != nil {")
+			f.P("return ", endpoint.ErrorReturnValues)
+			f.P("}")
+			f.P("if _, err := io.Copy(", partVariable, ", ", fileVariable, "); err != nil {")
+			f.P("return ", endpoint.ErrorReturnValues)
+			f.P("}")
+			if fileProperty.IsOptional {
 				f.P("}")
-				f.P("if _, err := io.Copy(", partVariable, ", ", fileVariable, "); err != nil {")
+			}
+		}
+
+		for _, fileBodyProperty := range endpoint.FileBodyProperties {
+			if isLiteral := (fileBodyProperty.ValueType.Container != nil && fileBodyProperty.ValueType.Container.Literal != nil); isLiteral {
+				f.P(`if err := writer.WriteField("`, fileBodyProperty.Name.WireValue, `", fmt.Sprintf("%v", `, literalToValue(fileBodyProperty.ValueType.Container.Literal), ")); err != nil {")
 				f.P("return ", endpoint.ErrorReturnValues)
 				f.P("}")
-				if fileProperty.IsOptional {
-					f.P("}")
-				}
+				continue
 			}
-
-			for _, fileBodyProperty := range endpoint.FileBodyProperties {
-				if isLiteral := (fileBodyProperty.ValueType.Container != nil && fileBodyProperty.ValueType.Container.Literal != nil); isLiteral {
-					f.P(`if err := writer.WriteField("`, fileBodyProperty.Name.WireValue, `", fmt.Sprintf("%v", `, literalToValue(fileBodyProperty.ValueType.Container.Literal), ")); err != nil {")
-					f.P("return ", endpoint.ErrorReturnValues)
-					f.P("}")
-					continue
-				}
-				valueTypeFormat := formatForValueType(fileBodyProperty.ValueType)
-				requestField := valueTypeFormat.Prefix + endpoint.RequestParameterName + "." + fileBodyProperty.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
-
-				// Encapsulate the multipart form WriteField in a closure so that we can easily
-				// wrap it with an optional nil check below.
-				writeField := func() {
-					if !valueTypeFormat.IsPrimitive {
-						// Non-primitive types need to be JSON-serialized (e.g. lists, objects, etc).
-						f.P(`if err := core.WriteMultipartJSON(writer, "`, fileBodyProperty.Name.WireValue, `", `, requestField, "); err != nil {")
-					} else {
-						f.P(`if err := writer.WriteField("`, fileBodyProperty.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, ")); err != nil {")
-					}
-					f.P("return ", endpoint.ErrorReturnValues)
-					f.P("}")
-				}
-
-				if valueTypeFormat.IsOptional {
-					f.P("if ", endpoint.RequestParameterName, ".", fileBodyProperty.Name.Name.PascalCase.UnsafeName, "!= nil {")
-					writeField()
-					f.P("}")
+			valueTypeFormat := formatForValueType(fileBodyProperty.ValueType)
+			requestField := valueTypeFormat.Prefix + endpoint.RequestParameterName + "." + fileBodyProperty.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
+
+			// Encapsulate the multipart form WriteField in a closure so that we can easily
+			// wrap it with an optional nil check below.
+			writeField := func() {
+				if !valueTypeFormat.IsPrimitive {
+					// Non-primitive types need to be JSON-serialized (e.g. lists, objects, etc).
+					f.P(`if err := core.WriteMultipartJSON(writer, "`, fileBodyProperty.Name.WireValue, `", `, requestField, "); err != nil {")
 				} else {
-					writeField()
+					f.P(`if err := writer.WriteField("`, fileBodyProperty.Name.WireValue, `", fmt.Sprintf("%v", `, requestField, ")); err != nil {")
 				}
+				f.P("return ", endpoint.ErrorReturnValues)
+				f.P("}")
 			}
-			f.P("if err := writer.Close(); err != nil {")
-			f.P("return ", endpoint.ErrorReturnValues)
-			f.P("}")
-			f.P(headersParameter, `.Set("Content-Type", writer.FormDataContentType())`)
-			f.P()
-		}
 
-		// Issue the request.
-		if endpoint.IsStreaming {
-			f.P("streamer := core.NewStreamer[", endpoint.ResponseType, "](", receiver, ".caller)")
-			f.P("return streamer.Stream(")
-			f.P("ctx,")
-			f.P("&core.StreamParams{")
-			f.P("URL: endpointURL, ")
-			f.P("Method:", endpoint.Method, ",")
-			f.P("MaxAttempts: options.MaxAttempts,")
-			f.P("Headers:", headersParameter, ",")
-			f.P("Client: options.HTTPClient,")
-			if endpoint.RequestValueName != "" {
-				f.P("Request: ", endpoint.RequestValueName, ",")
-			}
-			if endpoint.ErrorDecoderParameterName != "" {
-				f.P("ErrorDecoder:", endpoint.ErrorDecoderParameterName, ",")
-			}
-			if endpoint.StreamDelimiter != "" {
-				f.P("Delimiter: ", endpoint.StreamDelimiter, ",")
-			}
-			f.P("},")
-			f.P(")")
-			f.P("}")
-			f.P()
-		} else {
-			f.P("if err := ", receiver, ".caller.Call(")
-			f.P("ctx,")
-			f.P("&core.CallParams{")
-			f.P("URL: endpointURL, ")
-			f.P("Method:", endpoint.Method, ",")
-			f.P("MaxAttempts: options.MaxAttempts,")
-			f.P("Headers:", headersParameter, ",")
-			f.P("Client: options.HTTPClient,")
-			if endpoint.RequestValueName != "" {
-				f.P("Request: ", endpoint.RequestValueName, ",")
-			}
-			if endpoint.ResponseParameterName != "" {
-				f.P("Response: ", endpoint.ResponseParameterName, ",")
-			}
-			if endpoint.ResponseIsOptionalParameter {
-				f.P("ResponseIsOptional: true,")
-			}
-			if endpoint.ErrorDecoderParameterName != "" {
-				f.P("ErrorDecoder:", endpoint.ErrorDecoderParameterName, ",")
+			if valueTypeFormat.IsOptional {
+				f.P("if ", endpoint.RequestParameterName, ".", fileBodyProperty.Name.Name.PascalCase.UnsafeName, "!= nil {")
+				writeField()
+				f.P("}")
+			} else {
+				writeField()
 			}
-			f.P("},")
-			f.P("); err != nil {")
-			f.P("return ", endpoint.ErrorReturnValues)
-			f.P("}")
-			f.P("return ", endpoint.SuccessfulReturnValues)
-			f.P("}")
-			f.P()
 		}
+		f.P("if err := writer.Close(); err != nil {")
+		f.P("return ", endpoint.ErrorReturnValues)
+		f.P("}")
+		f.P(headersParameter, `.Set("Content-Type", writer.FormDataContentType())`)
+		f.P()
 	}
-	var parameters []ast.Expr
-	if generatedAuth != nil {
-		parameters = append(parameters, generatedAuth.Option)
-	}
-	if generatedEnvironment != nil {
-		parameters = append(parameters, generatedEnvironment.Example)
+
+	// Issue the request.
+	if endpoint.IsStreaming {
+		f.P("streamer := core.NewStreamer[", endpoint.ResponseType, "](", receiver, ".caller)")
+		f.P("return streamer.Stream(")
+		f.P("ctx,")
+		f.P("&core.StreamParams{")
+		f.P("URL: endpointURL, ")
+		f.P("Method:", endpoint.Method, ",")
+		f.P("MaxAttempts: options.MaxAttempts,")
+		f.P("Timeout: options.Timeout,")
+		f.P("AttemptTimeout: options.AttemptTimeout,")
+		f.P("Headers:", headersParameter, ",")
+		f.P("Client: options.HTTPClient,")
+		f.P("QueryParameters: options.QueryParameters,")
+		if endpoint.RequestValueName != "" {
+			f.P("Request: ", endpoint.RequestValueName, ",")
+			f.P("RequestCompression: options.RequestCompression,")
+			f.P("BodyProperties: options.BodyProperties,")
+		}
+		if endpoint.ErrorDecoderParameterName != "" {
+			f.P("ErrorDecoder:", endpoint.ErrorDecoderParameterName, ",")
+		}
+		if endpoint.StreamDelimiter != "" {
+			f.P("Delimiter: ", endpoint.StreamDelimiter, ",")
+		}
+		f.P("},")
+		f.P(")")
+		f.P("}")
+		f.P()
+	} else {
+		f.P("if err := ", receiver, ".caller.Call(")
+		f.P("ctx,")
+		f.P("&core.CallParams{")
+		f.P("URL: endpointURL, ")
+		f.P("Method:", endpoint.Method, ",")
+		f.P("MaxAttempts: options.MaxAttempts,")
+		f.P("Timeout: options.Timeout,")
+		f.P("AttemptTimeout: options.AttemptTimeout,")
+		f.P("Headers:", headersParameter, ",")
+		f.P("Client: options.HTTPClient,")
+		f.P("QueryParameters: options.QueryParameters,")
+		if endpoint.RequestValueName != "" {
+			f.P("Request: ", endpoint.RequestValueName, ",")
+			f.P("RequestCompression: options.RequestCompression,")
+			f.P("BodyProperties: options.BodyProperties,")
+		}
+		if endpoint.ResponseParameterName != "" {
+			f.P("Response: ", endpoint.ResponseParameterName, ",")
+		}
+		if endpoint.ResponseIsOptionalParameter {
+			f.P("ResponseIsOptional: true,")
+		}
+		if endpoint.CacheScope != "" {
+			f.P("Cache: options.Cache,")
+			f.P(fmt.Sprintf("CacheScope: %q,", endpoint.CacheScope))
+		}
+		if endpoint.ErrorDecoderParameterName != "" {
+			f.P("ErrorDecoder:", endpoint.ErrorDecoderParameterName, ",")
+		}
+		f.P("},")
+		f.P("); err != nil {")
+		f.P("return ", endpoint.ErrorReturnValues)
+		f.P("}")
+		f.P("return ", endpoint.SuccessfulReturnValues)
+		f.P("}")
+		f.P()
 	}
-	return &GeneratedClient{
-		Instantiation: &ast.AssignStmt{
-			Left: []ast.Expr{
-				ast.NewLocalObject("client"),
-			},
-			Right: []ast.Expr{
-				ast.NewCallExpr(
-					ast.NewImportedObject(
-						"NewClient",
-						packagePathToImportPath(f.baseImportPath, packagePathForClient(fernFilepath)),
-					),
-					parameters,
-				),
-			},
-		},
-	}, nil
 }
 
 // endpoint holds the fields required to generate a client endpoint.
@@ -1180,6 +1571,7 @@ func (f *fileWriter) WriteClient(
 type endpoint struct {
 	Name                        *ir.Name
 	Docs                        *string
+	Availability                *ir.Availability
 	ImportPath                  string
 	OptionsParameterName        string
 	RequestParameterName        string
@@ -1187,11 +1579,13 @@ type endpoint struct {
 	RequestValueName            string
 	RequestIsBytes              bool
 	RequestIsOptional           bool
+	RequestIsValidated          bool
 	ResponseType                string
 	ResponseParameterName       string
 	ResponseInitializerFormat   string
 	ResponseIsOptionalParameter bool
 	PathParameterNames          string
+	ResolvedPathParameters      []*resolvedPathParameter
 	SignatureParameters         []*signatureParameter
 	ReturnValues                string
 	SuccessfulReturnValues      string
@@ -1201,6 +1595,7 @@ type endpoint struct {
 	PathSuffix                  string
 	Method                      string
 	IsStreaming                 bool
+	CacheScope                  string
 	StreamDelimiter             string
 	ErrorDecoderParameterName   string
 	Idempotent                  bool
@@ -1213,6 +1608,19 @@ type endpoint struct {
 	FileBodyProperties          []*ir.InlinedRequestBodyProperty
 }
 
+// resolvedPathParameter is a client path parameter that's resolved from the
+// client and request options, e.g.
+//
+//	tenantId := c.tenantId
+//	if options.TenantId != nil {
+//	  tenantId = options.TenantId
+//	}
+type resolvedPathParameter struct {
+	Name   string // e.g. 'tenantId'
+	Field  string // e.g. 'tenantId'
+	Option string // e.g. 'TenantId'
+}
+
 type signatureParameter struct {
 	docs      *string // e.g. "Identifies a single user."
 	parameter string  // e.g. 'userId string'
@@ -1224,7 +1632,9 @@ func (f *fileWriter) endpointFromIR(
 	irEndpoint *ir.HttpEndpoint,
 	irEnvironmentsConfig *ir.EnvironmentsConfig,
 	idempotencyHeaders []*ir.HttpHeader,
+	clientPathParameters []*clientPathParameter,
 	receiver string,
+	fromChannel bool,
 ) (*endpoint, error) {
 	importPath := fernFilepathToImportPath(f.baseImportPath, fernFilepath)
 
@@ -1233,10 +1643,31 @@ func (f *fileWriter) endpointFromIR(
 
 	// Add path parameters and request body, if any.
 	signatureParameters := []*signatureParameter{{parameter: "ctx context.Context"}}
-	var pathParameterNames []string
+	var (
+		pathParameterNames     []string
+		resolvedPathParameters []*resolvedPathParameter
+	)
 	for _, pathParameter := range irEndpoint.AllPathParameters {
+		// The path parameters are formatted the same way as the other parameters
+		// (e.g. dates), and then escaped by core.EncodeURL.
+		valueTypeFormat := formatForValueType(pathParameter.ValueType)
+		if clientPathParameter := clientPathParameterFor(pathParameter, clientPathParameters); clientPathParameter != nil {
+			// The client path parameters aren't included in the signature; they're
+			// resolved from the client and request options instead.
+			pathParameterName := scope.Add(clientPathParameter.Name.CamelCase.SafeName)
+			resolvedPathParameters = append(
+				resolvedPathParameters,
+				&resolvedPathParameter{
+					Name:   pathParameterName,
+					Field:  clientPathParameter.Name.CamelCase.UnsafeName,
+					Option: clientPathParameter.Name.PascalCase.UnsafeName,
+				},
+			)
+			pathParameterNames = append(pathParameterNames, valueTypeFormat.Prefix+"*"+pathParameterName+valueTypeFormat.Suffix)
+			continue
+		}
 		pathParameterName := scope.Add(pathParameter.Name.CamelCase.SafeName)
-		parameterType := typeReferenceToGoType(pathParameter.ValueType, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false)
+		parameterType := typeReferenceToGoType(pathParameter.ValueType, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false)
 		signatureParameters = append(
 			signatureParameters,
 			&signatureParameter{
@@ -1244,7 +1675,7 @@ func (f *fileWriter) endpointFromIR(
 				parameter: fmt.Sprintf("%s %s", pathParameterName, parameterType),
 			},
 		)
-		pathParameterNames = append(pathParameterNames, pathParameterName)
+		pathParameterNames = append(pathParameterNames, valueTypeFormat.Prefix+pathParameterName+valueTypeFormat.Suffix)
 	}
 
 	// Add the file parameter(s) after the path parameters, if any.
@@ -1279,6 +1710,7 @@ func (f *fileWriter) endpointFromIR(
 		requestValueName          = ""
 		requestIsBytes            = false
 		requestIsOptional         = false
+		requestIsValidated        = false
 	)
 	if irEndpoint.SdkRequest != nil {
 		if needsRequestParameter(irEndpoint) {
@@ -1287,7 +1719,14 @@ func (f *fileWriter) endpointFromIR(
 			if requestBody := irEndpoint.SdkRequest.Shape.JustRequestBody; requestBody != nil {
 				switch requestBody.Type {
 				case "typeReference":
-					requestType = typeReferenceToGoType(requestBody.TypeReference.RequestBodyType, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false)
+					requestType = typeReferenceToGoType(requestBody.TypeReference.RequestBodyType, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false)
+					requestIsValidated = f.implementsValidator(requestBody.TypeReference.RequestBodyType)
+					if fromChannel {
+						itemType := channelRequestItemType(irEndpoint, f.types)
+						requestType = "<-chan " + typeReferenceToGoType(itemType, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false)
+						// The items can't be validated before the request is sent.
+						requestIsValidated = false
+					}
 				case "bytes":
 					contentType = "application/octet-stream"
 					if irEndpoint.RequestBody.Bytes.ContentType != nil {
@@ -1305,6 +1744,7 @@ func (f *fileWriter) endpointFromIR(
 			if irEndpoint.SdkRequest.Shape.Wrapper != nil {
 				requestImportPath := fernFilepathToImportPath(f.baseImportPath, fernFilepath)
 				requestType = fmt.Sprintf("*%s.%s", scope.AddImport(requestImportPath), irEndpoint.SdkRequest.Shape.Wrapper.WrapperName.PascalCase.UnsafeName)
+				requestIsValidated = true
 				if irEndpoint.RequestBody != nil && irEndpoint.RequestBody.Bytes != nil {
 					contentType = "application/octet-stream"
 					if irEndpoint.RequestBody.Bytes.ContentType != nil {
@@ -1337,6 +1777,31 @@ func (f *fileWriter) endpointFromIR(
 			requestValueName = "requestBuffer"
 		}
 	}
+	if contentType == "" && irEndpoint.RequestBody != nil {
+		// The Content-Type also determines how the request body is encoded
+		// (e.g. application/x-www-form-urlencoded).
+		contentType = contentTypeForRequestBody(irEndpoint.RequestBody)
+	}
+	if sdkRequest := irEndpoint.SdkRequest; sdkRequest != nil && sdkRequest.Shape.JustRequestBody != nil && sdkRequest.Shape.JustRequestBody.TypeReference != nil &&
+		!fromChannel && contentType == "" && requestValueName == requestParameterName {
+		// Dates (and containers of them) are sent in their RFC 3339 formats, just
+		// like the date properties of a request type.
+		if coreType := dateValueCoreType(sdkRequest.Shape.JustRequestBody.TypeReference.RequestBodyType, f.types); coreType != "" {
+			requestValueName = fmt.Sprintf("core.New%ss(%s)", coreType, requestParameterName)
+		}
+	}
+	name, docs := irEndpoint.Name, irEndpoint.Docs
+	if fromChannel {
+		// The channel's items are encoded as a JSON array, unless the endpoint
+		// accepts newline-delimited JSON.
+		reader := "core.NewJSONArrayReader"
+		if contentType == "application/x-ndjson" {
+			reader = "core.NewNDJSONReader"
+		}
+		requestValueName = fmt.Sprintf("%s(ctx, %s)", reader, requestParameterName)
+		name = channelEndpointName(irEndpoint.Name)
+		docs = channelEndpointDocs(irEndpoint.Name, irEndpoint.Docs)
+	}
 
 	// The request options must always be the last parameter.
 	optionType := "option.RequestOption"
@@ -1369,21 +1834,24 @@ func (f *fileWriter) endpointFromIR(
 			if typeReference == nil {
 				return nil, fmt.Errorf("unsupported json response type: %s", irEndpoint.Response.Json.Type)
 			}
-			responseType = typeReferenceToGoType(typeReference, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false)
+			responseType = typeReferenceToGoType(typeReference, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false)
 			responseInitializerFormat = "var response %s"
 			responseIsOptionalParameter = typeReference.Container != nil && typeReference.Container.Optional != nil
 			responseParameterName = "&response"
+			if coreType := dateValueCoreType(typeReference, f.types); coreType != "" {
+				responseParameterName = fmt.Sprintf("core.New%ss(&response)", coreType)
+			}
 			signatureReturnValues = fmt.Sprintf("(%s, error)", responseType)
 			successfulReturnValues = "response, nil"
-			errorReturnValues = fmt.Sprintf("%s, err", defaultValueForTypeReference(typeReference, f.types))
+			errorReturnValues = fmt.Sprintf("%s, err", zeroValueForGoType(typeReference, responseType, f.types, f.typeOverrides))
 
 			if irEndpoint.Response.Json.NestedPropertyAsResponse != nil && irEndpoint.Response.Json.NestedPropertyAsResponse.ResponseProperty != nil {
 				responseProperty := irEndpoint.Response.Json.NestedPropertyAsResponse.ResponseProperty
 				responsePropertyTypeReference := responseProperty.ValueType
-				responsePropertyType := typeReferenceToGoType(responsePropertyTypeReference, f.types, f.scope, f.baseImportPath, "" /* The type is always imported */, false)
+				responsePropertyType := typeReferenceToGoType(responsePropertyTypeReference, f.types, f.typeOverrides, f.scope, f.baseImportPath, "" /* The type is always imported */, false)
 				signatureReturnValues = fmt.Sprintf("(%s, error)", responsePropertyType)
 				successfulReturnValues = fmt.Sprintf("response.%s, nil", responseProperty.Name.Name.PascalCase.UnsafeName)
-				errorReturnValues = fmt.Sprintf("%s, err", defaultValueForTypeReference(responsePropertyTypeReference, f.types))
+				errorReturnValues = fmt.Sprintf("%s, err", zeroValueForGoType(responsePropertyTypeReference, responsePropertyType, f.types, f.typeOverrides))
 			}
 		case "fileDownload":
 			responseType = "bytes.NewBuffer(nil)"
@@ -1407,7 +1875,7 @@ func (f *fileWriter) endpointFromIR(
 			if typeReference == nil {
 				return nil, fmt.Errorf("unsupported streaming response type: %s", irEndpoint.Response.Streaming.DataEventType.Type)
 			}
-			responseType = strings.TrimPrefix(typeReferenceToGoType(typeReference, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false), "*")
+			responseType = strings.TrimPrefix(typeReferenceToGoType(typeReference, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false), "*")
 			responseParameterName = "response"
 			signatureReturnValues = fmt.Sprintf("(*core.Stream[%s], error)", responseType)
 			errorReturnValues = "nil, err"
@@ -1461,6 +1929,9 @@ func (f *fileWriter) endpointFromIR(
 
 	var pathParameterDocs []*string
 	for _, pathParam := range irEndpoint.AllPathParameters {
+		if clientPathParameterFor(pathParam, clientPathParameters) != nil {
+			continue
+		}
 		if pathParam.Docs != nil && len(*pathParam.Docs) > 0 {
 			pathParameterDocs = append(pathParameterDocs, pathParam.Docs)
 		}
@@ -1471,9 +1942,20 @@ func (f *fileWriter) endpointFromIR(
 		optionConstructor = "core.NewIdempotentRequestOptions(opts...)"
 	}
 
+	// Only the responses of GET endpoints that return JSON or text are cached,
+	// scoped by the endpoint's ID so that they're never shared across endpoints.
+	var cacheScope string
+	if irEndpoint.Method == ir.HttpMethodGet && irEndpoint.Response != nil {
+		switch irEndpoint.Response.Type {
+		case "json", "text":
+			cacheScope = irEndpoint.Id
+		}
+	}
+
 	return &endpoint{
-		Name:                        irEndpoint.Name,
-		Docs:                        irEndpoint.Docs,
+		Name:                        name,
+		Docs:                        docs,
+		Availability:                irEndpoint.Availability,
 		ImportPath:                  importPath,
 		OptionsParameterName:        "options",
 		RequestParameterName:        requestParameterName,
@@ -1481,11 +1963,13 @@ func (f *fileWriter) endpointFromIR(
 		RequestValueName:            requestValueName,
 		RequestIsBytes:              requestIsBytes,
 		RequestIsOptional:           requestIsOptional,
+		RequestIsValidated:          requestIsValidated,
 		ResponseType:                responseType,
 		ResponseParameterName:       responseParameterName,
 		ResponseInitializerFormat:   responseInitializerFormat,
 		ResponseIsOptionalParameter: responseIsOptionalParameter,
 		PathParameterNames:          strings.Join(pathParameterNames, ", "),
+		ResolvedPathParameters:      resolvedPathParameters,
 		SignatureParameters:         signatureParameters,
 		ReturnValues:                signatureReturnValues,
 		SuccessfulReturnValues:      successfulReturnValues,
@@ -1495,6 +1979,7 @@ func (f *fileWriter) endpointFromIR(
 		PathSuffix:                  pathSuffix,
 		Method:                      irMethodToMethodEnum(irEndpoint.Method),
 		IsStreaming:                 isStreaming,
+		CacheScope:                  cacheScope,
 		StreamDelimiter:             streamDelimiter,
 		ErrorDecoderParameterName:   errorDecoderParameterName,
 		ContentType:                 contentType,
@@ -1546,7 +2031,7 @@ func (f *fileWriter) WriteError(errorDeclaration *ir.ErrorDeclaration) error {
 	}
 	var (
 		importPath = fernFilepathToImportPath(f.baseImportPath, errorDeclaration.Name.FernFilepath)
-		value      = typeReferenceToGoType(errorDeclaration.Type, f.types, f.scope, f.baseImportPath, importPath, false)
+		value      = typeReferenceToGoType(errorDeclaration.Type, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
 	)
 	var literal string
 	if errorDeclaration.Type.Container != nil && errorDeclaration.Type.Container.Literal != nil {
@@ -1611,6 +2096,62 @@ func (f *fileWriter) WriteRequestType(
 	endpoint *ir.HttpEndpoint,
 	idempotencyHeaders []*ir.HttpHeader,
 	includeGenericOptionals bool,
+	getters bool,
+) error {
+	return f.writeRequestType(fernFilepath, endpoint, includeGenericOptionals, getters, new(clientRequestTypeTagger))
+}
+
+// requestTypeTagger determines the struct tags used for the header and query
+// parameter fields of a request type (e.g. so that they can be bound by a
+// server framework).
+type requestTypeTagger interface {
+	headerTag(wireValue string) string
+	queryTag(queryParam *ir.QueryParameter, types map[ir.TypeId]*ir.TypeDeclaration) string
+}
+
+// clientRequestTypeTagger excludes the header and query parameters from the
+// request's JSON representation. The query parameters are tagged so that
+// they're encoded with core.QueryValues instead.
+type clientRequestTypeTagger struct{}
+
+func (*clientRequestTypeTagger) headerTag(string) string {
+	return `json:"-"`
+}
+
+func (*clientRequestTypeTagger) queryTag(queryParam *ir.QueryParameter, types map[ir.TypeId]*ir.TypeDeclaration) string {
+	var style string
+	if !queryParam.AllowMultiple && isListTypeReference(queryParam.ValueType, types) {
+		// Lists that aren't repeated are sent as a single comma-delimited value.
+		style = "comma"
+	}
+	return `json:"-" ` + urlTagForType(queryParam.Name.WireValue, queryParam.ValueType, types, style)
+}
+
+// isListTypeReference returns true if the given type is a list or set, or an
+// optional or alias of one.
+func isListTypeReference(valueType *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) bool {
+	switch {
+	case valueType.Container != nil:
+		if optional := valueType.Container.Optional; optional != nil {
+			return isListTypeReference(optional, types)
+		}
+		return valueType.Container.List != nil || valueType.Container.Set != nil
+	case valueType.Named != nil:
+		if alias := types[valueType.Named.TypeId].Shape.Alias; alias != nil {
+			return isListTypeReference(alias.AliasOf, types)
+		}
+	}
+	return false
+}
+
+// writeRequestType writes a type dedicated to the in-lined request, where the header and
+// query parameter fields are tagged with the given tagger.
+func (f *fileWriter) writeRequestType(
+	fernFilepath *ir.FernFilepath,
+	endpoint *ir.HttpEndpoint,
+	includeGenericOptionals bool,
+	getters bool,
+	tagger requestTypeTagger,
 ) error {
 	var (
 		// At this point, we've already verified that the given endpoint's request
@@ -1624,7 +2165,7 @@ func (f *fileWriter) WriteRequestType(
 	var literals []*literal
 	f.P("type ", typeName, " struct {")
 	for _, header := range endpoint.Headers {
-		f.WriteDocs(header.Docs)
+		f.WriteDocsWithAvailability(header.Docs, header.Availability)
 		if header.ValueType.Container != nil && header.ValueType.Container.Literal != nil {
 			literals = append(
 				literals,
@@ -1635,15 +2176,15 @@ func (f *fileWriter) WriteRequestType(
 			)
 			continue
 		}
-		goType := typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
-		f.P(header.Name.Name.PascalCase.UnsafeName, " ", goType, " `json:\"-\"`")
+		goType := typeReferenceToGoType(header.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
+		f.P(header.Name.Name.PascalCase.UnsafeName, " ", goType, " `", tagger.headerTag(header.Name.WireValue), "`")
 	}
 	for _, queryParam := range endpoint.QueryParameters {
-		value := typeReferenceToGoType(queryParam.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+		value := typeReferenceToGoType(queryParam.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false)
 		if queryParam.AllowMultiple {
 			value = fmt.Sprintf("[]%s", value)
 		}
-		f.WriteDocs(queryParam.Docs)
+		f.WriteDocsWithAvailability(queryParam.Docs, queryParam.Availability)
 		if queryParam.ValueType.Container != nil && queryParam.ValueType.Container.Literal != nil {
 			literals = append(
 				literals,
@@ -1654,7 +2195,7 @@ func (f *fileWriter) WriteRequestType(
 			)
 			continue
 		}
-		f.P(queryParam.Name.Name.PascalCase.UnsafeName, " ", value, " `json:\"-\"`")
+		f.P(queryParam.Name.Name.PascalCase.UnsafeName, " ", value, " `", tagger.queryTag(queryParam, f.types), "`")
 	}
 	if endpoint.RequestBody == nil {
 		// If the request doesn't have a body, we don't need any custom [de]serialization logic.
@@ -1663,12 +2204,16 @@ func (f *fileWriter) WriteRequestType(
 		}
 		f.P("}")
 		f.P()
+		if getters {
+			f.writeGetters(typeName, receiver, f.structFieldsForRequest(endpoint, importPath, bodyField, includeGenericOptionals))
+		}
 		for _, literal := range literals {
 			f.P("func (", receiver, " *", typeName, ") ", literal.Name.PascalCase.UnsafeName, "()", literalToGoType(literal.Value), "{")
 			f.P("return ", receiver, ".", literal.Name.CamelCase.SafeName)
 			f.P("}")
 			f.P()
 		}
+		f.writeValidateMethod(typeName, receiver, validationFieldsForRequest(endpoint, f.types, includeGenericOptionals))
 		return nil
 	}
 	fieldLiterals, err := requestBodyToFieldDeclaration(endpoint.RequestBody, f, importPath, bodyField, includeGenericOptionals)
@@ -1682,6 +2227,9 @@ func (f *fileWriter) WriteRequestType(
 	f.P("}")
 	f.P()
 	// Implement the getter methods.
+	if getters {
+		f.writeGetters(typeName, receiver, f.structFieldsForRequest(endpoint, importPath, bodyField, includeGenericOptionals))
+	}
 	for _, literal := range literals {
 		f.P("func (", receiver, " *", typeName, ") ", literal.Name.PascalCase.UnsafeName, "()", literalToGoType(literal.Value), "{")
 		f.P("return ", receiver, ".", literal.Name.CamelCase.SafeName)
@@ -1689,24 +2237,34 @@ func (f *fileWriter) WriteRequestType(
 		f.P()
 	}
 
+	// Implement the core.Validator interface.
+	f.writeValidateMethod(typeName, receiver, validationFieldsForRequest(endpoint, f.types, includeGenericOptionals))
+
 	var (
 		referenceType      string
 		referenceIsPointer bool
 		referenceLiteral   string
+		referenceDateType  string
 	)
 	if reference := endpoint.RequestBody.Reference; reference != nil {
 		referenceType = strings.TrimPrefix(
-			typeReferenceToGoType(reference.RequestBodyType, f.types, f.scope, f.baseImportPath, importPath, false),
+			typeReferenceToGoType(reference.RequestBodyType, f.types, f.typeOverrides, f.scope, f.baseImportPath, importPath, false),
 			"*",
 		)
 		referenceIsPointer = reference.RequestBodyType.Named != nil && isPointer(f.types[reference.RequestBodyType.Named.TypeId])
 		if reference.RequestBodyType.Container != nil && reference.RequestBodyType.Container.Literal != nil {
 			referenceLiteral = literalToValue(reference.RequestBodyType.Container.Literal)
 		}
+		referenceDateType = dateValueCoreType(reference.RequestBodyType, f.types)
 	}
 
-	if len(literals) == 0 && len(referenceType) == 0 {
-		// If the request doesn't specify any literals or a reference type,
+	var dates []*dateProperty
+	if inlinedRequestBody := endpoint.RequestBody.InlinedRequestBody; inlinedRequestBody != nil {
+		dates = datePropertiesForObject(inlinedRequestBodyToObjectTypeDeclaration(inlinedRequestBody), f.types, includeGenericOptionals)
+	}
+
+	if len(literals) == 0 && len(referenceType) == 0 && len(dates) == 0 {
+		// If the request doesn't specify any literals, dates, or a reference type,
 		// we don't need to customize the [de]serialization logic at all.
 		return nil
 	}
@@ -1719,14 +2277,33 @@ func (f *fileWriter) WriteRequestType(
 		} else {
 			f.P("var body ", referenceType)
 		}
+	} else if len(dates) > 0 {
+		f.P("type embed ", typeName)
+		f.P("var body = struct{")
+		f.P("embed")
+		for _, date := range dates {
+			f.P(date.field())
+		}
+		f.P("}{")
+		f.P("embed: embed(*", receiver, "),")
+		f.P("}")
 	} else {
 		f.P("type unmarshaler ", typeName)
 		f.P("var body unmarshaler")
 	}
-	f.P("if err := json.Unmarshal(data, &body); err != nil {")
+	if len(referenceDateType) > 0 {
+		f.P("if err := json.Unmarshal(data, core.New", referenceDateType, "s(&body)); err != nil {")
+	} else {
+		f.P("if err := json.Unmarshal(data, &body); err != nil {")
+	}
 	f.P("return err")
 	f.P("}")
-	if len(referenceType) > 0 {
+	if len(dates) > 0 {
+		f.P("*", receiver, " = ", typeName, "(body.embed)")
+		for _, date := range dates {
+			f.writeDateAccessor(date, receiver+"."+date.name, "body."+date.name)
+		}
+	} else if len(referenceType) > 0 {
 		if len(referenceLiteral) > 0 {
 			f.P("if body != ", referenceLiteral, "{")
 			f.P(`return fmt.Errorf("expected literal %q, but found %q", `, referenceLiteral, ", body)")
@@ -1751,6 +2328,9 @@ func (f *fileWriter) WriteRequestType(
 		if len(referenceLiteral) > 0 {
 			value = referenceLiteral
 		}
+		if len(referenceDateType) > 0 {
+			value = fmt.Sprintf("core.New%ss(%s)", referenceDateType, value)
+		}
 		f.P("return json.Marshal(", value, ")")
 	} else {
 		f.P("type embed ", typeName)
@@ -1759,11 +2339,17 @@ func (f *fileWriter) WriteRequestType(
 		for _, literal := range literals {
 			f.P(literal.Name.PascalCase.UnsafeName, " ", literalToGoType(literal.Value), " `json:\"", literal.Name.OriginalName, "\"`")
 		}
+		for _, date := range dates {
+			f.P(date.field())
+		}
 		f.P("}{")
 		f.P("embed: embed(*", receiver, "),")
 		for _, literal := range literals {
 			f.P(literal.Name.PascalCase.UnsafeName, ": ", literalToValue(literal.Value), ",")
 		}
+		for _, date := range dates {
+			f.P(date.name, ": ", date.constructor(receiver+"."+date.name), ",")
+		}
 		f.P("}")
 		f.P("return json.Marshal(marshaler)")
 	}
@@ -1992,7 +2578,7 @@ func (r *requestBodyVisitor) VisitInlinedRequestBody(inlinedRequestBody *ir.Inli
 		writer:         r.writer,
 	}
 	objectTypeDeclaration := inlinedRequestBodyToObjectTypeDeclaration(inlinedRequestBody)
-	_, literals := typeVisitor.visitObjectProperties(objectTypeDeclaration, true /* includeTags */, r.includeGenericOptionals)
+	_, literals := typeVisitor.visitObjectProperties(objectTypeDeclaration, true /* includeTags */, false /* includeURLTags */, r.includeGenericOptionals)
 	r.literals = literals
 	return nil
 }
@@ -2002,7 +2588,7 @@ func (r *requestBodyVisitor) VisitReference(reference *ir.HttpRequestBodyReferen
 	r.writer.P(
 		r.bodyField,
 		" ",
-		typeReferenceToGoType(reference.RequestBodyType, r.types, r.scope, r.baseImportPath, r.importPath, false),
+		typeReferenceToGoType(reference.RequestBodyType, r.types, r.writer.typeOverrides, r.scope, r.baseImportPath, r.importPath, false),
 		" `json:\"-\"`",
 	)
 	return nil
@@ -2028,7 +2614,7 @@ func (r *requestBodyVisitor) VisitFileUpload(fileUpload *ir.FileUploadRequest) e
 		writer:         r.writer,
 	}
 	objectTypeDeclaration := inlinedRequestBodyPropertiesToObjectTypeDeclaration(bodyProperties)
-	_, literals := typeVisitor.visitObjectProperties(objectTypeDeclaration, true /* includeTags */, r.includeGenericOptionals)
+	_, literals := typeVisitor.visitObjectProperties(objectTypeDeclaration, true /* includeTags */, false /* includeURLTags */, r.includeGenericOptionals)
 	r.literals = literals
 	return nil
 }
@@ -2081,6 +2667,85 @@ func inlinedRequestBodyPropertiesToObjectTypeDeclaration(bodyProperties []*ir.In
 // irMethodToMethodEnum maps the given ir.HttpMethod to the net/http equivalent.
 // Note this returns the string representation of the net/http constant (e.g.
 // "http.MethodGet"), not the value the constant points to (e.g. "GET").
+// channelRequestItemType returns the type of the items sent by the given endpoint
+// if its request body is a list, i.e. it can be sent from a channel.
+func channelRequestItemType(irEndpoint *ir.HttpEndpoint, types map[ir.TypeId]*ir.TypeDeclaration) *ir.TypeReference {
+	if irEndpoint.SdkRequest == nil || irEndpoint.SdkRequest.Shape.JustRequestBody == nil {
+		return nil
+	}
+	requestBody := irEndpoint.SdkRequest.Shape.JustRequestBody
+	if requestBody.Type != "typeReference" {
+		return nil
+	}
+	return listItemTypeReference(requestBody.TypeReference.RequestBodyType, types)
+}
+
+// listItemTypeReference returns the item type of the given list (or set), which
+// might be referenced by an alias.
+func listItemTypeReference(valueType *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) *ir.TypeReference {
+	switch {
+	case valueType.Container != nil:
+		if list := valueType.Container.List; list != nil {
+			return list
+		}
+		return valueType.Container.Set
+	case valueType.Named != nil:
+		if alias := types[valueType.Named.TypeId].Shape.Alias; alias != nil {
+			return listItemTypeReference(alias.AliasOf, types)
+		}
+	}
+	return nil
+}
+
+// channelEndpointName returns the name of the given endpoint's channel
+// variant, e.g. CreateUsersFromChannel.
+func channelEndpointName(name *ir.Name) *ir.Name {
+	return &ir.Name{
+		OriginalName: name.OriginalName + "FromChannel",
+		CamelCase: &ir.SafeAndUnsafeString{
+			UnsafeName: name.CamelCase.UnsafeName + "FromChannel",
+			SafeName:   name.CamelCase.UnsafeName + "FromChannel",
+		},
+		PascalCase: &ir.SafeAndUnsafeString{
+			UnsafeName: name.PascalCase.UnsafeName + "FromChannel",
+			SafeName:   name.PascalCase.UnsafeName + "FromChannel",
+		},
+	}
+}
+
+// channelEndpointDocs returns the docs for the given endpoint's channel variant,
+// which refer to the endpoint's own docs, if any.
+func channelEndpointDocs(name *ir.Name, docs *string) *string {
+	channelDocs := fmt.Sprintf(
+		"%sFromChannel is equivalent to %s, but the request's items are received\n"+
+			"from the given channel and encoded as the request is sent, so the list is never\n"+
+			"held in memory all at once. The request ends when the channel is closed, and it\n"+
+			"fails if the context is cancelled before then.",
+		name.PascalCase.UnsafeName,
+		name.PascalCase.UnsafeName,
+	)
+	if docs != nil && len(*docs) > 0 {
+		channelDocs = *docs + "\n\n" + channelDocs
+	}
+	return &channelDocs
+}
+
+// contentTypeForRequestBody returns the Content-Type of the given JSON request
+// body, if it isn't the default (i.e. application/json).
+func contentTypeForRequestBody(requestBody *ir.HttpRequestBody) string {
+	var contentType *string
+	switch {
+	case requestBody.InlinedRequestBody != nil:
+		contentType = requestBody.InlinedRequestBody.ContentType
+	case requestBody.Reference != nil:
+		contentType = requestBody.Reference.ContentType
+	}
+	if contentType == nil || *contentType == "application/json" {
+		return ""
+	}
+	return *contentType
+}
+
 func irMethodToMethodEnum(method ir.HttpMethod) string {
 	switch method {
 	case ir.HttpMethodGet:
@@ -2125,11 +2790,11 @@ func formatForValueType(typeReference *ir.TypeReference) *valueTypeFormat {
 		}
 		switch primitive {
 		case ir.PrimitiveTypeDateTime:
-			prefix = ""
-			suffix = ".Format(time.RFC3339)"
+			prefix = "core.NewDateTime(" + prefix
+			suffix = ").String()"
 		case ir.PrimitiveTypeDate:
-			prefix = ""
-			suffix = `.Format("2006-01-02")`
+			prefix = "core.NewDate(" + prefix
+			suffix = ").String()"
 		case ir.PrimitiveTypeBase64:
 			prefix = "base64.StdEncoding.EncodeToString(" + prefix
 			suffix = ")"
@@ -2145,6 +2810,14 @@ func formatForValueType(typeReference *ir.TypeReference) *valueTypeFormat {
 	}
 }
 
+// formatForValueTypeInCore is equivalent to formatForValueType, but for values
+// that are formatted within the core package (e.g. the request options).
+func formatForValueTypeInCore(typeReference *ir.TypeReference) *valueTypeFormat {
+	format := formatForValueType(typeReference)
+	format.Prefix = strings.TrimPrefix(format.Prefix, "core.")
+	return format
+}
+
 func typeReferenceFromJsonResponse(
 	jsonResponse *ir.JsonResponse,
 ) *ir.TypeReference {
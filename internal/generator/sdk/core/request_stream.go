@@ -0,0 +1,72 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// NewJSONArrayReader returns an io.Reader that encodes the items received from
+// the given channel as a JSON array. The items are encoded incrementally as the
+// request body is sent, so the array is never held in memory all at once.
+//
+// The array is terminated when the channel is closed, and the request fails if
+// the given context is cancelled before then.
+func NewJSONArrayReader[T any](ctx context.Context, items <-chan T) io.Reader {
+	return newItemReader(ctx, items, false)
+}
+
+// NewNDJSONReader is equivalent to NewJSONArrayReader, but each item is encoded
+// as a separate line of JSON (i.e. newline-delimited JSON).
+func NewNDJSONReader[T any](ctx context.Context, items <-chan T) io.Reader {
+	return newItemReader(ctx, items, true)
+}
+
+// newItemReader returns an io.Reader that's written to by a separate goroutine,
+// which stops as soon as the channel is closed, the context is cancelled, or the
+// reader is closed (e.g. the request fails).
+func newItemReader[T any](ctx context.Context, items <-chan T, newlineDelimited bool) io.Reader {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeItems(ctx, writer, items, newlineDelimited))
+	}()
+	return reader
+}
+
+// writeItems writes every item received from the given channel to the given
+// writer, either as a JSON array or as newline-delimited JSON.
+func writeItems[T any](ctx context.Context, writer io.Writer, items <-chan T, newlineDelimited bool) error {
+	buffer := bufio.NewWriter(writer)
+	encoder := json.NewEncoder(buffer)
+	if !newlineDelimited {
+		if err := buffer.WriteByte('['); err != nil {
+			return err
+		}
+	}
+	for i := 0; ; i++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case item, ok := <-items:
+			if !ok {
+				if !newlineDelimited {
+					if err := buffer.WriteByte(']'); err != nil {
+						return err
+					}
+				}
+				return buffer.Flush()
+			}
+			if i > 0 && !newlineDelimited {
+				if err := buffer.WriteByte(','); err != nil {
+					return err
+				}
+			}
+			// The encoder terminates each item with a newline, which is
+			// insignificant whitespace in a JSON array.
+			if err := encoder.Encode(item); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJSONArrayReader(t *testing.T) {
+	tests := []struct {
+		desc      string
+		giveItems []*Request
+		wantBody  string
+	}{
+		{
+			desc:     "empty",
+			wantBody: "[]",
+		},
+		{
+			desc:      "single item",
+			giveItems: []*Request{{Id: "123"}},
+			wantBody:  "[{\"id\":\"123\"}\n]",
+		},
+		{
+			desc:      "multiple items",
+			giveItems: []*Request{{Id: "123"}, {Id: "456"}},
+			wantBody:  "[{\"id\":\"123\"}\n,{\"id\":\"456\"}\n]",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			body, err := io.ReadAll(NewJSONArrayReader(context.Background(), sendTestItems(test.giveItems)))
+			require.NoError(t, err)
+			assert.Equal(t, test.wantBody, string(body))
+			assert.True(t, isValidJSON(body))
+		})
+	}
+}
+
+func TestNewNDJSONReader(t *testing.T) {
+	items := []*Request{{Id: "123"}, {Id: "456"}}
+	body, err := io.ReadAll(NewNDJSONReader(context.Background(), sendTestItems(items)))
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":\"123\"}\n{\"id\":\"456\"}\n", string(body))
+}
+
+func TestNewJSONArrayReaderCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The channel is never closed, so the reader only stops because
+	// the context is cancelled.
+	_, err := io.ReadAll(NewJSONArrayReader(ctx, make(chan *Request)))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// sendTestItems returns a closed channel that's buffered with the given items.
+func sendTestItems(items []*Request) <-chan *Request {
+	channel := make(chan *Request, len(items))
+	for _, item := range items {
+		channel <- item
+	}
+	close(channel)
+	return channel
+}
+
+// isValidJSON returns true if the given bytes are a single valid JSON value.
+func isValidJSON(data []byte) bool {
+	var value interface{}
+	return json.Unmarshal(data, &value) == nil
+}
@@ -6,40 +6,93 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 )
 
 const defaultStreamDelimiter = '\n'
 
 // Streamer calls APIs and streams responses using a *Stream.
 type Streamer[T any] struct {
-	client  HTTPClient
-	retrier *Retrier
+	client             HTTPClient
+	retrier            *Retrier
+	requestCompression string
+	timeout            time.Duration
+	attemptTimeout     time.Duration
+	queryParameters    url.Values
+	bodyProperties     map[string]interface{}
 }
 
 // NewStreamer returns a new *Streamer backed by the given caller's HTTP client.
 func NewStreamer[T any](caller *Caller) *Streamer[T] {
 	return &Streamer[T]{
-		client:  caller.client,
-		retrier: caller.retrier,
+		client:             caller.client,
+		retrier:            caller.retrier,
+		requestCompression: caller.requestCompression,
+		timeout:            caller.timeout,
+		attemptTimeout:     caller.attemptTimeout,
+		queryParameters:    caller.queryParameters,
+		bodyProperties:     caller.bodyProperties,
 	}
 }
 
 // StreamParams represents the parameters used to issue an API streaming call.
 type StreamParams struct {
-	URL          string
-	Method       string
-	Delimiter    string
-	MaxAttempts  uint
-	Headers      http.Header
-	Client       HTTPClient
-	Request      interface{}
-	ErrorDecoder ErrorDecoder
+	URL                string
+	Method             string
+	Delimiter          string
+	MaxAttempts        uint
+	Headers            http.Header
+	Client             HTTPClient
+	Request            interface{}
+	ErrorDecoder       ErrorDecoder
+	RequestCompression string
+	Timeout            time.Duration
+	AttemptTimeout     time.Duration
+	QueryParameters    url.Values
+	BodyProperties     map[string]interface{}
 }
 
 // Stream issues an API streaming call according to the given stream parameters.
+//
+// The call is bounded by its timeout, if any, until the stream is established;
+// receiving the stream's messages isn't. A *TimeoutError is returned if it's
+// exceeded, whereas the context's error is returned if it's cancelled.
 func (s *Streamer[T]) Stream(ctx context.Context, params *StreamParams) (*Stream[T], error) {
-	req, err := newRequest(ctx, params.URL, params.Method, params.Headers, params.Request)
+	timeout := s.timeout
+	if params.Timeout > 0 {
+		// Use the timeout scoped to the request.
+		timeout = params.Timeout
+	}
+	ctx, streamTimeout := newTimeout(ctx, timeout, false)
+	stream, err := s.stream(ctx, params, streamTimeout)
+	if err != nil {
+		streamTimeout.release()
+		return nil, streamTimeout.wrap(err)
+	}
+	return stream, nil
+}
+
+// stream issues an API streaming call according to the given stream parameters,
+// and stops the given timeout once the stream is established.
+func (s *Streamer[T]) stream(ctx context.Context, params *StreamParams, streamTimeout *timeout) (*Stream[T], error) {
+	requestCompression := s.requestCompression
+	if params.RequestCompression != "" {
+		// Use the compression scoped to the request.
+		requestCompression = params.RequestCompression
+	}
+	req, err := newRequest(
+		ctx,
+		params.URL,
+		params.Method,
+		params.Headers,
+		params.Request,
+		mergeQueryParameters(s.queryParameters, params.QueryParameters),
+		s.bodyProperties,
+		params.BodyProperties,
+		requestCompression,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -55,13 +108,19 @@ func (s *Streamer[T]) Stream(ctx context.Context, params *StreamParams) (*Stream
 		client = params.Client
 	}
 
+	attemptTimeout := s.attemptTimeout
+	if params.AttemptTimeout > 0 {
+		// Use the attempt timeout scoped to the request.
+		attemptTimeout = params.AttemptTimeout
+	}
+
 	var retryOptions []RetryOption
 	if params.MaxAttempts > 0 {
 		retryOptions = append(retryOptions, WithMaxAttempts(params.MaxAttempts))
 	}
 
 	resp, err := s.retrier.Run(
-		client.Do,
+		withAttemptTimeout(withResponseDecompression(client.Do), attemptTimeout, true),
 		req,
 		params.ErrorDecoder,
 		retryOptions...,
@@ -82,6 +141,14 @@ func (s *Streamer[T]) Stream(ctx context.Context, params *StreamParams) (*Stream
 		return nil, decodeError(resp, params.ErrorDecoder)
 	}
 
+	// The stream is established, so its timeout no longer applies. The timeout
+	// is released once the stream is closed.
+	streamTimeout.stop()
+	resp.Body = &timeoutBody{
+		body:    resp.Body,
+		timeout: streamTimeout,
+	}
+
 	var opts []StreamOption
 	if params.Delimiter != "" {
 		opts = append(opts, WithDelimiter(params.Delimiter))
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamTimeout(t *testing.T) {
+	t.Run("before the stream is established", func(t *testing.T) {
+		server := httptest.NewServer(
+			http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					// The response is never written, so the request times out.
+					<-r.Context().Done()
+				},
+			),
+		)
+		defer server.Close()
+
+		streamer := NewStreamer[*Response](
+			NewCaller(
+				&CallerParams{
+					Client:  server.Client(),
+					Timeout: 10 * time.Millisecond,
+				},
+				nil,
+			),
+		)
+		_, err := streamer.Stream(
+			context.Background(),
+			&StreamParams{
+				URL:    server.URL,
+				Method: http.MethodGet,
+			},
+		)
+		var timeoutErr *TimeoutError
+		require.True(t, errors.As(err, &timeoutErr), "unexpected error: %v", err)
+		assert.False(t, timeoutErr.PerAttempt)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("after the stream is established", func(t *testing.T) {
+		const timeout = 250 * time.Millisecond
+		release := make(chan struct{})
+		server := httptest.NewServer(
+			http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+					w.(http.Flusher).Flush()
+
+					// The stream is established, so it outlives its timeout.
+					select {
+					case <-release:
+					case <-r.Context().Done():
+						return
+					}
+					_, err := w.Write([]byte(`{"id":"123"}` + "\n"))
+					require.NoError(t, err)
+				},
+			),
+		)
+		defer server.Close()
+
+		streamer := NewStreamer[*Response](
+			NewCaller(
+				&CallerParams{
+					Client:         server.Client(),
+					Timeout:        timeout,
+					AttemptTimeout: timeout,
+				},
+				nil,
+			),
+		)
+		stream, err := streamer.Stream(
+			context.Background(),
+			&StreamParams{
+				URL:    server.URL,
+				Method: http.MethodGet,
+			},
+		)
+		require.NoError(t, err)
+		defer stream.Close()
+
+		// Only release the message once both timeouts would have elapsed.
+		<-time.After(2 * timeout)
+		close(release)
+
+		response, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, &Response{Id: "123"}, response)
+	})
+}
@@ -0,0 +1,884 @@
+package generator
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+var (
+	//go:embed sdk/core/handler.go
+	handlerFile string
+
+	//go:embed sdk/core/handler_test.go
+	handlerTestFile string
+
+	//go:embed sdk/core/router.go
+	routerFile string
+
+	//go:embed sdk/core/router_test.go
+	routerTestFile string
+)
+
+// serverFramework generates the framework-specific parts of a server, i.e. how
+// routes are registered, how request values are read, and how responses are written.
+//
+// The statements returned by the framework are written in terms of the handler's
+// parameters (e.g. w and r for net/http).
+type serverFramework interface {
+	requestTypeTagger
+
+	// imports returns the import paths required by the framework.
+	imports() []string
+	// reserved returns the identifiers used by the framework's handlers.
+	reserved() []string
+	// returnsError reports whether the framework's handlers return an error.
+	returnsError() bool
+
+	// writeRegister writes the signature of the Register function, as well as
+	// any helpers that precede it.
+	writeRegister(f *fileWriter)
+	// route returns the statement that opens the handler for the given route.
+	route(method string, path string) string
+	// pathParameter formats the given path parameter for the route's path template.
+	pathParameter(name string) string
+
+	context() string
+	pathValue(name string) string
+	// escapedPathValues reports whether the path values are returned as they appear
+	// in the request's path (e.g. a%2Fb), in which case they're unescaped when parsed.
+	escapedPathValues() bool
+	header(name string) string
+	formValue(name string) string
+	formFile(name string) string
+	body() string
+	writeQuery(f *fileWriter)
+
+	badRequestResponse(format string, args ...string) string
+	errorResponse(err string) string
+	statusResponse(statusCode string) string
+	jsonResponse(statusCode string, value string) string
+	writeFile(f *fileWriter, value string)
+	writeText(f *fileWriter, value string)
+	writeStream(f *fileWriter, delimiter string, call string, writeError func())
+}
+
+// newServerFramework returns the serverFramework with the given name.
+func newServerFramework(name string) (serverFramework, error) {
+	switch name {
+	case "", FrameworkNetHTTP:
+		return new(netHTTPFramework), nil
+	case FrameworkChi:
+		return new(chiFramework), nil
+	case FrameworkEcho:
+		return new(echoFramework), nil
+	case FrameworkFiber:
+		return new(fiberFramework), nil
+	case FrameworkGin:
+		return new(ginFramework), nil
+	}
+	return nil, fmt.Errorf(
+		"unsupported framework %q; expected one of %q, %q, %q, %q, or %q",
+		name,
+		FrameworkNetHTTP,
+		FrameworkChi,
+		FrameworkEcho,
+		FrameworkFiber,
+		FrameworkGin,
+	)
+}
+
+// netHTTPFramework generates handlers for the standard library's net/http
+// package, which are routed with the generated core.Router.
+type netHTTPFramework struct {
+	clientRequestTypeTagger
+}
+
+func (*netHTTPFramework) imports() []string {
+	return nil
+}
+
+func (*netHTTPFramework) reserved() []string {
+	return []string{"w", "r", "params", "router"}
+}
+
+func (*netHTTPFramework) returnsError() bool {
+	return false
+}
+
+func (*netHTTPFramework) writeRegister(f *fileWriter) {
+	f.P("// NewHandler returns an http.Handler that routes requests to the given Service.")
+	f.P("func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {")
+	f.P("router := core.NewRouter()")
+	f.P("Register(router, service, opts...)")
+	f.P("return router")
+	f.P("}")
+	f.P()
+	f.P("// Register registers all of the Service's endpoints with the given router.")
+	f.P("func Register(router *core.Router, service Service, opts ...core.HandlerOption) {")
+}
+
+func (*netHTTPFramework) route(method string, path string) string {
+	return fmt.Sprintf("router.Handle(%s, %q, func(w http.ResponseWriter, r *http.Request, params core.PathParams) {", method, path)
+}
+
+func (*netHTTPFramework) pathParameter(name string) string {
+	return "{" + name + "}"
+}
+
+func (*netHTTPFramework) context() string {
+	return "r.Context()"
+}
+
+func (*netHTTPFramework) pathValue(name string) string {
+	return fmt.Sprintf("params[%q]", name)
+}
+
+func (*netHTTPFramework) escapedPathValues() bool {
+	return false
+}
+
+func (*netHTTPFramework) header(name string) string {
+	return fmt.Sprintf("r.Header.Get(%q)", name)
+}
+
+func (*netHTTPFramework) formValue(name string) string {
+	return fmt.Sprintf("r.FormValue(%q)", name)
+}
+
+func (*netHTTPFramework) formFile(name string) string {
+	return fmt.Sprintf("core.FormFile(r, %q)", name)
+}
+
+func (*netHTTPFramework) body() string {
+	return "r.Body"
+}
+
+func (*netHTTPFramework) writeQuery(f *fileWriter) {
+	f.P("query := r.URL.Query()")
+}
+
+func (*netHTTPFramework) badRequestResponse(format string, args ...string) string {
+	return fmt.Sprintf("core.WriteError(w, core.NewBadRequestError(%s))", strings.Join(append([]string{format}, args...), ", "))
+}
+
+func (*netHTTPFramework) errorResponse(err string) string {
+	return fmt.Sprintf("core.WriteError(w, %s)", err)
+}
+
+func (*netHTTPFramework) statusResponse(statusCode string) string {
+	return fmt.Sprintf("w.WriteHeader(%s)", statusCode)
+}
+
+func (*netHTTPFramework) jsonResponse(statusCode string, value string) string {
+	return fmt.Sprintf("core.WriteResponse(w, %s, %s)", statusCode, value)
+}
+
+func (*netHTTPFramework) writeFile(f *fileWriter, value string) {
+	f.P("if closer, ok := ", value, ".(io.Closer); ok {")
+	f.P("defer closer.Close()")
+	f.P("}")
+	f.P(`w.Header().Set("Content-Type", "application/octet-stream")`)
+	f.P("_, _ = io.Copy(w, ", value, ")")
+}
+
+func (*netHTTPFramework) writeText(f *fileWriter, value string) {
+	f.P(`w.Header().Set("Content-Type", "text/plain; charset=utf-8")`)
+	f.P("_, _ = io.WriteString(w, ", value, ")")
+}
+
+func (*netHTTPFramework) writeStream(f *fileWriter, delimiter string, call string, writeError func()) {
+	f.P("stream := core.NewStreamWriter(w, ", fmt.Sprintf("%q", delimiter), ", opts...)")
+	f.P("if err := ", call, "; err != nil {")
+	f.P("if stream.Started() {")
+	f.P("stream.Abort(err)")
+	f.P("} else {")
+	writeError()
+	f.P("}")
+	f.P("}")
+}
+
+// serverEndpoint holds the fields required to generate a server endpoint.
+//
+// Like the client's endpoint, all of the fields are pre-formatted so that
+// they can all be simple strings.
+type serverEndpoint struct {
+	Name               *ir.Name
+	Docs               *string
+	Availability       *ir.Availability
+	Method             string
+	Path               string
+	PathParameters     []*serverPathParameter
+	FileProperties     []*serverFileProperty
+	FileBodyProperties []*ir.InlinedRequestBodyProperty
+	QueryParameters    []*ir.QueryParameter
+	Headers            []*ir.HttpHeader
+	Errors             ir.ResponseErrors
+
+	// The request parameter, if any.
+	RequestParameterName string
+	RequestType          string
+	RequestIsWrapper     bool
+	RequestBodyField     string
+	RequestBodyIsBytes   bool
+	RequestBodyOptional  bool
+	RequestHasJSONBody   bool
+	RequestIsValidated   bool
+	RequestDateType      string // e.g. Date, if the body's dates are (de)serialized with core.Times
+
+	// The response, if any.
+	ResponseType        string
+	ResponseIsFile      bool
+	ResponseIsText      bool
+	ResponseIsStreaming bool
+	ResponseDateType    string // e.g. Date, if the response's dates are serialized with core.Times
+	StreamDelimiter     string
+
+	SignatureParameters []*signatureParameter
+	ReturnValues        string
+}
+
+// serverPathParameter is a path parameter parsed by the server.
+type serverPathParameter struct {
+	Name     string // e.g. userId (the Go variable)
+	WireName string // e.g. userId (the name used in the path template)
+	Type     string
+}
+
+// serverFileProperty is a multipart file read by the server.
+type serverFileProperty struct {
+	Name       string
+	HeaderName string
+	WireName   string
+	IsOptional bool
+}
+
+// WriteServerRequestType writes a type dedicated to the in-lined request (if any), such
+// that its header and query parameters can be bound by the given framework.
+func (f *fileWriter) WriteServerRequestType(
+	framework serverFramework,
+	fernFilepath *ir.FernFilepath,
+	endpoint *ir.HttpEndpoint,
+	includeGenericOptionals bool,
+	getters bool,
+) error {
+	return f.writeRequestType(fernFilepath, endpoint, includeGenericOptionals, getters, framework)
+}
+
+// WriteServer writes the server interface for the given service, as well as
+// the function that registers the interface's endpoints with the given framework.
+func (f *fileWriter) WriteServer(
+	framework serverFramework,
+	irEndpoints []*ir.HttpEndpoint,
+	errorDiscriminationStrategy *ir.ErrorDiscriminationStrategy,
+	fernFilepath *ir.FernFilepath,
+) error {
+	for _, importPath := range framework.imports() {
+		// The framework's imports are added first so that their
+		// aliases always match their package names.
+		f.scope.AddImport(importPath)
+	}
+	endpoints, err := f.serverEndpointsFromIR(framework, irEndpoints, fernFilepath)
+	if err != nil {
+		return err
+	}
+	f.writeServiceInterface(endpoints)
+	framework.writeRegister(f)
+	for _, endpoint := range endpoints {
+		f.P(framework.route(endpoint.Method, endpoint.Path))
+		f.P("ctx := ", framework.context())
+		for _, pathParameter := range endpoint.PathParameters {
+			parse := "core.ParseParameter"
+			if framework.escapedPathValues() {
+				parse = "core.ParsePathParameter"
+			}
+			f.P("var ", pathParameter.Name, " ", pathParameter.Type)
+			f.P("if err := ", parse, "(", framework.pathValue(pathParameter.WireName), ", &", pathParameter.Name, "); err != nil {")
+			f.writeServerBadRequest(framework, "invalid path parameter %q: %v", fmt.Sprintf("%q", pathParameter.WireName), "err")
+			f.P("}")
+		}
+		f.writeServerRequest(framework, endpoint)
+		f.writeServerCall(framework, endpoint, errorDiscriminationStrategy)
+		f.P("})")
+	}
+	f.P("}")
+	f.P()
+	return nil
+}
+
+// writeServiceInterface writes the Service interface implemented by the user.
+func (f *fileWriter) writeServiceInterface(endpoints []*serverEndpoint) {
+	f.P("// Service defines the server-side implementation of this package's endpoints.")
+	f.P("type Service interface {")
+	for _, endpoint := range endpoints {
+		f.WriteDocsWithAvailability(endpoint.Docs, endpoint.Availability)
+		f.P(endpoint.Name.PascalCase.UnsafeName, "(")
+		for _, signatureParameter := range endpoint.SignatureParameters {
+			f.WriteDocs(signatureParameter.docs)
+			f.P(signatureParameter.parameter, ",")
+		}
+		f.P(") ", endpoint.ReturnValues)
+	}
+	f.P("}")
+	f.P()
+}
+
+// writeServerRequest writes the statements required to decode the request
+// parameters (i.e. the files, request body, query parameters, and headers).
+func (f *fileWriter) writeServerRequest(framework serverFramework, endpoint *serverEndpoint) {
+	for _, fileProperty := range endpoint.FileProperties {
+		if fileProperty.IsOptional {
+			f.P("var ", fileProperty.Name, " io.Reader")
+			f.P("if ", fileProperty.HeaderName, ", err := ", framework.formFile(fileProperty.WireName), "; err == nil {")
+			f.P("multipartFile, err := ", fileProperty.HeaderName, ".Open()")
+			f.P("if err != nil {")
+			f.writeServerBadRequest(framework, "invalid file %q: %v", fmt.Sprintf("%q", fileProperty.WireName), "err")
+			f.P("}")
+			f.P("defer multipartFile.Close()")
+			f.P(fileProperty.Name, " = multipartFile")
+			f.P("}")
+			continue
+		}
+		f.P(fileProperty.HeaderName, ", err := ", framework.formFile(fileProperty.WireName))
+		f.P("if err != nil {")
+		f.writeServerBadRequest(framework, "invalid file %q: %v", fmt.Sprintf("%q", fileProperty.WireName), "err")
+		f.P("}")
+		f.P(fileProperty.Name, ", err := ", fileProperty.HeaderName, ".Open()")
+		f.P("if err != nil {")
+		f.writeServerBadRequest(framework, "invalid file %q: %v", fmt.Sprintf("%q", fileProperty.WireName), "err")
+		f.P("}")
+		f.P("defer ", fileProperty.Name, ".Close()")
+	}
+	if endpoint.RequestParameterName == "" {
+		return
+	}
+	requestParameterName := endpoint.RequestParameterName
+	if endpoint.RequestBodyIsBytes {
+		target := requestParameterName
+		if endpoint.RequestIsWrapper {
+			f.P(requestParameterName, " := new(", strings.TrimPrefix(endpoint.RequestType, "*"), ")")
+			target = requestParameterName + "." + endpoint.RequestBodyField
+		} else {
+			f.P("var ", requestParameterName, " []byte")
+		}
+		f.P("if body, err := io.ReadAll(", framework.body(), "); err != nil {")
+		f.writeServerBadRequest(framework, "invalid request body: %v", "err")
+		f.P("} else if len(body) > 0 {")
+		f.P(target, " = body")
+		if !endpoint.RequestBodyOptional {
+			f.P("} else {")
+			f.writeServerBadRequest(framework, "the request body is required")
+		}
+		f.P("}")
+	} else {
+		target := "&" + requestParameterName
+		if strings.HasPrefix(endpoint.RequestType, "*") {
+			f.P(requestParameterName, " := new(", strings.TrimPrefix(endpoint.RequestType, "*"), ")")
+			target = requestParameterName
+		} else {
+			f.P("var ", requestParameterName, " ", endpoint.RequestType)
+		}
+		if endpoint.RequestHasJSONBody {
+			// The body is decoded first so that the wrapper's custom json.Unmarshaler
+			// (if any) doesn't overwrite the query parameters and headers.
+			if endpoint.RequestDateType != "" {
+				target = "core.New" + endpoint.RequestDateType + "s(" + target + ")"
+			}
+			f.P("if err := core.DecodeRequestBody(", framework.body(), ", ", target, ", ", endpoint.RequestBodyOptional, "); err != nil {")
+			f.writeServerBadRequest(framework, "invalid request body: %v", "err")
+			f.P("}")
+		}
+	}
+	for _, fileBodyProperty := range endpoint.FileBodyProperties {
+		if fileBodyProperty.ValueType.Container != nil && fileBodyProperty.ValueType.Container.Literal != nil {
+			f.writeServerLiteral(
+				framework,
+				framework.formValue(fileBodyProperty.Name.WireValue),
+				fileBodyProperty.ValueType.Container.Literal,
+				"form field",
+				fileBodyProperty.Name.WireValue,
+			)
+			continue
+		}
+		f.writeServerParameter(
+			framework,
+			framework.formValue(fileBodyProperty.Name.WireValue),
+			requestParameterName+"."+fileBodyProperty.Name.Name.PascalCase.UnsafeName,
+			fileBodyProperty.ValueType,
+			"form field",
+			fileBodyProperty.Name.WireValue,
+		)
+	}
+	if len(endpoint.QueryParameters) > 0 {
+		framework.writeQuery(f)
+	}
+	for _, queryParameter := range endpoint.QueryParameters {
+		if queryParameter.ValueType.Container != nil && queryParameter.ValueType.Container.Literal != nil {
+			f.writeServerLiteral(
+				framework,
+				fmt.Sprintf("query.Get(%q)", queryParameter.Name.WireValue),
+				queryParameter.ValueType.Container.Literal,
+				"query parameter",
+				queryParameter.Name.WireValue,
+			)
+			continue
+		}
+		target := requestParameterName + "." + queryParameter.Name.Name.PascalCase.UnsafeName
+		if queryParameter.AllowMultiple {
+			valueType := typeReferenceToGoType(queryParameter.ValueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, "", false)
+			f.P(`for _, value := range query["`, queryParameter.Name.WireValue, `"] {`)
+			if strings.HasPrefix(valueType, "*") {
+				f.P("parsed := new(", strings.TrimPrefix(valueType, "*"), ")")
+				f.P("if err := core.ParseParameter(value, parsed); err != nil {")
+			} else {
+				f.P("var parsed ", valueType)
+				f.P("if err := core.ParseParameter(value, &parsed); err != nil {")
+			}
+			f.writeServerBadRequest(framework, "invalid query parameter %q: %v", fmt.Sprintf("%q", queryParameter.Name.WireValue), "err")
+			f.P("}")
+			f.P(target, " = append(", target, ", parsed)")
+			f.P("}")
+			continue
+		}
+		f.writeServerParameter(
+			framework,
+			fmt.Sprintf("query.Get(%q)", queryParameter.Name.WireValue),
+			target,
+			queryParameter.ValueType,
+			"query parameter",
+			queryParameter.Name.WireValue,
+		)
+	}
+	for _, header := range endpoint.Headers {
+		if header.ValueType.Container != nil && header.ValueType.Container.Literal != nil {
+			f.writeServerLiteral(
+				framework,
+				framework.header(header.Name.WireValue),
+				header.ValueType.Container.Literal,
+				"header",
+				header.Name.WireValue,
+			)
+			continue
+		}
+		f.writeServerParameter(
+			framework,
+			framework.header(header.Name.WireValue),
+			requestParameterName+"."+header.Name.Name.PascalCase.UnsafeName,
+			header.ValueType,
+			"header",
+			header.Name.WireValue,
+		)
+	}
+	if endpoint.RequestIsValidated {
+		// Validate the request now that all of its fields are bound.
+		f.P("if err := core.Validate(", requestParameterName, "); err != nil {")
+		f.writeServerBadRequest(framework, "invalid request: %v", "err")
+		f.P("}")
+	}
+}
+
+// writeServerLiteral writes the statements required to verify that the given value
+// matches the literal, if it's specified at all. Literals aren't stored on the request
+// because they're set by the generated types themselves.
+func (f *fileWriter) writeServerLiteral(
+	framework serverFramework,
+	value string,
+	literal *ir.Literal,
+	description string,
+	wireValue string,
+) {
+	expected := fmt.Sprintf("%q", literalToString(literal))
+	f.P("if value := ", value, `; value != "" && value != `, expected, " {")
+	f.writeServerBadRequest(framework, "invalid "+description+" %q: expected %q", fmt.Sprintf("%q", wireValue), expected)
+	f.P("}")
+}
+
+// literalToString returns the string representation of the given literal,
+// as it's specified in a query parameter or header.
+func literalToString(literal *ir.Literal) string {
+	if literal.Type == "boolean" {
+		return strconv.FormatBool(literal.Boolean)
+	}
+	return literal.String
+}
+
+// writeServerParameter writes the statements required to parse a single string value
+// (e.g. a query parameter) into the given target. Required values are rejected if they're
+// missing.
+func (f *fileWriter) writeServerParameter(
+	framework serverFramework,
+	value string,
+	target string,
+	valueType *ir.TypeReference,
+	description string,
+	wireValue string,
+) {
+	goType := typeReferenceToGoType(valueType, f.types, f.typeOverrides, f.scope, f.baseImportPath, "", false)
+	isOptional := valueType.Container != nil && valueType.Container.Optional != nil
+	f.P("if value := ", value, `; value != "" {`)
+	if strings.HasPrefix(goType, "*") {
+		f.P("parsed := new(", strings.TrimPrefix(goType, "*"), ")")
+		f.P("if err := core.ParseParameter(value, parsed); err != nil {")
+		f.writeServerBadRequest(framework, "invalid "+description+" %q: %v", fmt.Sprintf("%q", wireValue), "err")
+		f.P("}")
+		f.P(target, " = parsed")
+	} else {
+		f.P("if err := core.ParseParameter(value, &", target, "); err != nil {")
+		f.writeServerBadRequest(framework, "invalid "+description+" %q: %v", fmt.Sprintf("%q", wireValue), "err")
+		f.P("}")
+	}
+	if !isOptional {
+		f.P("} else {")
+		f.writeServerBadRequest(framework, "missing required "+description+" %q", fmt.Sprintf("%q", wireValue))
+	}
+	f.P("}")
+}
+
+// writeServerCall writes the statements required to call the Service and write
+// its response (or error) back to the caller.
+func (f *fileWriter) writeServerCall(
+	framework serverFramework,
+	endpoint *serverEndpoint,
+	errorDiscriminationStrategy *ir.ErrorDiscriminationStrategy,
+) {
+	arguments := []string{"ctx"}
+	for _, pathParameter := range endpoint.PathParameters {
+		arguments = append(arguments, pathParameter.Name)
+	}
+	for _, fileProperty := range endpoint.FileProperties {
+		arguments = append(arguments, fileProperty.Name)
+	}
+	if endpoint.RequestParameterName != "" {
+		arguments = append(arguments, endpoint.RequestParameterName)
+	}
+	call := "service." + endpoint.Name.PascalCase.UnsafeName + "(" + strings.Join(arguments, ", ") + ")"
+	switch {
+	case endpoint.ResponseIsStreaming:
+		// The stream is always named stream, regardless of the framework.
+		arguments = append(arguments, fmt.Sprintf("func(message %s) error { return stream.Send(message) }", endpoint.ResponseType))
+		framework.writeStream(
+			f,
+			endpoint.StreamDelimiter,
+			"service."+endpoint.Name.PascalCase.UnsafeName+"("+strings.Join(arguments, ", ")+")",
+			func() {
+				f.writeServerError(framework, endpoint, errorDiscriminationStrategy)
+			},
+		)
+	case endpoint.ResponseType == "":
+		f.P("if err := ", call, "; err != nil {")
+		f.writeServerError(framework, endpoint, errorDiscriminationStrategy)
+		f.P("}")
+		f.writeServerResponse(framework, framework.statusResponse("http.StatusNoContent"), true)
+	default:
+		f.P("response, err := ", call)
+		f.P("if err != nil {")
+		f.writeServerError(framework, endpoint, errorDiscriminationStrategy)
+		f.P("}")
+		switch {
+		case endpoint.ResponseIsFile:
+			framework.writeFile(f, "response")
+		case endpoint.ResponseIsText:
+			framework.writeText(f, "response")
+		case endpoint.ResponseDateType != "":
+			f.writeServerResponse(framework, framework.jsonResponse("http.StatusOK", "core.New"+endpoint.ResponseDateType+"s(response)"), true)
+		default:
+			f.writeServerResponse(framework, framework.jsonResponse("http.StatusOK", "response"), true)
+		}
+	}
+}
+
+// writeServerError writes the statements required to map the error returned by the Service
+// into a response. Declared errors are written with their status code and body, and all
+// other errors are handled by the framework.
+func (f *fileWriter) writeServerError(
+	framework serverFramework,
+	endpoint *serverEndpoint,
+	errorDiscriminationStrategy *ir.ErrorDiscriminationStrategy,
+) {
+	var errorDiscriminationByPropertyStrategy *ir.ErrorDiscriminationByPropertyStrategy
+	if errorDiscriminationStrategy != nil && errorDiscriminationStrategy.Property != nil {
+		errorDiscriminationByPropertyStrategy = errorDiscriminationStrategy.Property
+	}
+	for _, responseError := range endpoint.Errors {
+		var (
+			errorDeclaration = f.errors[responseError.Error.ErrorId]
+			errorImportPath  = fernFilepathToImportPath(f.baseImportPath, errorDeclaration.Name.FernFilepath)
+			errorType        = f.scope.AddImport(errorImportPath) + "." + errorDeclaration.Name.Name.PascalCase.UnsafeName
+			errorVariable    = errorDeclaration.Name.Name.CamelCase.SafeName
+			statusCode       = fmt.Sprintf("%d", errorDeclaration.StatusCode)
+		)
+		f.P("var ", errorVariable, " *", errorType)
+		f.P("if errors.As(err, &", errorVariable, ") {")
+		var body string
+		if errorDeclaration.Type != nil {
+			body = errorVariable + ".Body"
+			if errorDeclaration.Type.Container != nil && errorDeclaration.Type.Container.Literal != nil {
+				body = literalToValue(errorDeclaration.Type.Container.Literal)
+			}
+		}
+		if errorDiscriminationByPropertyStrategy != nil {
+			discriminant := errorDiscriminationByPropertyStrategy.Discriminant
+			content := errorDiscriminationByPropertyStrategy.ContentProperty
+			f.P("body := map[string]interface{}{")
+			f.P(fmt.Sprintf("%q: %q,", discriminant.WireValue, errorDeclaration.DiscriminantValue.WireValue))
+			if body != "" {
+				f.P(fmt.Sprintf("%q: %s,", content.WireValue, body))
+			}
+			f.P("}")
+			f.writeServerResponse(framework, framework.jsonResponse(statusCode, "body"), false)
+		} else if body != "" {
+			f.writeServerResponse(framework, framework.jsonResponse(statusCode, body), false)
+		} else {
+			f.writeServerResponse(framework, framework.statusResponse(statusCode), false)
+		}
+		f.P("}")
+	}
+	f.writeServerResponse(framework, framework.errorResponse("err"), false)
+}
+
+// writeServerBadRequest writes a 400 response with the given message format
+// and returns early.
+func (f *fileWriter) writeServerBadRequest(framework serverFramework, format string, args ...string) {
+	f.writeServerResponse(framework, framework.badRequestResponse(fmt.Sprintf("%q", format), args...), false)
+}
+
+// writeServerResponse writes the given response statement. Frameworks that return
+// errors from their handlers always return the statement's value, whereas all other
+// frameworks return early unless the statement is the last one in the handler.
+func (f *fileWriter) writeServerResponse(framework serverFramework, statement string, last bool) {
+	if framework.returnsError() {
+		f.P("return ", statement)
+		return
+	}
+	f.P(statement)
+	if !last {
+		f.P("return")
+	}
+}
+
+// serverEndpointsFromIR maps the given endpoints into a structure that's
+// suitable for server code generation.
+func (f *fileWriter) serverEndpointsFromIR(
+	framework serverFramework,
+	irEndpoints []*ir.HttpEndpoint,
+	fernFilepath *ir.FernFilepath,
+) ([]*serverEndpoint, error) {
+	endpoints := make([]*serverEndpoint, 0, len(irEndpoints))
+	for _, irEndpoint := range irEndpoints {
+		endpoint, err := f.serverEndpointFromIR(framework, irEndpoint, fernFilepath)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
+
+func (f *fileWriter) serverEndpointFromIR(
+	framework serverFramework,
+	irEndpoint *ir.HttpEndpoint,
+	fernFilepath *ir.FernFilepath,
+) (*serverEndpoint, error) {
+	// Create a new child scope for this endpoint so that the generated
+	// variable names don't conflict with the handler's parameters.
+	scope := f.scope.Child()
+	for _, name := range append(
+		[]string{"ctx", "query", "value", "parsed", "body", "response", "err", "stream", "message", "multipartFile", "service", "opts"},
+		framework.reserved()...,
+	) {
+		scope.Add(name)
+	}
+
+	endpoint := &serverEndpoint{
+		Name:            irEndpoint.Name,
+		Docs:            irEndpoint.Docs,
+		Availability:    irEndpoint.Availability,
+		Method:          irMethodToMethodEnum(irEndpoint.Method),
+		Path:            serverPathForEndpoint(framework, irEndpoint),
+		QueryParameters: irEndpoint.QueryParameters,
+		Headers:         irEndpoint.Headers,
+		Errors:          irEndpoint.Errors,
+	}
+
+	signatureParameters := []*signatureParameter{{parameter: "ctx context.Context"}}
+	for _, pathParameter := range irEndpoint.AllPathParameters {
+		var (
+			pathParameterName = scope.Add(pathParameter.Name.CamelCase.SafeName)
+			parameterType     = typeReferenceToGoType(pathParameter.ValueType, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false)
+		)
+		signatureParameters = append(
+			signatureParameters,
+			&signatureParameter{
+				docs:      pathParameter.Docs,
+				parameter: fmt.Sprintf("%s %s", pathParameterName, parameterType),
+			},
+		)
+		endpoint.PathParameters = append(
+			endpoint.PathParameters,
+			&serverPathParameter{
+				Name:     pathParameterName,
+				WireName: pathParameter.Name.OriginalName,
+				Type:     parameterType,
+			},
+		)
+	}
+
+	if irEndpoint.RequestBody != nil && irEndpoint.RequestBody.FileUpload != nil {
+		for _, fileUploadProperty := range irEndpoint.RequestBody.FileUpload.Properties {
+			if fileUploadProperty.File != nil {
+				parameterName := scope.Add(fileUploadProperty.File.Key.Name.CamelCase.SafeName)
+				signatureParameters = append(
+					signatureParameters,
+					&signatureParameter{
+						parameter: fmt.Sprintf("%s io.Reader", parameterName),
+					},
+				)
+				endpoint.FileProperties = append(
+					endpoint.FileProperties,
+					&serverFileProperty{
+						Name:       parameterName,
+						HeaderName: scope.Add(parameterName + "Header"),
+						WireName:   fileUploadProperty.File.Key.WireValue,
+						IsOptional: fileUploadProperty.File.IsOptional,
+					},
+				)
+			}
+			if fileUploadProperty.BodyProperty != nil {
+				endpoint.FileBodyProperties = append(endpoint.FileBodyProperties, fileUploadProperty.BodyProperty)
+			}
+		}
+	}
+
+	if irEndpoint.SdkRequest != nil && needsRequestParameter(irEndpoint) {
+		requestParameterName := scope.Add(irEndpoint.SdkRequest.RequestParameterName.CamelCase.SafeName)
+		var requestType string
+		if requestBody := irEndpoint.SdkRequest.Shape.JustRequestBody; requestBody != nil {
+			switch requestBody.Type {
+			case "typeReference":
+				requestType = typeReferenceToGoType(requestBody.TypeReference.RequestBodyType, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false)
+				endpoint.RequestHasJSONBody = true
+				endpoint.RequestBodyOptional = requestBody.TypeReference.RequestBodyType.Container != nil && requestBody.TypeReference.RequestBodyType.Container.Optional != nil
+				endpoint.RequestIsValidated = f.implementsValidator(requestBody.TypeReference.RequestBodyType)
+				endpoint.RequestDateType = dateValueCoreType(requestBody.TypeReference.RequestBodyType, f.types)
+			case "bytes":
+				requestType = "[]byte"
+				endpoint.RequestBodyIsBytes = true
+				endpoint.RequestBodyOptional = requestBody.Bytes.IsOptional
+			default:
+				return nil, fmt.Errorf("%s requests are not supported yet", requestBody.Type)
+			}
+		}
+		if wrapper := irEndpoint.SdkRequest.Shape.Wrapper; wrapper != nil {
+			requestImportPath := fernFilepathToImportPath(f.baseImportPath, fernFilepath)
+			requestType = fmt.Sprintf("*%s.%s", scope.AddImport(requestImportPath), wrapper.WrapperName.PascalCase.UnsafeName)
+			endpoint.RequestIsWrapper = true
+			endpoint.RequestIsValidated = true
+			endpoint.RequestBodyField = wrapper.BodyKey.PascalCase.UnsafeName
+			if requestBody := irEndpoint.RequestBody; requestBody != nil {
+				switch {
+				case requestBody.Bytes != nil:
+					endpoint.RequestBodyIsBytes = true
+					endpoint.RequestBodyOptional = requestBody.Bytes.IsOptional
+				case requestBody.Reference != nil:
+					endpoint.RequestHasJSONBody = true
+					endpoint.RequestBodyOptional = requestBody.Reference.RequestBodyType.Container != nil && requestBody.Reference.RequestBodyType.Container.Optional != nil
+				case requestBody.InlinedRequestBody != nil:
+					endpoint.RequestHasJSONBody = true
+				}
+			}
+		}
+		endpoint.RequestParameterName = requestParameterName
+		endpoint.RequestType = requestType
+		signatureParameters = append(
+			signatureParameters,
+			&signatureParameter{
+				parameter: fmt.Sprintf("%s %s", requestParameterName, requestType),
+			},
+		)
+	}
+
+	endpoint.ReturnValues = "error"
+	if irEndpoint.Response != nil {
+		switch irEndpoint.Response.Type {
+		case "json":
+			typeReference := typeReferenceFromJsonResponse(irEndpoint.Response.Json)
+			if typeReference == nil {
+				return nil, fmt.Errorf("unsupported json response type: %s", irEndpoint.Response.Json.Type)
+			}
+			endpoint.ResponseType = typeReferenceToGoType(typeReference, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false)
+			endpoint.ResponseDateType = dateValueCoreType(typeReference, f.types)
+			endpoint.ReturnValues = fmt.Sprintf("(%s, error)", endpoint.ResponseType)
+		case "fileDownload":
+			endpoint.ResponseType = "io.Reader"
+			endpoint.ResponseIsFile = true
+			endpoint.ReturnValues = "(io.Reader, error)"
+		case "text":
+			endpoint.ResponseType = "string"
+			endpoint.ResponseIsText = true
+			endpoint.ReturnValues = "(string, error)"
+		case "streaming":
+			typeReference := typeReferenceFromStreamingResponseChunkType(irEndpoint.Response.Streaming.DataEventType)
+			if typeReference == nil {
+				return nil, fmt.Errorf("unsupported streaming response type: %s", irEndpoint.Response.Streaming.DataEventType.Type)
+			}
+			if terminator := irEndpoint.Response.Streaming.Terminator; terminator != nil {
+				endpoint.StreamDelimiter = *terminator
+			}
+			endpoint.ResponseType = typeReferenceToGoType(typeReference, f.types, f.typeOverrides, scope, f.baseImportPath, "" /* The type is always imported */, false)
+			endpoint.ResponseIsStreaming = true
+			signatureParameters = append(
+				signatureParameters,
+				&signatureParameter{
+					parameter: fmt.Sprintf("send func(%s) error", endpoint.ResponseType),
+				},
+			)
+		default:
+			return nil, fmt.Errorf("%s responses are not supported yet", irEndpoint.Response.Type)
+		}
+	}
+	endpoint.SignatureParameters = signatureParameters
+	return endpoint, nil
+}
+
+// serverPathForEndpoint returns the path template used to route requests
+// to the given endpoint (e.g. /users/{userId}).
+func serverPathForEndpoint(framework serverFramework, irEndpoint *ir.HttpEndpoint) string {
+	if irEndpoint.FullPath == nil {
+		return "/"
+	}
+	path := irEndpoint.FullPath.Head
+	for _, part := range irEndpoint.FullPath.Parts {
+		if part.PathParameter != "" {
+			path += framework.pathParameter(part.PathParameter)
+		}
+		path += part.Tail
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path
+}
+
+func fileInfoForServer(fernFilepath *ir.FernFilepath) *fileInfo {
+	packagePath := packagePathForServer(fernFilepath)
+	return &fileInfo{
+		filename:    filepath.Join(append(packagePath, "server.go")...),
+		packageName: packagePath[len(packagePath)-1],
+	}
+}
+
+func packagePathForServer(fernFilepath *ir.FernFilepath) []string {
+	var packages []string
+	for _, packageName := range fernFilepath.PackagePath {
+		packages = append(packages, strings.ToLower(packageName.CamelCase.SafeName))
+	}
+	directory := "server"
+	if fernFilepath.File != nil {
+		directory = strings.ToLower(fernFilepath.File.CamelCase.SafeName)
+	}
+	return append(packages, directory)
+}
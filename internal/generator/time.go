@@ -0,0 +1,263 @@
+package generator
+
+import (
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// dateProperty is a date or date-time property that's represented as a time.Time,
+// but (de)serialized with the core.Date or core.DateTime type so that it uses
+// the correct wire format.
+type dateProperty struct {
+	name       string // e.g. "CreatedAt"
+	wireValue  string // e.g. "created_at"
+	coreType   string // e.g. "Date"
+	isOptional bool
+
+	// includeOptionals is set for optional properties represented as a
+	// *core.Optional[time.Time], which are (de)serialized as a
+	// *core.Optional[*core.Date] (or *core.DateTime).
+	includeOptionals bool
+
+	// container is set for lists, sets, and maps of dates (which may be
+	// nested), which are (de)serialized with the core.Times type.
+	container bool
+}
+
+// field returns the declaration of the property's field in a generated
+// marshaler or unmarshaler struct.
+func (d *dateProperty) field() string {
+	tag := "`json:\"" + d.wireValue + "\"`"
+	if d.isOptional {
+		tag = "`json:\"" + d.wireValue + ",omitempty\"`"
+	}
+	coreType := "*core." + d.coreType
+	if d.container {
+		coreType = "*core.Times"
+	}
+	if d.includeOptionals {
+		return d.name + " *core.Optional[" + coreType + "] " + tag
+	}
+	return d.name + " " + coreType + " " + tag
+}
+
+// constructor returns the expression that converts the given time.Time
+// value into its core type.
+func (d *dateProperty) constructor(value string) string {
+	if d.container {
+		switch {
+		case d.includeOptionals:
+			return "core.WrapOptional(" + value + ", core.New" + d.coreType + "s)"
+		case d.isOptional:
+			return "core.NewOptional" + d.coreType + "s(" + value + ")"
+		}
+		return "core.New" + d.coreType + "s(" + value + ")"
+	}
+	if d.includeOptionals {
+		return "core.MapOptional(" + value + ", core.New" + d.coreType + ")"
+	}
+	if d.isOptional {
+		return "core.NewOptional" + d.coreType + "(" + value + ")"
+	}
+	return "core.New" + d.coreType + "(" + value + ")"
+}
+
+// accessor returns the expression that converts the given core type value
+// back into its time.Time representation. Containers are decoded with
+// writeDateAccessor instead.
+func (d *dateProperty) accessor(value string) string {
+	if d.includeOptionals {
+		return "core.MapOptional(" + value + ", (*core." + d.coreType + ").Time)"
+	}
+	if d.isOptional {
+		return value + ".TimePtr()"
+	}
+	return value + ".Time()"
+}
+
+// writeDateAccessor writes the statements that set the given target to the
+// time.Time representation of the given core type value, which return early
+// if a container's dates can't be decoded.
+func (f *fileWriter) writeDateAccessor(date *dateProperty, target string, value string) {
+	if !date.container {
+		f.P(target, " = ", date.accessor(value))
+		return
+	}
+	if date.includeOptionals {
+		f.P("if err := core.DecodeOptional(", value, ", &", target, ", (*core.Times).Decode", date.coreType, "s); err != nil {")
+	} else {
+		f.P("if err := ", value, ".Decode", date.coreType, "s(&", target, "); err != nil {")
+	}
+	f.P("return err")
+	f.P("}")
+}
+
+// datePropertiesForObject returns the dateProperties for all of the given object's
+// properties, including the extended properties (if any).
+//
+// Optional properties (other than aliases to an optional) are represented as
+// a *core.Optional[time.Time] if includeOptionals is set.
+func datePropertiesForObject(
+	object *ir.ObjectTypeDeclaration,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+	includeOptionals bool,
+) []*dateProperty {
+	var properties []*dateProperty
+	for _, extend := range object.Extends {
+		properties = append(properties, datePropertiesForObject(types[extend.TypeId].Shape.Object, types, includeOptionals)...)
+	}
+	for _, property := range object.Properties {
+		date := newDateProperty(property.ValueType, types)
+		if date == nil {
+			continue
+		}
+		date.name = property.Name.Name.PascalCase.UnsafeName
+		date.wireValue = property.Name.WireValue
+		date.includeOptionals = includeOptionals && property.ValueType.Container != nil && property.ValueType.Container.Optional != nil
+		properties = append(properties, date)
+	}
+	return properties
+}
+
+// singlePropertyDate returns the dateProperty for the given union type's single
+// property, if it's a date or date-time. The property is named after the union
+// type's discriminant value, just like its field on the union.
+func singlePropertyDate(unionType *ir.SingleUnionType, types map[ir.TypeId]*ir.TypeDeclaration) *dateProperty {
+	property := unionType.Shape.SingleProperty
+	if property == nil {
+		return nil
+	}
+	date := newDateProperty(property.Type, types)
+	if date == nil {
+		return nil
+	}
+	date.name = unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+	date.wireValue = property.Name.WireValue
+	return date
+}
+
+// undiscriminatedUnionMemberDate returns the dateProperty for the given undiscriminated
+// union member, if it's a date or date-time.
+func undiscriminatedUnionMemberDate(
+	field string,
+	valueType *ir.TypeReference,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+) *dateProperty {
+	date := newDateProperty(valueType, types)
+	if date == nil {
+		return nil
+	}
+	date.name = field
+	if date.container {
+		// Unlike properties, members are never omitted, even if they're empty.
+		date.isOptional = false
+	}
+	return date
+}
+
+// newDateProperty returns an unnamed dateProperty for the given type, if it's a
+// date or date-time, or a container of them.
+func newDateProperty(valueType *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) *dateProperty {
+	if coreType, isOptional := dateCoreType(valueType, types); coreType != "" {
+		return &dateProperty{
+			coreType:   coreType,
+			isOptional: isOptional,
+		}
+	}
+	if coreType := dateContainerCoreType(valueType, types); coreType != "" {
+		// Containers are always tagged with omitempty (see jsonTagForType).
+		return &dateProperty{
+			coreType:   coreType,
+			isOptional: true,
+			container:  true,
+		}
+	}
+	return nil
+}
+
+// dateCoreType returns the core type used to (de)serialize the given type (i.e. Date
+// or DateTime), if any, and whether or not it's optional. Aliases are resolved to
+// the type they refer to.
+func dateCoreType(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) (string, bool) {
+	if typeReference.Container != nil && typeReference.Container.Optional != nil {
+		coreType, isOptional := dateCoreType(typeReference.Container.Optional, types)
+		if isOptional {
+			// Nested optionals are represented with the same pointer.
+			return coreType, true
+		}
+		return coreType, coreType != ""
+	}
+	if typeReference.Named != nil {
+		if alias := types[typeReference.Named.TypeId].Shape.Alias; alias != nil {
+			return dateCoreType(alias.AliasOf, types)
+		}
+		return "", false
+	}
+	switch typeReference.Primitive {
+	case ir.PrimitiveTypeDate:
+		return "Date", false
+	case ir.PrimitiveTypeDateTime:
+		return "DateTime", false
+	}
+	return "", false
+}
+
+// dateContainerCoreType returns the core type used to (de)serialize the dates in
+// the given list, set, or map (at any depth), if any. Aliases and optionals are
+// resolved to the type they refer to.
+func dateContainerCoreType(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) string {
+	if typeReference.Named != nil {
+		if alias := types[typeReference.Named.TypeId].Shape.Alias; alias != nil {
+			return dateContainerCoreType(alias.AliasOf, types)
+		}
+		return ""
+	}
+	switch container := typeReference.Container; {
+	case container == nil:
+		return ""
+	case container.Optional != nil:
+		return dateContainerCoreType(container.Optional, types)
+	case container.List != nil:
+		return dateValueCoreType(container.List, types)
+	case container.Set != nil:
+		return dateValueCoreType(container.Set, types)
+	case container.Map != nil:
+		return dateValueCoreType(container.Map.ValueType, types)
+	}
+	return ""
+}
+
+// dateValueCoreType returns the core type used to (de)serialize the dates in the
+// given type, whether it's a date itself or a container of them (e.g. a request
+// body), if any.
+func dateValueCoreType(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) string {
+	if coreType, _ := dateCoreType(typeReference, types); coreType != "" {
+		return coreType
+	}
+	return dateContainerCoreType(typeReference, types)
+}
+
+// hasCustomMarshaler returns true if the given object implements the json.Marshaler
+// interface, i.e. if it has any literal, date, or extra properties (or if every
+// type is serialized without reflection).
+func (t *typeVisitor) hasCustomMarshaler(object *ir.ObjectTypeDeclaration) bool {
+	if t.reflectionFreeJSON || t.hasExtraProperties(object) || len(datePropertiesForObject(object, t.writer.types, t.includeOptionals)) > 0 {
+		return true
+	}
+	return hasLiteralProperties(object, t.writer.types)
+}
+
+// hasLiteralProperties returns true if the given object has any literal properties,
+// including the extended properties (if any).
+func hasLiteralProperties(object *ir.ObjectTypeDeclaration, types map[ir.TypeId]*ir.TypeDeclaration) bool {
+	for _, extend := range object.Extends {
+		if hasLiteralProperties(types[extend.TypeId].Shape.Object, types) {
+			return true
+		}
+	}
+	for _, property := range object.Properties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// unionObject contains the properties of an undiscriminated union's object member,
+// which are used to match the member strictly (i.e. with a core.UnionObject).
+type unionObject struct {
+	required []string // e.g. ["name"]
+	optional []string // e.g. ["email"]
+	literals []*unionObjectLiteral
+
+	// extraProperties is set if the object captures its extra properties.
+	extraProperties bool
+}
+
+// unionObjectLiteral is a single literal property of an undiscriminated union's
+// object member.
+type unionObjectLiteral struct {
+	wireValue string
+	value     string // e.g. "\"user\""
+}
+
+// unionObjectForTypeReference returns the unionObject for the given undiscriminated
+// union member, or nil if the member isn't an object.
+func (t *typeVisitor) unionObjectForTypeReference(valueType *ir.TypeReference) *unionObject {
+	if valueType.Named == nil {
+		return nil
+	}
+	typeDeclaration := t.writer.types[valueType.Named.TypeId]
+	if alias := typeDeclaration.Shape.Alias; alias != nil {
+		return t.unionObjectForTypeReference(alias.AliasOf)
+	}
+	object := typeDeclaration.Shape.Object
+	if object == nil {
+		return nil
+	}
+	result := &unionObject{
+		extraProperties: t.hasExtraProperties(object),
+	}
+	result.addProperties(object, t.writer.types)
+	return result
+}
+
+// addProperties adds all of the given object's properties, including the
+// extended properties (if any).
+func (u *unionObject) addProperties(object *ir.ObjectTypeDeclaration, types map[ir.TypeId]*ir.TypeDeclaration) {
+	for _, extend := range object.Extends {
+		u.addProperties(types[extend.TypeId].Shape.Object, types)
+	}
+	for _, property := range object.Properties {
+		wireValue := property.Name.WireValue
+		if container := property.ValueType.Container; container != nil && container.Literal != nil {
+			u.literals = append(u.literals, &unionObjectLiteral{wireValue: wireValue, value: literalToValue(container.Literal)})
+			continue
+		}
+		if isOptionalOrUnknown(property.ValueType, types) {
+			u.optional = append(u.optional, wireValue)
+			continue
+		}
+		u.required = append(u.required, wireValue)
+	}
+}
+
+// isOptionalOrUnknown returns true if the given type doesn't need to be specified
+// in a JSON object, i.e. it's optional (or an alias to an optional) or unknown.
+func isOptionalOrUnknown(valueType *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) bool {
+	switch {
+	case valueType.Container != nil:
+		return valueType.Container.Optional != nil
+	case valueType.Named != nil:
+		if alias := types[valueType.Named.TypeId].Shape.Alias; alias != nil {
+			return isOptionalOrUnknown(alias.AliasOf, types)
+		}
+		return false
+	}
+	return valueType.Type == "unknown"
+}
+
+// writeUnionMember writes the *core.UnionMember that describes the undiscriminated
+// union member with the given name, followed by a trailing comma.
+func (f *fileWriter) writeUnionMember(name string, literal string, object *unionObject) {
+	if object == nil {
+		if literal != "" {
+			f.P("&core.UnionMember{Name: ", strconv.Quote(name), ", Literal: ", literal, "},")
+			return
+		}
+		f.P("&core.UnionMember{Name: ", strconv.Quote(name), "},")
+		return
+	}
+	f.P("&core.UnionMember{")
+	f.P("Name: ", strconv.Quote(name), ",")
+	f.P("Object: &core.UnionObject{")
+	if len(object.required) > 0 {
+		f.P("Required: []string{", quoteStrings(object.required), "},")
+	}
+	if len(object.optional) > 0 {
+		f.P("Optional: []string{", quoteStrings(object.optional), "},")
+	}
+	if len(object.literals) > 0 {
+		f.P("Literals: map[string]interface{}{")
+		for _, literal := range object.literals {
+			f.P(strconv.Quote(literal.wireValue), ": ", literal.value, ",")
+		}
+		f.P("},")
+	}
+	if object.extraProperties {
+		f.P("ExtraProperties: true,")
+	}
+	f.P("},")
+	f.P("},")
+}
+
+// quoteStrings returns the comma-separated list of the given strings as
+// Go string literals.
+func quoteStrings(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return strings.Join(quoted, ", ")
+}
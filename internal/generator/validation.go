@@ -0,0 +1,334 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fern/ir"
+)
+
+// validationField contains the information required to validate a single
+// field in a generated Validate method.
+type validationField struct {
+	name      string // e.g. "Name"
+	wireValue string // e.g. "name"
+	valueType *ir.TypeReference
+
+	// allowMultiple is set for query parameters that are represented as a slice.
+	allowMultiple bool
+
+	// includeOptionals is set for optional fields represented as a *core.Optional[T].
+	includeOptionals bool
+}
+
+// validationFieldsForObject returns the validationFields for all of the given object's
+// properties, including the extended properties (if any). Literals are excluded because
+// they can't be set by the user.
+func validationFieldsForObject(
+	object *ir.ObjectTypeDeclaration,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+	includeOptionals bool,
+) []*validationField {
+	var fields []*validationField
+	for _, extend := range object.Extends {
+		fields = append(fields, validationFieldsForObject(types[extend.TypeId].Shape.Object, types, includeOptionals)...)
+	}
+	for _, property := range object.Properties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(
+			fields,
+			&validationField{
+				name:             property.Name.Name.PascalCase.UnsafeName,
+				wireValue:        property.Name.WireValue,
+				valueType:        property.ValueType,
+				includeOptionals: includeOptionals,
+			},
+		)
+	}
+	return fields
+}
+
+// validationFieldsForRequest returns the validationFields for all of the given endpoint's
+// in-lined request fields, i.e. its headers, query parameters, and body.
+func validationFieldsForRequest(
+	endpoint *ir.HttpEndpoint,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+	includeOptionals bool,
+) []*validationField {
+	var fields []*validationField
+	for _, header := range endpoint.Headers {
+		if header.ValueType.Container != nil && header.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(
+			fields,
+			&validationField{
+				name:      header.Name.Name.PascalCase.UnsafeName,
+				wireValue: header.Name.WireValue,
+				valueType: header.ValueType,
+			},
+		)
+	}
+	for _, queryParam := range endpoint.QueryParameters {
+		if queryParam.ValueType.Container != nil && queryParam.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(
+			fields,
+			&validationField{
+				name:          queryParam.Name.Name.PascalCase.UnsafeName,
+				wireValue:     queryParam.Name.WireValue,
+				valueType:     queryParam.ValueType,
+				allowMultiple: queryParam.AllowMultiple,
+			},
+		)
+	}
+	requestBody := endpoint.RequestBody
+	if requestBody == nil {
+		return fields
+	}
+	switch {
+	case requestBody.InlinedRequestBody != nil:
+		object := inlinedRequestBodyToObjectTypeDeclaration(requestBody.InlinedRequestBody)
+		fields = append(fields, validationFieldsForObject(object, types, includeOptionals)...)
+	case requestBody.FileUpload != nil:
+		var bodyProperties []*ir.InlinedRequestBodyProperty
+		for _, property := range requestBody.FileUpload.Properties {
+			if property.BodyProperty != nil {
+				bodyProperties = append(bodyProperties, property.BodyProperty)
+			}
+		}
+		object := inlinedRequestBodyPropertiesToObjectTypeDeclaration(bodyProperties)
+		fields = append(fields, validationFieldsForObject(object, types, includeOptionals)...)
+	case requestBody.Reference != nil:
+		bodyKey := endpoint.SdkRequest.Shape.Wrapper.BodyKey
+		fields = append(
+			fields,
+			&validationField{
+				name:      bodyKey.PascalCase.UnsafeName,
+				wireValue: bodyKey.OriginalName,
+				valueType: requestBody.Reference.RequestBodyType,
+			},
+		)
+	}
+	return fields
+}
+
+// writeValidateMethod writes a Validate method that validates all of the given fields.
+func (f *fileWriter) writeValidateMethod(typeName string, receiver string, fields []*validationField) {
+	f.writeValidateMethodDocs(typeName)
+	f.P("func (", receiver, " *", typeName, ") Validate() error {")
+	if !f.validatesFields(fields) {
+		f.P("return nil")
+		f.P("}")
+		f.P()
+		return
+	}
+	f.P("if ", receiver, " == nil {")
+	f.P("return nil")
+	f.P("}")
+	f.P("var validation core.Validation")
+	f.writeValidateFields(receiver, fields)
+	f.P("return validation.Err()")
+	f.P("}")
+	f.P()
+}
+
+// writeValidateMethodDocs writes the documentation shared by all of the generated
+// Validate methods.
+func (f *fileWriter) writeValidateMethodDocs(typeName string) {
+	f.P("// Validate reports all of the ", typeName, "'s invalid fields (e.g. missing")
+	f.P("// required fields), if any.")
+}
+
+// validatesFields returns true if any of the given fields require validation.
+func (f *fileWriter) validatesFields(fields []*validationField) bool {
+	for _, field := range fields {
+		if (!field.allowMultiple && f.isRequiredPointer(field.valueType)) || f.isValidated(field.valueType) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeValidateFields writes the statements required to validate each of the
+// given fields on the receiver.
+func (f *fileWriter) writeValidateFields(receiver string, fields []*validationField) {
+	for _, field := range fields {
+		value := receiver + "." + field.name
+		path := newValidationPath(field.wireValue)
+		if !field.allowMultiple && f.isRequiredPointer(field.valueType) {
+			f.P("if ", value, " == nil {")
+			f.P("validation.Required(", path.expr(), ")")
+			f.P("}")
+		}
+		if !f.isValidated(field.valueType) {
+			continue
+		}
+		if field.allowMultiple {
+			f.P("for index, value := range ", value, " {")
+			f.writeValidateValue("value", path.index("%d", "index"), field.valueType, 1)
+			f.P("}")
+			continue
+		}
+		if optional := field.valueType.Container; field.includeOptionals && optional != nil && optional.Optional != nil {
+			f.P("if ", value, " != nil && !", value, ".Null {")
+			f.writeValidateValue(value+".Value", path, optional.Optional, 0)
+			f.P("}")
+			continue
+		}
+		f.writeValidateValue(value, path, field.valueType, 0)
+	}
+}
+
+// writeValidateValue writes the statements required to validate the given value,
+// which recursively validates every element of container types (e.g. lists).
+func (f *fileWriter) writeValidateValue(value string, path *validationPath, valueType *ir.TypeReference, depth int) {
+	if valueType.Named != nil {
+		if alias := f.types[valueType.Named.TypeId].Shape.Alias; alias != nil {
+			f.writeValidateValue(value, path, alias.AliasOf, depth)
+			return
+		}
+		f.P("validation.Add(", path.expr(), ", ", value, ".Validate())")
+		return
+	}
+	container := valueType.Container
+	if container == nil {
+		return
+	}
+	suffix := ""
+	if depth > 0 {
+		suffix = strconv.Itoa(depth)
+	}
+	switch {
+	case container.List != nil, container.Set != nil:
+		elementType := container.List
+		if elementType == nil {
+			elementType = container.Set
+		}
+		var (
+			index   = "index" + suffix
+			element = "value" + suffix
+		)
+		f.P("for ", index, ", ", element, " := range ", value, " {")
+		f.writeValidateValue(element, path.index("%d", index), elementType, depth+1)
+		f.P("}")
+	case container.Map != nil:
+		var (
+			key     = "key" + suffix
+			element = "value" + suffix
+		)
+		f.P("for ", key, ", ", element, " := range ", value, " {")
+		f.writeValidateValue(element, path.index("%v", key), container.Map.ValueType, depth+1)
+		f.P("}")
+	case container.Optional != nil:
+		if !f.isEnum(container.Optional) {
+			// Objects and unions can be validated even if they're nil,
+			// and nil containers don't have any elements.
+			f.writeValidateValue(value, path, container.Optional, depth)
+			return
+		}
+		f.P("if ", value, " != nil {")
+		f.writeValidateValue(value, path, container.Optional, depth)
+		f.P("}")
+	}
+}
+
+// isValidated returns true if values of the given type need to be validated, i.e. if
+// the type includes any enums, objects, or unions.
+func (f *fileWriter) isValidated(valueType *ir.TypeReference) bool {
+	if valueType.Named != nil {
+		if alias := f.types[valueType.Named.TypeId].Shape.Alias; alias != nil {
+			return f.isValidated(alias.AliasOf)
+		}
+		return true
+	}
+	container := valueType.Container
+	if container == nil {
+		return false
+	}
+	switch {
+	case container.List != nil:
+		return f.isValidated(container.List)
+	case container.Set != nil:
+		return f.isValidated(container.Set)
+	case container.Map != nil:
+		return f.isValidated(container.Map.ValueType)
+	case container.Optional != nil:
+		return f.isValidated(container.Optional)
+	}
+	return false
+}
+
+// isRequiredPointer returns true if the given type is required, but is represented
+// as a pointer (e.g. objects and unions), so it's missing if it's nil.
+func (f *fileWriter) isRequiredPointer(valueType *ir.TypeReference) bool {
+	if valueType.Named == nil {
+		return false
+	}
+	typeDeclaration := f.types[valueType.Named.TypeId]
+	if alias := typeDeclaration.Shape.Alias; alias != nil {
+		return f.isRequiredPointer(alias.AliasOf)
+	}
+	return isPointer(typeDeclaration)
+}
+
+// isEnum returns true if the given type is an enum (or an alias to an enum).
+func (f *fileWriter) isEnum(valueType *ir.TypeReference) bool {
+	if valueType.Named == nil {
+		return false
+	}
+	typeDeclaration := f.types[valueType.Named.TypeId]
+	if alias := typeDeclaration.Shape.Alias; alias != nil {
+		return f.isEnum(alias.AliasOf)
+	}
+	return typeDeclaration.Shape.Enum != nil
+}
+
+// implementsValidator returns true if values of the given type implement the
+// core.Validator interface, and can be validated with core.Validate.
+func (f *fileWriter) implementsValidator(valueType *ir.TypeReference) bool {
+	if valueType.Container != nil && valueType.Container.Optional != nil {
+		// Optional enums are nil pointers, which can't be validated.
+		return f.isRequiredPointer(valueType.Container.Optional)
+	}
+	if valueType.Named == nil {
+		return false
+	}
+	if alias := f.types[valueType.Named.TypeId].Shape.Alias; alias != nil {
+		return f.implementsValidator(alias.AliasOf)
+	}
+	return true
+}
+
+// validationPath is the path to a field reported in a core.ValidationError,
+// which is formatted at runtime for elements in a container (e.g. "users[%d]").
+type validationPath struct {
+	format string
+	args   []string
+}
+
+func newValidationPath(wireValue string) *validationPath {
+	return &validationPath{
+		format: strings.ReplaceAll(wireValue, "%", "%%"),
+	}
+}
+
+// index returns the path to an element of this path's container.
+func (v *validationPath) index(verb string, arg string) *validationPath {
+	return &validationPath{
+		format: v.format + "[" + verb + "]",
+		args:   append(append([]string(nil), v.args...), arg),
+	}
+}
+
+// expr returns the Go expression that evaluates to this path.
+func (v *validationPath) expr() string {
+	if len(v.args) == 0 {
+		return strconv.Quote(strings.ReplaceAll(v.format, "%%", "%"))
+	}
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", v.format, strings.Join(v.args, ", "))
+}
@@ -7,9 +7,15 @@ import (
 	"unicode"
 )
 
-// invalidIdentifier matches invalid identifier characters
-// according to the Go language spec.
-var invalidIdentifierChar = regexp.MustCompile("[^[:digit:][:alpha:]_]")
+var (
+	// invalidIdentifier matches invalid identifier characters
+	// according to the Go language spec.
+	invalidIdentifierChar = regexp.MustCompile("[^[:digit:][:alpha:]_]")
+
+	// majorVersionSuffix matches the major version suffix used
+	// by Go modules (e.g. github.com/gofiber/fiber/v2).
+	majorVersionSuffix = regexp.MustCompile("^v[0-9]+$")
+)
 
 // Scope tracks all the identifiers used in the current scope, including
 // import paths and their aliases.
@@ -67,6 +73,9 @@ func (s *Scope) Add(ident string) string {
 //	scope := NewScope("json")
 //	scope.AddImport("encoding/json") -> "encodingjson"
 //	scope.AddImport("encodingjson")  -> "_encodingjson"
+//
+// Major version suffixes are ignored, so that the alias matches
+// the package name (e.g. github.com/gofiber/fiber/v2 -> "fiber").
 func (s *Scope) AddImport(path string) string {
 	if path == "" || path == "." || path == "/" {
 		return ""
@@ -78,6 +87,11 @@ func (s *Scope) AddImport(path string) string {
 		alias string
 		elems = strings.Split(path, "/")
 	)
+	if len(elems) > 1 && majorVersionSuffix.MatchString(elems[len(elems)-1]) {
+		// The package name is determined by the element that
+		// precedes the major version suffix.
+		elems = elems[:len(elems)-1]
+	}
 	for i := 1; i <= len(elems); i++ {
 		alias = newIdent(elems[len(elems)-i:]...)
 		if s.isValid(alias) {
@@ -16,6 +16,15 @@ func TestScope(t *testing.T) {
 		encodingjson := scope.AddImport("other/encoding/json")
 		assert.Equal(t, "encodingjson", encodingjson)
 	})
+	t.Run("major version suffix", func(t *testing.T) {
+		scope := NewScope()
+
+		fiber := scope.AddImport("github.com/gofiber/fiber/v2")
+		assert.Equal(t, "fiber", fiber)
+
+		gofiberfiber := scope.AddImport("github.com/other/gofiber/fiber/v3")
+		assert.Equal(t, "gofiberfiber", gofiberfiber)
+	})
 	t.Run("import <-> ident collision", func(t *testing.T) {
 		scope := NewScope()
 
@@ -0,0 +1,38 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package file
+
+import (
+	context "context"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/fiber/download/fixtures/core"
+	fiber "github.com/gofiber/fiber/v2"
+	utils "github.com/gofiber/fiber/v2/utils"
+	io "io"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Download(
+		ctx context.Context,
+		filename string,
+	) (io.Reader, error)
+}
+
+// Register registers all of the Service's endpoints with the given Fiber router.
+func Register(app fiber.Router, service Service, opts ...core.HandlerOption) {
+	app.Add(http.MethodGet, "/file/:filename/download", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		var filename string
+		if err := core.ParsePathParameter(utils.CopyString(c.Params("filename")), &filename); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid path parameter %q: %v", "filename", err))
+		}
+		response, err := service.Download(ctx, filename)
+		if err != nil {
+			return err
+		}
+		c.Set(fiber.HeaderContentType, fiber.MIMEOctetStream)
+		return c.SendStream(response)
+	})
+}
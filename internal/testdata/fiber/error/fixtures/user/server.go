@@ -0,0 +1,88 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/fiber/error/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/fiber/error/fixtures/core"
+	fiber "github.com/gofiber/fiber/v2"
+	utils "github.com/gofiber/fiber/v2/utils"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Get(
+		ctx context.Context,
+		id string,
+	) (string, error)
+	Update(
+		ctx context.Context,
+		id string,
+		request string,
+	) (string, error)
+}
+
+// Register registers all of the Service's endpoints with the given Fiber router.
+func Register(app fiber.Router, service Service, opts ...core.HandlerOption) {
+	app.Add(http.MethodGet, "/:id", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		var id string
+		if err := core.ParsePathParameter(utils.CopyString(c.Params("id")), &id); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid path parameter %q: %v", "id", err))
+		}
+		response, err := service.Get(ctx, id)
+		if err != nil {
+			var userNotFoundError *fixtures.UserNotFoundError
+			if errors.As(err, &userNotFoundError) {
+				return c.Status(404).JSON(userNotFoundError.Body)
+			}
+			var notImplementedError *fixtures.NotImplementedError
+			if errors.As(err, &notImplementedError) {
+				return c.Status(501).JSON(notImplementedError.Body)
+			}
+			var teapotError *fixtures.TeapotError
+			if errors.As(err, &teapotError) {
+				return c.Status(418).JSON(teapotError.Body)
+			}
+			var upgradeError *fixtures.UpgradeError
+			if errors.As(err, &upgradeError) {
+				return c.Status(426).JSON("upgrade")
+			}
+			var untypedError *fixtures.UntypedError
+			if errors.As(err, &untypedError) {
+				return c.SendStatus(400)
+			}
+			return err
+		}
+		return c.Status(http.StatusOK).JSON(response)
+	})
+	app.Add(http.MethodPost, "/:id", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		var id string
+		if err := core.ParsePathParameter(utils.CopyString(c.Params("id")), &id); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid path parameter %q: %v", "id", err))
+		}
+		var request string
+		if err := core.DecodeRequestBody(bytes.NewReader(c.Body()), &request, false); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		}
+		response, err := service.Update(ctx, id, request)
+		if err != nil {
+			var upgradeError *fixtures.UpgradeError
+			if errors.As(err, &upgradeError) {
+				return c.Status(426).JSON("upgrade")
+			}
+			var untypedError *fixtures.UntypedError
+			if errors.As(err, &untypedError) {
+				return c.SendStatus(400)
+			}
+			return err
+		}
+		return c.Status(http.StatusOK).JSON(response)
+	})
+}
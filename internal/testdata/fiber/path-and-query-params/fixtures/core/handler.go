@@ -0,0 +1,353 @@
+package core
+
+import (
+	"bufio"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// responseContentType specifies the Content-Type header value of the
+	// JSON responses and streams.
+	responseContentType       = "application/json"
+	responseContentTypeHeader = "Content-Type"
+)
+
+// DefaultMultipartMemory is the maximum number of bytes of a multipart
+// form's file parts that are stored in memory, with the remainder stored
+// on disk in temporary files.
+const DefaultMultipartMemory = 32 << 20
+
+// HandlerOption adapts the behavior of the handlers added by each
+// service's Register function.
+type HandlerOption func(*handlerOptions)
+
+// WithStreamErrorHandler sets the function that's called with the errors
+// returned by streaming endpoints after their stream has started, at which
+// point the errors can no longer be written to the response.
+//
+// By default, these errors aren't reported, but the stream still breaks.
+func WithStreamErrorHandler(handler func(error)) HandlerOption {
+	return func(opts *handlerOptions) {
+		opts.streamErrorHandler = handler
+	}
+}
+
+// ParseParameter parses the given path, query, or header parameter value
+// into the value pointed to by dst.
+func ParseParameter(value string, dst interface{}) error {
+	switch v := dst.(type) {
+	case *string:
+		*v = value
+		return nil
+	case *bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	case *int:
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	case *int64:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	case *float64:
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	case *[]byte:
+		parsed, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return err
+		}
+		*v = parsed
+		return nil
+	case *time.Time:
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			// Dates are formatted without a time component.
+			parsed, err = time.Parse("2006-01-02", value)
+			if err != nil {
+				return err
+			}
+		}
+		*v = parsed
+		return nil
+	case encoding.TextUnmarshaler:
+		return v.UnmarshalText([]byte(value))
+	}
+	// Enums and other named types are decoded from their JSON representation,
+	// which is either a string or a bare value (e.g. a number).
+	if err := json.Unmarshal([]byte(strconv.Quote(value)), dst); err == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(value), dst)
+}
+
+// ParsePathParameter is like ParseParameter, but unescapes the given path
+// parameter value first (e.g. a%2Fb is parsed as a/b). It's used by the
+// routers that return the path parameters as they appear in the request's
+// path, such as Fiber.
+func ParsePathParameter(value string, dst interface{}) error {
+	unescaped, err := url.PathUnescape(value)
+	if err != nil {
+		return err
+	}
+	return ParseParameter(unescaped, dst)
+}
+
+// PathValue returns the given path parameter value, unescaped if it was matched
+// against the request's escaped path. Routers like chi and Echo match the escaped
+// path whenever it differs from the default encoding of the decoded path (i.e.
+// r.URL.RawPath is set, such as for a%2Fb), and the decoded path otherwise.
+func PathValue(r *http.Request, value string) string {
+	if r.URL.RawPath == "" {
+		return value
+	}
+	if unescaped, err := url.PathUnescape(value); err == nil {
+		return unescaped
+	}
+	return value
+}
+
+// DecodeRequestBody decodes the JSON request body into the value pointed to by dst.
+// If the body is optional, an empty body is not considered an error.
+func DecodeRequestBody(body io.Reader, dst interface{}, isOptional bool) error {
+	if err := json.NewDecoder(body).Decode(dst); err != nil {
+		if err == io.EOF {
+			if isOptional {
+				return nil
+			}
+			return errors.New("the request body is required")
+		}
+		return err
+	}
+	return nil
+}
+
+// WriteResponse writes the given value as a JSON response.
+func WriteResponse(w http.ResponseWriter, statusCode int, value interface{}) {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	w.Header().Set(responseContentTypeHeader, responseContentType)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(bytes)
+}
+
+// WriteError writes the given error as a plain text response. The status code
+// is read from the *APIError in the error's chain (if any), and defaults to 500.
+//
+// The error message is only exposed for client errors (i.e. 4XX status codes)
+// so that internal details aren't leaked to the caller.
+func WriteError(w http.ResponseWriter, err error) {
+	statusCode := ErrorStatusCode(err)
+	message := http.StatusText(statusCode)
+	var apiError *APIError
+	if statusCode < http.StatusInternalServerError && errors.As(err, &apiError) && apiError.Unwrap() != nil {
+		message = apiError.Unwrap().Error()
+	}
+	http.Error(w, message, statusCode)
+}
+
+// ErrorStatusCode returns the status code of the *APIError in the error's chain
+// (if any), and defaults to 500.
+func ErrorStatusCode(err error) int {
+	var apiError *APIError
+	if errors.As(err, &apiError) && apiError != nil && apiError.StatusCode != 0 {
+		return apiError.StatusCode
+	}
+	return http.StatusInternalServerError
+}
+
+// NewBadRequestError returns an *APIError that describes an invalid request.
+func NewBadRequestError(format string, args ...interface{}) *APIError {
+	return NewAPIError(http.StatusBadRequest, fmt.Errorf(format, args...))
+}
+
+// FormFile returns the first file associated with the given key in the
+// request's multipart form, which is parsed on demand.
+func FormFile(r *http.Request, key string) (*multipart.FileHeader, error) {
+	if r.MultipartForm == nil {
+		if err := r.ParseMultipartForm(DefaultMultipartMemory); err != nil {
+			return nil, err
+		}
+	}
+	if files := r.MultipartForm.File[key]; len(files) > 0 {
+		return files[0], nil
+	}
+	return nil, http.ErrMissingFile
+}
+
+// StreamWriter writes a stream of JSON messages to the response, separated
+// by the configured delimiter.
+type StreamWriter struct {
+	writer       io.Writer
+	delimiter    string
+	errorHandler func(error)
+	started      bool
+}
+
+// NewStreamWriter returns a new *StreamWriter. By default, messages are
+// newline-delimited.
+//
+// If the given writer is an http.ResponseWriter, the response headers
+// are written alongside the first message.
+func NewStreamWriter(w io.Writer, delimiter string, opts ...HandlerOption) *StreamWriter {
+	options := new(handlerOptions)
+	for _, opt := range opts {
+		opt(options)
+	}
+	if delimiter == "" {
+		delimiter = "\n"
+	}
+	return &StreamWriter{
+		writer:       w,
+		delimiter:    delimiter,
+		errorHandler: options.streamErrorHandler,
+	}
+}
+
+// Send writes a single message to the stream, and flushes it to the caller.
+func (s *StreamWriter) Send(value interface{}) error {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if !s.started {
+		if responseWriter, ok := s.writer.(http.ResponseWriter); ok {
+			responseWriter.Header().Set(responseContentTypeHeader, responseContentType)
+			responseWriter.WriteHeader(http.StatusOK)
+		}
+		s.started = true
+	}
+	if _, err := s.writer.Write(append(bytes, s.delimiter...)); err != nil {
+		return err
+	}
+	switch flusher := s.writer.(type) {
+	case http.Flusher:
+		flusher.Flush()
+	case interface{ Flush() error }:
+		// Buffered writers (e.g. *bufio.Writer) report flush errors.
+		return flusher.Flush()
+	}
+	return nil
+}
+
+// Started returns true if at least one message was written to the stream.
+// At that point, the response status can no longer be changed.
+func (s *StreamWriter) Started() bool {
+	return s.started
+}
+
+// Abort reports the given error, which was returned after the stream started,
+// with the stream error handler, if any (see WithStreamErrorHandler). If the
+// stream is written to an http.ResponseWriter, the response is aborted too, so
+// that the caller sees the stream break rather than end cleanly.
+func (s *StreamWriter) Abort(err error) {
+	if s.errorHandler != nil {
+		s.errorHandler(err)
+	}
+	responseWriter, ok := s.writer.(http.ResponseWriter)
+	if !ok {
+		return
+	}
+	if hijacker, ok := responseWriter.(http.Hijacker); ok && closeConnection(hijacker) {
+		return
+	}
+	// HTTP/2 connections can't be hijacked, but aborting the handler resets
+	// the response's stream.
+	panic(http.ErrAbortHandler)
+}
+
+// closeConnection hijacks the connection and closes it, and reports whether it
+// succeeded. Framework response writers (e.g. Gin's) implement http.Hijacker even
+// if the underlying writer doesn't, in which case their Hijack method panics.
+func closeConnection(hijacker http.Hijacker) (closed bool) {
+	defer func() {
+		if recover() != nil {
+			closed = false
+		}
+	}()
+	conn, _, err := hijacker.Hijack()
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
+
+// StartStream calls the given function with a *StreamWriter in the background,
+// and waits until it either sends its first message or returns. If it returns an
+// error before the stream starts, the error is returned so that it can still be
+// written to the response. Otherwise, the stream is read from the returned body,
+// which must be closed once the response is written.
+//
+// This is required by the frameworks that write the response status before they
+// write the stream (e.g. Fiber). If the function returns an error after the stream
+// starts, the error is reported with the stream error handler, and reading the body
+// fails with it, so that the caller sees the stream break rather than end cleanly.
+func StartStream(
+	delimiter string,
+	call func(stream *StreamWriter) error,
+	opts ...HandlerOption,
+) (io.ReadCloser, error) {
+	reader, writer := io.Pipe()
+	stream := NewStreamWriter(writer, delimiter, opts...)
+	go func() {
+		err := call(stream)
+		if err != nil && stream.Started() {
+			stream.Abort(err)
+		}
+		_ = writer.CloseWithError(err)
+	}()
+	body := &streamBody{
+		Reader: bufio.NewReader(reader),
+		pipe:   reader,
+	}
+	if _, err := body.Peek(1); err != nil && err != io.EOF {
+		_ = reader.Close()
+		return nil, err
+	}
+	return body, nil
+}
+
+// streamBody is the body of a stream started with StartStream. Closing it
+// unblocks the stream's function if the caller stops reading early.
+type streamBody struct {
+	*bufio.Reader
+
+	pipe *io.PipeReader
+}
+
+func (s *streamBody) Close() error {
+	return s.pipe.Close()
+}
+
+type handlerOptions struct {
+	streamErrorHandler func(error)
+}
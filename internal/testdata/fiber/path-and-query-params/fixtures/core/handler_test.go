@@ -0,0 +1,346 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// message is a simple request body and stream message.
+type message struct {
+	Id string `json:"id"`
+}
+
+func TestParseParameter(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		var value string
+		require.NoError(t, ParseParameter("fern", &value))
+		assert.Equal(t, "fern", value)
+	})
+
+	t.Run("integer", func(t *testing.T) {
+		var value int
+		require.NoError(t, ParseParameter("42", &value))
+		assert.Equal(t, 42, value)
+		assert.Error(t, ParseParameter("fern", &value))
+	})
+
+	t.Run("boolean", func(t *testing.T) {
+		var value bool
+		require.NoError(t, ParseParameter("true", &value))
+		assert.True(t, value)
+	})
+
+	t.Run("base64", func(t *testing.T) {
+		var value []byte
+		require.NoError(t, ParseParameter("ZmVybg==", &value))
+		assert.Equal(t, []byte("fern"), value)
+	})
+
+	t.Run("datetime", func(t *testing.T) {
+		var value time.Time
+		require.NoError(t, ParseParameter("2024-01-02T03:04:05Z", &value))
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), value)
+	})
+
+	t.Run("date", func(t *testing.T) {
+		var value time.Time
+		require.NoError(t, ParseParameter("2024-01-02", &value))
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), value)
+	})
+
+	t.Run("enum", func(t *testing.T) {
+		type Color string
+		var value Color
+		require.NoError(t, ParseParameter("red", &value))
+		assert.Equal(t, Color("red"), value)
+	})
+}
+
+func TestParsePathParameter(t *testing.T) {
+	tests := []struct {
+		desc      string
+		giveValue string
+		wantValue string
+		wantError string
+	}{
+		{
+			desc:      "unescaped",
+			giveValue: "fern",
+			wantValue: "fern",
+		},
+		{
+			desc:      "space",
+			giveValue: "a%20b",
+			wantValue: "a b",
+		},
+		{
+			desc:      "slash",
+			giveValue: "a%2Fb",
+			wantValue: "a/b",
+		},
+		{
+			desc:      "percent",
+			giveValue: "100%25",
+			wantValue: "100%",
+		},
+		{
+			desc:      "invalid escape",
+			giveValue: "a%zzb",
+			wantError: `invalid URL escape "%zz"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var value string
+			err := ParsePathParameter(test.giveValue, &value)
+			if test.wantError != "" {
+				assert.EqualError(t, err, test.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.wantValue, value)
+		})
+	}
+}
+
+func TestPathValue(t *testing.T) {
+	tests := []struct {
+		desc      string
+		givePath  string
+		giveValue string
+		wantValue string
+	}{
+		{
+			desc:      "decoded path",
+			givePath:  "/users/a%20b",
+			giveValue: "a b",
+			wantValue: "a b",
+		},
+		{
+			desc:      "decoded path with a percent sign",
+			givePath:  "/users/100%25",
+			giveValue: "100%",
+			wantValue: "100%",
+		},
+		{
+			desc:      "escaped path",
+			givePath:  "/users/a%2Fb",
+			giveValue: "a%2Fb",
+			wantValue: "a/b",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, test.givePath, nil)
+			assert.Equal(t, test.wantValue, PathValue(r, test.giveValue))
+		})
+	}
+}
+
+func TestDecodeRequestBody(t *testing.T) {
+	t.Run("required", func(t *testing.T) {
+		var value message
+		require.NoError(t, DecodeRequestBody(strings.NewReader(`{"id":"123"}`), &value, false))
+		assert.Equal(t, "123", value.Id)
+		assert.EqualError(t, DecodeRequestBody(strings.NewReader(""), &value, false), "the request body is required")
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		var value *message
+		require.NoError(t, DecodeRequestBody(strings.NewReader(""), &value, true))
+		assert.Nil(t, value)
+	})
+}
+
+func TestWriteError(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		WriteError(recorder, NewAPIError(http.StatusNotFound, nil))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), strings.TrimSpace(recorder.Body.String()))
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		WriteError(recorder, NewBadRequestError("invalid %s", "limit"))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Equal(t, "invalid limit", strings.TrimSpace(recorder.Body.String()))
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		WriteError(recorder, errors.New("database unavailable"))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Equal(t, http.StatusText(http.StatusInternalServerError), strings.TrimSpace(recorder.Body.String()))
+	})
+}
+
+func TestFormFile(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "file.txt")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("fern"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	request := httptest.NewRequest(http.MethodPost, "/upload", &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	fileHeader, err := FormFile(request, "file")
+	require.NoError(t, err)
+	file, err := fileHeader.Open()
+	require.NoError(t, err)
+	defer file.Close()
+	contents, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "fern", string(contents))
+
+	_, err = FormFile(request, "other")
+	assert.Equal(t, http.ErrMissingFile, err)
+}
+
+func TestStreamWriter(t *testing.T) {
+	t.Run("response writer", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		writer := NewStreamWriter(recorder, "")
+		assert.False(t, writer.Started())
+		require.NoError(t, writer.Send(&message{Id: "1"}))
+		require.NoError(t, writer.Send(&message{Id: "2"}))
+		assert.True(t, writer.Started())
+		assert.Equal(t, responseContentType, recorder.Header().Get(responseContentTypeHeader))
+		assert.Equal(t, "{\"id\":\"1\"}\n{\"id\":\"2\"}\n", recorder.Body.String())
+	})
+
+	t.Run("buffered writer", func(t *testing.T) {
+		var buffer bytes.Buffer
+		writer := NewStreamWriter(bufio.NewWriter(&buffer), "\n\n")
+		require.NoError(t, writer.Send(&message{Id: "1"}))
+		assert.Equal(t, "{\"id\":\"1\"}\n\n", buffer.String())
+	})
+}
+
+func TestStreamWriterAbort(t *testing.T) {
+	tests := []struct {
+		desc        string
+		giveHandler bool
+	}{
+		{
+			desc: "without an error handler",
+		},
+		{
+			desc:        "with an error handler",
+			giveHandler: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			handled := make(chan error, 1)
+			var opts []HandlerOption
+			if test.giveHandler {
+				opts = append(opts, WithStreamErrorHandler(func(err error) { handled <- err }))
+			}
+
+			streamErr := errors.New("failed")
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						stream := NewStreamWriter(w, "", opts...)
+						require.NoError(t, stream.Send(&message{Id: "1"}))
+						stream.Abort(streamErr)
+					},
+				),
+			)
+			defer server.Close()
+
+			response, err := server.Client().Get(server.URL)
+			require.NoError(t, err)
+			defer response.Body.Close()
+
+			// The message sent before the error is received, but the stream
+			// breaks rather than ending cleanly.
+			body, err := io.ReadAll(response.Body)
+			assert.Equal(t, io.ErrUnexpectedEOF, err)
+			assert.Equal(t, "{\"id\":\"1\"}\n", string(body))
+			if test.giveHandler {
+				assert.Equal(t, streamErr, <-handled)
+			}
+		})
+	}
+}
+
+func TestStartStream(t *testing.T) {
+	t.Run("messages", func(t *testing.T) {
+		body, err := StartStream("", func(stream *StreamWriter) error {
+			if err := stream.Send(&message{Id: "1"}); err != nil {
+				return err
+			}
+			return stream.Send(&message{Id: "2"})
+		})
+		require.NoError(t, err)
+		defer body.Close()
+
+		bytes, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "{\"id\":\"1\"}\n{\"id\":\"2\"}\n", string(bytes))
+	})
+
+	t.Run("error before the stream starts", func(t *testing.T) {
+		streamErr := errors.New("failed")
+		_, err := StartStream("", func(stream *StreamWriter) error {
+			return streamErr
+		})
+		assert.Equal(t, streamErr, err)
+	})
+
+	t.Run("error after the stream starts", func(t *testing.T) {
+		handled := make(chan error, 1)
+		opt := WithStreamErrorHandler(func(err error) { handled <- err })
+
+		streamErr := errors.New("failed")
+		body, err := StartStream("", func(stream *StreamWriter) error {
+			if err := stream.Send(&message{Id: "1"}); err != nil {
+				return err
+			}
+			return streamErr
+		}, opt)
+		require.NoError(t, err)
+		defer body.Close()
+
+		// The message sent before the error is read, but the stream
+		// breaks rather than ending cleanly.
+		bytes, err := io.ReadAll(body)
+		assert.Equal(t, streamErr, err)
+		assert.Equal(t, "{\"id\":\"1\"}\n", string(bytes))
+		assert.Equal(t, streamErr, <-handled)
+	})
+
+	t.Run("closed early", func(t *testing.T) {
+		handled := make(chan error, 1)
+		opt := WithStreamErrorHandler(func(err error) { handled <- err })
+
+		body, err := StartStream("", func(stream *StreamWriter) error {
+			for {
+				if err := stream.Send(&message{Id: "1"}); err != nil {
+					return err
+				}
+			}
+		}, opt)
+		require.NoError(t, err)
+
+		// Closing the body unblocks the stream, which then fails to send.
+		require.NoError(t, body.Close())
+		assert.Equal(t, io.ErrClosedPipe, <-handled)
+	})
+}
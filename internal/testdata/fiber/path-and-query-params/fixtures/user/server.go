@@ -0,0 +1,54 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/fiber/path-and-query-params/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/fiber/path-and-query-params/fixtures/core"
+	fiber "github.com/gofiber/fiber/v2"
+	utils "github.com/gofiber/fiber/v2/utils"
+	http "net/http"
+	url "net/url"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetUser(
+		ctx context.Context,
+		userId string,
+		request *fixtures.GetUserRequest,
+	) (string, error)
+}
+
+// Register registers all of the Service's endpoints with the given Fiber router.
+func Register(app fiber.Router, service Service, opts ...core.HandlerOption) {
+	app.Add(http.MethodGet, "/users/:userId", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		var userId string
+		if err := core.ParsePathParameter(utils.CopyString(c.Params("userId")), &userId); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid path parameter %q: %v", "userId", err))
+		}
+		request := new(fixtures.GetUserRequest)
+		query := make(url.Values)
+		c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+			query.Add(string(key), string(value))
+		})
+		if value := query.Get("shallow"); value != "" {
+			parsed := new(bool)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "shallow", err))
+			}
+			request.Shallow = parsed
+		}
+		if err := core.Validate(request); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+		}
+		response, err := service.GetUser(ctx, userId, request)
+		if err != nil {
+			return err
+		}
+		return c.Status(http.StatusOK).JSON(response)
+	})
+}
@@ -20,9 +20,15 @@ type GetUsersRequest struct {
 	OptionalBytes    *[]byte    `query:"optionalBytes"`
 }
 
+// Validate reports all of the GetUsersRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetUsersRequest) Validate() error {
+	return nil
+}
+
 type User struct {
-	Name string   `json:"name"`
-	Tags []string `json:"tags,omitempty"`
+	Name string   `json:"name" url:"name"`
+	Tags []string `json:"tags,omitempty" url:"tags"`
 }
 
 func (u *User) String() string {
@@ -31,3 +37,9 @@ func (u *User) String() string {
 	}
 	return fmt.Sprintf("%#v", u)
 }
+
+// Validate reports all of the User's invalid fields (e.g. missing
+// required fields), if any.
+func (u *User) Validate() error {
+	return nil
+}
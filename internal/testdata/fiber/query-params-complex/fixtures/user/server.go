@@ -0,0 +1,99 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/fiber/query-params-complex/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/fiber/query-params-complex/fixtures/core"
+	fiber "github.com/gofiber/fiber/v2"
+	uuid "github.com/google/uuid"
+	http "net/http"
+	url "net/url"
+	time "time"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetUsername(
+		ctx context.Context,
+		request *fixtures.GetUsersRequest,
+	) (*fixtures.User, error)
+}
+
+// Register registers all of the Service's endpoints with the given Fiber router.
+func Register(app fiber.Router, service Service, opts ...core.HandlerOption) {
+	app.Add(http.MethodGet, "/user", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		request := new(fixtures.GetUsersRequest)
+		query := make(url.Values)
+		c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+			query.Add(string(key), string(value))
+		})
+		if value := query.Get("id"); value != "" {
+			if err := core.ParseParameter(value, &request.Id); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "id", err))
+			}
+		} else {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("missing required query parameter %q", "id"))
+		}
+		if value := query.Get("date"); value != "" {
+			if err := core.ParseParameter(value, &request.Date); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "date", err))
+			}
+		} else {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("missing required query parameter %q", "date"))
+		}
+		if value := query.Get("deadline"); value != "" {
+			if err := core.ParseParameter(value, &request.Deadline); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "deadline", err))
+			}
+		} else {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("missing required query parameter %q", "deadline"))
+		}
+		if value := query.Get("bytes"); value != "" {
+			if err := core.ParseParameter(value, &request.Bytes); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "bytes", err))
+			}
+		} else {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("missing required query parameter %q", "bytes"))
+		}
+		if value := query.Get("optionalId"); value != "" {
+			parsed := new(uuid.UUID)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "optionalId", err))
+			}
+			request.OptionalId = parsed
+		}
+		if value := query.Get("optionalDate"); value != "" {
+			parsed := new(time.Time)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "optionalDate", err))
+			}
+			request.OptionalDate = parsed
+		}
+		if value := query.Get("optionalDeadline"); value != "" {
+			parsed := new(time.Time)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "optionalDeadline", err))
+			}
+			request.OptionalDeadline = parsed
+		}
+		if value := query.Get("optionalBytes"); value != "" {
+			parsed := new([]byte)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "optionalBytes", err))
+			}
+			request.OptionalBytes = parsed
+		}
+		if err := core.Validate(request); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+		}
+		response, err := service.GetUsername(ctx, request)
+		if err != nil {
+			return err
+		}
+		return c.Status(http.StatusOK).JSON(response)
+	})
+}
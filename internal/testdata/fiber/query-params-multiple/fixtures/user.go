@@ -9,3 +9,9 @@ type GetAllUsersRequest struct {
 	Filter          *string  `query:"filter"`
 	Series          []string `query:"series"`
 }
+
+// Validate reports all of the GetAllUsersRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetAllUsersRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,76 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/fiber/query-params-multiple/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/fiber/query-params-multiple/fixtures/core"
+	fiber "github.com/gofiber/fiber/v2"
+	http "net/http"
+	url "net/url"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetAllUsers(
+		ctx context.Context,
+		request *fixtures.GetAllUsersRequest,
+	) (string, error)
+}
+
+// Register registers all of the Service's endpoints with the given Fiber router.
+func Register(app fiber.Router, service Service, opts ...core.HandlerOption) {
+	app.Add(http.MethodGet, "/users/all", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		request := new(fixtures.GetAllUsersRequest)
+		query := make(url.Values)
+		c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+			query.Add(string(key), string(value))
+		})
+		if value := query.Get("tag"); value != "" {
+			if err := core.ParseParameter(value, &request.Tag); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "tag", err))
+			}
+		} else {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("missing required query parameter %q", "tag"))
+		}
+		for _, value := range query["limit"] {
+			parsed := new(int)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "limit", err))
+			}
+			request.Limit = append(request.Limit, parsed)
+		}
+		if value := query.Get("filter"); value != "" {
+			parsed := new(string)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "filter", err))
+			}
+			request.Filter = parsed
+		}
+		for _, value := range query["series"] {
+			var parsed string
+			if err := core.ParseParameter(value, &parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "series", err))
+			}
+			request.Series = append(request.Series, parsed)
+		}
+		if value := c.Get("X-Endpoint-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointHeader); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid header %q: %v", "X-Endpoint-Header", err))
+			}
+		} else {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("missing required header %q", "X-Endpoint-Header"))
+		}
+		if err := core.Validate(request); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+		}
+		response, err := service.GetAllUsers(ctx, request)
+		if err != nil {
+			return err
+		}
+		return c.Status(http.StatusOK).JSON(response)
+	})
+}
@@ -11,3 +11,9 @@ type GetAllUsersRequest struct {
 func (g *GetAllUsersRequest) Key() string {
 	return g.key
 }
+
+// Validate reports all of the GetAllUsersRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetAllUsersRequest) Validate() error {
+	return nil
+}
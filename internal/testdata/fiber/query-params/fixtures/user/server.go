@@ -0,0 +1,58 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/fiber/query-params/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/fiber/query-params/fixtures/core"
+	fiber "github.com/gofiber/fiber/v2"
+	http "net/http"
+	url "net/url"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetAllUsers(
+		ctx context.Context,
+		request *fixtures.GetAllUsersRequest,
+	) (string, error)
+}
+
+// Register registers all of the Service's endpoints with the given Fiber router.
+func Register(app fiber.Router, service Service, opts ...core.HandlerOption) {
+	app.Add(http.MethodGet, "/users/all", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		request := new(fixtures.GetAllUsersRequest)
+		query := make(url.Values)
+		c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+			query.Add(string(key), string(value))
+		})
+		if value := query.Get("limit"); value != "" {
+			parsed := new(int)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "limit", err))
+			}
+			request.Limit = parsed
+		}
+		if value := query.Get("key"); value != "" && value != "fern" {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: expected %q", "key", "fern"))
+		}
+		if value := c.Get("X-Endpoint-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointHeader); err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid header %q: %v", "X-Endpoint-Header", err))
+			}
+		} else {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("missing required header %q", "X-Endpoint-Header"))
+		}
+		if err := core.Validate(request); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+		}
+		response, err := service.GetAllUsers(ctx, request)
+		if err != nil {
+			return err
+		}
+		return c.Status(http.StatusOK).JSON(response)
+	})
+}
@@ -0,0 +1,25 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/fiber/stream-error/fixtures/core"
+)
+
+type UserNotFoundErrorBody struct {
+	RequestedUserId string `json:"requestedUserId" url:"requestedUserId"`
+}
+
+func (u *UserNotFoundErrorBody) String() string {
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
+
+// Validate reports all of the UserNotFoundErrorBody's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UserNotFoundErrorBody) Validate() error {
+	return nil
+}
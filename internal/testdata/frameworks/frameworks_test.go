@@ -0,0 +1,131 @@
+package frameworks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	fiberfixtures "github.com/fern-api/fern-go/internal/testdata/fiber/path-and-query-params/fixtures"
+	fiberuser "github.com/fern-api/fern-go/internal/testdata/fiber/path-and-query-params/fixtures/user"
+	chifixtures "github.com/fern-api/fern-go/internal/testdata/server/chi-path-and-query-params/fixtures"
+	chiuser "github.com/fern-api/fern-go/internal/testdata/server/chi-path-and-query-params/fixtures/user"
+	echofixtures "github.com/fern-api/fern-go/internal/testdata/server/echo-path-and-query-params/fixtures"
+	echouser "github.com/fern-api/fern-go/internal/testdata/server/echo-path-and-query-params/fixtures/user"
+	ginfixtures "github.com/fern-api/fern-go/internal/testdata/server/gin-path-and-query-params/fixtures"
+	ginuser "github.com/fern-api/fern-go/internal/testdata/server/gin-path-and-query-params/fixtures/user"
+	nethttpfixtures "github.com/fern-api/fern-go/internal/testdata/server/path-and-query-params/fixtures"
+	nethttpuser "github.com/fern-api/fern-go/internal/testdata/server/path-and-query-params/fixtures/user"
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// userService returns the path parameter it's called with.
+type userService[T any] struct{}
+
+func (*userService[T]) GetUser(_ context.Context, userId string, _ T) (string, error) {
+	return userId, nil
+}
+
+// TestPathParameters verifies that every framework's server receives the same
+// path parameters as core.Router, which unescapes them.
+func TestPathParameters(t *testing.T) {
+	frameworks := []struct {
+		name      string
+		newServer func(t *testing.T) string
+	}{
+		{
+			name: "net/http",
+			newServer: func(t *testing.T) string {
+				return serve(t, nethttpuser.NewHandler(new(userService[*nethttpfixtures.GetUserRequest])))
+			},
+		},
+		{
+			name: "chi",
+			newServer: func(t *testing.T) string {
+				return serve(t, chiuser.NewHandler(new(userService[*chifixtures.GetUserRequest])))
+			},
+		},
+		{
+			name: "echo",
+			newServer: func(t *testing.T) string {
+				router := echo.New()
+				echouser.Register(router, new(userService[*echofixtures.GetUserRequest]))
+				return serve(t, router)
+			},
+		},
+		{
+			name: "gin",
+			newServer: func(t *testing.T) string {
+				gin.SetMode(gin.TestMode)
+				router := gin.New()
+				ginuser.Register(router, new(userService[*ginfixtures.GetUserRequest]))
+				return serve(t, router)
+			},
+		},
+		{
+			name: "fiber",
+			newServer: func(t *testing.T) string {
+				app := fiber.New(fiber.Config{DisableStartupMessage: true})
+				fiberuser.Register(app, new(userService[*fiberfixtures.GetUserRequest]))
+				listener, err := net.Listen("tcp", "127.0.0.1:0")
+				require.NoError(t, err)
+				go func() {
+					_ = app.Listener(listener)
+				}()
+				t.Cleanup(func() {
+					_ = app.Shutdown()
+				})
+				return "http://" + listener.Addr().String()
+			},
+		},
+	}
+	tests := []struct {
+		desc       string
+		givePath   string
+		wantUserId string
+	}{
+		{
+			desc:       "space",
+			givePath:   "/users/a%20b",
+			wantUserId: "a b",
+		},
+		{
+			desc:       "slash",
+			givePath:   "/users/a%2Fb",
+			wantUserId: "a/b",
+		},
+	}
+	for _, framework := range frameworks {
+		t.Run(framework.name, func(t *testing.T) {
+			url := framework.newServer(t)
+			for _, test := range tests {
+				t.Run(test.desc, func(t *testing.T) {
+					response, err := http.Get(url + test.givePath)
+					require.NoError(t, err)
+					defer response.Body.Close()
+
+					body, err := io.ReadAll(response.Body)
+					require.NoError(t, err)
+					require.Equal(t, http.StatusOK, response.StatusCode, string(body))
+
+					var userId string
+					require.NoError(t, json.Unmarshal(body, &userId))
+					assert.Equal(t, test.wantUserId, userId)
+				})
+			}
+		})
+	}
+}
+
+func serve(t *testing.T, handler http.Handler) string {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
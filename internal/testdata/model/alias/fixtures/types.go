@@ -11,7 +11,7 @@ import (
 )
 
 type Bar struct {
-	Foo *Foo `json:"foo,omitempty"`
+	Foo *Foo `json:"foo,omitempty" url:"foo"`
 }
 
 func (b *Bar) String() string {
@@ -21,6 +21,20 @@ func (b *Bar) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var validation core.Validation
+	if b.Foo == nil {
+		validation.Required("foo")
+	}
+	validation.Add("foo", b.Foo.Validate())
+	return validation.Err()
+}
+
 type BarAlias = *Bar
 
 type Base64 = []byte
@@ -36,9 +50,9 @@ type Double = float64
 type DoubleSet = []float64
 
 type Foo struct {
-	Id          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	StringAlias String    `json:"stringAlias"`
+	Id          uuid.UUID `json:"id" url:"id"`
+	Name        string    `json:"name" url:"name"`
+	StringAlias String    `json:"stringAlias" url:"stringAlias"`
 }
 
 func (f *Foo) String() string {
@@ -48,6 +62,12 @@ func (f *Foo) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
+
 type FooAlias = *Foo
 
 type Integer = int
@@ -73,6 +93,8 @@ type Union struct {
 	FooAlias    *Foo
 	BarAlias    BarAlias
 	DoubleAlias Double
+
+	_unknown json.RawMessage
 }
 
 func NewUnionFromFooAlias(value *Foo) *Union {
@@ -118,6 +140,8 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.DoubleAlias = valueUnmarshaler.DoubleAlias
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -125,6 +149,9 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 func (u Union) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "fooAlias":
 		var marshaler = struct {
@@ -160,11 +187,17 @@ type UnionVisitor interface {
 	VisitFooAlias(*Foo) error
 	VisitBarAlias(BarAlias) error
 	VisitDoubleAlias(Double) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *Union) Accept(visitor UnionVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "fooAlias":
 		return visitor.VisitFooAlias(u.FooAlias)
@@ -175,6 +208,30 @@ func (u *Union) Accept(visitor UnionVisitor) error {
 	}
 }
 
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "fooAlias":
+		validation.Add("", u.FooAlias.Validate())
+	case "barAlias":
+		if u.BarAlias == nil {
+			validation.Required("barAlias")
+		}
+		validation.Add("barAlias", u.BarAlias.Validate())
+	case "doubleAlias":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type Unknown = interface{}
 
 type Uuid = uuid.UUID
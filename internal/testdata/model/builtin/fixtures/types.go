@@ -11,23 +11,23 @@ import (
 )
 
 type Type struct {
-	One       int              `json:"one"`
-	Two       float64          `json:"two"`
-	Three     string           `json:"three"`
-	Four      bool             `json:"four"`
-	Five      int64            `json:"five"`
-	Six       time.Time        `json:"six"`
-	Seven     time.Time        `json:"seven"`
-	Eight     uuid.UUID        `json:"eight"`
-	Nine      []byte           `json:"nine"`
-	Ten       []int            `json:"ten,omitempty"`
-	Eleven    []float64        `json:"eleven,omitempty"`
-	Twelve    map[string]bool  `json:"twelve,omitempty"`
-	Thirteen  *int64           `json:"thirteen,omitempty"`
-	Fourteen  interface{}      `json:"fourteen,omitempty"`
-	Fifteen   [][]int          `json:"fifteen,omitempty"`
-	Sixteen   []map[string]int `json:"sixteen,omitempty"`
-	Seventeen []*uuid.UUID     `json:"seventeen,omitempty"`
+	One       int              `json:"one" url:"one"`
+	Two       float64          `json:"two" url:"two"`
+	Three     string           `json:"three" url:"three"`
+	Four      bool             `json:"four" url:"four"`
+	Five      int64            `json:"five" url:"five"`
+	Six       time.Time        `json:"six" url:"six"`
+	Seven     time.Time        `json:"seven" url:"seven,date"`
+	Eight     uuid.UUID        `json:"eight" url:"eight"`
+	Nine      []byte           `json:"nine" url:"nine"`
+	Ten       []int            `json:"ten,omitempty" url:"ten"`
+	Eleven    []float64        `json:"eleven,omitempty" url:"eleven"`
+	Twelve    map[string]bool  `json:"twelve,omitempty" url:"twelve"`
+	Thirteen  *int64           `json:"thirteen,omitempty" url:"thirteen,omitempty"`
+	Fourteen  interface{}      `json:"fourteen,omitempty" url:"fourteen"`
+	Fifteen   [][]int          `json:"fifteen,omitempty" url:"fifteen"`
+	Sixteen   []map[string]int `json:"sixteen,omitempty" url:"sixteen"`
+	Seventeen []*uuid.UUID     `json:"seventeen,omitempty" url:"seventeen"`
 	eighteen  string
 }
 
@@ -36,12 +36,20 @@ func (t *Type) Eighteen() string {
 }
 
 func (t *Type) UnmarshalJSON(data []byte) error {
-	type unmarshaler Type
-	var value unmarshaler
-	if err := json.Unmarshal(data, &value); err != nil {
+	type embed Type
+	var unmarshaler = struct {
+		embed
+		Six   *core.DateTime `json:"six"`
+		Seven *core.Date     `json:"seven"`
+	}{
+		embed: embed(*t),
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
 		return err
 	}
-	*t = Type(value)
+	*t = Type(unmarshaler.embed)
+	t.Six = unmarshaler.Six.Time()
+	t.Seven = unmarshaler.Seven.Time()
 	t.eighteen = "fern"
 	return nil
 }
@@ -50,10 +58,14 @@ func (t *Type) MarshalJSON() ([]byte, error) {
 	type embed Type
 	var marshaler = struct {
 		embed
-		Eighteen string `json:"eighteen"`
+		Eighteen string         `json:"eighteen"`
+		Six      *core.DateTime `json:"six"`
+		Seven    *core.Date     `json:"seven"`
 	}{
 		embed:    embed(*t),
 		Eighteen: "fern",
+		Six:      core.NewDateTime(t.Six),
+		Seven:    core.NewDate(t.Seven),
 	}
 	return json.Marshal(marshaler)
 }
@@ -64,3 +76,9 @@ func (t *Type) String() string {
 	}
 	return fmt.Sprintf("%#v", t)
 }
+
+// Validate reports all of the Type's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Type) Validate() error {
+	return nil
+}
@@ -10,7 +10,7 @@ import (
 
 type Bar struct {
 	// This is a Foo field.
-	Foo *Foo `json:"foo,omitempty"`
+	Foo *Foo `json:"foo,omitempty" url:"foo"`
 }
 
 func (b *Bar) String() string {
@@ -20,10 +20,24 @@ func (b *Bar) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var validation core.Validation
+	if b.Foo == nil {
+		validation.Required("foo")
+	}
+	validation.Add("foo", b.Foo.Validate())
+	return validation.Err()
+}
+
 // This is a Foo.
 type Foo struct {
-	Id   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
+	Id   uuid.UUID `json:"id" url:"id"`
+	Name string    `json:"name" url:"name"`
 }
 
 func (f *Foo) String() string {
@@ -32,3 +46,9 @@ func (f *Foo) String() string {
 	}
 	return fmt.Sprintf("%#v", f)
 }
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
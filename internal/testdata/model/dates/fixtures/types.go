@@ -0,0 +1,258 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/dates/fixtures/core"
+	time "time"
+)
+
+type Type struct {
+	Date            time.Time              `json:"date" url:"date,date"`
+	Dates           []time.Time            `json:"dates,omitempty" url:"dates"`
+	DateSet         []time.Time            `json:"dateSet,omitempty" url:"dateSet"`
+	DateMap         map[string]time.Time   `json:"dateMap,omitempty" url:"dateMap"`
+	OptionalDate    *time.Time             `json:"optionalDate,omitempty" url:"optionalDate,omitempty,date"`
+	OptionalDates   []time.Time            `json:"optionalDates,omitempty" url:"optionalDates,omitempty"`
+	DatesWithNulls  []*time.Time           `json:"datesWithNulls,omitempty" url:"datesWithNulls"`
+	DateTimes       []time.Time            `json:"dateTimes,omitempty" url:"dateTimes"`
+	DateTimesByName map[string][]time.Time `json:"dateTimesByName,omitempty" url:"dateTimesByName"`
+}
+
+// Equal reports whether the Type is equal to the other Type.
+func (t *Type) Equal(other *Type) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if !t.Date.Equal(other.Date) {
+		return false
+	}
+	if len(t.Dates) != len(other.Dates) {
+		return false
+	}
+	for index, value := range t.Dates {
+		if !value.Equal(other.Dates[index]) {
+			return false
+		}
+	}
+	if len(t.DateSet) != len(other.DateSet) {
+		return false
+	}
+	for index, value := range t.DateSet {
+		if !value.Equal(other.DateSet[index]) {
+			return false
+		}
+	}
+	if len(t.DateMap) != len(other.DateMap) {
+		return false
+	}
+	for key, value := range t.DateMap {
+		otherValue, ok := other.DateMap[key]
+		if !ok {
+			return false
+		}
+		if !value.Equal(otherValue) {
+			return false
+		}
+	}
+	if (t.OptionalDate == nil) != (other.OptionalDate == nil) {
+		return false
+	}
+	if t.OptionalDate != nil {
+		if !(*t.OptionalDate).Equal(*other.OptionalDate) {
+			return false
+		}
+	}
+	if len(t.OptionalDates) != len(other.OptionalDates) {
+		return false
+	}
+	for index, value := range t.OptionalDates {
+		if !value.Equal(other.OptionalDates[index]) {
+			return false
+		}
+	}
+	if len(t.DatesWithNulls) != len(other.DatesWithNulls) {
+		return false
+	}
+	for index, value := range t.DatesWithNulls {
+		if (value == nil) != (other.DatesWithNulls[index] == nil) {
+			return false
+		}
+		if value != nil {
+			if !(*value).Equal(*other.DatesWithNulls[index]) {
+				return false
+			}
+		}
+	}
+	if len(t.DateTimes) != len(other.DateTimes) {
+		return false
+	}
+	for index, value := range t.DateTimes {
+		if !value.Equal(other.DateTimes[index]) {
+			return false
+		}
+	}
+	if len(t.DateTimesByName) != len(other.DateTimesByName) {
+		return false
+	}
+	for key, value := range t.DateTimesByName {
+		otherValue, ok := other.DateTimesByName[key]
+		if !ok {
+			return false
+		}
+		if len(value) != len(otherValue) {
+			return false
+		}
+		for index1, value1 := range value {
+			if !value1.Equal(otherValue[index1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Type.
+func (t *Type) DeepCopy() *Type {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	if t.Dates != nil {
+		copied.Dates = make([]time.Time, len(t.Dates))
+		copy(copied.Dates, t.Dates)
+	}
+	if t.DateSet != nil {
+		copied.DateSet = make([]time.Time, len(t.DateSet))
+		copy(copied.DateSet, t.DateSet)
+	}
+	if t.DateMap != nil {
+		copied.DateMap = make(map[string]time.Time, len(t.DateMap))
+		for key, value := range t.DateMap {
+			copied.DateMap[key] = value
+		}
+	}
+	if t.OptionalDate != nil {
+		copiedValue := *t.OptionalDate
+		copied.OptionalDate = &copiedValue
+	}
+	if t.OptionalDates != nil {
+		copied.OptionalDates = make([]time.Time, len(t.OptionalDates))
+		copy(copied.OptionalDates, t.OptionalDates)
+	}
+	if t.DatesWithNulls != nil {
+		copied.DatesWithNulls = make([]*time.Time, len(t.DatesWithNulls))
+		copy(copied.DatesWithNulls, t.DatesWithNulls)
+		for index, value := range t.DatesWithNulls {
+			if value != nil {
+				copiedValue1 := *value
+				copied.DatesWithNulls[index] = &copiedValue1
+			}
+		}
+	}
+	if t.DateTimes != nil {
+		copied.DateTimes = make([]time.Time, len(t.DateTimes))
+		copy(copied.DateTimes, t.DateTimes)
+	}
+	if t.DateTimesByName != nil {
+		copied.DateTimesByName = make(map[string][]time.Time, len(t.DateTimesByName))
+		for key, value := range t.DateTimesByName {
+			var copiedElement []time.Time
+			if value != nil {
+				copiedElement = make([]time.Time, len(value))
+				copy(copiedElement, value)
+			}
+			copied.DateTimesByName[key] = copiedElement
+		}
+	}
+	return &copied
+}
+
+func (t *Type) UnmarshalJSON(data []byte) error {
+	type embed Type
+	var unmarshaler = struct {
+		embed
+		Date            *core.Date  `json:"date"`
+		Dates           *core.Times `json:"dates,omitempty"`
+		DateSet         *core.Times `json:"dateSet,omitempty"`
+		DateMap         *core.Times `json:"dateMap,omitempty"`
+		OptionalDate    *core.Date  `json:"optionalDate,omitempty"`
+		OptionalDates   *core.Times `json:"optionalDates,omitempty"`
+		DatesWithNulls  *core.Times `json:"datesWithNulls,omitempty"`
+		DateTimes       *core.Times `json:"dateTimes,omitempty"`
+		DateTimesByName *core.Times `json:"dateTimesByName,omitempty"`
+	}{
+		embed: embed(*t),
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*t = Type(unmarshaler.embed)
+	t.Date = unmarshaler.Date.Time()
+	if err := unmarshaler.Dates.DecodeDates(&t.Dates); err != nil {
+		return err
+	}
+	if err := unmarshaler.DateSet.DecodeDates(&t.DateSet); err != nil {
+		return err
+	}
+	if err := unmarshaler.DateMap.DecodeDates(&t.DateMap); err != nil {
+		return err
+	}
+	t.OptionalDate = unmarshaler.OptionalDate.TimePtr()
+	if err := unmarshaler.OptionalDates.DecodeDates(&t.OptionalDates); err != nil {
+		return err
+	}
+	if err := unmarshaler.DatesWithNulls.DecodeDates(&t.DatesWithNulls); err != nil {
+		return err
+	}
+	if err := unmarshaler.DateTimes.DecodeDateTimes(&t.DateTimes); err != nil {
+		return err
+	}
+	if err := unmarshaler.DateTimesByName.DecodeDateTimes(&t.DateTimesByName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *Type) MarshalJSON() ([]byte, error) {
+	type embed Type
+	var marshaler = struct {
+		embed
+		Date            *core.Date  `json:"date"`
+		Dates           *core.Times `json:"dates,omitempty"`
+		DateSet         *core.Times `json:"dateSet,omitempty"`
+		DateMap         *core.Times `json:"dateMap,omitempty"`
+		OptionalDate    *core.Date  `json:"optionalDate,omitempty"`
+		OptionalDates   *core.Times `json:"optionalDates,omitempty"`
+		DatesWithNulls  *core.Times `json:"datesWithNulls,omitempty"`
+		DateTimes       *core.Times `json:"dateTimes,omitempty"`
+		DateTimesByName *core.Times `json:"dateTimesByName,omitempty"`
+	}{
+		embed:           embed(*t),
+		Date:            core.NewDate(t.Date),
+		Dates:           core.NewOptionalDates(t.Dates),
+		DateSet:         core.NewOptionalDates(t.DateSet),
+		DateMap:         core.NewOptionalDates(t.DateMap),
+		OptionalDate:    core.NewOptionalDate(t.OptionalDate),
+		OptionalDates:   core.NewOptionalDates(t.OptionalDates),
+		DatesWithNulls:  core.NewOptionalDates(t.DatesWithNulls),
+		DateTimes:       core.NewOptionalDateTimes(t.DateTimes),
+		DateTimesByName: core.NewOptionalDateTimes(t.DateTimesByName),
+	}
+	return json.Marshal(marshaler)
+}
+
+func (t *Type) String() string {
+	if value, err := core.StringifyJSON(t); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", t)
+}
+
+// Validate reports all of the Type's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Type) Validate() error {
+	return nil
+}
@@ -0,0 +1,143 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+)
+
+type Enum string
+
+const (
+	// The first enum value.
+	EnumOne   Enum = "ONE"
+	EnumTwo   Enum = "TWO"
+	EnumThree Enum = "THREE"
+)
+
+// NewEnumFromString returns the Enum for the given string, which
+// might not be one of its known values (see IsKnown).
+func NewEnumFromString(s string) (Enum, error) {
+	return Enum(s), nil
+}
+
+func (e Enum) Ptr() *Enum {
+	return &e
+}
+
+// IsKnown returns true if the Enum is one of its known values.
+func (e Enum) IsKnown() bool {
+	switch e {
+	case EnumOne, EnumTwo, EnumThree:
+		return true
+	}
+	return false
+}
+
+// Values returns all of the Enum's known values.
+func (e Enum) Values() []Enum {
+	return []Enum{
+		EnumOne,
+		EnumTwo,
+		EnumThree,
+	}
+}
+
+// EnumVisitor visits each of the Enum's known values, as well as
+// any unknown value that was added to the API after this code was generated.
+type EnumVisitor interface {
+	VisitOne() error
+	VisitTwo() error
+	VisitThree() error
+	VisitUnknown(value string) error
+}
+
+func (e Enum) Accept(visitor EnumVisitor) error {
+	switch e {
+	case EnumOne:
+		return visitor.VisitOne()
+	case EnumTwo:
+		return visitor.VisitTwo()
+	case EnumThree:
+		return visitor.VisitThree()
+	}
+	return visitor.VisitUnknown(string(e))
+}
+
+// Validate returns an error if the Enum isn't one of its known values.
+func (e Enum) Validate() error {
+	if !e.IsKnown() {
+		return fmt.Errorf("%s is not a valid %T", string(e), e)
+	}
+	return nil
+}
+
+type Something string
+
+const (
+	Somethingone Something = "one"
+	SomethingOne Something = "One"
+	SomethingONe Something = "ONe"
+	SomethingONE Something = "ONE"
+)
+
+// NewSomethingFromString returns the Something for the given string, which
+// might not be one of its known values (see IsKnown).
+func NewSomethingFromString(s string) (Something, error) {
+	return Something(s), nil
+}
+
+func (s Something) Ptr() *Something {
+	return &s
+}
+
+// IsKnown returns true if the Something is one of its known values.
+func (s Something) IsKnown() bool {
+	switch s {
+	case Somethingone, SomethingOne, SomethingONe, SomethingONE:
+		return true
+	}
+	return false
+}
+
+// Values returns all of the Something's known values.
+func (s Something) Values() []Something {
+	return []Something{
+		Somethingone,
+		SomethingOne,
+		SomethingONe,
+		SomethingONE,
+	}
+}
+
+// SomethingVisitor visits each of the Something's known values, as well as
+// any unknown value that was added to the API after this code was generated.
+type SomethingVisitor interface {
+	Visitone() error
+	VisitOne() error
+	VisitONe() error
+	VisitONE() error
+	VisitUnknown(value string) error
+}
+
+func (s Something) Accept(visitor SomethingVisitor) error {
+	switch s {
+	case Somethingone:
+		return visitor.Visitone()
+	case SomethingOne:
+		return visitor.VisitOne()
+	case SomethingONe:
+		return visitor.VisitONe()
+	case SomethingONE:
+		return visitor.VisitONE()
+	}
+	return visitor.VisitUnknown(string(s))
+}
+
+// Validate returns an error if the Something isn't one of its known values.
+func (s Something) Validate() error {
+	if !s.IsKnown() {
+		return fmt.Errorf("%s is not a valid %T", string(s), s)
+	}
+	return nil
+}
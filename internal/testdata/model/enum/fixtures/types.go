@@ -32,6 +32,12 @@ func (e Enum) Ptr() *Enum {
 	return &e
 }
 
+// Validate returns an error if the Enum isn't one of its known values.
+func (e Enum) Validate() error {
+	_, err := NewEnumFromString(string(e))
+	return err
+}
+
 type Something string
 
 const (
@@ -59,3 +65,9 @@ func NewSomethingFromString(s string) (Something, error) {
 func (s Something) Ptr() *Something {
 	return &s
 }
+
+// Validate returns an error if the Something isn't one of its known values.
+func (s Something) Validate() error {
+	_, err := NewSomethingFromString(string(s))
+	return err
+}
@@ -0,0 +1,147 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Optional is a wrapper used to distinguish zero values from
+// null or omitted fields.
+//
+// To instantiate an Optional, use the `Optional()` and `Null()`
+// helpers exported from the root package.
+//
+// A *Optional[T] field distinguishes all three states of a property:
+// it's nil if the property was omitted, Null is set if the property
+// was an explicit null, and Value is set otherwise.
+type Optional[T any] struct {
+	Value T
+	Null  bool
+}
+
+// IsSet returns true if the Optional has a (possibly zero) value,
+// i.e. it's neither omitted nor null.
+func (o *Optional[T]) IsSet() bool {
+	return o != nil && !o.Null
+}
+
+// IsNull returns true if the Optional is an explicit null.
+func (o *Optional[T]) IsNull() bool {
+	return o != nil && o.Null
+}
+
+func (o *Optional[T]) String() string {
+	if o == nil {
+		return ""
+	}
+	if s, ok := any(o.Value).(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprintf("%#v", o.Value)
+}
+
+func (o *Optional[T]) MarshalJSON() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	if o.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(&o.Value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Optional[T]{Null: true}
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Optional[T]{Value: value}
+	return nil
+}
+
+// NullProperties returns the set of the given JSON object's properties
+// that are an explicit null. Just like encoding/json, a property that matches
+// one of the known properties case-insensitively is keyed by the known property.
+//
+// The encoding/json package sets a pointer to nil for a null property
+// (rather than calling its UnmarshalJSON method), so the generated types
+// use this to tell null *Optional[T] fields apart from omitted ones.
+func NullProperties(data []byte, knownProperties ...string) (map[string]bool, error) {
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, err
+	}
+	var nulls map[string]bool
+	for property, value := range properties {
+		if !bytes.Equal(value, []byte("null")) {
+			continue
+		}
+		if nulls == nil {
+			nulls = make(map[string]bool)
+		}
+		nulls[knownProperty(property, knownProperties)] = true
+	}
+	return nulls, nil
+}
+
+// knownProperty returns the known property that matches the given property,
+// preferring an exact match over a case-insensitive one, or the property
+// itself if none of them match.
+func knownProperty(property string, knownProperties []string) string {
+	for _, known := range knownProperties {
+		if known == property {
+			return known
+		}
+	}
+	for _, known := range knownProperties {
+		if strings.EqualFold(known, property) {
+			return known
+		}
+	}
+	return property
+}
+
+// MapOptional returns the given Optional with its value converted by the
+// given function, preserving whether it's omitted or null (e.g. to
+// (de)serialize an optional time.Time as a *Date).
+func MapOptional[T, U any](o *Optional[T], convert func(T) U) *Optional[U] {
+	if o == nil {
+		return nil
+	}
+	if o.Null {
+		return &Optional[U]{Null: true}
+	}
+	return &Optional[U]{Value: convert(o.Value)}
+}
+
+// WrapOptional returns the given Optional with its value wrapped by the given
+// function, preserving whether it's omitted or null (e.g. to serialize an
+// optional list of time.Time values with core.NewDates).
+func WrapOptional[T, U any](o *Optional[T], wrap func(interface{}) U) *Optional[U] {
+	return MapOptional(o, func(value T) U { return wrap(value) })
+}
+
+// DecodeOptional sets the given target to the given Optional with its value
+// decoded by the given function, preserving whether it's omitted or null (e.g.
+// to read an optional list of dates with (*core.Times).DecodeDates).
+func DecodeOptional[T, U any](o *Optional[U], target **Optional[T], decode func(U, interface{}) error) error {
+	if !o.IsSet() {
+		*target = MapOptional(o, func(U) T {
+			var zero T
+			return zero
+		})
+		return nil
+	}
+	var value T
+	if err := decode(o.Value, &value); err != nil {
+		return err
+	}
+	*target = &Optional[T]{Value: value}
+	return nil
+}
@@ -0,0 +1,1020 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/explicit-null/fixtures/core"
+	time "time"
+)
+
+type Bar struct {
+	Name string `json:"name" url:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	return nil
+}
+
+type Baz struct {
+	Tags     *core.Optional[[]string] `json:"tags,omitempty" url:"tags,omitempty"`
+	extended string
+}
+
+func (b *Baz) GetTags() []string {
+	if b == nil || b.Tags == nil {
+		return nil
+	}
+	return b.Tags.Value
+}
+
+func (b *Baz) Extended() string {
+	return b.extended
+}
+
+func (b *Baz) UnmarshalJSON(data []byte) error {
+	type unmarshaler Baz
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Baz(value)
+	nullProperties, err := core.NullProperties(data, "tags")
+	if err != nil {
+		return err
+	}
+	if nullProperties["tags"] {
+		b.Tags = &core.Optional[[]string]{Null: true}
+	}
+	b.extended = "extended"
+	return nil
+}
+
+func (b *Baz) MarshalJSON() ([]byte, error) {
+	type embed Baz
+	var marshaler = struct {
+		embed
+		Extended string `json:"extended"`
+	}{
+		embed:    embed(*b),
+		Extended: "extended",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Baz's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Baz) Validate() error {
+	return nil
+}
+
+type Foo struct {
+	Name        string                    `json:"name" url:"name"`
+	Description *core.Optional[string]    `json:"description,omitempty" url:"description,omitempty"`
+	CreatedAt   *core.Optional[time.Time] `json:"createdAt,omitempty" url:"createdAt,omitempty"`
+	Birthday    *core.Optional[time.Time] `json:"birthday,omitempty" url:"birthday,omitempty,date"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) GetDescription() string {
+	if f == nil || f.Description == nil {
+		return ""
+	}
+	return f.Description.Value
+}
+
+func (f *Foo) GetCreatedAt() time.Time {
+	if f == nil || f.CreatedAt == nil {
+		return time.Time{}
+	}
+	return f.CreatedAt.Value
+}
+
+func (f *Foo) GetBirthday() time.Time {
+	if f == nil || f.Birthday == nil {
+		return time.Time{}
+	}
+	return f.Birthday.Value
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	type embed Foo
+	var unmarshaler = struct {
+		embed
+		CreatedAt *core.Optional[*core.DateTime] `json:"createdAt,omitempty"`
+		Birthday  *core.Optional[*core.Date]     `json:"birthday,omitempty"`
+	}{
+		embed: embed(*f),
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*f = Foo(unmarshaler.embed)
+	f.CreatedAt = core.MapOptional(unmarshaler.CreatedAt, (*core.DateTime).Time)
+	f.Birthday = core.MapOptional(unmarshaler.Birthday, (*core.Date).Time)
+	nullProperties, err := core.NullProperties(data, "description", "createdAt", "birthday")
+	if err != nil {
+		return err
+	}
+	if nullProperties["description"] {
+		f.Description = &core.Optional[string]{Null: true}
+	}
+	if nullProperties["createdAt"] {
+		f.CreatedAt = &core.Optional[time.Time]{Null: true}
+	}
+	if nullProperties["birthday"] {
+		f.Birthday = &core.Optional[time.Time]{Null: true}
+	}
+	return nil
+}
+
+func (f *Foo) MarshalJSON() ([]byte, error) {
+	type embed Foo
+	var marshaler = struct {
+		embed
+		CreatedAt *core.Optional[*core.DateTime] `json:"createdAt,omitempty"`
+		Birthday  *core.Optional[*core.Date]     `json:"birthday,omitempty"`
+	}{
+		embed:     embed(*f),
+		CreatedAt: core.MapOptional(f.CreatedAt, core.NewDateTime),
+		Birthday:  core.MapOptional(f.Birthday, core.NewDate),
+	}
+	return json.Marshal(marshaler)
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
+
+// This is a simple union.
+type Union struct {
+	Type string
+	Foo  *Foo
+	Bar  *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		if u.Foo == nil {
+			validation.Required("foo")
+		}
+		validation.Add("foo", u.Foo.Validate())
+	case "bar":
+		if u.Bar == nil {
+			validation.Required("bar")
+		}
+		validation.Add("bar", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithDiscriminant struct {
+	Type string
+	// This is a Foo field.
+	Foo *Foo
+	Bar *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithDiscriminantFromFoo(value *Foo) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "foo", Foo: value}
+}
+
+func NewUnionWithDiscriminantFromBar(value *Bar) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "bar", Bar: value}
+}
+
+func (u *UnionWithDiscriminant) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithDiscriminant) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithDiscriminant) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *UnionWithDiscriminant) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"_type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithDiscriminant) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"_type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"_type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithDiscriminantVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithDiscriminant) Accept(visitor UnionWithDiscriminantVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the UnionWithDiscriminant's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithDiscriminant) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		if u.Foo == nil {
+			validation.Required("foo")
+		}
+		validation.Add("foo", u.Foo.Validate())
+	case "bar":
+		if u.Bar == nil {
+			validation.Required("bar")
+		}
+		validation.Add("bar", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithLiteral struct {
+	Type     string
+	Tags     *core.Optional[[]string]
+	Label    *core.Optional[string]
+	fern     string
+	extended string
+	base     string
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithLiteralWithFern() *UnionWithLiteral {
+	return &UnionWithLiteral{Type: "fern", fern: "fern"}
+}
+
+func (u *UnionWithLiteral) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithLiteral) GetTags() []string {
+	if u == nil || u.Tags == nil {
+		return nil
+	}
+	return u.Tags.Value
+}
+
+func (u *UnionWithLiteral) GetLabel() string {
+	if u == nil || u.Label == nil {
+		return ""
+	}
+	return u.Label.Value
+}
+
+func (u *UnionWithLiteral) Extended() string {
+	return u.extended
+}
+
+func (u *UnionWithLiteral) Base() string {
+	return u.base
+}
+
+func (u *UnionWithLiteral) Fern() string {
+	return u.fern
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type  string                   `json:"type"`
+		Tags  *core.Optional[[]string] `json:"tags,omitempty"`
+		Label *core.Optional[string]   `json:"label,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	u.Tags = unmarshaler.Tags
+	u.Label = unmarshaler.Label
+	nullProperties, err := core.NullProperties(data, "tags", "label")
+	if err != nil {
+		return err
+	}
+	if nullProperties["tags"] {
+		u.Tags = &core.Optional[[]string]{Null: true}
+	}
+	if nullProperties["label"] {
+		u.Label = &core.Optional[string]{Null: true}
+	}
+	u.extended = "extended"
+	u.base = "base"
+	switch unmarshaler.Type {
+	case "fern":
+		u.fern = "fern"
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		var marshaler = struct {
+			Type     string                   `json:"type"`
+			Tags     *core.Optional[[]string] `json:"tags,omitempty"`
+			Label    *core.Optional[string]   `json:"label,omitempty"`
+			Extended string                   `json:"extended"`
+			Base     string                   `json:"base"`
+			Fern     string                   `json:"value,omitempty"`
+		}{
+			Type:     u.Type,
+			Tags:     u.Tags,
+			Label:    u.Label,
+			Extended: "extended",
+			Base:     "base",
+			Fern:     "fern",
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitFern(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		return visitor.VisitFern(u.fern)
+	}
+}
+
+// Validate reports all of the UnionWithLiteral's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithLiteral) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "fern":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithPrimitive struct {
+	Type    string
+	Boolean bool
+	String  string
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithPrimitiveFromBoolean(value bool) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "boolean", Boolean: value}
+}
+
+func NewUnionWithPrimitiveFromString(value string) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "string", String: value}
+}
+
+func (u *UnionWithPrimitive) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithPrimitive) GetBoolean() bool {
+	if u == nil {
+		return false
+	}
+	return u.Boolean
+}
+
+func (u *UnionWithPrimitive) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *UnionWithPrimitive) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "boolean":
+		var valueUnmarshaler struct {
+			Boolean bool `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Boolean = valueUnmarshaler.Boolean
+	case "string":
+		var valueUnmarshaler struct {
+			String string `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.String = valueUnmarshaler.String
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithPrimitive) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		var marshaler = struct {
+			Type    string `json:"type"`
+			Boolean bool   `json:"value"`
+		}{
+			Type:    u.Type,
+			Boolean: u.Boolean,
+		}
+		return json.Marshal(marshaler)
+	case "string":
+		var marshaler = struct {
+			Type   string `json:"type"`
+			String string `json:"value"`
+		}{
+			Type:   u.Type,
+			String: u.String,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithPrimitiveVisitor interface {
+	VisitBoolean(bool) error
+	VisitString(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithPrimitive) Accept(visitor UnionWithPrimitiveVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		return visitor.VisitBoolean(u.Boolean)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
+
+// Validate reports all of the UnionWithPrimitive's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithPrimitive) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "boolean":
+	case "string":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithUnknown struct {
+	Type    string
+	Foo     *Foo
+	Unknown interface{}
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithUnknownFromFoo(value *Foo) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "foo", Foo: value}
+}
+
+func NewUnionWithUnknownFromUnknown(value interface{}) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "unknown", Unknown: value}
+}
+
+func (u *UnionWithUnknown) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithUnknown) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithUnknown) GetUnknown() interface{} {
+	if u == nil {
+		return nil
+	}
+	return u.Unknown
+}
+
+func (u *UnionWithUnknown) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Foo = value
+	case "unknown":
+		value := make(map[string]interface{})
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Unknown = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithUnknown) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+		}{
+			Type: u.Type,
+		}
+		return core.MergeJSON(marshaler, u.Foo)
+	case "unknown":
+		var marshaler = struct {
+			Type    string      `json:"type"`
+			Unknown interface{} `json:"unknown,omitempty"`
+		}{
+			Type:    u.Type,
+			Unknown: u.Unknown,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithUnknownVisitor interface {
+	VisitFoo(*Foo) error
+	VisitUnknown(interface{}) error
+	// VisitUnknownValue is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknownValue(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithUnknown) Accept(visitor UnionWithUnknownVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknownValue(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "unknown":
+		return visitor.VisitUnknown(u.Unknown)
+	}
+}
+
+// Validate reports all of the UnionWithUnknown's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithUnknown) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "unknown":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithoutKey struct {
+	Type string
+	Foo  *Foo
+	// This is a bar field.
+	Bar *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithoutKeyFromFoo(value *Foo) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "foo", Foo: value}
+}
+
+func NewUnionWithoutKeyFromBar(value *Bar) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "bar", Bar: value}
+}
+
+func (u *UnionWithoutKey) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithoutKey) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithoutKey) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *UnionWithoutKey) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Foo = value
+	case "bar":
+		value := new(Bar)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Bar = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithoutKey) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+		}{
+			Type: u.Type,
+		}
+		return core.MergeJSON(marshaler, u.Foo)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Bar
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithoutKeyVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithoutKey) Accept(visitor UnionWithoutKeyVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the UnionWithoutKey's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithoutKey) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
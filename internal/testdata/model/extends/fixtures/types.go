@@ -9,7 +9,7 @@ import (
 )
 
 type Docs struct {
-	Docs string `json:"docs"`
+	Docs string `json:"docs" url:"docs"`
 }
 
 func (d *Docs) String() string {
@@ -19,9 +19,15 @@ func (d *Docs) String() string {
 	return fmt.Sprintf("%#v", d)
 }
 
+// Validate reports all of the Docs's invalid fields (e.g. missing
+// required fields), if any.
+func (d *Docs) Validate() error {
+	return nil
+}
+
 type ExampleType struct {
-	Docs string `json:"docs"`
-	Name string `json:"name"`
+	Docs string `json:"docs" url:"docs"`
+	Name string `json:"name" url:"name"`
 }
 
 func (e *ExampleType) String() string {
@@ -31,9 +37,15 @@ func (e *ExampleType) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleType's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleType) Validate() error {
+	return nil
+}
+
 type Json struct {
-	Docs string `json:"docs"`
-	Raw  string `json:"raw"`
+	Docs string `json:"docs" url:"docs"`
+	Raw  string `json:"raw" url:"raw"`
 }
 
 func (j *Json) String() string {
@@ -43,10 +55,16 @@ func (j *Json) String() string {
 	return fmt.Sprintf("%#v", j)
 }
 
+// Validate reports all of the Json's invalid fields (e.g. missing
+// required fields), if any.
+func (j *Json) Validate() error {
+	return nil
+}
+
 type NestedType struct {
-	Docs string `json:"docs"`
-	Raw  string `json:"raw"`
-	Name string `json:"name"`
+	Docs string `json:"docs" url:"docs"`
+	Raw  string `json:"raw" url:"raw"`
+	Name string `json:"name" url:"name"`
 }
 
 func (n *NestedType) String() string {
@@ -56,11 +74,19 @@ func (n *NestedType) String() string {
 	return fmt.Sprintf("%#v", n)
 }
 
+// Validate reports all of the NestedType's invalid fields (e.g. missing
+// required fields), if any.
+func (n *NestedType) Validate() error {
+	return nil
+}
+
 type NestedUnion struct {
 	Type string
 	Docs string
 	Raw  string
 	One  *ExampleType
+
+	_unknown json.RawMessage
 }
 
 func NewNestedUnionFromOne(value *ExampleType) *NestedUnion {
@@ -86,6 +112,8 @@ func (n *NestedUnion) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.One = value
+	default:
+		n._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -93,6 +121,9 @@ func (n *NestedUnion) UnmarshalJSON(data []byte) error {
 func (n NestedUnion) MarshalJSON() ([]byte, error) {
 	switch n.Type {
 	default:
+		if n._unknown != nil {
+			return n._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", n.Type, n)
 	case "one":
 		var marshaler = struct {
@@ -112,21 +143,47 @@ func (n NestedUnion) MarshalJSON() ([]byte, error) {
 
 type NestedUnionVisitor interface {
 	VisitOne(*ExampleType) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (n *NestedUnion) Accept(visitor NestedUnionVisitor) error {
 	switch n.Type {
 	default:
+		if n._unknown != nil {
+			return visitor.VisitUnknown(n.Type, n._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", n.Type, n)
 	case "one":
 		return visitor.VisitOne(n.One)
 	}
 }
 
+// Validate reports all of the NestedUnion's invalid fields (e.g. missing
+// required fields), if any.
+func (n *NestedUnion) Validate() error {
+	if n == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch n.Type {
+	case "one":
+		validation.Add("", n.One.Validate())
+	default:
+		if n._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", n.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type Union struct {
 	Type string
 	Docs string
 	One  *ExampleType
+
+	_unknown json.RawMessage
 }
 
 func NewUnionFromOne(value *ExampleType) *Union {
@@ -150,6 +207,8 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.One = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -157,6 +216,9 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 func (u Union) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "one":
 		var marshaler = struct {
@@ -174,13 +236,37 @@ func (u Union) MarshalJSON() ([]byte, error) {
 
 type UnionVisitor interface {
 	VisitOne(*ExampleType) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *Union) Accept(visitor UnionVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "one":
 		return visitor.VisitOne(u.One)
 	}
 }
+
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "one":
+		validation.Add("", u.One.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
@@ -0,0 +1,1123 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/extra-properties/fixtures/core"
+)
+
+type Bar struct {
+	Name string `json:"name" url:"name"`
+}
+
+// Equal reports whether the Bar is equal to the other Bar.
+func (b *Bar) Equal(other *Bar) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Bar.
+func (b *Bar) DeepCopy() *Bar {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	return nil
+}
+
+type Baz struct {
+	extended string
+
+	// ExtraProperties contains the properties that aren't recognized
+	// by the Baz, which are included when it's marshaled.
+	ExtraProperties map[string]interface{} `json:"-"`
+}
+
+func (b *Baz) GetExtraProperties() map[string]interface{} {
+	if b == nil {
+		return nil
+	}
+	return b.ExtraProperties
+}
+
+func (b *Baz) Extended() string {
+	return b.extended
+}
+
+// Equal reports whether the Baz is equal to the other Baz.
+func (b *Baz) Equal(other *Baz) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if len(b.ExtraProperties) != len(other.ExtraProperties) {
+		return false
+	}
+	for key, value := range b.ExtraProperties {
+		otherValue, ok := other.ExtraProperties[key]
+		if !ok {
+			return false
+		}
+		if !core.EqualValue(value, otherValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Baz.
+func (b *Baz) DeepCopy() *Baz {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	if b.ExtraProperties != nil {
+		copied.ExtraProperties = make(map[string]interface{}, len(b.ExtraProperties))
+		for key, value := range b.ExtraProperties {
+			copied.ExtraProperties[key] = core.DeepCopyValue(value)
+		}
+	}
+	return &copied
+}
+
+func (b *Baz) UnmarshalJSON(data []byte) error {
+	type unmarshaler Baz
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Baz(value)
+	b.extended = "extended"
+	extraProperties, err := core.ExtractExtraProperties(data, "extended")
+	if err != nil {
+		return err
+	}
+	b.ExtraProperties = extraProperties
+	return nil
+}
+
+func (b *Baz) MarshalJSON() ([]byte, error) {
+	type embed Baz
+	var marshaler = struct {
+		embed
+		Extended string `json:"extended"`
+	}{
+		embed:    embed(*b),
+		Extended: "extended",
+	}
+	return core.MarshalJSONWithExtraProperties(marshaler, b.ExtraProperties)
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Baz's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Baz) Validate() error {
+	return nil
+}
+
+type Foo struct {
+	Name string `json:"name" url:"name"`
+
+	// ExtraProperties contains the properties that aren't recognized
+	// by the Foo, which are included when it's marshaled.
+	ExtraProperties map[string]interface{} `json:"-"`
+}
+
+func (f *Foo) GetExtraProperties() map[string]interface{} {
+	if f == nil {
+		return nil
+	}
+	return f.ExtraProperties
+}
+
+// Equal reports whether the Foo is equal to the other Foo.
+func (f *Foo) Equal(other *Foo) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if f.Name != other.Name {
+		return false
+	}
+	if len(f.ExtraProperties) != len(other.ExtraProperties) {
+		return false
+	}
+	for key, value := range f.ExtraProperties {
+		otherValue, ok := other.ExtraProperties[key]
+		if !ok {
+			return false
+		}
+		if !core.EqualValue(value, otherValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Foo.
+func (f *Foo) DeepCopy() *Foo {
+	if f == nil {
+		return nil
+	}
+	copied := *f
+	if f.ExtraProperties != nil {
+		copied.ExtraProperties = make(map[string]interface{}, len(f.ExtraProperties))
+		for key, value := range f.ExtraProperties {
+			copied.ExtraProperties[key] = core.DeepCopyValue(value)
+		}
+	}
+	return &copied
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	type unmarshaler Foo
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Foo(value)
+	extraProperties, err := core.ExtractExtraProperties(data, "name")
+	if err != nil {
+		return err
+	}
+	f.ExtraProperties = extraProperties
+	return nil
+}
+
+func (f *Foo) MarshalJSON() ([]byte, error) {
+	type embed Foo
+	var marshaler = struct {
+		embed
+	}{
+		embed: embed(*f),
+	}
+	return core.MarshalJSONWithExtraProperties(marshaler, f.ExtraProperties)
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
+
+// This is a simple union.
+type Union struct {
+	Type string
+	Foo  *Foo
+	Bar  *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+// Equal reports whether the Union is equal to the other Union.
+func (u *Union) Equal(other *Union) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Union.
+func (u *Union) DeepCopy() *Union {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		if u.Foo == nil {
+			validation.Required("foo")
+		}
+		validation.Add("foo", u.Foo.Validate())
+	case "bar":
+		if u.Bar == nil {
+			validation.Required("bar")
+		}
+		validation.Add("bar", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithDiscriminant struct {
+	Type string
+	// This is a Foo field.
+	Foo *Foo
+	Bar *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithDiscriminantFromFoo(value *Foo) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "foo", Foo: value}
+}
+
+func NewUnionWithDiscriminantFromBar(value *Bar) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "bar", Bar: value}
+}
+
+// Equal reports whether the UnionWithDiscriminant is equal to the other UnionWithDiscriminant.
+func (u *UnionWithDiscriminant) Equal(other *UnionWithDiscriminant) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithDiscriminant.
+func (u *UnionWithDiscriminant) DeepCopy() *UnionWithDiscriminant {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithDiscriminant) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"_type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithDiscriminant) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"_type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"_type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithDiscriminantVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithDiscriminant) Accept(visitor UnionWithDiscriminantVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the UnionWithDiscriminant's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithDiscriminant) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		if u.Foo == nil {
+			validation.Required("foo")
+		}
+		validation.Add("foo", u.Foo.Validate())
+	case "bar":
+		if u.Bar == nil {
+			validation.Required("bar")
+		}
+		validation.Add("bar", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithLiteral struct {
+	Type     string
+	fern     string
+	extended string
+	base     string
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithLiteralWithFern() *UnionWithLiteral {
+	return &UnionWithLiteral{Type: "fern", fern: "fern"}
+}
+
+func (u *UnionWithLiteral) Extended() string {
+	return u.extended
+}
+
+func (u *UnionWithLiteral) Base() string {
+	return u.base
+}
+
+func (u *UnionWithLiteral) Fern() string {
+	return u.fern
+}
+
+// Equal reports whether the UnionWithLiteral is equal to the other UnionWithLiteral.
+func (u *UnionWithLiteral) Equal(other *UnionWithLiteral) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithLiteral.
+func (u *UnionWithLiteral) DeepCopy() *UnionWithLiteral {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	u.extended = "extended"
+	u.base = "base"
+	switch unmarshaler.Type {
+	case "fern":
+		u.fern = "fern"
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		var marshaler = struct {
+			Type     string `json:"type"`
+			Extended string `json:"extended"`
+			Base     string `json:"base"`
+			Fern     string `json:"value,omitempty"`
+		}{
+			Type:     u.Type,
+			Extended: "extended",
+			Base:     "base",
+			Fern:     "fern",
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitFern(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		return visitor.VisitFern(u.fern)
+	}
+}
+
+// Validate reports all of the UnionWithLiteral's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithLiteral) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "fern":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithPrimitive struct {
+	Type    string
+	Boolean bool
+	String  string
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithPrimitiveFromBoolean(value bool) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "boolean", Boolean: value}
+}
+
+func NewUnionWithPrimitiveFromString(value string) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "string", String: value}
+}
+
+// Equal reports whether the UnionWithPrimitive is equal to the other UnionWithPrimitive.
+func (u *UnionWithPrimitive) Equal(other *UnionWithPrimitive) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if u.Boolean != other.Boolean {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithPrimitive.
+func (u *UnionWithPrimitive) DeepCopy() *UnionWithPrimitive {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithPrimitive) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "boolean":
+		var valueUnmarshaler struct {
+			Boolean bool `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Boolean = valueUnmarshaler.Boolean
+	case "string":
+		var valueUnmarshaler struct {
+			String string `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.String = valueUnmarshaler.String
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithPrimitive) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		var marshaler = struct {
+			Type    string `json:"type"`
+			Boolean bool   `json:"value"`
+		}{
+			Type:    u.Type,
+			Boolean: u.Boolean,
+		}
+		return json.Marshal(marshaler)
+	case "string":
+		var marshaler = struct {
+			Type   string `json:"type"`
+			String string `json:"value"`
+		}{
+			Type:   u.Type,
+			String: u.String,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithPrimitiveVisitor interface {
+	VisitBoolean(bool) error
+	VisitString(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithPrimitive) Accept(visitor UnionWithPrimitiveVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		return visitor.VisitBoolean(u.Boolean)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
+
+// Validate reports all of the UnionWithPrimitive's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithPrimitive) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "boolean":
+	case "string":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithUnknown struct {
+	Type    string
+	Foo     *Foo
+	Unknown interface{}
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithUnknownFromFoo(value *Foo) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "foo", Foo: value}
+}
+
+func NewUnionWithUnknownFromUnknown(value interface{}) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "unknown", Unknown: value}
+}
+
+// Equal reports whether the UnionWithUnknown is equal to the other UnionWithUnknown.
+func (u *UnionWithUnknown) Equal(other *UnionWithUnknown) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !core.EqualValue(u.Unknown, other.Unknown) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithUnknown.
+func (u *UnionWithUnknown) DeepCopy() *UnionWithUnknown {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Unknown = core.DeepCopyValue(u.Unknown)
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithUnknown) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		core.DeleteExtraProperties(value.ExtraProperties, "type")
+		u.Foo = value
+	case "unknown":
+		value := make(map[string]interface{})
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Unknown = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithUnknown) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+		}{
+			Type: u.Type,
+		}
+		return core.MergeJSON(marshaler, u.Foo)
+	case "unknown":
+		var marshaler = struct {
+			Type    string      `json:"type"`
+			Unknown interface{} `json:"unknown,omitempty"`
+		}{
+			Type:    u.Type,
+			Unknown: u.Unknown,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithUnknownVisitor interface {
+	VisitFoo(*Foo) error
+	VisitUnknown(interface{}) error
+	// VisitUnknownValue is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknownValue(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithUnknown) Accept(visitor UnionWithUnknownVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknownValue(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "unknown":
+		return visitor.VisitUnknown(u.Unknown)
+	}
+}
+
+// Validate reports all of the UnionWithUnknown's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithUnknown) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "unknown":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithoutKey struct {
+	Type string
+	Foo  *Foo
+	// This is a bar field.
+	Bar *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithoutKeyFromFoo(value *Foo) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "foo", Foo: value}
+}
+
+func NewUnionWithoutKeyFromBar(value *Bar) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "bar", Bar: value}
+}
+
+// Equal reports whether the UnionWithoutKey is equal to the other UnionWithoutKey.
+func (u *UnionWithoutKey) Equal(other *UnionWithoutKey) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithoutKey.
+func (u *UnionWithoutKey) DeepCopy() *UnionWithoutKey {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithoutKey) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		core.DeleteExtraProperties(value.ExtraProperties, "type")
+		u.Foo = value
+	case "bar":
+		value := new(Bar)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Bar = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithoutKey) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+		}{
+			Type: u.Type,
+		}
+		return core.MergeJSON(marshaler, u.Foo)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Bar
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithoutKeyVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithoutKey) Accept(visitor UnionWithoutKeyVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the UnionWithoutKey's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithoutKey) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
@@ -10,9 +10,9 @@ import (
 )
 
 type Foo struct {
-	Name string    `json:"name"`
-	Bar  *bar.Bar  `json:"bar,omitempty"`
-	Uuid uuid.UUID `json:"uuid"`
+	Name string    `json:"name" url:"name"`
+	Bar  *bar.Bar  `json:"bar,omitempty" url:"bar"`
+	Uuid uuid.UUID `json:"uuid" url:"uuid"`
 }
 
 func (f *Foo) String() string {
@@ -21,3 +21,17 @@ func (f *Foo) String() string {
 	}
 	return fmt.Sprintf("%#v", f)
 }
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	if f.Bar == nil {
+		validation.Required("bar")
+	}
+	validation.Add("bar", f.Bar.Validate())
+	return validation.Err()
+}
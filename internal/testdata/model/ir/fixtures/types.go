@@ -11,9 +11,9 @@ import (
 )
 
 type ApiAuth struct {
-	Docs        *string                `json:"docs,omitempty"`
-	Requirement AuthSchemesRequirement `json:"requirement,omitempty"`
-	Schemes     []*AuthScheme          `json:"schemes,omitempty"`
+	Docs        *string                `json:"docs,omitempty" url:"docs,omitempty"`
+	Requirement AuthSchemesRequirement `json:"requirement,omitempty" url:"requirement"`
+	Schemes     []*AuthScheme          `json:"schemes,omitempty" url:"schemes"`
 }
 
 func (a *ApiAuth) String() string {
@@ -23,11 +23,27 @@ func (a *ApiAuth) String() string {
 	return fmt.Sprintf("%#v", a)
 }
 
+// Validate reports all of the ApiAuth's invalid fields (e.g. missing
+// required fields), if any.
+func (a *ApiAuth) Validate() error {
+	if a == nil {
+		return nil
+	}
+	var validation core.Validation
+	validation.Add("requirement", a.Requirement.Validate())
+	for index, value := range a.Schemes {
+		validation.Add(fmt.Sprintf("schemes[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type AuthScheme struct {
 	Type   string
 	Bearer *BearerAuthScheme
 	Basic  *BasicAuthScheme
 	Header *HeaderAuthScheme
+
+	_unknown json.RawMessage
 }
 
 func NewAuthSchemeFromBearer(value *BearerAuthScheme) *AuthScheme {
@@ -69,6 +85,8 @@ func (a *AuthScheme) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		a.Header = value
+	default:
+		a._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -76,6 +94,9 @@ func (a *AuthScheme) UnmarshalJSON(data []byte) error {
 func (a AuthScheme) MarshalJSON() ([]byte, error) {
 	switch a.Type {
 	default:
+		if a._unknown != nil {
+			return a._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", a.Type, a)
 	case "bearer":
 		var marshaler = struct {
@@ -111,11 +132,17 @@ type AuthSchemeVisitor interface {
 	VisitBearer(*BearerAuthScheme) error
 	VisitBasic(*BasicAuthScheme) error
 	VisitHeader(*HeaderAuthScheme) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (a *AuthScheme) Accept(visitor AuthSchemeVisitor) error {
 	switch a.Type {
 	default:
+		if a._unknown != nil {
+			return visitor.VisitUnknown(a.Type, a._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", a.Type, a)
 	case "bearer":
 		return visitor.VisitBearer(a.Bearer)
@@ -126,6 +153,28 @@ func (a *AuthScheme) Accept(visitor AuthSchemeVisitor) error {
 	}
 }
 
+// Validate reports all of the AuthScheme's invalid fields (e.g. missing
+// required fields), if any.
+func (a *AuthScheme) Validate() error {
+	if a == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch a.Type {
+	case "bearer":
+		validation.Add("", a.Bearer.Validate())
+	case "basic":
+		validation.Add("", a.Basic.Validate())
+	case "header":
+		validation.Add("", a.Header.Validate())
+	default:
+		if a._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", a.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type AuthSchemesRequirement string
 
 const (
@@ -148,10 +197,16 @@ func (a AuthSchemesRequirement) Ptr() *AuthSchemesRequirement {
 	return &a
 }
 
+// Validate returns an error if the AuthSchemesRequirement isn't one of its known values.
+func (a AuthSchemesRequirement) Validate() error {
+	_, err := NewAuthSchemesRequirementFromString(string(a))
+	return err
+}
+
 type BasicAuthScheme struct {
-	Docs     *string `json:"docs,omitempty"`
-	Username *Name   `json:"username,omitempty"`
-	Password *Name   `json:"password,omitempty"`
+	Docs     *string `json:"docs,omitempty" url:"docs,omitempty"`
+	Username *Name   `json:"username,omitempty" url:"username"`
+	Password *Name   `json:"password,omitempty" url:"password"`
 }
 
 func (b *BasicAuthScheme) String() string {
@@ -161,9 +216,27 @@ func (b *BasicAuthScheme) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the BasicAuthScheme's invalid fields (e.g. missing
+// required fields), if any.
+func (b *BasicAuthScheme) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var validation core.Validation
+	if b.Username == nil {
+		validation.Required("username")
+	}
+	validation.Add("username", b.Username.Validate())
+	if b.Password == nil {
+		validation.Required("password")
+	}
+	validation.Add("password", b.Password.Validate())
+	return validation.Err()
+}
+
 type BearerAuthScheme struct {
-	Docs  *string `json:"docs,omitempty"`
-	Token *Name   `json:"token,omitempty"`
+	Docs  *string `json:"docs,omitempty" url:"docs,omitempty"`
+	Token *Name   `json:"token,omitempty" url:"token"`
 }
 
 func (b *BearerAuthScheme) String() string {
@@ -173,11 +246,25 @@ func (b *BearerAuthScheme) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the BearerAuthScheme's invalid fields (e.g. missing
+// required fields), if any.
+func (b *BearerAuthScheme) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var validation core.Validation
+	if b.Token == nil {
+		validation.Required("token")
+	}
+	validation.Add("token", b.Token.Validate())
+	return validation.Err()
+}
+
 type HeaderAuthScheme struct {
-	Docs      *string           `json:"docs,omitempty"`
-	Name      *NameAndWireValue `json:"name,omitempty"`
-	ValueType *TypeReference    `json:"valueType,omitempty"`
-	Prefix    *string           `json:"prefix,omitempty"`
+	Docs      *string           `json:"docs,omitempty" url:"docs,omitempty"`
+	Name      *NameAndWireValue `json:"name,omitempty" url:"name"`
+	ValueType *TypeReference    `json:"valueType,omitempty" url:"valueType"`
+	Prefix    *string           `json:"prefix,omitempty" url:"prefix,omitempty"`
 }
 
 func (h *HeaderAuthScheme) String() string {
@@ -187,9 +274,27 @@ func (h *HeaderAuthScheme) String() string {
 	return fmt.Sprintf("%#v", h)
 }
 
+// Validate reports all of the HeaderAuthScheme's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HeaderAuthScheme) Validate() error {
+	if h == nil {
+		return nil
+	}
+	var validation core.Validation
+	if h.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", h.Name.Validate())
+	if h.ValueType == nil {
+		validation.Required("valueType")
+	}
+	validation.Add("valueType", h.ValueType.Validate())
+	return validation.Err()
+}
+
 type Availability struct {
-	Status  AvailabilityStatus `json:"status,omitempty"`
-	Message *string            `json:"message,omitempty"`
+	Status  AvailabilityStatus `json:"status,omitempty" url:"status"`
+	Message *string            `json:"message,omitempty" url:"message,omitempty"`
 }
 
 func (a *Availability) String() string {
@@ -199,6 +304,17 @@ func (a *Availability) String() string {
 	return fmt.Sprintf("%#v", a)
 }
 
+// Validate reports all of the Availability's invalid fields (e.g. missing
+// required fields), if any.
+func (a *Availability) Validate() error {
+	if a == nil {
+		return nil
+	}
+	var validation core.Validation
+	validation.Add("status", a.Status.Validate())
+	return validation.Err()
+}
+
 type AvailabilityStatus string
 
 const (
@@ -227,9 +343,15 @@ func (a AvailabilityStatus) Ptr() *AvailabilityStatus {
 	return &a
 }
 
+// Validate returns an error if the AvailabilityStatus isn't one of its known values.
+func (a AvailabilityStatus) Validate() error {
+	_, err := NewAvailabilityStatusFromString(string(a))
+	return err
+}
+
 type Declaration struct {
-	Docs         *string       `json:"docs,omitempty"`
-	Availability *Availability `json:"availability,omitempty"`
+	Docs         *string       `json:"docs,omitempty" url:"docs,omitempty"`
+	Availability *Availability `json:"availability,omitempty" url:"availability"`
 }
 
 func (d *Declaration) String() string {
@@ -239,12 +361,26 @@ func (d *Declaration) String() string {
 	return fmt.Sprintf("%#v", d)
 }
 
+// Validate reports all of the Declaration's invalid fields (e.g. missing
+// required fields), if any.
+func (d *Declaration) Validate() error {
+	if d == nil {
+		return nil
+	}
+	var validation core.Validation
+	if d.Availability == nil {
+		validation.Required("availability")
+	}
+	validation.Add("availability", d.Availability.Validate())
+	return validation.Err()
+}
+
 type ErrorId = string
 
 type FernFilepath struct {
-	AllParts    []*Name `json:"allParts,omitempty"`
-	PackagePath []*Name `json:"packagePath,omitempty"`
-	File        *Name   `json:"file,omitempty"`
+	AllParts    []*Name `json:"allParts,omitempty" url:"allParts"`
+	PackagePath []*Name `json:"packagePath,omitempty" url:"packagePath"`
+	File        *Name   `json:"file,omitempty" url:"file,omitempty"`
 }
 
 func (f *FernFilepath) String() string {
@@ -254,12 +390,29 @@ func (f *FernFilepath) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the FernFilepath's invalid fields (e.g. missing
+// required fields), if any.
+func (f *FernFilepath) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range f.AllParts {
+		validation.Add(fmt.Sprintf("allParts[%d]", index), value.Validate())
+	}
+	for index, value := range f.PackagePath {
+		validation.Add(fmt.Sprintf("packagePath[%d]", index), value.Validate())
+	}
+	validation.Add("file", f.File.Validate())
+	return validation.Err()
+}
+
 type Name struct {
-	OriginalName       string               `json:"originalName"`
-	CamelCase          *SafeAndUnsafeString `json:"camelCase,omitempty"`
-	PascalCase         *SafeAndUnsafeString `json:"pascalCase,omitempty"`
-	SnakeCase          *SafeAndUnsafeString `json:"snakeCase,omitempty"`
-	ScreamingSnakeCase *SafeAndUnsafeString `json:"screamingSnakeCase,omitempty"`
+	OriginalName       string               `json:"originalName" url:"originalName"`
+	CamelCase          *SafeAndUnsafeString `json:"camelCase,omitempty" url:"camelCase"`
+	PascalCase         *SafeAndUnsafeString `json:"pascalCase,omitempty" url:"pascalCase"`
+	SnakeCase          *SafeAndUnsafeString `json:"snakeCase,omitempty" url:"snakeCase"`
+	ScreamingSnakeCase *SafeAndUnsafeString `json:"screamingSnakeCase,omitempty" url:"screamingSnakeCase"`
 }
 
 func (n *Name) String() string {
@@ -269,9 +422,35 @@ func (n *Name) String() string {
 	return fmt.Sprintf("%#v", n)
 }
 
+// Validate reports all of the Name's invalid fields (e.g. missing
+// required fields), if any.
+func (n *Name) Validate() error {
+	if n == nil {
+		return nil
+	}
+	var validation core.Validation
+	if n.CamelCase == nil {
+		validation.Required("camelCase")
+	}
+	validation.Add("camelCase", n.CamelCase.Validate())
+	if n.PascalCase == nil {
+		validation.Required("pascalCase")
+	}
+	validation.Add("pascalCase", n.PascalCase.Validate())
+	if n.SnakeCase == nil {
+		validation.Required("snakeCase")
+	}
+	validation.Add("snakeCase", n.SnakeCase.Validate())
+	if n.ScreamingSnakeCase == nil {
+		validation.Required("screamingSnakeCase")
+	}
+	validation.Add("screamingSnakeCase", n.ScreamingSnakeCase.Validate())
+	return validation.Err()
+}
+
 type NameAndWireValue struct {
-	WireValue string `json:"wireValue"`
-	Name      *Name  `json:"name,omitempty"`
+	WireValue string `json:"wireValue" url:"wireValue"`
+	Name      *Name  `json:"name,omitempty" url:"name"`
 }
 
 func (n *NameAndWireValue) String() string {
@@ -281,11 +460,25 @@ func (n *NameAndWireValue) String() string {
 	return fmt.Sprintf("%#v", n)
 }
 
+// Validate reports all of the NameAndWireValue's invalid fields (e.g. missing
+// required fields), if any.
+func (n *NameAndWireValue) Validate() error {
+	if n == nil {
+		return nil
+	}
+	var validation core.Validation
+	if n.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", n.Name.Validate())
+	return validation.Err()
+}
+
 type SafeAndUnsafeString struct {
 	// this name might overlap with reserved keywords of the language being generated
-	UnsafeName string `json:"unsafeName"`
+	UnsafeName string `json:"unsafeName" url:"unsafeName"`
 	// this name will NOT overlap with reserved keywords of the language being generated
-	SafeName string `json:"safeName"`
+	SafeName string `json:"safeName" url:"safeName"`
 }
 
 func (s *SafeAndUnsafeString) String() string {
@@ -295,6 +488,12 @@ func (s *SafeAndUnsafeString) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SafeAndUnsafeString's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SafeAndUnsafeString) Validate() error {
+	return nil
+}
+
 type ServiceId = string
 
 type SubpackageId = string
@@ -302,7 +501,7 @@ type SubpackageId = string
 type TypeId = string
 
 type WithDocs struct {
-	Docs *string `json:"docs,omitempty"`
+	Docs *string `json:"docs,omitempty" url:"docs,omitempty"`
 }
 
 func (w *WithDocs) String() string {
@@ -312,8 +511,14 @@ func (w *WithDocs) String() string {
 	return fmt.Sprintf("%#v", w)
 }
 
+// Validate reports all of the WithDocs's invalid fields (e.g. missing
+// required fields), if any.
+func (w *WithDocs) Validate() error {
+	return nil
+}
+
 type WithJsonExample struct {
-	JsonExample interface{} `json:"jsonExample,omitempty"`
+	JsonExample interface{} `json:"jsonExample,omitempty" url:"jsonExample"`
 }
 
 func (w *WithJsonExample) String() string {
@@ -323,8 +528,14 @@ func (w *WithJsonExample) String() string {
 	return fmt.Sprintf("%#v", w)
 }
 
+// Validate reports all of the WithJsonExample's invalid fields (e.g. missing
+// required fields), if any.
+func (w *WithJsonExample) Validate() error {
+	return nil
+}
+
 type Constants struct {
-	ErrorInstanceIdKey *NameAndWireValue `json:"errorInstanceIdKey,omitempty"`
+	ErrorInstanceIdKey *NameAndWireValue `json:"errorInstanceIdKey,omitempty" url:"errorInstanceIdKey"`
 }
 
 func (c *Constants) String() string {
@@ -334,11 +545,25 @@ func (c *Constants) String() string {
 	return fmt.Sprintf("%#v", c)
 }
 
+// Validate reports all of the Constants's invalid fields (e.g. missing
+// required fields), if any.
+func (c *Constants) Validate() error {
+	if c == nil {
+		return nil
+	}
+	var validation core.Validation
+	if c.ErrorInstanceIdKey == nil {
+		validation.Required("errorInstanceIdKey")
+	}
+	validation.Add("errorInstanceIdKey", c.ErrorInstanceIdKey.Validate())
+	return validation.Err()
+}
+
 type EnvironmentBaseUrlId = string
 
 type EnvironmentBaseUrlWithId struct {
-	Id   EnvironmentBaseUrlId `json:"id"`
-	Name *Name                `json:"name,omitempty"`
+	Id   EnvironmentBaseUrlId `json:"id" url:"id"`
+	Name *Name                `json:"name,omitempty" url:"name"`
 }
 
 func (e *EnvironmentBaseUrlWithId) String() string {
@@ -348,6 +573,20 @@ func (e *EnvironmentBaseUrlWithId) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the EnvironmentBaseUrlWithId's invalid fields (e.g. missing
+// required fields), if any.
+func (e *EnvironmentBaseUrlWithId) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", e.Name.Validate())
+	return validation.Err()
+}
+
 type EnvironmentId = string
 
 type EnvironmentUrl = string
@@ -356,6 +595,8 @@ type Environments struct {
 	Type             string
 	SingleBaseUrl    *SingleBaseUrlEnvironments
 	MultipleBaseUrls *MultipleBaseUrlsEnvironments
+
+	_unknown json.RawMessage
 }
 
 func NewEnvironmentsFromSingleBaseUrl(value *SingleBaseUrlEnvironments) *Environments {
@@ -387,6 +628,8 @@ func (e *Environments) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.MultipleBaseUrls = value
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -394,6 +637,9 @@ func (e *Environments) UnmarshalJSON(data []byte) error {
 func (e Environments) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "singleBaseUrl":
 		var marshaler = struct {
@@ -419,11 +665,17 @@ func (e Environments) MarshalJSON() ([]byte, error) {
 type EnvironmentsVisitor interface {
 	VisitSingleBaseUrl(*SingleBaseUrlEnvironments) error
 	VisitMultipleBaseUrls(*MultipleBaseUrlsEnvironments) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *Environments) Accept(visitor EnvironmentsVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "singleBaseUrl":
 		return visitor.VisitSingleBaseUrl(e.SingleBaseUrl)
@@ -432,9 +684,29 @@ func (e *Environments) Accept(visitor EnvironmentsVisitor) error {
 	}
 }
 
+// Validate reports all of the Environments's invalid fields (e.g. missing
+// required fields), if any.
+func (e *Environments) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "singleBaseUrl":
+		validation.Add("", e.SingleBaseUrl.Validate())
+	case "multipleBaseUrls":
+		validation.Add("", e.MultipleBaseUrls.Validate())
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type EnvironmentsConfig struct {
-	DefaultEnvironment *EnvironmentId `json:"defaultEnvironment,omitempty"`
-	Environments       *Environments  `json:"environments,omitempty"`
+	DefaultEnvironment *EnvironmentId `json:"defaultEnvironment,omitempty" url:"defaultEnvironment,omitempty"`
+	Environments       *Environments  `json:"environments,omitempty" url:"environments"`
 }
 
 func (e *EnvironmentsConfig) String() string {
@@ -444,11 +716,25 @@ func (e *EnvironmentsConfig) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the EnvironmentsConfig's invalid fields (e.g. missing
+// required fields), if any.
+func (e *EnvironmentsConfig) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Environments == nil {
+		validation.Required("environments")
+	}
+	validation.Add("environments", e.Environments.Validate())
+	return validation.Err()
+}
+
 type MultipleBaseUrlsEnvironment struct {
-	Docs *string                                 `json:"docs,omitempty"`
-	Id   EnvironmentId                           `json:"id"`
-	Name *Name                                   `json:"name,omitempty"`
-	Urls map[EnvironmentBaseUrlId]EnvironmentUrl `json:"urls,omitempty"`
+	Docs *string                                 `json:"docs,omitempty" url:"docs,omitempty"`
+	Id   EnvironmentId                           `json:"id" url:"id"`
+	Name *Name                                   `json:"name,omitempty" url:"name"`
+	Urls map[EnvironmentBaseUrlId]EnvironmentUrl `json:"urls,omitempty" url:"urls"`
 }
 
 func (m *MultipleBaseUrlsEnvironment) String() string {
@@ -458,9 +744,23 @@ func (m *MultipleBaseUrlsEnvironment) String() string {
 	return fmt.Sprintf("%#v", m)
 }
 
+// Validate reports all of the MultipleBaseUrlsEnvironment's invalid fields (e.g. missing
+// required fields), if any.
+func (m *MultipleBaseUrlsEnvironment) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var validation core.Validation
+	if m.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", m.Name.Validate())
+	return validation.Err()
+}
+
 type MultipleBaseUrlsEnvironments struct {
-	BaseUrls     []*EnvironmentBaseUrlWithId    `json:"baseUrls,omitempty"`
-	Environments []*MultipleBaseUrlsEnvironment `json:"environments,omitempty"`
+	BaseUrls     []*EnvironmentBaseUrlWithId    `json:"baseUrls,omitempty" url:"baseUrls"`
+	Environments []*MultipleBaseUrlsEnvironment `json:"environments,omitempty" url:"environments"`
 }
 
 func (m *MultipleBaseUrlsEnvironments) String() string {
@@ -470,11 +770,27 @@ func (m *MultipleBaseUrlsEnvironments) String() string {
 	return fmt.Sprintf("%#v", m)
 }
 
+// Validate reports all of the MultipleBaseUrlsEnvironments's invalid fields (e.g. missing
+// required fields), if any.
+func (m *MultipleBaseUrlsEnvironments) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range m.BaseUrls {
+		validation.Add(fmt.Sprintf("baseUrls[%d]", index), value.Validate())
+	}
+	for index, value := range m.Environments {
+		validation.Add(fmt.Sprintf("environments[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type SingleBaseUrlEnvironment struct {
-	Docs *string        `json:"docs,omitempty"`
-	Id   EnvironmentId  `json:"id"`
-	Name *Name          `json:"name,omitempty"`
-	Url  EnvironmentUrl `json:"url"`
+	Docs *string        `json:"docs,omitempty" url:"docs,omitempty"`
+	Id   EnvironmentId  `json:"id" url:"id"`
+	Name *Name          `json:"name,omitempty" url:"name"`
+	Url  EnvironmentUrl `json:"url" url:"url"`
 }
 
 func (s *SingleBaseUrlEnvironment) String() string {
@@ -484,8 +800,22 @@ func (s *SingleBaseUrlEnvironment) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SingleBaseUrlEnvironment's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SingleBaseUrlEnvironment) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", s.Name.Validate())
+	return validation.Err()
+}
+
 type SingleBaseUrlEnvironments struct {
-	Environments []*SingleBaseUrlEnvironment `json:"environments,omitempty"`
+	Environments []*SingleBaseUrlEnvironment `json:"environments,omitempty" url:"environments"`
 }
 
 func (s *SingleBaseUrlEnvironments) String() string {
@@ -495,10 +825,23 @@ func (s *SingleBaseUrlEnvironments) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SingleBaseUrlEnvironments's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SingleBaseUrlEnvironments) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range s.Environments {
+		validation.Add(fmt.Sprintf("environments[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type DeclaredErrorName struct {
-	ErrorId      ErrorId       `json:"errorId"`
-	FernFilepath *FernFilepath `json:"fernFilepath,omitempty"`
-	Name         *Name         `json:"name,omitempty"`
+	ErrorId      ErrorId       `json:"errorId" url:"errorId"`
+	FernFilepath *FernFilepath `json:"fernFilepath,omitempty" url:"fernFilepath"`
+	Name         *Name         `json:"name,omitempty" url:"name"`
 }
 
 func (d *DeclaredErrorName) String() string {
@@ -508,12 +851,30 @@ func (d *DeclaredErrorName) String() string {
 	return fmt.Sprintf("%#v", d)
 }
 
+// Validate reports all of the DeclaredErrorName's invalid fields (e.g. missing
+// required fields), if any.
+func (d *DeclaredErrorName) Validate() error {
+	if d == nil {
+		return nil
+	}
+	var validation core.Validation
+	if d.FernFilepath == nil {
+		validation.Required("fernFilepath")
+	}
+	validation.Add("fernFilepath", d.FernFilepath.Validate())
+	if d.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", d.Name.Validate())
+	return validation.Err()
+}
+
 type ErrorDeclaration struct {
-	Docs              *string            `json:"docs,omitempty"`
-	Name              *DeclaredErrorName `json:"name,omitempty"`
-	DiscriminantValue *NameAndWireValue  `json:"discriminantValue,omitempty"`
-	Type              *TypeReference     `json:"type,omitempty"`
-	StatusCode        int                `json:"statusCode"`
+	Docs              *string            `json:"docs,omitempty" url:"docs,omitempty"`
+	Name              *DeclaredErrorName `json:"name,omitempty" url:"name"`
+	DiscriminantValue *NameAndWireValue  `json:"discriminantValue,omitempty" url:"discriminantValue"`
+	Type              *TypeReference     `json:"type,omitempty" url:"type,omitempty"`
+	StatusCode        int                `json:"statusCode" url:"statusCode"`
 }
 
 func (e *ErrorDeclaration) String() string {
@@ -523,10 +884,31 @@ func (e *ErrorDeclaration) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ErrorDeclaration's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ErrorDeclaration) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", e.Name.Validate())
+	if e.DiscriminantValue == nil {
+		validation.Required("discriminantValue")
+	}
+	validation.Add("discriminantValue", e.DiscriminantValue.Validate())
+	validation.Add("type", e.Type.Validate())
+	return validation.Err()
+}
+
 type ErrorDeclarationDiscriminantValue struct {
 	Type       string
 	Property   *NameAndWireValue
 	StatusCode interface{}
+
+	_unknown json.RawMessage
 }
 
 func NewErrorDeclarationDiscriminantValueFromProperty(value *NameAndWireValue) *ErrorDeclarationDiscriminantValue {
@@ -558,6 +940,8 @@ func (e *ErrorDeclarationDiscriminantValue) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.StatusCode = value
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -565,6 +949,9 @@ func (e *ErrorDeclarationDiscriminantValue) UnmarshalJSON(data []byte) error {
 func (e ErrorDeclarationDiscriminantValue) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "property":
 		var marshaler = struct {
@@ -590,11 +977,17 @@ func (e ErrorDeclarationDiscriminantValue) MarshalJSON() ([]byte, error) {
 type ErrorDeclarationDiscriminantValueVisitor interface {
 	VisitProperty(*NameAndWireValue) error
 	VisitStatusCode(interface{}) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *ErrorDeclarationDiscriminantValue) Accept(visitor ErrorDeclarationDiscriminantValueVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "property":
 		return visitor.VisitProperty(e.Property)
@@ -603,8 +996,27 @@ func (e *ErrorDeclarationDiscriminantValue) Accept(visitor ErrorDeclarationDiscr
 	}
 }
 
+// Validate reports all of the ErrorDeclarationDiscriminantValue's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ErrorDeclarationDiscriminantValue) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "property":
+		validation.Add("", e.Property.Validate())
+	case "statusCode":
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type DeclaredServiceName struct {
-	FernFilepath *FernFilepath `json:"fernFilepath,omitempty"`
+	FernFilepath *FernFilepath `json:"fernFilepath,omitempty" url:"fernFilepath"`
 }
 
 func (d *DeclaredServiceName) String() string {
@@ -614,20 +1026,34 @@ func (d *DeclaredServiceName) String() string {
 	return fmt.Sprintf("%#v", d)
 }
 
+// Validate reports all of the DeclaredServiceName's invalid fields (e.g. missing
+// required fields), if any.
+func (d *DeclaredServiceName) Validate() error {
+	if d == nil {
+		return nil
+	}
+	var validation core.Validation
+	if d.FernFilepath == nil {
+		validation.Required("fernFilepath")
+	}
+	validation.Add("fernFilepath", d.FernFilepath.Validate())
+	return validation.Err()
+}
+
 type EndpointName = *Name
 
 type ExampleEndpointCall struct {
-	Docs                   *string                  `json:"docs,omitempty"`
-	Name                   *Name                    `json:"name,omitempty"`
-	Url                    string                   `json:"url"`
-	RootPathParameters     []*ExamplePathParameter  `json:"rootPathParameters,omitempty"`
-	ServicePathParameters  []*ExamplePathParameter  `json:"servicePathParameters,omitempty"`
-	EndpointPathParameters []*ExamplePathParameter  `json:"endpointPathParameters,omitempty"`
-	ServiceHeaders         []*ExampleHeader         `json:"serviceHeaders,omitempty"`
-	EndpointHeaders        []*ExampleHeader         `json:"endpointHeaders,omitempty"`
-	QueryParameters        []*ExampleQueryParameter `json:"queryParameters,omitempty"`
-	Request                *ExampleRequestBody      `json:"request,omitempty"`
-	Response               *ExampleResponse         `json:"response,omitempty"`
+	Docs                   *string                  `json:"docs,omitempty" url:"docs,omitempty"`
+	Name                   *Name                    `json:"name,omitempty" url:"name,omitempty"`
+	Url                    string                   `json:"url" url:"url"`
+	RootPathParameters     []*ExamplePathParameter  `json:"rootPathParameters,omitempty" url:"rootPathParameters"`
+	ServicePathParameters  []*ExamplePathParameter  `json:"servicePathParameters,omitempty" url:"servicePathParameters"`
+	EndpointPathParameters []*ExamplePathParameter  `json:"endpointPathParameters,omitempty" url:"endpointPathParameters"`
+	ServiceHeaders         []*ExampleHeader         `json:"serviceHeaders,omitempty" url:"serviceHeaders"`
+	EndpointHeaders        []*ExampleHeader         `json:"endpointHeaders,omitempty" url:"endpointHeaders"`
+	QueryParameters        []*ExampleQueryParameter `json:"queryParameters,omitempty" url:"queryParameters"`
+	Request                *ExampleRequestBody      `json:"request,omitempty" url:"request,omitempty"`
+	Response               *ExampleResponse         `json:"response,omitempty" url:"response"`
 }
 
 func (e *ExampleEndpointCall) String() string {
@@ -637,9 +1063,43 @@ func (e *ExampleEndpointCall) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleEndpointCall's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleEndpointCall) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	validation.Add("name", e.Name.Validate())
+	for index, value := range e.RootPathParameters {
+		validation.Add(fmt.Sprintf("rootPathParameters[%d]", index), value.Validate())
+	}
+	for index, value := range e.ServicePathParameters {
+		validation.Add(fmt.Sprintf("servicePathParameters[%d]", index), value.Validate())
+	}
+	for index, value := range e.EndpointPathParameters {
+		validation.Add(fmt.Sprintf("endpointPathParameters[%d]", index), value.Validate())
+	}
+	for index, value := range e.ServiceHeaders {
+		validation.Add(fmt.Sprintf("serviceHeaders[%d]", index), value.Validate())
+	}
+	for index, value := range e.EndpointHeaders {
+		validation.Add(fmt.Sprintf("endpointHeaders[%d]", index), value.Validate())
+	}
+	for index, value := range e.QueryParameters {
+		validation.Add(fmt.Sprintf("queryParameters[%d]", index), value.Validate())
+	}
+	validation.Add("request", e.Request.Validate())
+	if e.Response == nil {
+		validation.Required("response")
+	}
+	validation.Add("response", e.Response.Validate())
+	return validation.Err()
+}
+
 type ExampleEndpointErrorResponse struct {
-	Error *DeclaredErrorName    `json:"error,omitempty"`
-	Body  *ExampleTypeReference `json:"body,omitempty"`
+	Error *DeclaredErrorName    `json:"error,omitempty" url:"error"`
+	Body  *ExampleTypeReference `json:"body,omitempty" url:"body,omitempty"`
 }
 
 func (e *ExampleEndpointErrorResponse) String() string {
@@ -649,8 +1109,23 @@ func (e *ExampleEndpointErrorResponse) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleEndpointErrorResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleEndpointErrorResponse) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Error == nil {
+		validation.Required("error")
+	}
+	validation.Add("error", e.Error.Validate())
+	validation.Add("body", e.Body.Validate())
+	return validation.Err()
+}
+
 type ExampleEndpointSuccessResponse struct {
-	Body *ExampleTypeReference `json:"body,omitempty"`
+	Body *ExampleTypeReference `json:"body,omitempty" url:"body,omitempty"`
 }
 
 func (e *ExampleEndpointSuccessResponse) String() string {
@@ -660,9 +1135,20 @@ func (e *ExampleEndpointSuccessResponse) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleEndpointSuccessResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleEndpointSuccessResponse) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	validation.Add("body", e.Body.Validate())
+	return validation.Err()
+}
+
 type ExampleHeader struct {
-	WireKey string                `json:"wireKey"`
-	Value   *ExampleTypeReference `json:"value,omitempty"`
+	WireKey string                `json:"wireKey" url:"wireKey"`
+	Value   *ExampleTypeReference `json:"value,omitempty" url:"value"`
 }
 
 func (e *ExampleHeader) String() string {
@@ -672,9 +1158,23 @@ func (e *ExampleHeader) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleHeader's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleHeader) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Value == nil {
+		validation.Required("value")
+	}
+	validation.Add("value", e.Value.Validate())
+	return validation.Err()
+}
+
 type ExampleInlinedRequestBody struct {
-	JsonExample interface{}                          `json:"jsonExample,omitempty"`
-	Properties  []*ExampleInlinedRequestBodyProperty `json:"properties,omitempty"`
+	JsonExample interface{}                          `json:"jsonExample,omitempty" url:"jsonExample"`
+	Properties  []*ExampleInlinedRequestBodyProperty `json:"properties,omitempty" url:"properties"`
 }
 
 func (e *ExampleInlinedRequestBody) String() string {
@@ -684,12 +1184,25 @@ func (e *ExampleInlinedRequestBody) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleInlinedRequestBody's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleInlinedRequestBody) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range e.Properties {
+		validation.Add(fmt.Sprintf("properties[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type ExampleInlinedRequestBodyProperty struct {
-	WireKey string                `json:"wireKey"`
-	Value   *ExampleTypeReference `json:"value,omitempty"`
+	WireKey string                `json:"wireKey" url:"wireKey"`
+	Value   *ExampleTypeReference `json:"value,omitempty" url:"value"`
 	// this property may have been brought in via extension. originalTypeDeclaration
 	// is the name of the type that contains this property
-	OriginalTypeDeclaration *DeclaredTypeName `json:"originalTypeDeclaration,omitempty"`
+	OriginalTypeDeclaration *DeclaredTypeName `json:"originalTypeDeclaration,omitempty" url:"originalTypeDeclaration,omitempty"`
 }
 
 func (e *ExampleInlinedRequestBodyProperty) String() string {
@@ -699,9 +1212,24 @@ func (e *ExampleInlinedRequestBodyProperty) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleInlinedRequestBodyProperty's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleInlinedRequestBodyProperty) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Value == nil {
+		validation.Required("value")
+	}
+	validation.Add("value", e.Value.Validate())
+	validation.Add("originalTypeDeclaration", e.OriginalTypeDeclaration.Validate())
+	return validation.Err()
+}
+
 type ExamplePathParameter struct {
-	Key   string                `json:"key"`
-	Value *ExampleTypeReference `json:"value,omitempty"`
+	Key   string                `json:"key" url:"key"`
+	Value *ExampleTypeReference `json:"value,omitempty" url:"value"`
 }
 
 func (e *ExamplePathParameter) String() string {
@@ -711,9 +1239,23 @@ func (e *ExamplePathParameter) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExamplePathParameter's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExamplePathParameter) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Value == nil {
+		validation.Required("value")
+	}
+	validation.Add("value", e.Value.Validate())
+	return validation.Err()
+}
+
 type ExampleQueryParameter struct {
-	WireKey string                `json:"wireKey"`
-	Value   *ExampleTypeReference `json:"value,omitempty"`
+	WireKey string                `json:"wireKey" url:"wireKey"`
+	Value   *ExampleTypeReference `json:"value,omitempty" url:"value"`
 }
 
 func (e *ExampleQueryParameter) String() string {
@@ -723,10 +1265,26 @@ func (e *ExampleQueryParameter) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleQueryParameter's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleQueryParameter) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Value == nil {
+		validation.Required("value")
+	}
+	validation.Add("value", e.Value.Validate())
+	return validation.Err()
+}
+
 type ExampleRequestBody struct {
 	Type               string
 	InlinedRequestBody *ExampleInlinedRequestBody
 	Reference          *ExampleTypeReference
+
+	_unknown json.RawMessage
 }
 
 func NewExampleRequestBodyFromInlinedRequestBody(value *ExampleInlinedRequestBody) *ExampleRequestBody {
@@ -758,6 +1316,8 @@ func (e *ExampleRequestBody) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.Reference = value
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -765,6 +1325,9 @@ func (e *ExampleRequestBody) UnmarshalJSON(data []byte) error {
 func (e ExampleRequestBody) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "inlinedRequestBody":
 		var marshaler = struct {
@@ -790,11 +1353,17 @@ func (e ExampleRequestBody) MarshalJSON() ([]byte, error) {
 type ExampleRequestBodyVisitor interface {
 	VisitInlinedRequestBody(*ExampleInlinedRequestBody) error
 	VisitReference(*ExampleTypeReference) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *ExampleRequestBody) Accept(visitor ExampleRequestBodyVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "inlinedRequestBody":
 		return visitor.VisitInlinedRequestBody(e.InlinedRequestBody)
@@ -803,10 +1372,32 @@ func (e *ExampleRequestBody) Accept(visitor ExampleRequestBodyVisitor) error {
 	}
 }
 
+// Validate reports all of the ExampleRequestBody's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleRequestBody) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "inlinedRequestBody":
+		validation.Add("", e.InlinedRequestBody.Validate())
+	case "reference":
+		validation.Add("", e.Reference.Validate())
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type ExampleResponse struct {
 	Type  string
 	Ok    *ExampleEndpointSuccessResponse
 	Error *ExampleEndpointErrorResponse
+
+	_unknown json.RawMessage
 }
 
 func NewExampleResponseFromOk(value *ExampleEndpointSuccessResponse) *ExampleResponse {
@@ -838,6 +1429,8 @@ func (e *ExampleResponse) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.Error = value
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -845,6 +1438,9 @@ func (e *ExampleResponse) UnmarshalJSON(data []byte) error {
 func (e ExampleResponse) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "ok":
 		var marshaler = struct {
@@ -870,11 +1466,17 @@ func (e ExampleResponse) MarshalJSON() ([]byte, error) {
 type ExampleResponseVisitor interface {
 	VisitOk(*ExampleEndpointSuccessResponse) error
 	VisitError(*ExampleEndpointErrorResponse) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *ExampleResponse) Accept(visitor ExampleResponseVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "ok":
 		return visitor.VisitOk(e.Ok)
@@ -883,8 +1485,28 @@ func (e *ExampleResponse) Accept(visitor ExampleResponseVisitor) error {
 	}
 }
 
+// Validate reports all of the ExampleResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleResponse) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "ok":
+		validation.Add("", e.Ok.Validate())
+	case "error":
+		validation.Add("", e.Error.Validate())
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type FileDownloadResponse struct {
-	Docs *string `json:"docs,omitempty"`
+	Docs *string `json:"docs,omitempty" url:"docs,omitempty"`
 }
 
 func (f *FileDownloadResponse) String() string {
@@ -894,9 +1516,15 @@ func (f *FileDownloadResponse) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the FileDownloadResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (f *FileDownloadResponse) Validate() error {
+	return nil
+}
+
 type FileProperty struct {
-	Key        *NameAndWireValue `json:"key,omitempty"`
-	IsOptional bool              `json:"isOptional"`
+	Key        *NameAndWireValue `json:"key,omitempty" url:"key"`
+	IsOptional bool              `json:"isOptional" url:"isOptional"`
 }
 
 func (f *FileProperty) String() string {
@@ -906,9 +1534,23 @@ func (f *FileProperty) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the FileProperty's invalid fields (e.g. missing
+// required fields), if any.
+func (f *FileProperty) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	if f.Key == nil {
+		validation.Required("key")
+	}
+	validation.Add("key", f.Key.Validate())
+	return validation.Err()
+}
+
 type FileUploadRequest struct {
-	Name       *Name                        `json:"name,omitempty"`
-	Properties []*FileUploadRequestProperty `json:"properties,omitempty"`
+	Name       *Name                        `json:"name,omitempty" url:"name"`
+	Properties []*FileUploadRequestProperty `json:"properties,omitempty" url:"properties"`
 }
 
 func (f *FileUploadRequest) String() string {
@@ -918,10 +1560,29 @@ func (f *FileUploadRequest) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the FileUploadRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (f *FileUploadRequest) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	if f.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", f.Name.Validate())
+	for index, value := range f.Properties {
+		validation.Add(fmt.Sprintf("properties[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type FileUploadRequestProperty struct {
 	Type         string
 	File         *FileProperty
 	BodyProperty *InlinedRequestBodyProperty
+
+	_unknown json.RawMessage
 }
 
 func NewFileUploadRequestPropertyFromFile(value *FileProperty) *FileUploadRequestProperty {
@@ -953,6 +1614,8 @@ func (f *FileUploadRequestProperty) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		f.BodyProperty = value
+	default:
+		f._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -960,6 +1623,9 @@ func (f *FileUploadRequestProperty) UnmarshalJSON(data []byte) error {
 func (f FileUploadRequestProperty) MarshalJSON() ([]byte, error) {
 	switch f.Type {
 	default:
+		if f._unknown != nil {
+			return f._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", f.Type, f)
 	case "file":
 		var marshaler = struct {
@@ -985,11 +1651,17 @@ func (f FileUploadRequestProperty) MarshalJSON() ([]byte, error) {
 type FileUploadRequestPropertyVisitor interface {
 	VisitFile(*FileProperty) error
 	VisitBodyProperty(*InlinedRequestBodyProperty) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (f *FileUploadRequestProperty) Accept(visitor FileUploadRequestPropertyVisitor) error {
 	switch f.Type {
 	default:
+		if f._unknown != nil {
+			return visitor.VisitUnknown(f.Type, f._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", f.Type, f)
 	case "file":
 		return visitor.VisitFile(f.File)
@@ -998,27 +1670,47 @@ func (f *FileUploadRequestProperty) Accept(visitor FileUploadRequestPropertyVisi
 	}
 }
 
+// Validate reports all of the FileUploadRequestProperty's invalid fields (e.g. missing
+// required fields), if any.
+func (f *FileUploadRequestProperty) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch f.Type {
+	case "file":
+		validation.Add("", f.File.Validate())
+	case "bodyProperty":
+		validation.Add("", f.BodyProperty.Validate())
+	default:
+		if f._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", f.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type HttpEndpoint struct {
-	Docs              *string                `json:"docs,omitempty"`
-	Availability      *Availability          `json:"availability,omitempty"`
-	Name              EndpointName           `json:"name,omitempty"`
-	DisplayName       *string                `json:"displayName,omitempty"`
-	Method            HttpMethod             `json:"method,omitempty"`
-	Headers           []*HttpHeader          `json:"headers,omitempty"`
-	BaseUrl           *EnvironmentBaseUrlId  `json:"baseUrl,omitempty"`
-	Path              *HttpPath              `json:"path,omitempty"`
-	FullPath          *HttpPath              `json:"fullPath,omitempty"`
-	PathParameters    []*PathParameter       `json:"pathParameters,omitempty"`
-	AllPathParameters []*PathParameter       `json:"allPathParameters,omitempty"`
-	QueryParameters   []*QueryParameter      `json:"queryParameters,omitempty"`
-	RequestBody       *HttpRequestBody       `json:"requestBody,omitempty"`
-	SdkRequest        *SdkRequest            `json:"sdkRequest,omitempty"`
-	Response          *HttpResponse          `json:"response,omitempty"`
-	StreamingResponse *StreamingResponse     `json:"streamingResponse,omitempty"`
-	SdkResponse       *SdkResponse           `json:"sdkResponse,omitempty"`
-	Errors            ResponseErrors         `json:"errors,omitempty"`
-	Auth              bool                   `json:"auth"`
-	Examples          []*ExampleEndpointCall `json:"examples,omitempty"`
+	Docs              *string                `json:"docs,omitempty" url:"docs,omitempty"`
+	Availability      *Availability          `json:"availability,omitempty" url:"availability"`
+	Name              EndpointName           `json:"name,omitempty" url:"name"`
+	DisplayName       *string                `json:"displayName,omitempty" url:"displayName,omitempty"`
+	Method            HttpMethod             `json:"method,omitempty" url:"method"`
+	Headers           []*HttpHeader          `json:"headers,omitempty" url:"headers"`
+	BaseUrl           *EnvironmentBaseUrlId  `json:"baseUrl,omitempty" url:"baseUrl,omitempty"`
+	Path              *HttpPath              `json:"path,omitempty" url:"path"`
+	FullPath          *HttpPath              `json:"fullPath,omitempty" url:"fullPath"`
+	PathParameters    []*PathParameter       `json:"pathParameters,omitempty" url:"pathParameters"`
+	AllPathParameters []*PathParameter       `json:"allPathParameters,omitempty" url:"allPathParameters"`
+	QueryParameters   []*QueryParameter      `json:"queryParameters,omitempty" url:"queryParameters"`
+	RequestBody       *HttpRequestBody       `json:"requestBody,omitempty" url:"requestBody,omitempty"`
+	SdkRequest        *SdkRequest            `json:"sdkRequest,omitempty" url:"sdkRequest,omitempty"`
+	Response          *HttpResponse          `json:"response,omitempty" url:"response,omitempty"`
+	StreamingResponse *StreamingResponse     `json:"streamingResponse,omitempty" url:"streamingResponse,omitempty"`
+	SdkResponse       *SdkResponse           `json:"sdkResponse,omitempty" url:"sdkResponse,omitempty"`
+	Errors            ResponseErrors         `json:"errors,omitempty" url:"errors"`
+	Auth              bool                   `json:"auth" url:"auth"`
+	Examples          []*ExampleEndpointCall `json:"examples,omitempty" url:"examples"`
 }
 
 func (h *HttpEndpoint) String() string {
@@ -1028,11 +1720,61 @@ func (h *HttpEndpoint) String() string {
 	return fmt.Sprintf("%#v", h)
 }
 
+// Validate reports all of the HttpEndpoint's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HttpEndpoint) Validate() error {
+	if h == nil {
+		return nil
+	}
+	var validation core.Validation
+	if h.Availability == nil {
+		validation.Required("availability")
+	}
+	validation.Add("availability", h.Availability.Validate())
+	if h.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", h.Name.Validate())
+	validation.Add("method", h.Method.Validate())
+	for index, value := range h.Headers {
+		validation.Add(fmt.Sprintf("headers[%d]", index), value.Validate())
+	}
+	if h.Path == nil {
+		validation.Required("path")
+	}
+	validation.Add("path", h.Path.Validate())
+	if h.FullPath == nil {
+		validation.Required("fullPath")
+	}
+	validation.Add("fullPath", h.FullPath.Validate())
+	for index, value := range h.PathParameters {
+		validation.Add(fmt.Sprintf("pathParameters[%d]", index), value.Validate())
+	}
+	for index, value := range h.AllPathParameters {
+		validation.Add(fmt.Sprintf("allPathParameters[%d]", index), value.Validate())
+	}
+	for index, value := range h.QueryParameters {
+		validation.Add(fmt.Sprintf("queryParameters[%d]", index), value.Validate())
+	}
+	validation.Add("requestBody", h.RequestBody.Validate())
+	validation.Add("sdkRequest", h.SdkRequest.Validate())
+	validation.Add("response", h.Response.Validate())
+	validation.Add("streamingResponse", h.StreamingResponse.Validate())
+	validation.Add("sdkResponse", h.SdkResponse.Validate())
+	for index, value := range h.Errors {
+		validation.Add(fmt.Sprintf("errors[%d]", index), value.Validate())
+	}
+	for index, value := range h.Examples {
+		validation.Add(fmt.Sprintf("examples[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type HttpHeader struct {
-	Docs         *string           `json:"docs,omitempty"`
-	Availability *Availability     `json:"availability,omitempty"`
-	Name         *NameAndWireValue `json:"name,omitempty"`
-	ValueType    *TypeReference    `json:"valueType,omitempty"`
+	Docs         *string           `json:"docs,omitempty" url:"docs,omitempty"`
+	Availability *Availability     `json:"availability,omitempty" url:"availability"`
+	Name         *NameAndWireValue `json:"name,omitempty" url:"name"`
+	ValueType    *TypeReference    `json:"valueType,omitempty" url:"valueType"`
 }
 
 func (h *HttpHeader) String() string {
@@ -1042,6 +1784,28 @@ func (h *HttpHeader) String() string {
 	return fmt.Sprintf("%#v", h)
 }
 
+// Validate reports all of the HttpHeader's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HttpHeader) Validate() error {
+	if h == nil {
+		return nil
+	}
+	var validation core.Validation
+	if h.Availability == nil {
+		validation.Required("availability")
+	}
+	validation.Add("availability", h.Availability.Validate())
+	if h.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", h.Name.Validate())
+	if h.ValueType == nil {
+		validation.Required("valueType")
+	}
+	validation.Add("valueType", h.ValueType.Validate())
+	return validation.Err()
+}
+
 type HttpMethod string
 
 const (
@@ -1073,9 +1837,15 @@ func (h HttpMethod) Ptr() *HttpMethod {
 	return &h
 }
 
+// Validate returns an error if the HttpMethod isn't one of its known values.
+func (h HttpMethod) Validate() error {
+	_, err := NewHttpMethodFromString(string(h))
+	return err
+}
+
 type HttpPath struct {
-	Head  string          `json:"head"`
-	Parts []*HttpPathPart `json:"parts,omitempty"`
+	Head  string          `json:"head" url:"head"`
+	Parts []*HttpPathPart `json:"parts,omitempty" url:"parts"`
 }
 
 func (h *HttpPath) String() string {
@@ -1085,9 +1855,22 @@ func (h *HttpPath) String() string {
 	return fmt.Sprintf("%#v", h)
 }
 
+// Validate reports all of the HttpPath's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HttpPath) Validate() error {
+	if h == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range h.Parts {
+		validation.Add(fmt.Sprintf("parts[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type HttpPathPart struct {
-	PathParameter string `json:"pathParameter"`
-	Tail          string `json:"tail"`
+	PathParameter string `json:"pathParameter" url:"pathParameter"`
+	Tail          string `json:"tail" url:"tail"`
 }
 
 func (h *HttpPathPart) String() string {
@@ -1097,11 +1880,19 @@ func (h *HttpPathPart) String() string {
 	return fmt.Sprintf("%#v", h)
 }
 
+// Validate reports all of the HttpPathPart's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HttpPathPart) Validate() error {
+	return nil
+}
+
 type HttpRequestBody struct {
 	Type               string
 	InlinedRequestBody *InlinedRequestBody
 	Reference          *HttpRequestBodyReference
 	FileUpload         *FileUploadRequest
+
+	_unknown json.RawMessage
 }
 
 func NewHttpRequestBodyFromInlinedRequestBody(value *InlinedRequestBody) *HttpRequestBody {
@@ -1143,6 +1934,8 @@ func (h *HttpRequestBody) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		h.FileUpload = value
+	default:
+		h._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -1150,6 +1943,9 @@ func (h *HttpRequestBody) UnmarshalJSON(data []byte) error {
 func (h HttpRequestBody) MarshalJSON() ([]byte, error) {
 	switch h.Type {
 	default:
+		if h._unknown != nil {
+			return h._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", h.Type, h)
 	case "inlinedRequestBody":
 		var marshaler = struct {
@@ -1185,11 +1981,17 @@ type HttpRequestBodyVisitor interface {
 	VisitInlinedRequestBody(*InlinedRequestBody) error
 	VisitReference(*HttpRequestBodyReference) error
 	VisitFileUpload(*FileUploadRequest) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (h *HttpRequestBody) Accept(visitor HttpRequestBodyVisitor) error {
 	switch h.Type {
 	default:
+		if h._unknown != nil {
+			return visitor.VisitUnknown(h.Type, h._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", h.Type, h)
 	case "inlinedRequestBody":
 		return visitor.VisitInlinedRequestBody(h.InlinedRequestBody)
@@ -1200,9 +2002,31 @@ func (h *HttpRequestBody) Accept(visitor HttpRequestBodyVisitor) error {
 	}
 }
 
+// Validate reports all of the HttpRequestBody's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HttpRequestBody) Validate() error {
+	if h == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch h.Type {
+	case "inlinedRequestBody":
+		validation.Add("", h.InlinedRequestBody.Validate())
+	case "reference":
+		validation.Add("", h.Reference.Validate())
+	case "fileUpload":
+		validation.Add("", h.FileUpload.Validate())
+	default:
+		if h._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", h.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type HttpRequestBodyReference struct {
-	Docs            *string        `json:"docs,omitempty"`
-	RequestBodyType *TypeReference `json:"requestBodyType,omitempty"`
+	Docs            *string        `json:"docs,omitempty" url:"docs,omitempty"`
+	RequestBodyType *TypeReference `json:"requestBodyType,omitempty" url:"requestBodyType"`
 }
 
 func (h *HttpRequestBodyReference) String() string {
@@ -1212,10 +2036,26 @@ func (h *HttpRequestBodyReference) String() string {
 	return fmt.Sprintf("%#v", h)
 }
 
+// Validate reports all of the HttpRequestBodyReference's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HttpRequestBodyReference) Validate() error {
+	if h == nil {
+		return nil
+	}
+	var validation core.Validation
+	if h.RequestBodyType == nil {
+		validation.Required("requestBodyType")
+	}
+	validation.Add("requestBodyType", h.RequestBodyType.Validate())
+	return validation.Err()
+}
+
 type HttpResponse struct {
 	Type         string
 	Json         *JsonResponse
 	FileDownload *FileDownloadResponse
+
+	_unknown json.RawMessage
 }
 
 func NewHttpResponseFromJson(value *JsonResponse) *HttpResponse {
@@ -1247,6 +2087,8 @@ func (h *HttpResponse) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		h.FileDownload = value
+	default:
+		h._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -1254,6 +2096,9 @@ func (h *HttpResponse) UnmarshalJSON(data []byte) error {
 func (h HttpResponse) MarshalJSON() ([]byte, error) {
 	switch h.Type {
 	default:
+		if h._unknown != nil {
+			return h._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", h.Type, h)
 	case "json":
 		var marshaler = struct {
@@ -1279,11 +2124,17 @@ func (h HttpResponse) MarshalJSON() ([]byte, error) {
 type HttpResponseVisitor interface {
 	VisitJson(*JsonResponse) error
 	VisitFileDownload(*FileDownloadResponse) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (h *HttpResponse) Accept(visitor HttpResponseVisitor) error {
 	switch h.Type {
 	default:
+		if h._unknown != nil {
+			return visitor.VisitUnknown(h.Type, h._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", h.Type, h)
 	case "json":
 		return visitor.VisitJson(h.Json)
@@ -1292,14 +2143,34 @@ func (h *HttpResponse) Accept(visitor HttpResponseVisitor) error {
 	}
 }
 
+// Validate reports all of the HttpResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HttpResponse) Validate() error {
+	if h == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch h.Type {
+	case "json":
+		validation.Add("", h.Json.Validate())
+	case "fileDownload":
+		validation.Add("", h.FileDownload.Validate())
+	default:
+		if h._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", h.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type HttpService struct {
-	Availability   *Availability        `json:"availability,omitempty"`
-	Name           *DeclaredServiceName `json:"name,omitempty"`
-	DisplayName    *string              `json:"displayName,omitempty"`
-	BasePath       *HttpPath            `json:"basePath,omitempty"`
-	Endpoints      []*HttpEndpoint      `json:"endpoints,omitempty"`
-	Headers        []*HttpHeader        `json:"headers,omitempty"`
-	PathParameters []*PathParameter     `json:"pathParameters,omitempty"`
+	Availability   *Availability        `json:"availability,omitempty" url:"availability"`
+	Name           *DeclaredServiceName `json:"name,omitempty" url:"name"`
+	DisplayName    *string              `json:"displayName,omitempty" url:"displayName,omitempty"`
+	BasePath       *HttpPath            `json:"basePath,omitempty" url:"basePath"`
+	Endpoints      []*HttpEndpoint      `json:"endpoints,omitempty" url:"endpoints"`
+	Headers        []*HttpHeader        `json:"headers,omitempty" url:"headers"`
+	PathParameters []*PathParameter     `json:"pathParameters,omitempty" url:"pathParameters"`
 }
 
 func (h *HttpService) String() string {
@@ -1309,10 +2180,41 @@ func (h *HttpService) String() string {
 	return fmt.Sprintf("%#v", h)
 }
 
+// Validate reports all of the HttpService's invalid fields (e.g. missing
+// required fields), if any.
+func (h *HttpService) Validate() error {
+	if h == nil {
+		return nil
+	}
+	var validation core.Validation
+	if h.Availability == nil {
+		validation.Required("availability")
+	}
+	validation.Add("availability", h.Availability.Validate())
+	if h.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", h.Name.Validate())
+	if h.BasePath == nil {
+		validation.Required("basePath")
+	}
+	validation.Add("basePath", h.BasePath.Validate())
+	for index, value := range h.Endpoints {
+		validation.Add(fmt.Sprintf("endpoints[%d]", index), value.Validate())
+	}
+	for index, value := range h.Headers {
+		validation.Add(fmt.Sprintf("headers[%d]", index), value.Validate())
+	}
+	for index, value := range h.PathParameters {
+		validation.Add(fmt.Sprintf("pathParameters[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type InlinedRequestBody struct {
-	Name       *Name                         `json:"name,omitempty"`
-	Extends    []*DeclaredTypeName           `json:"extends,omitempty"`
-	Properties []*InlinedRequestBodyProperty `json:"properties,omitempty"`
+	Name       *Name                         `json:"name,omitempty" url:"name"`
+	Extends    []*DeclaredTypeName           `json:"extends,omitempty" url:"extends"`
+	Properties []*InlinedRequestBodyProperty `json:"properties,omitempty" url:"properties"`
 }
 
 func (i *InlinedRequestBody) String() string {
@@ -1322,10 +2224,30 @@ func (i *InlinedRequestBody) String() string {
 	return fmt.Sprintf("%#v", i)
 }
 
+// Validate reports all of the InlinedRequestBody's invalid fields (e.g. missing
+// required fields), if any.
+func (i *InlinedRequestBody) Validate() error {
+	if i == nil {
+		return nil
+	}
+	var validation core.Validation
+	if i.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", i.Name.Validate())
+	for index, value := range i.Extends {
+		validation.Add(fmt.Sprintf("extends[%d]", index), value.Validate())
+	}
+	for index, value := range i.Properties {
+		validation.Add(fmt.Sprintf("properties[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type InlinedRequestBodyProperty struct {
-	Docs      *string           `json:"docs,omitempty"`
-	Name      *NameAndWireValue `json:"name,omitempty"`
-	ValueType *TypeReference    `json:"valueType,omitempty"`
+	Docs      *string           `json:"docs,omitempty" url:"docs,omitempty"`
+	Name      *NameAndWireValue `json:"name,omitempty" url:"name"`
+	ValueType *TypeReference    `json:"valueType,omitempty" url:"valueType"`
 }
 
 func (i *InlinedRequestBodyProperty) String() string {
@@ -1335,9 +2257,27 @@ func (i *InlinedRequestBodyProperty) String() string {
 	return fmt.Sprintf("%#v", i)
 }
 
+// Validate reports all of the InlinedRequestBodyProperty's invalid fields (e.g. missing
+// required fields), if any.
+func (i *InlinedRequestBodyProperty) Validate() error {
+	if i == nil {
+		return nil
+	}
+	var validation core.Validation
+	if i.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", i.Name.Validate())
+	if i.ValueType == nil {
+		validation.Required("valueType")
+	}
+	validation.Add("valueType", i.ValueType.Validate())
+	return validation.Err()
+}
+
 type JsonResponse struct {
-	Docs             *string        `json:"docs,omitempty"`
-	ResponseBodyType *TypeReference `json:"responseBodyType,omitempty"`
+	Docs             *string        `json:"docs,omitempty" url:"docs,omitempty"`
+	ResponseBodyType *TypeReference `json:"responseBodyType,omitempty" url:"responseBodyType"`
 }
 
 func (j *JsonResponse) String() string {
@@ -1347,10 +2287,24 @@ func (j *JsonResponse) String() string {
 	return fmt.Sprintf("%#v", j)
 }
 
+// Validate reports all of the JsonResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (j *JsonResponse) Validate() error {
+	if j == nil {
+		return nil
+	}
+	var validation core.Validation
+	if j.ResponseBodyType == nil {
+		validation.Required("responseBodyType")
+	}
+	validation.Add("responseBodyType", j.ResponseBodyType.Validate())
+	return validation.Err()
+}
+
 type MaybeStreamingResponse struct {
-	Condition    *StreamCondition   `json:"condition,omitempty"`
-	NonStreaming *HttpResponse      `json:"nonStreaming,omitempty"`
-	Streaming    *StreamingResponse `json:"streaming,omitempty"`
+	Condition    *StreamCondition   `json:"condition,omitempty" url:"condition"`
+	NonStreaming *HttpResponse      `json:"nonStreaming,omitempty" url:"nonStreaming"`
+	Streaming    *StreamingResponse `json:"streaming,omitempty" url:"streaming"`
 }
 
 func (m *MaybeStreamingResponse) String() string {
@@ -1360,12 +2314,34 @@ func (m *MaybeStreamingResponse) String() string {
 	return fmt.Sprintf("%#v", m)
 }
 
+// Validate reports all of the MaybeStreamingResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (m *MaybeStreamingResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var validation core.Validation
+	if m.Condition == nil {
+		validation.Required("condition")
+	}
+	validation.Add("condition", m.Condition.Validate())
+	if m.NonStreaming == nil {
+		validation.Required("nonStreaming")
+	}
+	validation.Add("nonStreaming", m.NonStreaming.Validate())
+	if m.Streaming == nil {
+		validation.Required("streaming")
+	}
+	validation.Add("streaming", m.Streaming.Validate())
+	return validation.Err()
+}
+
 type PathParameter struct {
-	Docs      *string               `json:"docs,omitempty"`
-	Name      *Name                 `json:"name,omitempty"`
-	ValueType *TypeReference        `json:"valueType,omitempty"`
-	Location  PathParameterLocation `json:"location,omitempty"`
-	Variable  *VariableId           `json:"variable,omitempty"`
+	Docs      *string               `json:"docs,omitempty" url:"docs,omitempty"`
+	Name      *Name                 `json:"name,omitempty" url:"name"`
+	ValueType *TypeReference        `json:"valueType,omitempty" url:"valueType"`
+	Location  PathParameterLocation `json:"location,omitempty" url:"location"`
+	Variable  *VariableId           `json:"variable,omitempty" url:"variable,omitempty"`
 }
 
 func (p *PathParameter) String() string {
@@ -1375,6 +2351,25 @@ func (p *PathParameter) String() string {
 	return fmt.Sprintf("%#v", p)
 }
 
+// Validate reports all of the PathParameter's invalid fields (e.g. missing
+// required fields), if any.
+func (p *PathParameter) Validate() error {
+	if p == nil {
+		return nil
+	}
+	var validation core.Validation
+	if p.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", p.Name.Validate())
+	if p.ValueType == nil {
+		validation.Required("valueType")
+	}
+	validation.Add("valueType", p.ValueType.Validate())
+	validation.Add("location", p.Location.Validate())
+	return validation.Err()
+}
+
 type PathParameterLocation string
 
 const (
@@ -1400,12 +2395,18 @@ func (p PathParameterLocation) Ptr() *PathParameterLocation {
 	return &p
 }
 
+// Validate returns an error if the PathParameterLocation isn't one of its known values.
+func (p PathParameterLocation) Validate() error {
+	_, err := NewPathParameterLocationFromString(string(p))
+	return err
+}
+
 type QueryParameter struct {
-	Docs          *string           `json:"docs,omitempty"`
-	Availability  *Availability     `json:"availability,omitempty"`
-	Name          *NameAndWireValue `json:"name,omitempty"`
-	ValueType     *TypeReference    `json:"valueType,omitempty"`
-	AllowMultiple bool              `json:"allowMultiple"`
+	Docs          *string           `json:"docs,omitempty" url:"docs,omitempty"`
+	Availability  *Availability     `json:"availability,omitempty" url:"availability"`
+	Name          *NameAndWireValue `json:"name,omitempty" url:"name"`
+	ValueType     *TypeReference    `json:"valueType,omitempty" url:"valueType"`
+	AllowMultiple bool              `json:"allowMultiple" url:"allowMultiple"`
 }
 
 func (q *QueryParameter) String() string {
@@ -1415,9 +2416,31 @@ func (q *QueryParameter) String() string {
 	return fmt.Sprintf("%#v", q)
 }
 
+// Validate reports all of the QueryParameter's invalid fields (e.g. missing
+// required fields), if any.
+func (q *QueryParameter) Validate() error {
+	if q == nil {
+		return nil
+	}
+	var validation core.Validation
+	if q.Availability == nil {
+		validation.Required("availability")
+	}
+	validation.Add("availability", q.Availability.Validate())
+	if q.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", q.Name.Validate())
+	if q.ValueType == nil {
+		validation.Required("valueType")
+	}
+	validation.Add("valueType", q.ValueType.Validate())
+	return validation.Err()
+}
+
 type ResponseError struct {
-	Docs  *string            `json:"docs,omitempty"`
-	Error *DeclaredErrorName `json:"error,omitempty"`
+	Docs  *string            `json:"docs,omitempty" url:"docs,omitempty"`
+	Error *DeclaredErrorName `json:"error,omitempty" url:"error"`
 }
 
 func (r *ResponseError) String() string {
@@ -1427,11 +2450,25 @@ func (r *ResponseError) String() string {
 	return fmt.Sprintf("%#v", r)
 }
 
+// Validate reports all of the ResponseError's invalid fields (e.g. missing
+// required fields), if any.
+func (r *ResponseError) Validate() error {
+	if r == nil {
+		return nil
+	}
+	var validation core.Validation
+	if r.Error == nil {
+		validation.Required("error")
+	}
+	validation.Add("error", r.Error.Validate())
+	return validation.Err()
+}
+
 type ResponseErrors = []*ResponseError
 
 type SdkRequest struct {
-	RequestParameterName *Name            `json:"requestParameterName,omitempty"`
-	Shape                *SdkRequestShape `json:"shape,omitempty"`
+	RequestParameterName *Name            `json:"requestParameterName,omitempty" url:"requestParameterName"`
+	Shape                *SdkRequestShape `json:"shape,omitempty" url:"shape"`
 }
 
 func (s *SdkRequest) String() string {
@@ -1441,10 +2478,30 @@ func (s *SdkRequest) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SdkRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SdkRequest) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.RequestParameterName == nil {
+		validation.Required("requestParameterName")
+	}
+	validation.Add("requestParameterName", s.RequestParameterName.Validate())
+	if s.Shape == nil {
+		validation.Required("shape")
+	}
+	validation.Add("shape", s.Shape.Validate())
+	return validation.Err()
+}
+
 type SdkRequestShape struct {
 	Type            string
 	JustRequestBody *HttpRequestBodyReference
 	Wrapper         *SdkRequestWrapper
+
+	_unknown json.RawMessage
 }
 
 func NewSdkRequestShapeFromJustRequestBody(value *HttpRequestBodyReference) *SdkRequestShape {
@@ -1476,6 +2533,8 @@ func (s *SdkRequestShape) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		s.Wrapper = value
+	default:
+		s._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -1483,6 +2542,9 @@ func (s *SdkRequestShape) UnmarshalJSON(data []byte) error {
 func (s SdkRequestShape) MarshalJSON() ([]byte, error) {
 	switch s.Type {
 	default:
+		if s._unknown != nil {
+			return s._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", s.Type, s)
 	case "justRequestBody":
 		var marshaler = struct {
@@ -1508,11 +2570,17 @@ func (s SdkRequestShape) MarshalJSON() ([]byte, error) {
 type SdkRequestShapeVisitor interface {
 	VisitJustRequestBody(*HttpRequestBodyReference) error
 	VisitWrapper(*SdkRequestWrapper) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (s *SdkRequestShape) Accept(visitor SdkRequestShapeVisitor) error {
 	switch s.Type {
 	default:
+		if s._unknown != nil {
+			return visitor.VisitUnknown(s.Type, s._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", s.Type, s)
 	case "justRequestBody":
 		return visitor.VisitJustRequestBody(s.JustRequestBody)
@@ -1521,9 +2589,29 @@ func (s *SdkRequestShape) Accept(visitor SdkRequestShapeVisitor) error {
 	}
 }
 
+// Validate reports all of the SdkRequestShape's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SdkRequestShape) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch s.Type {
+	case "justRequestBody":
+		validation.Add("", s.JustRequestBody.Validate())
+	case "wrapper":
+		validation.Add("", s.Wrapper.Validate())
+	default:
+		if s._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", s.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type SdkRequestWrapper struct {
-	WrapperName *Name `json:"wrapperName,omitempty"`
-	BodyKey     *Name `json:"bodyKey,omitempty"`
+	WrapperName *Name `json:"wrapperName,omitempty" url:"wrapperName"`
+	BodyKey     *Name `json:"bodyKey,omitempty" url:"bodyKey"`
 }
 
 func (s *SdkRequestWrapper) String() string {
@@ -1533,12 +2621,32 @@ func (s *SdkRequestWrapper) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SdkRequestWrapper's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SdkRequestWrapper) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.WrapperName == nil {
+		validation.Required("wrapperName")
+	}
+	validation.Add("wrapperName", s.WrapperName.Validate())
+	if s.BodyKey == nil {
+		validation.Required("bodyKey")
+	}
+	validation.Add("bodyKey", s.BodyKey.Validate())
+	return validation.Err()
+}
+
 type SdkResponse struct {
 	Type           string
 	Json           *JsonResponse
 	Streaming      *StreamingResponse
 	MaybeStreaming *MaybeStreamingResponse
 	FileDownload   *FileDownloadResponse
+
+	_unknown json.RawMessage
 }
 
 func NewSdkResponseFromJson(value *JsonResponse) *SdkResponse {
@@ -1590,6 +2698,8 @@ func (s *SdkResponse) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		s.FileDownload = value
+	default:
+		s._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -1597,6 +2707,9 @@ func (s *SdkResponse) UnmarshalJSON(data []byte) error {
 func (s SdkResponse) MarshalJSON() ([]byte, error) {
 	switch s.Type {
 	default:
+		if s._unknown != nil {
+			return s._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", s.Type, s)
 	case "json":
 		var marshaler = struct {
@@ -1642,11 +2755,17 @@ type SdkResponseVisitor interface {
 	VisitStreaming(*StreamingResponse) error
 	VisitMaybeStreaming(*MaybeStreamingResponse) error
 	VisitFileDownload(*FileDownloadResponse) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (s *SdkResponse) Accept(visitor SdkResponseVisitor) error {
 	switch s.Type {
 	default:
+		if s._unknown != nil {
+			return visitor.VisitUnknown(s.Type, s._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", s.Type, s)
 	case "json":
 		return visitor.VisitJson(s.Json)
@@ -1659,14 +2778,42 @@ func (s *SdkResponse) Accept(visitor SdkResponseVisitor) error {
 	}
 }
 
+// Validate reports all of the SdkResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SdkResponse) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch s.Type {
+	case "json":
+		validation.Add("", s.Json.Validate())
+	case "streaming":
+		validation.Add("", s.Streaming.Validate())
+	case "maybeStreaming":
+		validation.Add("", s.MaybeStreaming.Validate())
+	case "fileDownload":
+		validation.Add("", s.FileDownload.Validate())
+	default:
+		if s._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", s.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type StreamCondition struct {
 	Type string
 	// The name of a boolean query parameter. If it is true, the response
 	// should be streamed. Otherwise, it should not be streamed.
+	//
 	QueryParameterKey string
 	// The name of a boolean property on the request. If it is true, the response
 	// should be streamed. Otherwise, it should not be streamed.
+	//
 	RequestPropertyKey string
+
+	_unknown json.RawMessage
 }
 
 func NewStreamConditionFromQueryParameterKey(value string) *StreamCondition {
@@ -1702,6 +2849,8 @@ func (s *StreamCondition) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		s.RequestPropertyKey = valueUnmarshaler.RequestPropertyKey
+	default:
+		s._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -1709,6 +2858,9 @@ func (s *StreamCondition) UnmarshalJSON(data []byte) error {
 func (s StreamCondition) MarshalJSON() ([]byte, error) {
 	switch s.Type {
 	default:
+		if s._unknown != nil {
+			return s._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", s.Type, s)
 	case "queryParameterKey":
 		var marshaler = struct {
@@ -1734,11 +2886,17 @@ func (s StreamCondition) MarshalJSON() ([]byte, error) {
 type StreamConditionVisitor interface {
 	VisitQueryParameterKey(string) error
 	VisitRequestPropertyKey(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (s *StreamCondition) Accept(visitor StreamConditionVisitor) error {
 	switch s.Type {
 	default:
+		if s._unknown != nil {
+			return visitor.VisitUnknown(s.Type, s._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", s.Type, s)
 	case "queryParameterKey":
 		return visitor.VisitQueryParameterKey(s.QueryParameterKey)
@@ -1747,9 +2905,27 @@ func (s *StreamCondition) Accept(visitor StreamConditionVisitor) error {
 	}
 }
 
+// Validate reports all of the StreamCondition's invalid fields (e.g. missing
+// required fields), if any.
+func (s *StreamCondition) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch s.Type {
+	case "queryParameterKey":
+	case "requestPropertyKey":
+	default:
+		if s._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", s.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type StreamingResponse struct {
-	DataEventType *TypeReference `json:"dataEventType,omitempty"`
-	Terminator    *string        `json:"terminator,omitempty"`
+	DataEventType *TypeReference `json:"dataEventType,omitempty" url:"dataEventType"`
+	Terminator    *string        `json:"terminator,omitempty" url:"terminator,omitempty"`
 }
 
 func (s *StreamingResponse) String() string {
@@ -1759,9 +2935,23 @@ func (s *StreamingResponse) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the StreamingResponse's invalid fields (e.g. missing
+// required fields), if any.
+func (s *StreamingResponse) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.DataEventType == nil {
+		validation.Required("dataEventType")
+	}
+	validation.Add("dataEventType", s.DataEventType.Validate())
+	return validation.Err()
+}
+
 type ErrorDiscriminationByPropertyStrategy struct {
-	Discriminant    *NameAndWireValue `json:"discriminant,omitempty"`
-	ContentProperty *NameAndWireValue `json:"contentProperty,omitempty"`
+	Discriminant    *NameAndWireValue `json:"discriminant,omitempty" url:"discriminant"`
+	ContentProperty *NameAndWireValue `json:"contentProperty,omitempty" url:"contentProperty"`
 }
 
 func (e *ErrorDiscriminationByPropertyStrategy) String() string {
@@ -1771,10 +2961,30 @@ func (e *ErrorDiscriminationByPropertyStrategy) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ErrorDiscriminationByPropertyStrategy's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ErrorDiscriminationByPropertyStrategy) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Discriminant == nil {
+		validation.Required("discriminant")
+	}
+	validation.Add("discriminant", e.Discriminant.Validate())
+	if e.ContentProperty == nil {
+		validation.Required("contentProperty")
+	}
+	validation.Add("contentProperty", e.ContentProperty.Validate())
+	return validation.Err()
+}
+
 type ErrorDiscriminationStrategy struct {
 	Type       string
 	StatusCode interface{}
 	Property   *ErrorDiscriminationByPropertyStrategy
+
+	_unknown json.RawMessage
 }
 
 func NewErrorDiscriminationStrategyFromStatusCode(value interface{}) *ErrorDiscriminationStrategy {
@@ -1806,6 +3016,8 @@ func (e *ErrorDiscriminationStrategy) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.Property = value
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -1813,6 +3025,9 @@ func (e *ErrorDiscriminationStrategy) UnmarshalJSON(data []byte) error {
 func (e ErrorDiscriminationStrategy) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "statusCode":
 		var marshaler = struct {
@@ -1838,11 +3053,17 @@ func (e ErrorDiscriminationStrategy) MarshalJSON() ([]byte, error) {
 type ErrorDiscriminationStrategyVisitor interface {
 	VisitStatusCode(interface{}) error
 	VisitProperty(*ErrorDiscriminationByPropertyStrategy) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *ErrorDiscriminationStrategy) Accept(visitor ErrorDiscriminationStrategyVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "statusCode":
 		return visitor.VisitStatusCode(e.StatusCode)
@@ -1851,29 +3072,48 @@ func (e *ErrorDiscriminationStrategy) Accept(visitor ErrorDiscriminationStrategy
 	}
 }
 
+// Validate reports all of the ErrorDiscriminationStrategy's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ErrorDiscriminationStrategy) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "statusCode":
+	case "property":
+		validation.Add("", e.Property.Validate())
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 // Complete representation of the API schema
 type IntermediateRepresentation struct {
 	// This is the human readable unique id for the API.
-	ApiName        *Name    `json:"apiName,omitempty"`
-	ApiDisplayName *string  `json:"apiDisplayName,omitempty"`
-	ApiDocs        *string  `json:"apiDocs,omitempty"`
-	Auth           *ApiAuth `json:"auth,omitempty"`
+	ApiName        *Name    `json:"apiName,omitempty" url:"apiName"`
+	ApiDisplayName *string  `json:"apiDisplayName,omitempty" url:"apiDisplayName,omitempty"`
+	ApiDocs        *string  `json:"apiDocs,omitempty" url:"apiDocs,omitempty"`
+	Auth           *ApiAuth `json:"auth,omitempty" url:"auth"`
 	// API Wide headers that are sent on every request
-	Headers []*HttpHeader `json:"headers,omitempty"`
+	Headers []*HttpHeader `json:"headers,omitempty" url:"headers"`
 	// The types described by this API
-	Types map[TypeId]*TypeDeclaration `json:"types,omitempty"`
+	Types map[TypeId]*TypeDeclaration `json:"types,omitempty" url:"types"`
 	// The services exposed by this API
-	Services                    map[ServiceId]*HttpService    `json:"services,omitempty"`
-	Errors                      map[ErrorId]*ErrorDeclaration `json:"errors,omitempty"`
-	Subpackages                 map[SubpackageId]*Subpackage  `json:"subpackages,omitempty"`
-	RootPackage                 *Package                      `json:"rootPackage,omitempty"`
-	Constants                   *Constants                    `json:"constants,omitempty"`
-	Environments                *EnvironmentsConfig           `json:"environments,omitempty"`
-	BasePath                    *HttpPath                     `json:"basePath,omitempty"`
-	PathParameters              []*PathParameter              `json:"pathParameters,omitempty"`
-	ErrorDiscriminationStrategy *ErrorDiscriminationStrategy  `json:"errorDiscriminationStrategy,omitempty"`
-	SdkConfig                   *SdkConfig                    `json:"sdkConfig,omitempty"`
-	Variables                   []*VariableDeclaration        `json:"variables,omitempty"`
+	Services                    map[ServiceId]*HttpService    `json:"services,omitempty" url:"services"`
+	Errors                      map[ErrorId]*ErrorDeclaration `json:"errors,omitempty" url:"errors"`
+	Subpackages                 map[SubpackageId]*Subpackage  `json:"subpackages,omitempty" url:"subpackages"`
+	RootPackage                 *Package                      `json:"rootPackage,omitempty" url:"rootPackage"`
+	Constants                   *Constants                    `json:"constants,omitempty" url:"constants"`
+	Environments                *EnvironmentsConfig           `json:"environments,omitempty" url:"environments,omitempty"`
+	BasePath                    *HttpPath                     `json:"basePath,omitempty" url:"basePath,omitempty"`
+	PathParameters              []*PathParameter              `json:"pathParameters,omitempty" url:"pathParameters"`
+	ErrorDiscriminationStrategy *ErrorDiscriminationStrategy  `json:"errorDiscriminationStrategy,omitempty" url:"errorDiscriminationStrategy"`
+	SdkConfig                   *SdkConfig                    `json:"sdkConfig,omitempty" url:"sdkConfig"`
+	Variables                   []*VariableDeclaration        `json:"variables,omitempty" url:"variables"`
 }
 
 func (i *IntermediateRepresentation) String() string {
@@ -1883,14 +3123,71 @@ func (i *IntermediateRepresentation) String() string {
 	return fmt.Sprintf("%#v", i)
 }
 
+// Validate reports all of the IntermediateRepresentation's invalid fields (e.g. missing
+// required fields), if any.
+func (i *IntermediateRepresentation) Validate() error {
+	if i == nil {
+		return nil
+	}
+	var validation core.Validation
+	if i.ApiName == nil {
+		validation.Required("apiName")
+	}
+	validation.Add("apiName", i.ApiName.Validate())
+	if i.Auth == nil {
+		validation.Required("auth")
+	}
+	validation.Add("auth", i.Auth.Validate())
+	for index, value := range i.Headers {
+		validation.Add(fmt.Sprintf("headers[%d]", index), value.Validate())
+	}
+	for key, value := range i.Types {
+		validation.Add(fmt.Sprintf("types[%v]", key), value.Validate())
+	}
+	for key, value := range i.Services {
+		validation.Add(fmt.Sprintf("services[%v]", key), value.Validate())
+	}
+	for key, value := range i.Errors {
+		validation.Add(fmt.Sprintf("errors[%v]", key), value.Validate())
+	}
+	for key, value := range i.Subpackages {
+		validation.Add(fmt.Sprintf("subpackages[%v]", key), value.Validate())
+	}
+	if i.RootPackage == nil {
+		validation.Required("rootPackage")
+	}
+	validation.Add("rootPackage", i.RootPackage.Validate())
+	if i.Constants == nil {
+		validation.Required("constants")
+	}
+	validation.Add("constants", i.Constants.Validate())
+	validation.Add("environments", i.Environments.Validate())
+	validation.Add("basePath", i.BasePath.Validate())
+	for index, value := range i.PathParameters {
+		validation.Add(fmt.Sprintf("pathParameters[%d]", index), value.Validate())
+	}
+	if i.ErrorDiscriminationStrategy == nil {
+		validation.Required("errorDiscriminationStrategy")
+	}
+	validation.Add("errorDiscriminationStrategy", i.ErrorDiscriminationStrategy.Validate())
+	if i.SdkConfig == nil {
+		validation.Required("sdkConfig")
+	}
+	validation.Add("sdkConfig", i.SdkConfig.Validate())
+	for index, value := range i.Variables {
+		validation.Add(fmt.Sprintf("variables[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type Package struct {
-	Docs               *string        `json:"docs,omitempty"`
-	FernFilepath       *FernFilepath  `json:"fernFilepath,omitempty"`
-	Service            *ServiceId     `json:"service,omitempty"`
-	Types              []TypeId       `json:"types,omitempty"`
-	Errors             []ErrorId      `json:"errors,omitempty"`
-	Subpackages        []SubpackageId `json:"subpackages,omitempty"`
-	HasEndpointsInTree bool           `json:"hasEndpointsInTree"`
+	Docs               *string        `json:"docs,omitempty" url:"docs,omitempty"`
+	FernFilepath       *FernFilepath  `json:"fernFilepath,omitempty" url:"fernFilepath"`
+	Service            *ServiceId     `json:"service,omitempty" url:"service,omitempty"`
+	Types              []TypeId       `json:"types,omitempty" url:"types"`
+	Errors             []ErrorId      `json:"errors,omitempty" url:"errors"`
+	Subpackages        []SubpackageId `json:"subpackages,omitempty" url:"subpackages"`
+	HasEndpointsInTree bool           `json:"hasEndpointsInTree" url:"hasEndpointsInTree"`
 }
 
 func (p *Package) String() string {
@@ -1900,10 +3197,24 @@ func (p *Package) String() string {
 	return fmt.Sprintf("%#v", p)
 }
 
+// Validate reports all of the Package's invalid fields (e.g. missing
+// required fields), if any.
+func (p *Package) Validate() error {
+	if p == nil {
+		return nil
+	}
+	var validation core.Validation
+	if p.FernFilepath == nil {
+		validation.Required("fernFilepath")
+	}
+	validation.Add("fernFilepath", p.FernFilepath.Validate())
+	return validation.Err()
+}
+
 type PlatformHeaders struct {
-	Language   string `json:"language"`
-	SdkName    string `json:"sdkName"`
-	SdkVersion string `json:"sdkVersion"`
+	Language   string `json:"language" url:"language"`
+	SdkName    string `json:"sdkName" url:"sdkName"`
+	SdkVersion string `json:"sdkVersion" url:"sdkVersion"`
 }
 
 func (p *PlatformHeaders) String() string {
@@ -1913,10 +3224,16 @@ func (p *PlatformHeaders) String() string {
 	return fmt.Sprintf("%#v", p)
 }
 
+// Validate reports all of the PlatformHeaders's invalid fields (e.g. missing
+// required fields), if any.
+func (p *PlatformHeaders) Validate() error {
+	return nil
+}
+
 type SdkConfig struct {
-	IsAuthMandatory       bool             `json:"isAuthMandatory"`
-	HasStreamingEndpoints bool             `json:"hasStreamingEndpoints"`
-	PlatformHeaders       *PlatformHeaders `json:"platformHeaders,omitempty"`
+	IsAuthMandatory       bool             `json:"isAuthMandatory" url:"isAuthMandatory"`
+	HasStreamingEndpoints bool             `json:"hasStreamingEndpoints" url:"hasStreamingEndpoints"`
+	PlatformHeaders       *PlatformHeaders `json:"platformHeaders,omitempty" url:"platformHeaders"`
 }
 
 func (s *SdkConfig) String() string {
@@ -1926,15 +3243,29 @@ func (s *SdkConfig) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SdkConfig's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SdkConfig) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.PlatformHeaders == nil {
+		validation.Required("platformHeaders")
+	}
+	validation.Add("platformHeaders", s.PlatformHeaders.Validate())
+	return validation.Err()
+}
+
 type Subpackage struct {
-	Docs               *string        `json:"docs,omitempty"`
-	FernFilepath       *FernFilepath  `json:"fernFilepath,omitempty"`
-	Service            *ServiceId     `json:"service,omitempty"`
-	Types              []TypeId       `json:"types,omitempty"`
-	Errors             []ErrorId      `json:"errors,omitempty"`
-	Subpackages        []SubpackageId `json:"subpackages,omitempty"`
-	HasEndpointsInTree bool           `json:"hasEndpointsInTree"`
-	Name               *Name          `json:"name,omitempty"`
+	Docs               *string        `json:"docs,omitempty" url:"docs,omitempty"`
+	FernFilepath       *FernFilepath  `json:"fernFilepath,omitempty" url:"fernFilepath"`
+	Service            *ServiceId     `json:"service,omitempty" url:"service,omitempty"`
+	Types              []TypeId       `json:"types,omitempty" url:"types"`
+	Errors             []ErrorId      `json:"errors,omitempty" url:"errors"`
+	Subpackages        []SubpackageId `json:"subpackages,omitempty" url:"subpackages"`
+	HasEndpointsInTree bool           `json:"hasEndpointsInTree" url:"hasEndpointsInTree"`
+	Name               *Name          `json:"name,omitempty" url:"name"`
 }
 
 func (s *Subpackage) String() string {
@@ -1944,9 +3275,27 @@ func (s *Subpackage) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the Subpackage's invalid fields (e.g. missing
+// required fields), if any.
+func (s *Subpackage) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.FernFilepath == nil {
+		validation.Required("fernFilepath")
+	}
+	validation.Add("fernFilepath", s.FernFilepath.Validate())
+	if s.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", s.Name.Validate())
+	return validation.Err()
+}
+
 type AliasTypeDeclaration struct {
-	AliasOf      *TypeReference         `json:"aliasOf,omitempty"`
-	ResolvedType *ResolvedTypeReference `json:"resolvedType,omitempty"`
+	AliasOf      *TypeReference         `json:"aliasOf,omitempty" url:"aliasOf"`
+	ResolvedType *ResolvedTypeReference `json:"resolvedType,omitempty" url:"resolvedType"`
 }
 
 func (a *AliasTypeDeclaration) String() string {
@@ -1956,6 +3305,24 @@ func (a *AliasTypeDeclaration) String() string {
 	return fmt.Sprintf("%#v", a)
 }
 
+// Validate reports all of the AliasTypeDeclaration's invalid fields (e.g. missing
+// required fields), if any.
+func (a *AliasTypeDeclaration) Validate() error {
+	if a == nil {
+		return nil
+	}
+	var validation core.Validation
+	if a.AliasOf == nil {
+		validation.Required("aliasOf")
+	}
+	validation.Add("aliasOf", a.AliasOf.Validate())
+	if a.ResolvedType == nil {
+		validation.Required("resolvedType")
+	}
+	validation.Add("resolvedType", a.ResolvedType.Validate())
+	return validation.Err()
+}
+
 type ContainerType struct {
 	Type     string
 	List     *TypeReference
@@ -1963,6 +3330,8 @@ type ContainerType struct {
 	Optional *TypeReference
 	Set      *TypeReference
 	Literal  *Literal
+
+	_unknown json.RawMessage
 }
 
 func NewContainerTypeFromList(value *TypeReference) *ContainerType {
@@ -2032,6 +3401,8 @@ func (c *ContainerType) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		c.Literal = valueUnmarshaler.Literal
+	default:
+		c._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -2039,6 +3410,9 @@ func (c *ContainerType) UnmarshalJSON(data []byte) error {
 func (c ContainerType) MarshalJSON() ([]byte, error) {
 	switch c.Type {
 	default:
+		if c._unknown != nil {
+			return c._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", c.Type, c)
 	case "list":
 		var marshaler = struct {
@@ -2094,11 +3468,17 @@ type ContainerTypeVisitor interface {
 	VisitOptional(*TypeReference) error
 	VisitSet(*TypeReference) error
 	VisitLiteral(*Literal) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (c *ContainerType) Accept(visitor ContainerTypeVisitor) error {
 	switch c.Type {
 	default:
+		if c._unknown != nil {
+			return visitor.VisitUnknown(c.Type, c._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", c.Type, c)
 	case "list":
 		return visitor.VisitList(c.List)
@@ -2113,10 +3493,48 @@ func (c *ContainerType) Accept(visitor ContainerTypeVisitor) error {
 	}
 }
 
+// Validate reports all of the ContainerType's invalid fields (e.g. missing
+// required fields), if any.
+func (c *ContainerType) Validate() error {
+	if c == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch c.Type {
+	case "list":
+		if c.List == nil {
+			validation.Required("list")
+		}
+		validation.Add("list", c.List.Validate())
+	case "map":
+		validation.Add("", c.Map.Validate())
+	case "optional":
+		if c.Optional == nil {
+			validation.Required("optional")
+		}
+		validation.Add("optional", c.Optional.Validate())
+	case "set":
+		if c.Set == nil {
+			validation.Required("set")
+		}
+		validation.Add("set", c.Set.Validate())
+	case "literal":
+		if c.Literal == nil {
+			validation.Required("literal")
+		}
+		validation.Add("literal", c.Literal.Validate())
+	default:
+		if c._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", c.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type DeclaredTypeName struct {
-	TypeId       TypeId        `json:"typeId"`
-	FernFilepath *FernFilepath `json:"fernFilepath,omitempty"`
-	Name         *Name         `json:"name,omitempty"`
+	TypeId       TypeId        `json:"typeId" url:"typeId"`
+	FernFilepath *FernFilepath `json:"fernFilepath,omitempty" url:"fernFilepath"`
+	Name         *Name         `json:"name,omitempty" url:"name"`
 }
 
 func (d *DeclaredTypeName) String() string {
@@ -2126,8 +3544,26 @@ func (d *DeclaredTypeName) String() string {
 	return fmt.Sprintf("%#v", d)
 }
 
+// Validate reports all of the DeclaredTypeName's invalid fields (e.g. missing
+// required fields), if any.
+func (d *DeclaredTypeName) Validate() error {
+	if d == nil {
+		return nil
+	}
+	var validation core.Validation
+	if d.FernFilepath == nil {
+		validation.Required("fernFilepath")
+	}
+	validation.Add("fernFilepath", d.FernFilepath.Validate())
+	if d.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", d.Name.Validate())
+	return validation.Err()
+}
+
 type EnumTypeDeclaration struct {
-	Values []*EnumValue `json:"values,omitempty"`
+	Values []*EnumValue `json:"values,omitempty" url:"values"`
 }
 
 func (e *EnumTypeDeclaration) String() string {
@@ -2137,10 +3573,23 @@ func (e *EnumTypeDeclaration) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the EnumTypeDeclaration's invalid fields (e.g. missing
+// required fields), if any.
+func (e *EnumTypeDeclaration) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range e.Values {
+		validation.Add(fmt.Sprintf("values[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type EnumValue struct {
-	Docs         *string           `json:"docs,omitempty"`
-	Availability *Availability     `json:"availability,omitempty"`
-	Name         *NameAndWireValue `json:"name,omitempty"`
+	Docs         *string           `json:"docs,omitempty" url:"docs,omitempty"`
+	Availability *Availability     `json:"availability,omitempty" url:"availability"`
+	Name         *NameAndWireValue `json:"name,omitempty" url:"name"`
 }
 
 func (e *EnumValue) String() string {
@@ -2150,15 +3599,47 @@ func (e *EnumValue) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the EnumValue's invalid fields (e.g. missing
+// required fields), if any.
+func (e *EnumValue) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Availability == nil {
+		validation.Required("availability")
+	}
+	validation.Add("availability", e.Availability.Validate())
+	if e.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", e.Name.Validate())
+	return validation.Err()
+}
+
 type ExampleAliasType struct {
-	Value *ExampleTypeReference `json:"value,omitempty"`
+	Value *ExampleTypeReference `json:"value,omitempty" url:"value"`
 }
 
 func (e *ExampleAliasType) String() string {
 	if value, err := core.StringifyJSON(e); err == nil {
 		return value
 	}
-	return fmt.Sprintf("%#v", e)
+	return fmt.Sprintf("%#v", e)
+}
+
+// Validate reports all of the ExampleAliasType's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleAliasType) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Value == nil {
+		validation.Required("value")
+	}
+	validation.Add("value", e.Value.Validate())
+	return validation.Err()
 }
 
 type ExampleContainer struct {
@@ -2167,6 +3648,8 @@ type ExampleContainer struct {
 	Set      []*ExampleTypeReference
 	Optional *ExampleTypeReference
 	Map      []*ExampleKeyValuePair
+
+	_unknown json.RawMessage
 }
 
 func NewExampleContainerFromList(value []*ExampleTypeReference) *ExampleContainer {
@@ -2226,6 +3709,8 @@ func (e *ExampleContainer) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.Map = valueUnmarshaler.Map
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -2233,6 +3718,9 @@ func (e *ExampleContainer) UnmarshalJSON(data []byte) error {
 func (e ExampleContainer) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "list":
 		var marshaler = struct {
@@ -2278,11 +3766,17 @@ type ExampleContainerVisitor interface {
 	VisitSet([]*ExampleTypeReference) error
 	VisitOptional(*ExampleTypeReference) error
 	VisitMap([]*ExampleKeyValuePair) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *ExampleContainer) Accept(visitor ExampleContainerVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "list":
 		return visitor.VisitList(e.List)
@@ -2295,8 +3789,38 @@ func (e *ExampleContainer) Accept(visitor ExampleContainerVisitor) error {
 	}
 }
 
+// Validate reports all of the ExampleContainer's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleContainer) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "list":
+		for index, value := range e.List {
+			validation.Add(fmt.Sprintf("list[%d]", index), value.Validate())
+		}
+	case "set":
+		for index, value := range e.Set {
+			validation.Add(fmt.Sprintf("set[%d]", index), value.Validate())
+		}
+	case "optional":
+		validation.Add("optional", e.Optional.Validate())
+	case "map":
+		for index, value := range e.Map {
+			validation.Add(fmt.Sprintf("map[%d]", index), value.Validate())
+		}
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type ExampleEnumType struct {
-	WireValue string `json:"wireValue"`
+	WireValue string `json:"wireValue" url:"wireValue"`
 }
 
 func (e *ExampleEnumType) String() string {
@@ -2306,9 +3830,15 @@ func (e *ExampleEnumType) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleEnumType's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleEnumType) Validate() error {
+	return nil
+}
+
 type ExampleKeyValuePair struct {
-	Key   *ExampleTypeReference `json:"key,omitempty"`
-	Value *ExampleTypeReference `json:"value,omitempty"`
+	Key   *ExampleTypeReference `json:"key,omitempty" url:"key"`
+	Value *ExampleTypeReference `json:"value,omitempty" url:"value"`
 }
 
 func (e *ExampleKeyValuePair) String() string {
@@ -2318,9 +3848,27 @@ func (e *ExampleKeyValuePair) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleKeyValuePair's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleKeyValuePair) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Key == nil {
+		validation.Required("key")
+	}
+	validation.Add("key", e.Key.Validate())
+	if e.Value == nil {
+		validation.Required("value")
+	}
+	validation.Add("value", e.Value.Validate())
+	return validation.Err()
+}
+
 type ExampleNamedType struct {
-	TypeName *DeclaredTypeName `json:"typeName,omitempty"`
-	Shape    *ExampleTypeShape `json:"shape,omitempty"`
+	TypeName *DeclaredTypeName `json:"typeName,omitempty" url:"typeName"`
+	Shape    *ExampleTypeShape `json:"shape,omitempty" url:"shape"`
 }
 
 func (e *ExampleNamedType) String() string {
@@ -2330,12 +3878,30 @@ func (e *ExampleNamedType) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleNamedType's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleNamedType) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.TypeName == nil {
+		validation.Required("typeName")
+	}
+	validation.Add("typeName", e.TypeName.Validate())
+	if e.Shape == nil {
+		validation.Required("shape")
+	}
+	validation.Add("shape", e.Shape.Validate())
+	return validation.Err()
+}
+
 type ExampleObjectProperty struct {
-	WireKey string                `json:"wireKey"`
-	Value   *ExampleTypeReference `json:"value,omitempty"`
+	WireKey string                `json:"wireKey" url:"wireKey"`
+	Value   *ExampleTypeReference `json:"value,omitempty" url:"value"`
 	// this property may have been brought in via extension. originalTypeDeclaration
 	// is the name of the type that contains this property
-	OriginalTypeDeclaration *DeclaredTypeName `json:"originalTypeDeclaration,omitempty"`
+	OriginalTypeDeclaration *DeclaredTypeName `json:"originalTypeDeclaration,omitempty" url:"originalTypeDeclaration"`
 }
 
 func (e *ExampleObjectProperty) String() string {
@@ -2345,8 +3911,26 @@ func (e *ExampleObjectProperty) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleObjectProperty's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleObjectProperty) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Value == nil {
+		validation.Required("value")
+	}
+	validation.Add("value", e.Value.Validate())
+	if e.OriginalTypeDeclaration == nil {
+		validation.Required("originalTypeDeclaration")
+	}
+	validation.Add("originalTypeDeclaration", e.OriginalTypeDeclaration.Validate())
+	return validation.Err()
+}
+
 type ExampleObjectType struct {
-	Properties []*ExampleObjectProperty `json:"properties,omitempty"`
+	Properties []*ExampleObjectProperty `json:"properties,omitempty" url:"properties"`
 }
 
 func (e *ExampleObjectType) String() string {
@@ -2356,6 +3940,19 @@ func (e *ExampleObjectType) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleObjectType's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleObjectType) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range e.Properties {
+		validation.Add(fmt.Sprintf("properties[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type ExamplePrimitive struct {
 	Type     string
 	Integer  int
@@ -2366,6 +3963,8 @@ type ExamplePrimitive struct {
 	Datetime time.Time
 	Date     time.Time
 	Uuid     uuid.UUID
+
+	_unknown json.RawMessage
 }
 
 func NewExamplePrimitiveFromInteger(value int) *ExamplePrimitive {
@@ -2451,20 +4050,20 @@ func (e *ExamplePrimitive) UnmarshalJSON(data []byte) error {
 		e.Long = valueUnmarshaler.Long
 	case "datetime":
 		var valueUnmarshaler struct {
-			Datetime time.Time `json:"datetime"`
+			Datetime *core.DateTime `json:"datetime"`
 		}
 		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
 			return err
 		}
-		e.Datetime = valueUnmarshaler.Datetime
+		e.Datetime = valueUnmarshaler.Datetime.Time()
 	case "date":
 		var valueUnmarshaler struct {
-			Date time.Time `json:"date"`
+			Date *core.Date `json:"date"`
 		}
 		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
 			return err
 		}
-		e.Date = valueUnmarshaler.Date
+		e.Date = valueUnmarshaler.Date.Time()
 	case "uuid":
 		var valueUnmarshaler struct {
 			Uuid uuid.UUID `json:"uuid"`
@@ -2473,6 +4072,8 @@ func (e *ExamplePrimitive) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.Uuid = valueUnmarshaler.Uuid
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -2480,6 +4081,9 @@ func (e *ExamplePrimitive) UnmarshalJSON(data []byte) error {
 func (e ExamplePrimitive) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "integer":
 		var marshaler = struct {
@@ -2528,20 +4132,20 @@ func (e ExamplePrimitive) MarshalJSON() ([]byte, error) {
 		return json.Marshal(marshaler)
 	case "datetime":
 		var marshaler = struct {
-			Type     string    `json:"type"`
-			Datetime time.Time `json:"datetime"`
+			Type     string         `json:"type"`
+			Datetime *core.DateTime `json:"datetime"`
 		}{
 			Type:     e.Type,
-			Datetime: e.Datetime,
+			Datetime: core.NewDateTime(e.Datetime),
 		}
 		return json.Marshal(marshaler)
 	case "date":
 		var marshaler = struct {
-			Type string    `json:"type"`
-			Date time.Time `json:"date"`
+			Type string     `json:"type"`
+			Date *core.Date `json:"date"`
 		}{
 			Type: e.Type,
-			Date: e.Date,
+			Date: core.NewDate(e.Date),
 		}
 		return json.Marshal(marshaler)
 	case "uuid":
@@ -2565,11 +4169,17 @@ type ExamplePrimitiveVisitor interface {
 	VisitDatetime(time.Time) error
 	VisitDate(time.Time) error
 	VisitUuid(uuid.UUID) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *ExamplePrimitive) Accept(visitor ExamplePrimitiveVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "integer":
 		return visitor.VisitInteger(e.Integer)
@@ -2590,9 +4200,33 @@ func (e *ExamplePrimitive) Accept(visitor ExamplePrimitiveVisitor) error {
 	}
 }
 
+// Validate reports all of the ExamplePrimitive's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExamplePrimitive) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "integer":
+	case "double":
+	case "string":
+	case "boolean":
+	case "long":
+	case "datetime":
+	case "date":
+	case "uuid":
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type ExampleSingleUnionType struct {
-	WireDiscriminantValue string                            `json:"wireDiscriminantValue"`
-	Properties            *ExampleSingleUnionTypeProperties `json:"properties,omitempty"`
+	WireDiscriminantValue string                            `json:"wireDiscriminantValue" url:"wireDiscriminantValue"`
+	Properties            *ExampleSingleUnionTypeProperties `json:"properties,omitempty" url:"properties"`
 }
 
 func (e *ExampleSingleUnionType) String() string {
@@ -2602,11 +4236,27 @@ func (e *ExampleSingleUnionType) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleSingleUnionType's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleSingleUnionType) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Properties == nil {
+		validation.Required("properties")
+	}
+	validation.Add("properties", e.Properties.Validate())
+	return validation.Err()
+}
+
 type ExampleSingleUnionTypeProperties struct {
 	Type                   string
 	SamePropertiesAsObject *ExampleNamedType
 	SingleProperty         *ExampleTypeReference
 	NoProperties           interface{}
+
+	_unknown json.RawMessage
 }
 
 func NewExampleSingleUnionTypePropertiesFromSamePropertiesAsObject(value *ExampleNamedType) *ExampleSingleUnionTypeProperties {
@@ -2648,6 +4298,8 @@ func (e *ExampleSingleUnionTypeProperties) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.NoProperties = value
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -2655,6 +4307,9 @@ func (e *ExampleSingleUnionTypeProperties) UnmarshalJSON(data []byte) error {
 func (e ExampleSingleUnionTypeProperties) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "samePropertiesAsObject":
 		var marshaler = struct {
@@ -2690,11 +4345,17 @@ type ExampleSingleUnionTypePropertiesVisitor interface {
 	VisitSamePropertiesAsObject(*ExampleNamedType) error
 	VisitSingleProperty(*ExampleTypeReference) error
 	VisitNoProperties(interface{}) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *ExampleSingleUnionTypeProperties) Accept(visitor ExampleSingleUnionTypePropertiesVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "samePropertiesAsObject":
 		return visitor.VisitSamePropertiesAsObject(e.SamePropertiesAsObject)
@@ -2705,11 +4366,32 @@ func (e *ExampleSingleUnionTypeProperties) Accept(visitor ExampleSingleUnionType
 	}
 }
 
+// Validate reports all of the ExampleSingleUnionTypeProperties's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleSingleUnionTypeProperties) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "samePropertiesAsObject":
+		validation.Add("", e.SamePropertiesAsObject.Validate())
+	case "singleProperty":
+		validation.Add("", e.SingleProperty.Validate())
+	case "noProperties":
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type ExampleType struct {
-	JsonExample interface{}       `json:"jsonExample,omitempty"`
-	Docs        *string           `json:"docs,omitempty"`
-	Name        *Name             `json:"name,omitempty"`
-	Shape       *ExampleTypeShape `json:"shape,omitempty"`
+	JsonExample interface{}       `json:"jsonExample,omitempty" url:"jsonExample"`
+	Docs        *string           `json:"docs,omitempty" url:"docs,omitempty"`
+	Name        *Name             `json:"name,omitempty" url:"name,omitempty"`
+	Shape       *ExampleTypeShape `json:"shape,omitempty" url:"shape"`
 }
 
 func (e *ExampleType) String() string {
@@ -2719,9 +4401,24 @@ func (e *ExampleType) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleType's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleType) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	validation.Add("name", e.Name.Validate())
+	if e.Shape == nil {
+		validation.Required("shape")
+	}
+	validation.Add("shape", e.Shape.Validate())
+	return validation.Err()
+}
+
 type ExampleTypeReference struct {
-	JsonExample interface{}                `json:"jsonExample,omitempty"`
-	Shape       *ExampleTypeReferenceShape `json:"shape,omitempty"`
+	JsonExample interface{}                `json:"jsonExample,omitempty" url:"jsonExample"`
+	Shape       *ExampleTypeReferenceShape `json:"shape,omitempty" url:"shape"`
 }
 
 func (e *ExampleTypeReference) String() string {
@@ -2731,12 +4428,28 @@ func (e *ExampleTypeReference) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the ExampleTypeReference's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleTypeReference) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	if e.Shape == nil {
+		validation.Required("shape")
+	}
+	validation.Add("shape", e.Shape.Validate())
+	return validation.Err()
+}
+
 type ExampleTypeReferenceShape struct {
 	Type      string
 	Primitive *ExamplePrimitive
 	Container *ExampleContainer
 	Unknown   interface{}
 	Named     *ExampleNamedType
+
+	_unknown json.RawMessage
 }
 
 func NewExampleTypeReferenceShapeFromPrimitive(value *ExamplePrimitive) *ExampleTypeReferenceShape {
@@ -2794,6 +4507,8 @@ func (e *ExampleTypeReferenceShape) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.Named = value
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -2801,6 +4516,9 @@ func (e *ExampleTypeReferenceShape) UnmarshalJSON(data []byte) error {
 func (e ExampleTypeReferenceShape) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "primitive":
 		var marshaler = struct {
@@ -2846,11 +4564,17 @@ type ExampleTypeReferenceShapeVisitor interface {
 	VisitContainer(*ExampleContainer) error
 	VisitUnknown(interface{}) error
 	VisitNamed(*ExampleNamedType) error
+	// VisitUnknownValue is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknownValue(discriminant string, data json.RawMessage) error
 }
 
 func (e *ExampleTypeReferenceShape) Accept(visitor ExampleTypeReferenceShapeVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknownValue(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "primitive":
 		return visitor.VisitPrimitive(e.Primitive)
@@ -2863,12 +4587,43 @@ func (e *ExampleTypeReferenceShape) Accept(visitor ExampleTypeReferenceShapeVisi
 	}
 }
 
+// Validate reports all of the ExampleTypeReferenceShape's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleTypeReferenceShape) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "primitive":
+		if e.Primitive == nil {
+			validation.Required("primitive")
+		}
+		validation.Add("primitive", e.Primitive.Validate())
+	case "container":
+		if e.Container == nil {
+			validation.Required("container")
+		}
+		validation.Add("container", e.Container.Validate())
+	case "unknown":
+	case "named":
+		validation.Add("", e.Named.Validate())
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type ExampleTypeShape struct {
 	Type   string
 	Alias  *ExampleAliasType
 	Enum   *ExampleEnumType
 	Object *ExampleObjectType
 	Union  *ExampleSingleUnionType
+
+	_unknown json.RawMessage
 }
 
 func NewExampleTypeShapeFromAlias(value *ExampleAliasType) *ExampleTypeShape {
@@ -2920,6 +4675,8 @@ func (e *ExampleTypeShape) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		e.Union = value
+	default:
+		e._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -2927,6 +4684,9 @@ func (e *ExampleTypeShape) UnmarshalJSON(data []byte) error {
 func (e ExampleTypeShape) MarshalJSON() ([]byte, error) {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return e._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "alias":
 		var marshaler = struct {
@@ -2972,11 +4732,17 @@ type ExampleTypeShapeVisitor interface {
 	VisitEnum(*ExampleEnumType) error
 	VisitObject(*ExampleObjectType) error
 	VisitUnion(*ExampleSingleUnionType) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (e *ExampleTypeShape) Accept(visitor ExampleTypeShapeVisitor) error {
 	switch e.Type {
 	default:
+		if e._unknown != nil {
+			return visitor.VisitUnknown(e.Type, e._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", e.Type, e)
 	case "alias":
 		return visitor.VisitAlias(e.Alias)
@@ -2989,9 +4755,35 @@ func (e *ExampleTypeShape) Accept(visitor ExampleTypeShapeVisitor) error {
 	}
 }
 
+// Validate reports all of the ExampleTypeShape's invalid fields (e.g. missing
+// required fields), if any.
+func (e *ExampleTypeShape) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch e.Type {
+	case "alias":
+		validation.Add("", e.Alias.Validate())
+	case "enum":
+		validation.Add("", e.Enum.Validate())
+	case "object":
+		validation.Add("", e.Object.Validate())
+	case "union":
+		validation.Add("", e.Union.Validate())
+	default:
+		if e._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", e.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type Literal struct {
 	Type   string
 	String string
+
+	_unknown json.RawMessage
 }
 
 func NewLiteralFromString(value string) *Literal {
@@ -3015,6 +4807,8 @@ func (l *Literal) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		l.String = valueUnmarshaler.String
+	default:
+		l._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -3022,6 +4816,9 @@ func (l *Literal) UnmarshalJSON(data []byte) error {
 func (l Literal) MarshalJSON() ([]byte, error) {
 	switch l.Type {
 	default:
+		if l._unknown != nil {
+			return l._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", l.Type, l)
 	case "string":
 		var marshaler = struct {
@@ -3037,20 +4834,43 @@ func (l Literal) MarshalJSON() ([]byte, error) {
 
 type LiteralVisitor interface {
 	VisitString(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (l *Literal) Accept(visitor LiteralVisitor) error {
 	switch l.Type {
 	default:
+		if l._unknown != nil {
+			return visitor.VisitUnknown(l.Type, l._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", l.Type, l)
 	case "string":
 		return visitor.VisitString(l.String)
 	}
 }
 
+// Validate reports all of the Literal's invalid fields (e.g. missing
+// required fields), if any.
+func (l *Literal) Validate() error {
+	if l == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch l.Type {
+	case "string":
+	default:
+		if l._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", l.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type MapType struct {
-	KeyType   *TypeReference `json:"keyType,omitempty"`
-	ValueType *TypeReference `json:"valueType,omitempty"`
+	KeyType   *TypeReference `json:"keyType,omitempty" url:"keyType"`
+	ValueType *TypeReference `json:"valueType,omitempty" url:"valueType"`
 }
 
 func (m *MapType) String() string {
@@ -3060,11 +4880,29 @@ func (m *MapType) String() string {
 	return fmt.Sprintf("%#v", m)
 }
 
+// Validate reports all of the MapType's invalid fields (e.g. missing
+// required fields), if any.
+func (m *MapType) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var validation core.Validation
+	if m.KeyType == nil {
+		validation.Required("keyType")
+	}
+	validation.Add("keyType", m.KeyType.Validate())
+	if m.ValueType == nil {
+		validation.Required("valueType")
+	}
+	validation.Add("valueType", m.ValueType.Validate())
+	return validation.Err()
+}
+
 type ObjectProperty struct {
-	Docs         *string           `json:"docs,omitempty"`
-	Availability *Availability     `json:"availability,omitempty"`
-	Name         *NameAndWireValue `json:"name,omitempty"`
-	ValueType    *TypeReference    `json:"valueType,omitempty"`
+	Docs         *string           `json:"docs,omitempty" url:"docs,omitempty"`
+	Availability *Availability     `json:"availability,omitempty" url:"availability"`
+	Name         *NameAndWireValue `json:"name,omitempty" url:"name"`
+	ValueType    *TypeReference    `json:"valueType,omitempty" url:"valueType"`
 }
 
 func (o *ObjectProperty) String() string {
@@ -3074,10 +4912,32 @@ func (o *ObjectProperty) String() string {
 	return fmt.Sprintf("%#v", o)
 }
 
+// Validate reports all of the ObjectProperty's invalid fields (e.g. missing
+// required fields), if any.
+func (o *ObjectProperty) Validate() error {
+	if o == nil {
+		return nil
+	}
+	var validation core.Validation
+	if o.Availability == nil {
+		validation.Required("availability")
+	}
+	validation.Add("availability", o.Availability.Validate())
+	if o.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", o.Name.Validate())
+	if o.ValueType == nil {
+		validation.Required("valueType")
+	}
+	validation.Add("valueType", o.ValueType.Validate())
+	return validation.Err()
+}
+
 type ObjectTypeDeclaration struct {
 	// A list of other types to inherit from
-	Extends    []*DeclaredTypeName `json:"extends,omitempty"`
-	Properties []*ObjectProperty   `json:"properties,omitempty"`
+	Extends    []*DeclaredTypeName `json:"extends,omitempty" url:"extends"`
+	Properties []*ObjectProperty   `json:"properties,omitempty" url:"properties"`
 }
 
 func (o *ObjectTypeDeclaration) String() string {
@@ -3087,6 +4947,22 @@ func (o *ObjectTypeDeclaration) String() string {
 	return fmt.Sprintf("%#v", o)
 }
 
+// Validate reports all of the ObjectTypeDeclaration's invalid fields (e.g. missing
+// required fields), if any.
+func (o *ObjectTypeDeclaration) Validate() error {
+	if o == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range o.Extends {
+		validation.Add(fmt.Sprintf("extends[%d]", index), value.Validate())
+	}
+	for index, value := range o.Properties {
+		validation.Add(fmt.Sprintf("properties[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type PrimitiveType string
 
 const (
@@ -3131,9 +5007,15 @@ func (p PrimitiveType) Ptr() *PrimitiveType {
 	return &p
 }
 
+// Validate returns an error if the PrimitiveType isn't one of its known values.
+func (p PrimitiveType) Validate() error {
+	_, err := NewPrimitiveTypeFromString(string(p))
+	return err
+}
+
 type ResolvedNamedType struct {
-	Name  *DeclaredTypeName `json:"name,omitempty"`
-	Shape ShapeType         `json:"shape,omitempty"`
+	Name  *DeclaredTypeName `json:"name,omitempty" url:"name"`
+	Shape ShapeType         `json:"shape,omitempty" url:"shape"`
 }
 
 func (r *ResolvedNamedType) String() string {
@@ -3143,12 +5025,29 @@ func (r *ResolvedNamedType) String() string {
 	return fmt.Sprintf("%#v", r)
 }
 
+// Validate reports all of the ResolvedNamedType's invalid fields (e.g. missing
+// required fields), if any.
+func (r *ResolvedNamedType) Validate() error {
+	if r == nil {
+		return nil
+	}
+	var validation core.Validation
+	if r.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", r.Name.Validate())
+	validation.Add("shape", r.Shape.Validate())
+	return validation.Err()
+}
+
 type ResolvedTypeReference struct {
 	Type      string
 	Container *ContainerType
 	Named     *ResolvedNamedType
 	Primitive PrimitiveType
 	Unknown   interface{}
+
+	_unknown json.RawMessage
 }
 
 func NewResolvedTypeReferenceFromContainer(value *ContainerType) *ResolvedTypeReference {
@@ -3204,6 +5103,8 @@ func (r *ResolvedTypeReference) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		r.Unknown = value
+	default:
+		r._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -3211,6 +5112,9 @@ func (r *ResolvedTypeReference) UnmarshalJSON(data []byte) error {
 func (r ResolvedTypeReference) MarshalJSON() ([]byte, error) {
 	switch r.Type {
 	default:
+		if r._unknown != nil {
+			return r._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", r.Type, r)
 	case "container":
 		var marshaler = struct {
@@ -3256,11 +5160,17 @@ type ResolvedTypeReferenceVisitor interface {
 	VisitNamed(*ResolvedNamedType) error
 	VisitPrimitive(PrimitiveType) error
 	VisitUnknown(interface{}) error
+	// VisitUnknownValue is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknownValue(discriminant string, data json.RawMessage) error
 }
 
 func (r *ResolvedTypeReference) Accept(visitor ResolvedTypeReferenceVisitor) error {
 	switch r.Type {
 	default:
+		if r._unknown != nil {
+			return visitor.VisitUnknownValue(r.Type, r._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", r.Type, r)
 	case "container":
 		return visitor.VisitContainer(r.Container)
@@ -3273,6 +5183,32 @@ func (r *ResolvedTypeReference) Accept(visitor ResolvedTypeReferenceVisitor) err
 	}
 }
 
+// Validate reports all of the ResolvedTypeReference's invalid fields (e.g. missing
+// required fields), if any.
+func (r *ResolvedTypeReference) Validate() error {
+	if r == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch r.Type {
+	case "container":
+		if r.Container == nil {
+			validation.Required("container")
+		}
+		validation.Add("container", r.Container.Validate())
+	case "named":
+		validation.Add("", r.Named.Validate())
+	case "primitive":
+		validation.Add("primitive", r.Primitive.Validate())
+	case "unknown":
+	default:
+		if r._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", r.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type ShapeType string
 
 const (
@@ -3301,10 +5237,16 @@ func (s ShapeType) Ptr() *ShapeType {
 	return &s
 }
 
+// Validate returns an error if the ShapeType isn't one of its known values.
+func (s ShapeType) Validate() error {
+	_, err := NewShapeTypeFromString(string(s))
+	return err
+}
+
 type SingleUnionType struct {
-	Docs              *string                    `json:"docs,omitempty"`
-	DiscriminantValue *NameAndWireValue          `json:"discriminantValue,omitempty"`
-	Shape             *SingleUnionTypeProperties `json:"shape,omitempty"`
+	Docs              *string                    `json:"docs,omitempty" url:"docs,omitempty"`
+	DiscriminantValue *NameAndWireValue          `json:"discriminantValue,omitempty" url:"discriminantValue"`
+	Shape             *SingleUnionTypeProperties `json:"shape,omitempty" url:"shape"`
 }
 
 func (s *SingleUnionType) String() string {
@@ -3314,11 +5256,31 @@ func (s *SingleUnionType) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SingleUnionType's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SingleUnionType) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.DiscriminantValue == nil {
+		validation.Required("discriminantValue")
+	}
+	validation.Add("discriminantValue", s.DiscriminantValue.Validate())
+	if s.Shape == nil {
+		validation.Required("shape")
+	}
+	validation.Add("shape", s.Shape.Validate())
+	return validation.Err()
+}
+
 type SingleUnionTypeProperties struct {
 	PropertiesType         string
 	SamePropertiesAsObject *DeclaredTypeName
 	SingleProperty         *SingleUnionTypeProperty
 	NoProperties           interface{}
+
+	_unknown json.RawMessage
 }
 
 func NewSingleUnionTypePropertiesFromSamePropertiesAsObject(value *DeclaredTypeName) *SingleUnionTypeProperties {
@@ -3360,6 +5322,8 @@ func (s *SingleUnionTypeProperties) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		s.NoProperties = value
+	default:
+		s._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -3367,6 +5331,9 @@ func (s *SingleUnionTypeProperties) UnmarshalJSON(data []byte) error {
 func (s SingleUnionTypeProperties) MarshalJSON() ([]byte, error) {
 	switch s.PropertiesType {
 	default:
+		if s._unknown != nil {
+			return s._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", s.PropertiesType, s)
 	case "samePropertiesAsObject":
 		var marshaler = struct {
@@ -3402,11 +5369,17 @@ type SingleUnionTypePropertiesVisitor interface {
 	VisitSamePropertiesAsObject(*DeclaredTypeName) error
 	VisitSingleProperty(*SingleUnionTypeProperty) error
 	VisitNoProperties(interface{}) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (s *SingleUnionTypeProperties) Accept(visitor SingleUnionTypePropertiesVisitor) error {
 	switch s.PropertiesType {
 	default:
+		if s._unknown != nil {
+			return visitor.VisitUnknown(s.PropertiesType, s._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", s.PropertiesType, s)
 	case "samePropertiesAsObject":
 		return visitor.VisitSamePropertiesAsObject(s.SamePropertiesAsObject)
@@ -3417,9 +5390,30 @@ func (s *SingleUnionTypeProperties) Accept(visitor SingleUnionTypePropertiesVisi
 	}
 }
 
+// Validate reports all of the SingleUnionTypeProperties's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SingleUnionTypeProperties) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch s.PropertiesType {
+	case "samePropertiesAsObject":
+		validation.Add("", s.SamePropertiesAsObject.Validate())
+	case "singleProperty":
+		validation.Add("", s.SingleProperty.Validate())
+	case "noProperties":
+	default:
+		if s._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", s.PropertiesType))
+		}
+	}
+	return validation.Err()
+}
+
 type SingleUnionTypeProperty struct {
-	Name *NameAndWireValue `json:"name,omitempty"`
-	Type *TypeReference    `json:"type,omitempty"`
+	Name *NameAndWireValue `json:"name,omitempty" url:"name"`
+	Type *TypeReference    `json:"type,omitempty" url:"type"`
 }
 
 func (s *SingleUnionTypeProperty) String() string {
@@ -3429,6 +5423,24 @@ func (s *SingleUnionTypeProperty) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SingleUnionTypeProperty's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SingleUnionTypeProperty) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", s.Name.Validate())
+	if s.Type == nil {
+		validation.Required("type")
+	}
+	validation.Add("type", s.Type.Validate())
+	return validation.Err()
+}
+
 type Type struct {
 	Type                 string
 	Alias                *AliasTypeDeclaration
@@ -3436,6 +5448,8 @@ type Type struct {
 	Object               *ObjectTypeDeclaration
 	Union                *UnionTypeDeclaration
 	UndiscriminatedUnion *UndiscriminatedUnionTypeDeclaration
+
+	_unknown json.RawMessage
 }
 
 func NewTypeFromAlias(value *AliasTypeDeclaration) *Type {
@@ -3497,6 +5511,8 @@ func (t *Type) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		t.UndiscriminatedUnion = value
+	default:
+		t._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -3504,6 +5520,9 @@ func (t *Type) UnmarshalJSON(data []byte) error {
 func (t Type) MarshalJSON() ([]byte, error) {
 	switch t.Type {
 	default:
+		if t._unknown != nil {
+			return t._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", t.Type, t)
 	case "alias":
 		var marshaler = struct {
@@ -3559,11 +5578,17 @@ type TypeVisitor interface {
 	VisitObject(*ObjectTypeDeclaration) error
 	VisitUnion(*UnionTypeDeclaration) error
 	VisitUndiscriminatedUnion(*UndiscriminatedUnionTypeDeclaration) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (t *Type) Accept(visitor TypeVisitor) error {
 	switch t.Type {
 	default:
+		if t._unknown != nil {
+			return visitor.VisitUnknown(t.Type, t._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", t.Type, t)
 	case "alias":
 		return visitor.VisitAlias(t.Alias)
@@ -3578,15 +5603,41 @@ func (t *Type) Accept(visitor TypeVisitor) error {
 	}
 }
 
+// Validate reports all of the Type's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Type) Validate() error {
+	if t == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch t.Type {
+	case "alias":
+		validation.Add("", t.Alias.Validate())
+	case "enum":
+		validation.Add("", t.Enum.Validate())
+	case "object":
+		validation.Add("", t.Object.Validate())
+	case "union":
+		validation.Add("", t.Union.Validate())
+	case "undiscriminatedUnion":
+		validation.Add("", t.UndiscriminatedUnion.Validate())
+	default:
+		if t._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", t.Type))
+		}
+	}
+	return validation.Err()
+}
+
 // A type, which is a name and a shape
 type TypeDeclaration struct {
-	Docs         *string           `json:"docs,omitempty"`
-	Availability *Availability     `json:"availability,omitempty"`
-	Name         *DeclaredTypeName `json:"name,omitempty"`
-	Shape        *Type             `json:"shape,omitempty"`
-	Examples     []*ExampleType    `json:"examples,omitempty"`
+	Docs         *string           `json:"docs,omitempty" url:"docs,omitempty"`
+	Availability *Availability     `json:"availability,omitempty" url:"availability"`
+	Name         *DeclaredTypeName `json:"name,omitempty" url:"name"`
+	Shape        *Type             `json:"shape,omitempty" url:"shape"`
+	Examples     []*ExampleType    `json:"examples,omitempty" url:"examples"`
 	// All other named types that this type references (directly or indirectly)
-	ReferencedTypes []*DeclaredTypeName `json:"referencedTypes,omitempty"`
+	ReferencedTypes []*DeclaredTypeName `json:"referencedTypes,omitempty" url:"referencedTypes"`
 }
 
 func (t *TypeDeclaration) String() string {
@@ -3596,12 +5647,42 @@ func (t *TypeDeclaration) String() string {
 	return fmt.Sprintf("%#v", t)
 }
 
+// Validate reports all of the TypeDeclaration's invalid fields (e.g. missing
+// required fields), if any.
+func (t *TypeDeclaration) Validate() error {
+	if t == nil {
+		return nil
+	}
+	var validation core.Validation
+	if t.Availability == nil {
+		validation.Required("availability")
+	}
+	validation.Add("availability", t.Availability.Validate())
+	if t.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", t.Name.Validate())
+	if t.Shape == nil {
+		validation.Required("shape")
+	}
+	validation.Add("shape", t.Shape.Validate())
+	for index, value := range t.Examples {
+		validation.Add(fmt.Sprintf("examples[%d]", index), value.Validate())
+	}
+	for index, value := range t.ReferencedTypes {
+		validation.Add(fmt.Sprintf("referencedTypes[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type TypeReference struct {
 	Type      string
 	Container *ContainerType
 	Named     *DeclaredTypeName
 	Primitive PrimitiveType
 	Unknown   interface{}
+
+	_unknown json.RawMessage
 }
 
 func NewTypeReferenceFromContainer(value *ContainerType) *TypeReference {
@@ -3657,6 +5738,8 @@ func (t *TypeReference) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		t.Unknown = value
+	default:
+		t._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -3664,6 +5747,9 @@ func (t *TypeReference) UnmarshalJSON(data []byte) error {
 func (t TypeReference) MarshalJSON() ([]byte, error) {
 	switch t.Type {
 	default:
+		if t._unknown != nil {
+			return t._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", t.Type, t)
 	case "container":
 		var marshaler = struct {
@@ -3709,11 +5795,17 @@ type TypeReferenceVisitor interface {
 	VisitNamed(*DeclaredTypeName) error
 	VisitPrimitive(PrimitiveType) error
 	VisitUnknown(interface{}) error
+	// VisitUnknownValue is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknownValue(discriminant string, data json.RawMessage) error
 }
 
 func (t *TypeReference) Accept(visitor TypeReferenceVisitor) error {
 	switch t.Type {
 	default:
+		if t._unknown != nil {
+			return visitor.VisitUnknownValue(t.Type, t._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", t.Type, t)
 	case "container":
 		return visitor.VisitContainer(t.Container)
@@ -3726,9 +5818,35 @@ func (t *TypeReference) Accept(visitor TypeReferenceVisitor) error {
 	}
 }
 
+// Validate reports all of the TypeReference's invalid fields (e.g. missing
+// required fields), if any.
+func (t *TypeReference) Validate() error {
+	if t == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch t.Type {
+	case "container":
+		if t.Container == nil {
+			validation.Required("container")
+		}
+		validation.Add("container", t.Container.Validate())
+	case "named":
+		validation.Add("", t.Named.Validate())
+	case "primitive":
+		validation.Add("primitive", t.Primitive.Validate())
+	case "unknown":
+	default:
+		if t._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", t.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type UndiscriminatedUnionMember struct {
-	Docs *string        `json:"docs,omitempty"`
-	Type *TypeReference `json:"type,omitempty"`
+	Docs *string        `json:"docs,omitempty" url:"docs,omitempty"`
+	Type *TypeReference `json:"type,omitempty" url:"type"`
 }
 
 func (u *UndiscriminatedUnionMember) String() string {
@@ -3738,8 +5856,22 @@ func (u *UndiscriminatedUnionMember) String() string {
 	return fmt.Sprintf("%#v", u)
 }
 
+// Validate reports all of the UndiscriminatedUnionMember's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UndiscriminatedUnionMember) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	if u.Type == nil {
+		validation.Required("type")
+	}
+	validation.Add("type", u.Type.Validate())
+	return validation.Err()
+}
+
 type UndiscriminatedUnionTypeDeclaration struct {
-	Members []*UndiscriminatedUnionMember `json:"members,omitempty"`
+	Members []*UndiscriminatedUnionMember `json:"members,omitempty" url:"members"`
 }
 
 func (u *UndiscriminatedUnionTypeDeclaration) String() string {
@@ -3749,12 +5881,25 @@ func (u *UndiscriminatedUnionTypeDeclaration) String() string {
 	return fmt.Sprintf("%#v", u)
 }
 
+// Validate reports all of the UndiscriminatedUnionTypeDeclaration's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UndiscriminatedUnionTypeDeclaration) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range u.Members {
+		validation.Add(fmt.Sprintf("members[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type UnionTypeDeclaration struct {
-	Discriminant *NameAndWireValue `json:"discriminant,omitempty"`
+	Discriminant *NameAndWireValue `json:"discriminant,omitempty" url:"discriminant"`
 	// A list of other types to inherit from
-	Extends        []*DeclaredTypeName `json:"extends,omitempty"`
-	Types          []*SingleUnionType  `json:"types,omitempty"`
-	BaseProperties []*ObjectProperty   `json:"baseProperties,omitempty"`
+	Extends        []*DeclaredTypeName `json:"extends,omitempty" url:"extends"`
+	Types          []*SingleUnionType  `json:"types,omitempty" url:"types"`
+	BaseProperties []*ObjectProperty   `json:"baseProperties,omitempty" url:"baseProperties"`
 }
 
 func (u *UnionTypeDeclaration) String() string {
@@ -3764,11 +5909,34 @@ func (u *UnionTypeDeclaration) String() string {
 	return fmt.Sprintf("%#v", u)
 }
 
+// Validate reports all of the UnionTypeDeclaration's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionTypeDeclaration) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	if u.Discriminant == nil {
+		validation.Required("discriminant")
+	}
+	validation.Add("discriminant", u.Discriminant.Validate())
+	for index, value := range u.Extends {
+		validation.Add(fmt.Sprintf("extends[%d]", index), value.Validate())
+	}
+	for index, value := range u.Types {
+		validation.Add(fmt.Sprintf("types[%d]", index), value.Validate())
+	}
+	for index, value := range u.BaseProperties {
+		validation.Add(fmt.Sprintf("baseProperties[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type VariableDeclaration struct {
-	Docs *string        `json:"docs,omitempty"`
-	Id   VariableId     `json:"id"`
-	Name *Name          `json:"name,omitempty"`
-	Type *TypeReference `json:"type,omitempty"`
+	Docs *string        `json:"docs,omitempty" url:"docs,omitempty"`
+	Id   VariableId     `json:"id" url:"id"`
+	Name *Name          `json:"name,omitempty" url:"name"`
+	Type *TypeReference `json:"type,omitempty" url:"type"`
 }
 
 func (v *VariableDeclaration) String() string {
@@ -3778,4 +5946,22 @@ func (v *VariableDeclaration) String() string {
 	return fmt.Sprintf("%#v", v)
 }
 
+// Validate reports all of the VariableDeclaration's invalid fields (e.g. missing
+// required fields), if any.
+func (v *VariableDeclaration) Validate() error {
+	if v == nil {
+		return nil
+	}
+	var validation core.Validation
+	if v.Name == nil {
+		validation.Required("name")
+	}
+	validation.Add("name", v.Name.Validate())
+	if v.Type == nil {
+		validation.Required("type")
+	}
+	validation.Add("type", v.Type.Validate())
+	return validation.Err()
+}
+
 type VariableId = string
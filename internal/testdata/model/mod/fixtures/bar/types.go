@@ -8,7 +8,7 @@ import (
 )
 
 type Bar struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
 }
 
 func (b *Bar) String() string {
@@ -17,3 +17,9 @@ func (b *Bar) String() string {
 	}
 	return fmt.Sprintf("%#v", b)
 }
+
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	return nil
+}
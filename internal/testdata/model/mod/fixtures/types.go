@@ -9,8 +9,8 @@ import (
 )
 
 type Foo struct {
-	Name string   `json:"name"`
-	Bar  *bar.Bar `json:"bar,omitempty"`
+	Name string   `json:"name" url:"name"`
+	Bar  *bar.Bar `json:"bar,omitempty" url:"bar"`
 }
 
 func (f *Foo) String() string {
@@ -19,3 +19,17 @@ func (f *Foo) String() string {
 	}
 	return fmt.Sprintf("%#v", f)
 }
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	if f.Bar == nil {
+		validation.Required("bar")
+	}
+	validation.Add("bar", f.Bar.Validate())
+	return validation.Err()
+}
@@ -0,0 +1,413 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// dateFormat is the RFC 3339 full-date format (e.g. 2006-01-02).
+const dateFormat = "2006-01-02"
+
+// dateTimeFormats are the RFC 3339 date-time variants accepted by
+// DateTime, in addition to the canonical time.RFC3339Nano format.
+var dateTimeFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// Date wraps a time.Time so that it's serialized as an
+// RFC 3339 full-date (e.g. 2006-01-02).
+//
+// This type is primarily used by the generated code and is not meant
+// to be used directly; the generated types use time.Time.
+type Date struct {
+	t *time.Time
+}
+
+// NewDate returns a new *Date for the given time.Time.
+func NewDate(t time.Time) *Date {
+	return &Date{t: &t}
+}
+
+// NewOptionalDate returns a new *Date for the given time.Time,
+// or nil if the time is nil.
+func NewOptionalDate(t *time.Time) *Date {
+	if t == nil {
+		return nil
+	}
+	return &Date{t: t}
+}
+
+// Time returns the Date's underlying time.Time, or the
+// zero value if the Date is nil.
+func (d *Date) Time() time.Time {
+	if d == nil || d.t == nil {
+		return time.Time{}
+	}
+	return *d.t
+}
+
+// TimePtr returns a pointer to the Date's underlying time.Time,
+// or nil if the Date is nil.
+func (d *Date) TimePtr() *time.Time {
+	if d == nil || d.t == nil {
+		return nil
+	}
+	return d.t
+}
+
+// String returns the Date formatted as an RFC 3339 full-date.
+func (d *Date) String() string {
+	return d.Time().Format(dateFormat)
+}
+
+func (d *Date) MarshalJSON() ([]byte, error) {
+	if d == nil || d.t == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	parsed, err := parseDate(raw)
+	if err != nil {
+		return err
+	}
+	*d = Date{t: &parsed}
+	return nil
+}
+
+// DateTime wraps a time.Time so that it's serialized as an
+// RFC 3339 date-time (e.g. 2006-01-02T15:04:05Z).
+//
+// This type is primarily used by the generated code and is not meant
+// to be used directly; the generated types use time.Time.
+type DateTime struct {
+	t *time.Time
+}
+
+// NewDateTime returns a new *DateTime for the given time.Time.
+func NewDateTime(t time.Time) *DateTime {
+	return &DateTime{t: &t}
+}
+
+// NewOptionalDateTime returns a new *DateTime for the given time.Time,
+// or nil if the time is nil.
+func NewOptionalDateTime(t *time.Time) *DateTime {
+	if t == nil {
+		return nil
+	}
+	return &DateTime{t: t}
+}
+
+// Time returns the DateTime's underlying time.Time, or the
+// zero value if the DateTime is nil.
+func (d *DateTime) Time() time.Time {
+	if d == nil || d.t == nil {
+		return time.Time{}
+	}
+	return *d.t
+}
+
+// TimePtr returns a pointer to the DateTime's underlying time.Time,
+// or nil if the DateTime is nil.
+func (d *DateTime) TimePtr() *time.Time {
+	if d == nil || d.t == nil {
+		return nil
+	}
+	return d.t
+}
+
+// String returns the DateTime formatted as an RFC 3339 date-time,
+// which only includes fractional seconds if they're non-zero.
+func (d *DateTime) String() string {
+	return d.Time().Format(time.RFC3339Nano)
+}
+
+func (d *DateTime) MarshalJSON() ([]byte, error) {
+	if d == nil || d.t == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts the common RFC 3339 date-time variants, i.e.
+// with or without fractional seconds, a space instead of the "T"
+// separator, an offset without a colon, or no offset at all (which
+// is interpreted as UTC).
+func (d *DateTime) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	parsed, err := parseDateTime(raw)
+	if err != nil {
+		return err
+	}
+	*d = DateTime{t: &parsed}
+	return nil
+}
+
+// Times wraps a value that contains time.Time values (e.g. a list or a map of
+// dates, which may be nested) so that every one of them is serialized as an
+// RFC 3339 full-date or date-time, just like a Date or DateTime.
+//
+// This type is primarily used by the generated code and is not meant
+// to be used directly; the generated types use time.Time.
+type Times struct {
+	value    interface{}
+	dateTime bool
+
+	// raw is the JSON read by UnmarshalJSON if the Times wasn't given a value
+	// to unmarshal into, which is read later by DecodeDates or DecodeDateTimes.
+	raw json.RawMessage
+}
+
+// NewDates returns a new *Times that serializes the given value's time.Time
+// values as full-dates. The value must be a pointer to be unmarshaled into.
+func NewDates(value interface{}) *Times {
+	return &Times{value: value}
+}
+
+// NewDateTimes returns a new *Times that serializes the given value's time.Time
+// values as date-times. The value must be a pointer to be unmarshaled into.
+func NewDateTimes(value interface{}) *Times {
+	return &Times{value: value, dateTime: true}
+}
+
+// NewOptionalDates is equivalent to NewDates, but returns nil if the given
+// value is empty (e.g. a nil or empty list), just like the omitempty option.
+func NewOptionalDates(value interface{}) *Times {
+	if isEmptyValue(reflect.ValueOf(value)) {
+		return nil
+	}
+	return NewDates(value)
+}
+
+// NewOptionalDateTimes is equivalent to NewDateTimes, but returns nil if the
+// given value is empty (e.g. a nil or empty list), just like the omitempty option.
+func NewOptionalDateTimes(value interface{}) *Times {
+	if isEmptyValue(reflect.ValueOf(value)) {
+		return nil
+	}
+	return NewDateTimes(value)
+}
+
+func (t *Times) MarshalJSON() ([]byte, error) {
+	if t == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(timesToJSON(reflect.ValueOf(t.value), t.dateTime))
+}
+
+// UnmarshalJSON reads the data into the value the Times was given, if any.
+// Otherwise, the data is kept until it's read with DecodeDates or DecodeDateTimes.
+func (t *Times) UnmarshalJSON(data []byte) error {
+	if t.value == nil {
+		t.raw = append(t.raw[:0], data...)
+		return nil
+	}
+	target := reflect.ValueOf(t.value)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return fmt.Errorf("cannot unmarshal into a non-pointer %T", t.value)
+	}
+	return timesFromJSON(data, target.Elem(), t.dateTime)
+}
+
+// DecodeDates reads the JSON kept by UnmarshalJSON into the value pointed to by
+// the given target, whose time.Time values are read as full-dates. The target is
+// set to its zero value if the Times is nil (e.g. an omitted or null property).
+func (t *Times) DecodeDates(target interface{}) error {
+	return t.decode(target, false)
+}
+
+// DecodeDateTimes is equivalent to DecodeDates, but the target's time.Time values
+// are read as date-times.
+func (t *Times) DecodeDateTimes(target interface{}) error {
+	return t.decode(target, true)
+}
+
+func (t *Times) decode(target interface{}, dateTime bool) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return fmt.Errorf("cannot decode into a non-pointer %T", target)
+	}
+	if t == nil || t.raw == nil {
+		value.Elem().Set(reflect.Zero(value.Elem().Type()))
+		return nil
+	}
+	return timesFromJSON(t.raw, value.Elem(), dateTime)
+}
+
+var (
+	timesTimeType       = reflect.TypeOf(time.Time{})
+	timesInterfaceType  = reflect.TypeOf((*interface{})(nil)).Elem()
+	timesRawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// timesToJSON returns a copy of the given value that's serialized with every
+// time.Time value replaced by a *Date (or *DateTime).
+func timesToJSON(value reflect.Value, dateTime bool) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+	if value.Type() == timesTimeType {
+		if dateTime {
+			return NewDateTime(value.Interface().(time.Time))
+		}
+		return NewDate(value.Interface().(time.Time))
+	}
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return timesToJSON(value.Elem(), dateTime)
+	case reflect.Slice:
+		if value.IsNil() {
+			return nil
+		}
+		elements := make([]interface{}, value.Len())
+		for i := range elements {
+			elements[i] = timesToJSON(value.Index(i), dateTime)
+		}
+		return elements
+	case reflect.Map:
+		if value.IsNil() {
+			return nil
+		}
+		// The map's key type is preserved so that its keys are serialized
+		// in the same way.
+		elements := reflect.MakeMapWithSize(reflect.MapOf(value.Type().Key(), timesInterfaceType), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			element := timesToJSON(iter.Value(), dateTime)
+			elements.SetMapIndex(iter.Key(), reflect.ValueOf(&element).Elem())
+		}
+		return elements.Interface()
+	}
+	return value.Interface()
+}
+
+// timesFromJSON reads the given JSON into the given (settable) value, whose
+// time.Time values are read as full-dates (or date-times).
+func timesFromJSON(data []byte, value reflect.Value, dateTime bool) error {
+	isNull := bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+	if value.Type() == timesTimeType {
+		if isNull {
+			// Just like encoding/json, a null time.Time is left as-is.
+			return nil
+		}
+		var raw string
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		parse := parseDate
+		if dateTime {
+			parse = parseDateTime
+		}
+		parsed, err := parse(raw)
+		if err != nil {
+			return err
+		}
+		value.Set(reflect.ValueOf(parsed))
+		return nil
+	}
+	switch value.Kind() {
+	case reflect.Ptr:
+		if isNull {
+			value.Set(reflect.Zero(value.Type()))
+			return nil
+		}
+		element := reflect.New(value.Type().Elem())
+		if err := timesFromJSON(data, element.Elem(), dateTime); err != nil {
+			return err
+		}
+		value.Set(element)
+		return nil
+	case reflect.Slice:
+		if isNull {
+			value.Set(reflect.Zero(value.Type()))
+			return nil
+		}
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			// Report the error in terms of the value's type.
+			return json.Unmarshal(data, value.Addr().Interface())
+		}
+		slice := reflect.MakeSlice(value.Type(), len(elements), len(elements))
+		for i, element := range elements {
+			if err := timesFromJSON(element, slice.Index(i), dateTime); err != nil {
+				return err
+			}
+		}
+		value.Set(slice)
+		return nil
+	case reflect.Map:
+		if isNull {
+			value.Set(reflect.Zero(value.Type()))
+			return nil
+		}
+		elements := reflect.New(reflect.MapOf(value.Type().Key(), timesRawMessageType))
+		if err := json.Unmarshal(data, elements.Interface()); err != nil {
+			// Report the error in terms of the value's type.
+			return json.Unmarshal(data, value.Addr().Interface())
+		}
+		m := reflect.MakeMapWithSize(value.Type(), elements.Elem().Len())
+		iter := elements.Elem().MapRange()
+		for iter.Next() {
+			element := reflect.New(value.Type().Elem()).Elem()
+			if err := timesFromJSON(iter.Value().Interface().(json.RawMessage), element, dateTime); err != nil {
+				return err
+			}
+			m.SetMapIndex(iter.Key(), element)
+		}
+		value.Set(m)
+		return nil
+	}
+	return json.Unmarshal(data, value.Addr().Interface())
+}
+
+// isEmptyValue reports whether the given value is empty according to the
+// omitempty option (e.g. a nil pointer, or an empty list or map).
+func isEmptyValue(value reflect.Value) bool {
+	if !value.IsValid() {
+		return true
+	}
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	}
+	return false
+}
+
+// parseDate parses the given RFC 3339 full-date.
+func parseDate(raw string) (time.Time, error) {
+	parsed, err := time.Parse(dateFormat, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a valid date: expected the format %q", raw, dateFormat)
+	}
+	return parsed, nil
+}
+
+// parseDateTime parses the given RFC 3339 date-time, which may be
+// any of the dateTimeFormats.
+func parseDateTime(raw string) (time.Time, error) {
+	for _, format := range dateTimeFormats {
+		if parsed, err := time.Parse(format, raw); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a valid date-time: expected an RFC 3339 date-time (e.g. %q)", raw, time.RFC3339)
+}
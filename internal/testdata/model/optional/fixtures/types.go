@@ -8,8 +8,22 @@ import (
 )
 
 type AnotherType struct {
-	String *string `json:"string,omitempty"`
-	Type   *Type   `json:"type,omitempty"`
+	String *string `json:"string,omitempty" url:"string,omitempty"`
+	Type   *Type   `json:"type,omitempty" url:"type,omitempty"`
+}
+
+func (a *AnotherType) GetString() string {
+	if a == nil || a.String == nil {
+		return ""
+	}
+	return *a.String
+}
+
+func (a *AnotherType) GetType() *Type {
+	if a == nil {
+		return nil
+	}
+	return a.Type
 }
 
 func (a *AnotherType) String() string {
@@ -19,8 +33,26 @@ func (a *AnotherType) String() string {
 	return fmt.Sprintf("%#v", a)
 }
 
+// Validate reports all of the AnotherType's invalid fields (e.g. missing
+// required fields), if any.
+func (a *AnotherType) Validate() error {
+	if a == nil {
+		return nil
+	}
+	var validation core.Validation
+	validation.Add("type", a.Type.Validate())
+	return validation.Err()
+}
+
 type Type struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
+}
+
+func (t *Type) GetName() string {
+	if t == nil {
+		return ""
+	}
+	return t.Name
 }
 
 func (t *Type) String() string {
@@ -29,3 +61,9 @@ func (t *Type) String() string {
 	}
 	return fmt.Sprintf("%#v", t)
 }
+
+// Validate reports all of the Type's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Type) Validate() error {
+	return nil
+}
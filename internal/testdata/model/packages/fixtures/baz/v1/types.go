@@ -8,7 +8,7 @@ import (
 )
 
 type Baz struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
 }
 
 func (b *Baz) String() string {
@@ -17,3 +17,9 @@ func (b *Baz) String() string {
 	}
 	return fmt.Sprintf("%#v", b)
 }
+
+// Validate reports all of the Baz's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Baz) Validate() error {
+	return nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type Foo struct {
-	Foo *fixtures.Foo `json:"foo,omitempty"`
+	Foo *fixtures.Foo `json:"foo,omitempty" url:"foo"`
 }
 
 func (f *Foo) String() string {
@@ -18,3 +18,17 @@ func (f *Foo) String() string {
 	}
 	return fmt.Sprintf("%#v", f)
 }
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	if f.Foo == nil {
+		validation.Required("foo")
+	}
+	validation.Add("foo", f.Foo.Validate())
+	return validation.Err()
+}
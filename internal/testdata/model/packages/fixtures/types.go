@@ -10,7 +10,7 @@ import (
 )
 
 type Base struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
 }
 
 func (b *Base) String() string {
@@ -20,8 +20,14 @@ func (b *Base) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Base's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Base) Validate() error {
+	return nil
+}
+
 type Value struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
 }
 
 func (v *Value) String() string {
@@ -31,10 +37,16 @@ func (v *Value) String() string {
 	return fmt.Sprintf("%#v", v)
 }
 
+// Validate reports all of the Value's invalid fields (e.g. missing
+// required fields), if any.
+func (v *Value) Validate() error {
+	return nil
+}
+
 type Foo struct {
-	Name  string   `json:"name"`
-	Value *Value   `json:"value,omitempty"`
-	Bar   *bar.Bar `json:"bar,omitempty"`
+	Name  string   `json:"name" url:"name"`
+	Value *Value   `json:"value,omitempty" url:"value"`
+	Bar   *bar.Bar `json:"bar,omitempty" url:"bar"`
 }
 
 func (f *Foo) String() string {
@@ -44,12 +56,32 @@ func (f *Foo) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	if f.Value == nil {
+		validation.Required("value")
+	}
+	validation.Add("value", f.Value.Validate())
+	if f.Bar == nil {
+		validation.Required("bar")
+	}
+	validation.Add("bar", f.Bar.Validate())
+	return validation.Err()
+}
+
 type Union struct {
 	Type         string
 	Value        *Value
 	AnotherValue *Value
 	Bar          *bar.Bar
 	AnotherBar   *bar.Bar
+
+	_unknown json.RawMessage
 }
 
 func NewUnionFromValue(value *Value) *Union {
@@ -105,6 +137,8 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.AnotherBar = valueUnmarshaler.AnotherBar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -112,6 +146,9 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 func (u Union) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "value":
 		var marshaler = struct {
@@ -157,11 +194,17 @@ type UnionVisitor interface {
 	VisitAnotherValue(*Value) error
 	VisitBar(*bar.Bar) error
 	VisitAnotherBar(*bar.Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *Union) Accept(visitor UnionVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "value":
 		return visitor.VisitValue(u.Value)
@@ -173,3 +216,33 @@ func (u *Union) Accept(visitor UnionVisitor) error {
 		return visitor.VisitAnotherBar(u.AnotherBar)
 	}
 }
+
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "value":
+		validation.Add("", u.Value.Validate())
+	case "anotherValue":
+		if u.AnotherValue == nil {
+			validation.Required("anotherValue")
+		}
+		validation.Add("anotherValue", u.AnotherValue.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	case "anotherBar":
+		if u.AnotherBar == nil {
+			validation.Required("anotherBar")
+		}
+		validation.Add("anotherBar", u.AnotherBar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
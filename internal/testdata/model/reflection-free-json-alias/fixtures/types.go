@@ -0,0 +1,451 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-alias/fixtures/core"
+	uuid "github.com/google/uuid"
+	time "time"
+)
+
+type Bar struct {
+	Foo *Foo `json:"foo,omitempty" url:"foo"`
+}
+
+// Equal reports whether the Bar is equal to the other Bar.
+func (b *Bar) Equal(other *Bar) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if !b.Foo.Equal(other.Foo) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Bar.
+func (b *Bar) DeepCopy() *Bar {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	copied.Foo = b.Foo.DeepCopy()
+	return &copied
+}
+
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *b
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*b = value
+	return nil
+}
+
+func (b *Bar) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Bar from the given core.JSONReader.
+func (b *Bar) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*b = Bar{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("foo") {
+		case "foo":
+			if !reader.ReadNull() {
+				b.Foo = new(Foo)
+				b.Foo.ReadJSON(reader)
+			}
+		default:
+			reader.Skip()
+		}
+	}
+}
+
+// WriteJSON writes the Bar to the given core.JSONWriter.
+func (b *Bar) WriteJSON(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	if b.Foo != nil {
+		writer.Key("foo")
+		b.Foo.WriteJSON(writer)
+	}
+	writer.EndObject()
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var validation core.Validation
+	if b.Foo == nil {
+		validation.Required("foo")
+	}
+	validation.Add("foo", b.Foo.Validate())
+	return validation.Err()
+}
+
+type BarAlias = *Bar
+
+type Base64 = []byte
+
+type Boolean = bool
+
+type Date = time.Time
+
+type DateTime = time.Time
+
+type Double = float64
+
+type DoubleSet = []float64
+
+type Foo struct {
+	Id          uuid.UUID `json:"id" url:"id"`
+	Name        string    `json:"name" url:"name"`
+	StringAlias String    `json:"stringAlias" url:"stringAlias"`
+}
+
+// Equal reports whether the Foo is equal to the other Foo.
+func (f *Foo) Equal(other *Foo) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if f.Id != other.Id {
+		return false
+	}
+	if f.Name != other.Name {
+		return false
+	}
+	if f.StringAlias != other.StringAlias {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Foo.
+func (f *Foo) DeepCopy() *Foo {
+	if f == nil {
+		return nil
+	}
+	copied := *f
+	return &copied
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *f
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*f = value
+	return nil
+}
+
+func (f *Foo) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	f.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Foo from the given core.JSONReader.
+func (f *Foo) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*f = Foo{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("id", "name", "stringAlias") {
+		case "id":
+			reader.ReadText(&f.Id)
+		case "name":
+			f.Name = reader.ReadString()
+		case "stringAlias":
+			f.StringAlias = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+}
+
+// WriteJSON writes the Foo to the given core.JSONWriter.
+func (f *Foo) WriteJSON(writer *core.JSONWriter) {
+	if f == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	writer.Key("id")
+	writer.String(f.Id.String())
+	writer.Key("name")
+	writer.String(f.Name)
+	writer.Key("stringAlias")
+	writer.String(f.StringAlias)
+	writer.EndObject()
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
+
+type FooAlias = *Foo
+
+type Integer = int
+
+type IntegerList = []int
+
+type ListListInteger = [][]int
+
+type ListOptionalUuid = []*uuid.UUID
+
+type ListStringIntegerMap = []map[string]int
+
+type Long = int64
+
+type OptionalLong = *int64
+
+type String = string
+
+type StringBooleanMap = map[string]bool
+
+type Union struct {
+	Type        string
+	FooAlias    *Foo
+	BarAlias    BarAlias
+	DoubleAlias Double
+
+	_unknown json.RawMessage
+}
+
+func NewUnionFromFooAlias(value *Foo) *Union {
+	return &Union{Type: "fooAlias", FooAlias: value}
+}
+
+func NewUnionFromBarAlias(value BarAlias) *Union {
+	return &Union{Type: "barAlias", BarAlias: value}
+}
+
+func NewUnionFromDoubleAlias(value Double) *Union {
+	return &Union{Type: "doubleAlias", DoubleAlias: value}
+}
+
+// Equal reports whether the Union is equal to the other Union.
+func (u *Union) Equal(other *Union) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.FooAlias.Equal(other.FooAlias) {
+		return false
+	}
+	if !u.BarAlias.Equal(other.BarAlias) {
+		return false
+	}
+	if u.DoubleAlias != other.DoubleAlias {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Union.
+func (u *Union) DeepCopy() *Union {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.FooAlias = u.FooAlias.DeepCopy()
+	copied.BarAlias = u.BarAlias.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *u
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*u = value
+	return nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Union from the given core.JSONReader.
+func (u *Union) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	offset := reader.Offset()
+	*u = Union{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("type") {
+		case "type":
+			u.Type = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+	data := reader.Since(offset)
+	if reader.Err() != nil {
+		return
+	}
+	switch u.Type {
+	case "fooAlias":
+		value := new(Foo)
+		reader.Fail(value.UnmarshalJSON(data))
+		u.FooAlias = value
+	case "barAlias":
+		reader.Fail(core.ReadJSONProperty(data, "barAlias", func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				u.BarAlias = new(Bar)
+				u.BarAlias.ReadJSON(reader)
+			}
+		}))
+	case "doubleAlias":
+		reader.Fail(core.ReadJSONProperty(data, "doubleAlias", func(reader *core.JSONReader) {
+			u.DoubleAlias = reader.ReadFloat64()
+		}))
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+}
+
+// WriteJSON writes the Union to the given core.JSONWriter.
+func (u *Union) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			writer.Raw(u._unknown)
+			return
+		}
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.Type, *u))
+	case "fooAlias":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Inline()
+		u.FooAlias.WriteJSON(writer)
+		writer.EndObject()
+	case "barAlias":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.BarAlias != nil {
+			writer.Key("barAlias")
+			u.BarAlias.WriteJSON(writer)
+		}
+		writer.EndObject()
+	case "doubleAlias":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Key("doubleAlias")
+		writer.Float64(u.DoubleAlias)
+		writer.EndObject()
+	}
+}
+
+type UnionVisitor interface {
+	VisitFooAlias(*Foo) error
+	VisitBarAlias(BarAlias) error
+	VisitDoubleAlias(Double) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fooAlias":
+		return visitor.VisitFooAlias(u.FooAlias)
+	case "barAlias":
+		return visitor.VisitBarAlias(u.BarAlias)
+	case "doubleAlias":
+		return visitor.VisitDoubleAlias(u.DoubleAlias)
+	}
+}
+
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "fooAlias":
+		validation.Add("", u.FooAlias.Validate())
+	case "barAlias":
+		if u.BarAlias == nil {
+			validation.Required("barAlias")
+		}
+		validation.Add("barAlias", u.BarAlias.Validate())
+	case "doubleAlias":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type Unknown = interface{}
+
+type Uuid = uuid.UUID
@@ -0,0 +1,402 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-alias/fixtures/core"
+	uuid "github.com/google/uuid"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+	strings "strings"
+	testing "testing"
+)
+
+func (b *Bar) reflectUnmarshalJSON(data []byte) error {
+	type unmarshaler Bar
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Bar(value)
+	return nil
+}
+
+func (b *Bar) reflectMarshalJSON() ([]byte, error) {
+	type embed Bar
+	var marshaler = struct {
+		embed
+	}{
+		embed: embed(*b),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testBarValues returns the sample Bar values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testBarValues(depth int) []*Bar {
+	if depth > 2 {
+		return []*Bar{{}}
+	}
+	return []*Bar{
+		{
+			Foo: testFooValues(depth + 1)[0],
+		},
+		{},
+	}
+}
+
+func TestBarJSON(t *testing.T) {
+	for _, value := range testBarValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Bar)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Bar)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Bar)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Bar).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Bar).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Bar)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Bar)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkBarJSON(b *testing.B) {
+	value := testBarValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Bar).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Bar).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (f *Foo) reflectUnmarshalJSON(data []byte) error {
+	type unmarshaler Foo
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Foo(value)
+	return nil
+}
+
+func (f *Foo) reflectMarshalJSON() ([]byte, error) {
+	type embed Foo
+	var marshaler = struct {
+		embed
+	}{
+		embed: embed(*f),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testFooValues returns the sample Foo values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testFooValues(depth int) []*Foo {
+	if depth > 2 {
+		return []*Foo{{}}
+	}
+	return []*Foo{
+		{
+			Id:          uuid.MustParse("7f5c4b1e-3a2d-4c6b-9e8f-0a1b2c3d4e5f"),
+			Name:        "fern <&> \"json\" é 🌿",
+			StringAlias: "fern <&> \"json\" é 🌿",
+		},
+		{},
+	}
+}
+
+func TestFooJSON(t *testing.T) {
+	for _, value := range testFooValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Foo)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Foo)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Foo)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Foo).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Foo).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Foo)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Foo)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkFooJSON(b *testing.B) {
+	value := testFooValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Foo).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Foo).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *Union) reflectUnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "fooAlias":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.FooAlias = value
+	case "barAlias":
+		var valueUnmarshaler struct {
+			BarAlias BarAlias `json:"barAlias,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.BarAlias = valueUnmarshaler.BarAlias
+	case "doubleAlias":
+		var valueUnmarshaler struct {
+			DoubleAlias Double `json:"doubleAlias"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.DoubleAlias = valueUnmarshaler.DoubleAlias
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u Union) reflectMarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fooAlias":
+		var marshaler = struct {
+			Type string `json:"type"`
+		}{
+			Type: u.Type,
+		}
+		return core.MergeJSON(marshaler, u.FooAlias)
+	case "barAlias":
+		var marshaler = struct {
+			Type     string   `json:"type"`
+			BarAlias BarAlias `json:"barAlias,omitempty"`
+		}{
+			Type:     u.Type,
+			BarAlias: u.BarAlias,
+		}
+		return json.Marshal(marshaler)
+	case "doubleAlias":
+		var marshaler = struct {
+			Type        string `json:"type"`
+			DoubleAlias Double `json:"doubleAlias"`
+		}{
+			Type:        u.Type,
+			DoubleAlias: u.DoubleAlias,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+// testUnionValues returns the sample Union values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionValues(depth int) []*Union {
+	if depth > 2 {
+		return []*Union{nil}
+	}
+	return []*Union{
+		{
+			Type:     "fooAlias",
+			FooAlias: testFooValues(depth + 1)[0],
+		},
+		{
+			Type:     "barAlias",
+			BarAlias: testBarValues(depth + 1)[0],
+		},
+		{
+			Type:        "doubleAlias",
+			DoubleAlias: 1.5,
+		},
+	}
+}
+
+func TestUnionUnknown(t *testing.T) {
+	data := []byte("{\"type\":\"fern-unknown-type\"}")
+	value := new(Union)
+	require.NoError(t, value.UnmarshalJSON(data))
+	if err := value.Validate(); err != nil {
+		assert.NotContains(t, err.Error(), "invalid type")
+	}
+	marshaled, err := value.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(marshaled))
+
+	// A type that was never set is still invalid.
+	err = new(Union).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid type")
+}
+
+func TestUnionJSON(t *testing.T) {
+	for _, value := range testUnionValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Union)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Union)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Union)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Union).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Union).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Union)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Union)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkUnionJSON(b *testing.B) {
+	value := testUnionValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Union).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Union).reflectUnmarshalJSON(data)
+		}
+	})
+}
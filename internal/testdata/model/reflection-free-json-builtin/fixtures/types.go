@@ -0,0 +1,520 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-builtin/fixtures/core"
+	uuid "github.com/google/uuid"
+	sort "sort"
+	time "time"
+)
+
+type Type struct {
+	One       int              `json:"one" url:"one"`
+	Two       float64          `json:"two" url:"two"`
+	Three     string           `json:"three" url:"three"`
+	Four      bool             `json:"four" url:"four"`
+	Five      int64            `json:"five" url:"five"`
+	Six       time.Time        `json:"six" url:"six"`
+	Seven     time.Time        `json:"seven" url:"seven,date"`
+	Eight     uuid.UUID        `json:"eight" url:"eight"`
+	Nine      []byte           `json:"nine" url:"nine"`
+	Ten       []int            `json:"ten,omitempty" url:"ten"`
+	Eleven    []float64        `json:"eleven,omitempty" url:"eleven"`
+	Twelve    map[string]bool  `json:"twelve,omitempty" url:"twelve"`
+	Thirteen  *int64           `json:"thirteen,omitempty" url:"thirteen,omitempty"`
+	Fourteen  interface{}      `json:"fourteen,omitempty" url:"fourteen"`
+	Fifteen   [][]int          `json:"fifteen,omitempty" url:"fifteen"`
+	Sixteen   []map[string]int `json:"sixteen,omitempty" url:"sixteen"`
+	Seventeen []*uuid.UUID     `json:"seventeen,omitempty" url:"seventeen"`
+	eighteen  string
+}
+
+func (t *Type) Eighteen() string {
+	return t.eighteen
+}
+
+// Equal reports whether the Type is equal to the other Type.
+func (t *Type) Equal(other *Type) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if t.One != other.One {
+		return false
+	}
+	if t.Two != other.Two {
+		return false
+	}
+	if t.Three != other.Three {
+		return false
+	}
+	if t.Four != other.Four {
+		return false
+	}
+	if t.Five != other.Five {
+		return false
+	}
+	if !t.Six.Equal(other.Six) {
+		return false
+	}
+	if !t.Seven.Equal(other.Seven) {
+		return false
+	}
+	if t.Eight != other.Eight {
+		return false
+	}
+	if !bytes.Equal(t.Nine, other.Nine) {
+		return false
+	}
+	if len(t.Ten) != len(other.Ten) {
+		return false
+	}
+	for index, value := range t.Ten {
+		if value != other.Ten[index] {
+			return false
+		}
+	}
+	if len(t.Eleven) != len(other.Eleven) {
+		return false
+	}
+	for index, value := range t.Eleven {
+		if value != other.Eleven[index] {
+			return false
+		}
+	}
+	if len(t.Twelve) != len(other.Twelve) {
+		return false
+	}
+	for key, value := range t.Twelve {
+		otherValue, ok := other.Twelve[key]
+		if !ok {
+			return false
+		}
+		if value != otherValue {
+			return false
+		}
+	}
+	if (t.Thirteen == nil) != (other.Thirteen == nil) {
+		return false
+	}
+	if t.Thirteen != nil {
+		if *t.Thirteen != *other.Thirteen {
+			return false
+		}
+	}
+	if !core.EqualValue(t.Fourteen, other.Fourteen) {
+		return false
+	}
+	if len(t.Fifteen) != len(other.Fifteen) {
+		return false
+	}
+	for index, value := range t.Fifteen {
+		if len(value) != len(other.Fifteen[index]) {
+			return false
+		}
+		for index1, value1 := range value {
+			if value1 != other.Fifteen[index][index1] {
+				return false
+			}
+		}
+	}
+	if len(t.Sixteen) != len(other.Sixteen) {
+		return false
+	}
+	for index, value := range t.Sixteen {
+		if len(value) != len(other.Sixteen[index]) {
+			return false
+		}
+		for key1, value1 := range value {
+			otherValue1, ok := other.Sixteen[index][key1]
+			if !ok {
+				return false
+			}
+			if value1 != otherValue1 {
+				return false
+			}
+		}
+	}
+	if len(t.Seventeen) != len(other.Seventeen) {
+		return false
+	}
+	for index, value := range t.Seventeen {
+		if (value == nil) != (other.Seventeen[index] == nil) {
+			return false
+		}
+		if value != nil {
+			if *value != *other.Seventeen[index] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Type.
+func (t *Type) DeepCopy() *Type {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	if t.Nine != nil {
+		copied.Nine = make([]byte, len(t.Nine))
+		copy(copied.Nine, t.Nine)
+	}
+	if t.Ten != nil {
+		copied.Ten = make([]int, len(t.Ten))
+		copy(copied.Ten, t.Ten)
+	}
+	if t.Eleven != nil {
+		copied.Eleven = make([]float64, len(t.Eleven))
+		copy(copied.Eleven, t.Eleven)
+	}
+	if t.Twelve != nil {
+		copied.Twelve = make(map[string]bool, len(t.Twelve))
+		for key, value := range t.Twelve {
+			copied.Twelve[key] = value
+		}
+	}
+	if t.Thirteen != nil {
+		copiedValue := *t.Thirteen
+		copied.Thirteen = &copiedValue
+	}
+	copied.Fourteen = core.DeepCopyValue(t.Fourteen)
+	if t.Fifteen != nil {
+		copied.Fifteen = make([][]int, len(t.Fifteen))
+		copy(copied.Fifteen, t.Fifteen)
+		for index, value := range t.Fifteen {
+			if value != nil {
+				copied.Fifteen[index] = make([]int, len(value))
+				copy(copied.Fifteen[index], value)
+			}
+		}
+	}
+	if t.Sixteen != nil {
+		copied.Sixteen = make([]map[string]int, len(t.Sixteen))
+		copy(copied.Sixteen, t.Sixteen)
+		for index, value := range t.Sixteen {
+			if value != nil {
+				copied.Sixteen[index] = make(map[string]int, len(value))
+				for key1, value1 := range value {
+					copied.Sixteen[index][key1] = value1
+				}
+			}
+		}
+	}
+	if t.Seventeen != nil {
+		copied.Seventeen = make([]*uuid.UUID, len(t.Seventeen))
+		copy(copied.Seventeen, t.Seventeen)
+		for index, value := range t.Seventeen {
+			if value != nil {
+				copiedValue1 := *value
+				copied.Seventeen[index] = &copiedValue1
+			}
+		}
+	}
+	return &copied
+}
+
+func (t *Type) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *t
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*t = value
+	return nil
+}
+
+func (t *Type) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	t.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Type from the given core.JSONReader.
+func (t *Type) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*t = Type{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen") {
+		case "one":
+			t.One = reader.ReadInt()
+		case "two":
+			t.Two = reader.ReadFloat64()
+		case "three":
+			t.Three = reader.ReadString()
+		case "four":
+			t.Four = reader.ReadBool()
+		case "five":
+			t.Five = reader.ReadInt64()
+		case "six":
+			t.Six = reader.ReadDateTime()
+		case "seven":
+			t.Seven = reader.ReadDate()
+		case "eight":
+			reader.ReadText(&t.Eight)
+		case "nine":
+			t.Nine = reader.ReadBytes()
+		case "ten":
+			if !reader.ReadNull() {
+				t.Ten = make([]int, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value int
+					value = reader.ReadInt()
+					t.Ten = append(t.Ten, value)
+				}
+			}
+		case "eleven":
+			if !reader.ReadNull() {
+				t.Eleven = make([]float64, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value float64
+					value = reader.ReadFloat64()
+					t.Eleven = append(t.Eleven, value)
+				}
+			}
+		case "twelve":
+			if !reader.ReadNull() {
+				t.Twelve = make(map[string]bool)
+				reader.BeginObject()
+				for reader.NextKey() {
+					key := reader.Key()
+					var value bool
+					value = reader.ReadBool()
+					t.Twelve[key] = value
+				}
+			}
+		case "thirteen":
+			if !reader.ReadNull() {
+				var value int64
+				value = reader.ReadInt64()
+				t.Thirteen = &value
+			}
+		case "fourteen":
+			t.Fourteen = reader.ReadValue()
+		case "fifteen":
+			if !reader.ReadNull() {
+				t.Fifteen = make([][]int, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value []int
+					if !reader.ReadNull() {
+						value = make([]int, 0)
+						reader.BeginArray()
+						for reader.NextElement() {
+							var value1 int
+							value1 = reader.ReadInt()
+							value = append(value, value1)
+						}
+					}
+					t.Fifteen = append(t.Fifteen, value)
+				}
+			}
+		case "sixteen":
+			if !reader.ReadNull() {
+				t.Sixteen = make([]map[string]int, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value map[string]int
+					if !reader.ReadNull() {
+						value = make(map[string]int)
+						reader.BeginObject()
+						for reader.NextKey() {
+							key1 := reader.Key()
+							var value1 int
+							value1 = reader.ReadInt()
+							value[key1] = value1
+						}
+					}
+					t.Sixteen = append(t.Sixteen, value)
+				}
+			}
+		case "seventeen":
+			if !reader.ReadNull() {
+				t.Seventeen = make([]*uuid.UUID, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value *uuid.UUID
+					if !reader.ReadNull() {
+						var value1 uuid.UUID
+						reader.ReadText(&value1)
+						value = &value1
+					}
+					t.Seventeen = append(t.Seventeen, value)
+				}
+			}
+		case "eighteen":
+			reader.Skip()
+		default:
+			reader.Skip()
+		}
+	}
+	t.eighteen = "fern"
+}
+
+// WriteJSON writes the Type to the given core.JSONWriter.
+func (t *Type) WriteJSON(writer *core.JSONWriter) {
+	if t == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	writer.Key("one")
+	writer.Int(t.One)
+	writer.Key("two")
+	writer.Float64(t.Two)
+	writer.Key("three")
+	writer.String(t.Three)
+	writer.Key("four")
+	writer.Bool(t.Four)
+	writer.Key("five")
+	writer.Int64(t.Five)
+	writer.Key("six")
+	writer.DateTime(t.Six)
+	writer.Key("seven")
+	writer.Date(t.Seven)
+	writer.Key("eight")
+	writer.String(t.Eight.String())
+	writer.Key("nine")
+	writer.Base64(t.Nine)
+	if len(t.Ten) > 0 {
+		writer.Key("ten")
+		if t.Ten == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.Ten {
+				writer.Int(value)
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.Eleven) > 0 {
+		writer.Key("eleven")
+		if t.Eleven == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.Eleven {
+				writer.Float64(value)
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.Twelve) > 0 {
+		writer.Key("twelve")
+		if t.Twelve == nil {
+			writer.Null()
+		} else {
+			keys := make([]string, 0, len(t.Twelve))
+			for key := range t.Twelve {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			writer.BeginObject()
+			for _, key := range keys {
+				writer.Key(key)
+				writer.Bool(t.Twelve[key])
+			}
+			writer.EndObject()
+		}
+	}
+	if t.Thirteen != nil {
+		writer.Key("thirteen")
+		if t.Thirteen == nil {
+			writer.Null()
+		} else {
+			writer.Int64(*t.Thirteen)
+		}
+	}
+	if t.Fourteen != nil {
+		writer.Key("fourteen")
+		writer.Value(t.Fourteen)
+	}
+	if len(t.Fifteen) > 0 {
+		writer.Key("fifteen")
+		if t.Fifteen == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.Fifteen {
+				if value == nil {
+					writer.Null()
+				} else {
+					writer.BeginArray()
+					for _, value1 := range value {
+						writer.Int(value1)
+					}
+					writer.EndArray()
+				}
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.Sixteen) > 0 {
+		writer.Key("sixteen")
+		if t.Sixteen == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.Sixteen {
+				if value == nil {
+					writer.Null()
+				} else {
+					keys1 := make([]string, 0, len(value))
+					for key1 := range value {
+						keys1 = append(keys1, key1)
+					}
+					sort.Strings(keys1)
+					writer.BeginObject()
+					for _, key1 := range keys1 {
+						writer.Key(key1)
+						writer.Int(value[key1])
+					}
+					writer.EndObject()
+				}
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.Seventeen) > 0 {
+		writer.Key("seventeen")
+		if t.Seventeen == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.Seventeen {
+				if value == nil {
+					writer.Null()
+				} else {
+					writer.String((*value).String())
+				}
+			}
+			writer.EndArray()
+		}
+	}
+	writer.Key("eighteen")
+	writer.String("fern")
+	writer.EndObject()
+}
+
+func (t *Type) String() string {
+	if value, err := core.StringifyJSON(t); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", t)
+}
+
+// Validate reports all of the Type's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Type) Validate() error {
+	return nil
+}
@@ -0,0 +1,148 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-builtin/fixtures/core"
+	uuid "github.com/google/uuid"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+	strings "strings"
+	testing "testing"
+	time "time"
+)
+
+func (t *Type) reflectUnmarshalJSON(data []byte) error {
+	type embed Type
+	var unmarshaler = struct {
+		embed
+		Six   *core.DateTime `json:"six"`
+		Seven *core.Date     `json:"seven"`
+	}{
+		embed: embed(*t),
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*t = Type(unmarshaler.embed)
+	t.Six = unmarshaler.Six.Time()
+	t.Seven = unmarshaler.Seven.Time()
+	t.eighteen = "fern"
+	return nil
+}
+
+func (t *Type) reflectMarshalJSON() ([]byte, error) {
+	type embed Type
+	var marshaler = struct {
+		embed
+		Eighteen string         `json:"eighteen"`
+		Six      *core.DateTime `json:"six"`
+		Seven    *core.Date     `json:"seven"`
+	}{
+		embed:    embed(*t),
+		Eighteen: "fern",
+		Six:      core.NewDateTime(t.Six),
+		Seven:    core.NewDate(t.Seven),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testTypeValues returns the sample Type values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testTypeValues(depth int) []*Type {
+	if depth > 2 {
+		return []*Type{{}}
+	}
+	return []*Type{
+		{
+			One:       1,
+			Two:       1.5,
+			Three:     "fern <&> \"json\" é 🌿",
+			Four:      true,
+			Five:      1234567890123,
+			Six:       time.Date(2024, time.January, 2, 3, 4, 5, 6000, time.UTC),
+			Seven:     time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC),
+			Eight:     uuid.MustParse("7f5c4b1e-3a2d-4c6b-9e8f-0a1b2c3d4e5f"),
+			Nine:      []byte("fern"),
+			Ten:       []int{1},
+			Eleven:    []float64{1.5},
+			Twelve:    map[string]bool{"fern <&> \"json\" é 🌿": true},
+			Thirteen:  &[]int64{1234567890123}[0],
+			Fourteen:  map[string]interface{}{"key": "value"},
+			Fifteen:   [][]int{[]int{1}},
+			Sixteen:   []map[string]int{map[string]int{"fern <&> \"json\" é 🌿": 1}},
+			Seventeen: []*uuid.UUID{&[]uuid.UUID{uuid.MustParse("7f5c4b1e-3a2d-4c6b-9e8f-0a1b2c3d4e5f")}[0]},
+		},
+		{},
+	}
+}
+
+func TestTypeJSON(t *testing.T) {
+	for _, value := range testTypeValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Type)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Type)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Type)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Type).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Type).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Type)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Type)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkTypeJSON(b *testing.B) {
+	value := testTypeValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Type).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Type).reflectUnmarshalJSON(data)
+		}
+	})
+}
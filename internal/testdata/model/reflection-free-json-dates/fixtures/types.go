@@ -0,0 +1,436 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-dates/fixtures/core"
+	sort "sort"
+	time "time"
+)
+
+type Type struct {
+	Date            time.Time              `json:"date" url:"date,date"`
+	Dates           []time.Time            `json:"dates,omitempty" url:"dates"`
+	DateSet         []time.Time            `json:"dateSet,omitempty" url:"dateSet"`
+	DateMap         map[string]time.Time   `json:"dateMap,omitempty" url:"dateMap"`
+	OptionalDate    *time.Time             `json:"optionalDate,omitempty" url:"optionalDate,omitempty,date"`
+	OptionalDates   []time.Time            `json:"optionalDates,omitempty" url:"optionalDates,omitempty"`
+	DatesWithNulls  []*time.Time           `json:"datesWithNulls,omitempty" url:"datesWithNulls"`
+	DateTimes       []time.Time            `json:"dateTimes,omitempty" url:"dateTimes"`
+	DateTimesByName map[string][]time.Time `json:"dateTimesByName,omitempty" url:"dateTimesByName"`
+}
+
+// Equal reports whether the Type is equal to the other Type.
+func (t *Type) Equal(other *Type) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if !t.Date.Equal(other.Date) {
+		return false
+	}
+	if len(t.Dates) != len(other.Dates) {
+		return false
+	}
+	for index, value := range t.Dates {
+		if !value.Equal(other.Dates[index]) {
+			return false
+		}
+	}
+	if len(t.DateSet) != len(other.DateSet) {
+		return false
+	}
+	for index, value := range t.DateSet {
+		if !value.Equal(other.DateSet[index]) {
+			return false
+		}
+	}
+	if len(t.DateMap) != len(other.DateMap) {
+		return false
+	}
+	for key, value := range t.DateMap {
+		otherValue, ok := other.DateMap[key]
+		if !ok {
+			return false
+		}
+		if !value.Equal(otherValue) {
+			return false
+		}
+	}
+	if (t.OptionalDate == nil) != (other.OptionalDate == nil) {
+		return false
+	}
+	if t.OptionalDate != nil {
+		if !(*t.OptionalDate).Equal(*other.OptionalDate) {
+			return false
+		}
+	}
+	if len(t.OptionalDates) != len(other.OptionalDates) {
+		return false
+	}
+	for index, value := range t.OptionalDates {
+		if !value.Equal(other.OptionalDates[index]) {
+			return false
+		}
+	}
+	if len(t.DatesWithNulls) != len(other.DatesWithNulls) {
+		return false
+	}
+	for index, value := range t.DatesWithNulls {
+		if (value == nil) != (other.DatesWithNulls[index] == nil) {
+			return false
+		}
+		if value != nil {
+			if !(*value).Equal(*other.DatesWithNulls[index]) {
+				return false
+			}
+		}
+	}
+	if len(t.DateTimes) != len(other.DateTimes) {
+		return false
+	}
+	for index, value := range t.DateTimes {
+		if !value.Equal(other.DateTimes[index]) {
+			return false
+		}
+	}
+	if len(t.DateTimesByName) != len(other.DateTimesByName) {
+		return false
+	}
+	for key, value := range t.DateTimesByName {
+		otherValue, ok := other.DateTimesByName[key]
+		if !ok {
+			return false
+		}
+		if len(value) != len(otherValue) {
+			return false
+		}
+		for index1, value1 := range value {
+			if !value1.Equal(otherValue[index1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Type.
+func (t *Type) DeepCopy() *Type {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	if t.Dates != nil {
+		copied.Dates = make([]time.Time, len(t.Dates))
+		copy(copied.Dates, t.Dates)
+	}
+	if t.DateSet != nil {
+		copied.DateSet = make([]time.Time, len(t.DateSet))
+		copy(copied.DateSet, t.DateSet)
+	}
+	if t.DateMap != nil {
+		copied.DateMap = make(map[string]time.Time, len(t.DateMap))
+		for key, value := range t.DateMap {
+			copied.DateMap[key] = value
+		}
+	}
+	if t.OptionalDate != nil {
+		copiedValue := *t.OptionalDate
+		copied.OptionalDate = &copiedValue
+	}
+	if t.OptionalDates != nil {
+		copied.OptionalDates = make([]time.Time, len(t.OptionalDates))
+		copy(copied.OptionalDates, t.OptionalDates)
+	}
+	if t.DatesWithNulls != nil {
+		copied.DatesWithNulls = make([]*time.Time, len(t.DatesWithNulls))
+		copy(copied.DatesWithNulls, t.DatesWithNulls)
+		for index, value := range t.DatesWithNulls {
+			if value != nil {
+				copiedValue1 := *value
+				copied.DatesWithNulls[index] = &copiedValue1
+			}
+		}
+	}
+	if t.DateTimes != nil {
+		copied.DateTimes = make([]time.Time, len(t.DateTimes))
+		copy(copied.DateTimes, t.DateTimes)
+	}
+	if t.DateTimesByName != nil {
+		copied.DateTimesByName = make(map[string][]time.Time, len(t.DateTimesByName))
+		for key, value := range t.DateTimesByName {
+			var copiedElement []time.Time
+			if value != nil {
+				copiedElement = make([]time.Time, len(value))
+				copy(copiedElement, value)
+			}
+			copied.DateTimesByName[key] = copiedElement
+		}
+	}
+	return &copied
+}
+
+func (t *Type) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *t
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*t = value
+	return nil
+}
+
+func (t *Type) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	t.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Type from the given core.JSONReader.
+func (t *Type) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*t = Type{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("date", "dates", "dateSet", "dateMap", "optionalDate", "optionalDates", "datesWithNulls", "dateTimes", "dateTimesByName") {
+		case "date":
+			t.Date = reader.ReadDate()
+		case "dates":
+			if !reader.ReadNull() {
+				t.Dates = make([]time.Time, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value time.Time
+					value = reader.ReadDate()
+					t.Dates = append(t.Dates, value)
+				}
+			}
+		case "dateSet":
+			if !reader.ReadNull() {
+				t.DateSet = make([]time.Time, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value time.Time
+					value = reader.ReadDate()
+					t.DateSet = append(t.DateSet, value)
+				}
+			}
+		case "dateMap":
+			if !reader.ReadNull() {
+				t.DateMap = make(map[string]time.Time)
+				reader.BeginObject()
+				for reader.NextKey() {
+					key := reader.Key()
+					var value time.Time
+					value = reader.ReadDate()
+					t.DateMap[key] = value
+				}
+			}
+		case "optionalDate":
+			if !reader.ReadNull() {
+				var value time.Time
+				value = reader.ReadDate()
+				t.OptionalDate = &value
+			}
+		case "optionalDates":
+			if !reader.ReadNull() {
+				t.OptionalDates = make([]time.Time, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value time.Time
+					value = reader.ReadDate()
+					t.OptionalDates = append(t.OptionalDates, value)
+				}
+			}
+		case "datesWithNulls":
+			if !reader.ReadNull() {
+				t.DatesWithNulls = make([]*time.Time, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value *time.Time
+					if !reader.ReadNull() {
+						var value1 time.Time
+						value1 = reader.ReadDate()
+						value = &value1
+					}
+					t.DatesWithNulls = append(t.DatesWithNulls, value)
+				}
+			}
+		case "dateTimes":
+			if !reader.ReadNull() {
+				t.DateTimes = make([]time.Time, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value time.Time
+					value = reader.ReadDateTime()
+					t.DateTimes = append(t.DateTimes, value)
+				}
+			}
+		case "dateTimesByName":
+			if !reader.ReadNull() {
+				t.DateTimesByName = make(map[string][]time.Time)
+				reader.BeginObject()
+				for reader.NextKey() {
+					key := reader.Key()
+					var value []time.Time
+					if !reader.ReadNull() {
+						value = make([]time.Time, 0)
+						reader.BeginArray()
+						for reader.NextElement() {
+							var value1 time.Time
+							value1 = reader.ReadDateTime()
+							value = append(value, value1)
+						}
+					}
+					t.DateTimesByName[key] = value
+				}
+			}
+		default:
+			reader.Skip()
+		}
+	}
+}
+
+// WriteJSON writes the Type to the given core.JSONWriter.
+func (t *Type) WriteJSON(writer *core.JSONWriter) {
+	if t == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	writer.Key("date")
+	writer.Date(t.Date)
+	if len(t.Dates) > 0 {
+		writer.Key("dates")
+		if t.Dates == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.Dates {
+				writer.Date(value)
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.DateSet) > 0 {
+		writer.Key("dateSet")
+		if t.DateSet == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.DateSet {
+				writer.Date(value)
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.DateMap) > 0 {
+		writer.Key("dateMap")
+		if t.DateMap == nil {
+			writer.Null()
+		} else {
+			keys := make([]string, 0, len(t.DateMap))
+			for key := range t.DateMap {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			writer.BeginObject()
+			for _, key := range keys {
+				writer.Key(key)
+				writer.Date(t.DateMap[key])
+			}
+			writer.EndObject()
+		}
+	}
+	if t.OptionalDate != nil {
+		writer.Key("optionalDate")
+		if t.OptionalDate == nil {
+			writer.Null()
+		} else {
+			writer.Date(*t.OptionalDate)
+		}
+	}
+	if len(t.OptionalDates) > 0 {
+		writer.Key("optionalDates")
+		if t.OptionalDates == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.OptionalDates {
+				writer.Date(value)
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.DatesWithNulls) > 0 {
+		writer.Key("datesWithNulls")
+		if t.DatesWithNulls == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.DatesWithNulls {
+				if value == nil {
+					writer.Null()
+				} else {
+					writer.Date(*value)
+				}
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.DateTimes) > 0 {
+		writer.Key("dateTimes")
+		if t.DateTimes == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range t.DateTimes {
+				writer.DateTime(value)
+			}
+			writer.EndArray()
+		}
+	}
+	if len(t.DateTimesByName) > 0 {
+		writer.Key("dateTimesByName")
+		if t.DateTimesByName == nil {
+			writer.Null()
+		} else {
+			keys := make([]string, 0, len(t.DateTimesByName))
+			for key := range t.DateTimesByName {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			writer.BeginObject()
+			for _, key := range keys {
+				writer.Key(key)
+				if t.DateTimesByName[key] == nil {
+					writer.Null()
+				} else {
+					writer.BeginArray()
+					for _, value1 := range t.DateTimesByName[key] {
+						writer.DateTime(value1)
+					}
+					writer.EndArray()
+				}
+			}
+			writer.EndObject()
+		}
+	}
+	writer.EndObject()
+}
+
+func (t *Type) String() string {
+	if value, err := core.StringifyJSON(t); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", t)
+}
+
+// Validate reports all of the Type's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Type) Validate() error {
+	return nil
+}
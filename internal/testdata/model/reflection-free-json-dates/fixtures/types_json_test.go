@@ -0,0 +1,178 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-dates/fixtures/core"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+	strings "strings"
+	testing "testing"
+	time "time"
+)
+
+func (t *Type) reflectUnmarshalJSON(data []byte) error {
+	type embed Type
+	var unmarshaler = struct {
+		embed
+		Date            *core.Date  `json:"date"`
+		Dates           *core.Times `json:"dates,omitempty"`
+		DateSet         *core.Times `json:"dateSet,omitempty"`
+		DateMap         *core.Times `json:"dateMap,omitempty"`
+		OptionalDate    *core.Date  `json:"optionalDate,omitempty"`
+		OptionalDates   *core.Times `json:"optionalDates,omitempty"`
+		DatesWithNulls  *core.Times `json:"datesWithNulls,omitempty"`
+		DateTimes       *core.Times `json:"dateTimes,omitempty"`
+		DateTimesByName *core.Times `json:"dateTimesByName,omitempty"`
+	}{
+		embed: embed(*t),
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*t = Type(unmarshaler.embed)
+	t.Date = unmarshaler.Date.Time()
+	if err := unmarshaler.Dates.DecodeDates(&t.Dates); err != nil {
+		return err
+	}
+	if err := unmarshaler.DateSet.DecodeDates(&t.DateSet); err != nil {
+		return err
+	}
+	if err := unmarshaler.DateMap.DecodeDates(&t.DateMap); err != nil {
+		return err
+	}
+	t.OptionalDate = unmarshaler.OptionalDate.TimePtr()
+	if err := unmarshaler.OptionalDates.DecodeDates(&t.OptionalDates); err != nil {
+		return err
+	}
+	if err := unmarshaler.DatesWithNulls.DecodeDates(&t.DatesWithNulls); err != nil {
+		return err
+	}
+	if err := unmarshaler.DateTimes.DecodeDateTimes(&t.DateTimes); err != nil {
+		return err
+	}
+	if err := unmarshaler.DateTimesByName.DecodeDateTimes(&t.DateTimesByName); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *Type) reflectMarshalJSON() ([]byte, error) {
+	type embed Type
+	var marshaler = struct {
+		embed
+		Date            *core.Date  `json:"date"`
+		Dates           *core.Times `json:"dates,omitempty"`
+		DateSet         *core.Times `json:"dateSet,omitempty"`
+		DateMap         *core.Times `json:"dateMap,omitempty"`
+		OptionalDate    *core.Date  `json:"optionalDate,omitempty"`
+		OptionalDates   *core.Times `json:"optionalDates,omitempty"`
+		DatesWithNulls  *core.Times `json:"datesWithNulls,omitempty"`
+		DateTimes       *core.Times `json:"dateTimes,omitempty"`
+		DateTimesByName *core.Times `json:"dateTimesByName,omitempty"`
+	}{
+		embed:           embed(*t),
+		Date:            core.NewDate(t.Date),
+		Dates:           core.NewOptionalDates(t.Dates),
+		DateSet:         core.NewOptionalDates(t.DateSet),
+		DateMap:         core.NewOptionalDates(t.DateMap),
+		OptionalDate:    core.NewOptionalDate(t.OptionalDate),
+		OptionalDates:   core.NewOptionalDates(t.OptionalDates),
+		DatesWithNulls:  core.NewOptionalDates(t.DatesWithNulls),
+		DateTimes:       core.NewOptionalDateTimes(t.DateTimes),
+		DateTimesByName: core.NewOptionalDateTimes(t.DateTimesByName),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testTypeValues returns the sample Type values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testTypeValues(depth int) []*Type {
+	if depth > 2 {
+		return []*Type{{}}
+	}
+	return []*Type{
+		{
+			Date:            time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC),
+			Dates:           []time.Time{time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+			DateSet:         []time.Time{time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+			DateMap:         map[string]time.Time{"fern <&> \"json\" é 🌿": time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+			OptionalDate:    &[]time.Time{time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)}[0],
+			OptionalDates:   []time.Time{time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+			DatesWithNulls:  []*time.Time{&[]time.Time{time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)}[0]},
+			DateTimes:       []time.Time{time.Date(2024, time.January, 2, 3, 4, 5, 6000, time.UTC)},
+			DateTimesByName: map[string][]time.Time{"fern <&> \"json\" é 🌿": []time.Time{time.Date(2024, time.January, 2, 3, 4, 5, 6000, time.UTC)}},
+		},
+		{},
+	}
+}
+
+func TestTypeJSON(t *testing.T) {
+	for _, value := range testTypeValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Type)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Type)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Type)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Type).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Type).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Type)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Type)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkTypeJSON(b *testing.B) {
+	value := testTypeValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Type).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Type).reflectUnmarshalJSON(data)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package core
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// EqualValue reports whether the given values are deeply equal. This is used
+// to compare unknown values (e.g. extra properties), which are otherwise
+// compared field by field in the generated Equal methods.
+func EqualValue(value interface{}, other interface{}) bool {
+	return reflect.DeepEqual(value, other)
+}
+
+// DeepCopyValue returns a deep copy of the given unknown value, which copies
+// all of the JSON objects, arrays, and raw messages it contains. Any other
+// value is returned as-is.
+func DeepCopyValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		if typed == nil {
+			return typed
+		}
+		copied := make(map[string]interface{}, len(typed))
+		for key, element := range typed {
+			copied[key] = DeepCopyValue(element)
+		}
+		return copied
+	case []interface{}:
+		if typed == nil {
+			return typed
+		}
+		copied := make([]interface{}, len(typed))
+		for index, element := range typed {
+			copied[index] = DeepCopyValue(element)
+		}
+		return copied
+	case json.RawMessage:
+		if typed == nil {
+			return typed
+		}
+		return append(json.RawMessage{}, typed...)
+	}
+	return value
+}
@@ -0,0 +1,1562 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-explicit-null/fixtures/core"
+	time "time"
+)
+
+type Bar struct {
+	Name string `json:"name" url:"name"`
+}
+
+// Equal reports whether the Bar is equal to the other Bar.
+func (b *Bar) Equal(other *Bar) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Bar.
+func (b *Bar) DeepCopy() *Bar {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
+}
+
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *b
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*b = value
+	return nil
+}
+
+func (b *Bar) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Bar from the given core.JSONReader.
+func (b *Bar) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*b = Bar{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("name") {
+		case "name":
+			b.Name = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+}
+
+// WriteJSON writes the Bar to the given core.JSONWriter.
+func (b *Bar) WriteJSON(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	writer.Key("name")
+	writer.String(b.Name)
+	writer.EndObject()
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	return nil
+}
+
+type Baz struct {
+	Tags     *core.Optional[[]string] `json:"tags,omitempty" url:"tags,omitempty"`
+	extended string
+}
+
+func (b *Baz) Extended() string {
+	return b.extended
+}
+
+// Equal reports whether the Baz is equal to the other Baz.
+func (b *Baz) Equal(other *Baz) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if (b.Tags == nil) != (other.Tags == nil) {
+		return false
+	}
+	if b.Tags != nil {
+		if b.Tags.Null != other.Tags.Null {
+			return false
+		}
+		if len(b.Tags.Value) != len(other.Tags.Value) {
+			return false
+		}
+		for index, value := range b.Tags.Value {
+			if value != other.Tags.Value[index] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Baz.
+func (b *Baz) DeepCopy() *Baz {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	if b.Tags != nil {
+		copiedValue := *b.Tags
+		if b.Tags.Value != nil {
+			copiedValue.Value = make([]string, len(b.Tags.Value))
+			copy(copiedValue.Value, b.Tags.Value)
+		}
+		copied.Tags = &copiedValue
+	}
+	return &copied
+}
+
+func (b *Baz) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *b
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*b = value
+	return nil
+}
+
+func (b *Baz) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Baz from the given core.JSONReader.
+func (b *Baz) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*b = Baz{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("tags", "extended") {
+		case "tags":
+			if reader.ReadNull() {
+				b.Tags = &core.Optional[[]string]{Null: true}
+			} else {
+				b.Tags = new(core.Optional[[]string])
+				if !reader.ReadNull() {
+					b.Tags.Value = make([]string, 0)
+					reader.BeginArray()
+					for reader.NextElement() {
+						var value string
+						value = reader.ReadString()
+						b.Tags.Value = append(b.Tags.Value, value)
+					}
+				}
+			}
+		case "extended":
+			reader.Skip()
+		default:
+			reader.Skip()
+		}
+	}
+	b.extended = "extended"
+}
+
+// WriteJSON writes the Baz to the given core.JSONWriter.
+func (b *Baz) WriteJSON(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	if b.Tags != nil {
+		writer.Key("tags")
+		if b.Tags.Null {
+			writer.Null()
+		} else {
+			if b.Tags.Value == nil {
+				writer.Null()
+			} else {
+				writer.BeginArray()
+				for _, value := range b.Tags.Value {
+					writer.String(value)
+				}
+				writer.EndArray()
+			}
+		}
+	}
+	writer.Key("extended")
+	writer.String("extended")
+	writer.EndObject()
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Baz's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Baz) Validate() error {
+	return nil
+}
+
+type Foo struct {
+	Name        string                    `json:"name" url:"name"`
+	Description *core.Optional[string]    `json:"description,omitempty" url:"description,omitempty"`
+	CreatedAt   *core.Optional[time.Time] `json:"createdAt,omitempty" url:"createdAt,omitempty"`
+	Birthday    *core.Optional[time.Time] `json:"birthday,omitempty" url:"birthday,omitempty,date"`
+}
+
+// Equal reports whether the Foo is equal to the other Foo.
+func (f *Foo) Equal(other *Foo) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if f.Name != other.Name {
+		return false
+	}
+	if (f.Description == nil) != (other.Description == nil) {
+		return false
+	}
+	if f.Description != nil {
+		if f.Description.Null != other.Description.Null {
+			return false
+		}
+		if f.Description.Value != other.Description.Value {
+			return false
+		}
+	}
+	if (f.CreatedAt == nil) != (other.CreatedAt == nil) {
+		return false
+	}
+	if f.CreatedAt != nil {
+		if f.CreatedAt.Null != other.CreatedAt.Null {
+			return false
+		}
+		if !f.CreatedAt.Value.Equal(other.CreatedAt.Value) {
+			return false
+		}
+	}
+	if (f.Birthday == nil) != (other.Birthday == nil) {
+		return false
+	}
+	if f.Birthday != nil {
+		if f.Birthday.Null != other.Birthday.Null {
+			return false
+		}
+		if !f.Birthday.Value.Equal(other.Birthday.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Foo.
+func (f *Foo) DeepCopy() *Foo {
+	if f == nil {
+		return nil
+	}
+	copied := *f
+	if f.Description != nil {
+		copiedValue := *f.Description
+		copied.Description = &copiedValue
+	}
+	if f.CreatedAt != nil {
+		copiedValue := *f.CreatedAt
+		copied.CreatedAt = &copiedValue
+	}
+	if f.Birthday != nil {
+		copiedValue := *f.Birthday
+		copied.Birthday = &copiedValue
+	}
+	return &copied
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *f
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*f = value
+	return nil
+}
+
+func (f *Foo) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	f.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Foo from the given core.JSONReader.
+func (f *Foo) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*f = Foo{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("name", "description", "createdAt", "birthday") {
+		case "name":
+			f.Name = reader.ReadString()
+		case "description":
+			if reader.ReadNull() {
+				f.Description = &core.Optional[string]{Null: true}
+			} else {
+				f.Description = new(core.Optional[string])
+				f.Description.Value = reader.ReadString()
+			}
+		case "createdAt":
+			if reader.ReadNull() {
+				f.CreatedAt = &core.Optional[time.Time]{Null: true}
+			} else {
+				f.CreatedAt = new(core.Optional[time.Time])
+				f.CreatedAt.Value = reader.ReadDateTime()
+			}
+		case "birthday":
+			if reader.ReadNull() {
+				f.Birthday = &core.Optional[time.Time]{Null: true}
+			} else {
+				f.Birthday = new(core.Optional[time.Time])
+				f.Birthday.Value = reader.ReadDate()
+			}
+		default:
+			reader.Skip()
+		}
+	}
+}
+
+// WriteJSON writes the Foo to the given core.JSONWriter.
+func (f *Foo) WriteJSON(writer *core.JSONWriter) {
+	if f == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	writer.Key("name")
+	writer.String(f.Name)
+	if f.Description != nil {
+		writer.Key("description")
+		if f.Description.Null {
+			writer.Null()
+		} else {
+			writer.String(f.Description.Value)
+		}
+	}
+	if f.CreatedAt != nil {
+		writer.Key("createdAt")
+		if f.CreatedAt.Null {
+			writer.Null()
+		} else {
+			writer.DateTime(f.CreatedAt.Value)
+		}
+	}
+	if f.Birthday != nil {
+		writer.Key("birthday")
+		if f.Birthday.Null {
+			writer.Null()
+		} else {
+			writer.Date(f.Birthday.Value)
+		}
+	}
+	writer.EndObject()
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
+
+// This is a simple union.
+type Union struct {
+	Type string
+	Foo  *Foo
+	Bar  *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+// Equal reports whether the Union is equal to the other Union.
+func (u *Union) Equal(other *Union) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Union.
+func (u *Union) DeepCopy() *Union {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *u
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*u = value
+	return nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Union from the given core.JSONReader.
+func (u *Union) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	offset := reader.Offset()
+	*u = Union{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("type") {
+		case "type":
+			u.Type = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+	data := reader.Since(offset)
+	if reader.Err() != nil {
+		return
+	}
+	switch u.Type {
+	case "foo":
+		reader.Fail(core.ReadJSONProperty(data, "foo", func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				u.Foo = new(Foo)
+				u.Foo.ReadJSON(reader)
+			}
+		}))
+	case "bar":
+		reader.Fail(core.ReadJSONProperty(data, "bar", func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				u.Bar = new(Bar)
+				u.Bar.ReadJSON(reader)
+			}
+		}))
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+}
+
+// WriteJSON writes the Union to the given core.JSONWriter.
+func (u *Union) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			writer.Raw(u._unknown)
+			return
+		}
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.Type, *u))
+	case "foo":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Foo != nil {
+			writer.Key("foo")
+			u.Foo.WriteJSON(writer)
+		}
+		writer.EndObject()
+	case "bar":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Bar != nil {
+			writer.Key("bar")
+			u.Bar.WriteJSON(writer)
+		}
+		writer.EndObject()
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		if u.Foo == nil {
+			validation.Required("foo")
+		}
+		validation.Add("foo", u.Foo.Validate())
+	case "bar":
+		if u.Bar == nil {
+			validation.Required("bar")
+		}
+		validation.Add("bar", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithDiscriminant struct {
+	Type string
+	// This is a Foo field.
+	Foo *Foo
+	Bar *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithDiscriminantFromFoo(value *Foo) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "foo", Foo: value}
+}
+
+func NewUnionWithDiscriminantFromBar(value *Bar) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "bar", Bar: value}
+}
+
+// Equal reports whether the UnionWithDiscriminant is equal to the other UnionWithDiscriminant.
+func (u *UnionWithDiscriminant) Equal(other *UnionWithDiscriminant) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithDiscriminant.
+func (u *UnionWithDiscriminant) DeepCopy() *UnionWithDiscriminant {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithDiscriminant) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *u
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*u = value
+	return nil
+}
+
+func (u UnionWithDiscriminant) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the UnionWithDiscriminant from the given core.JSONReader.
+func (u *UnionWithDiscriminant) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	offset := reader.Offset()
+	*u = UnionWithDiscriminant{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("_type") {
+		case "_type":
+			u.Type = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+	data := reader.Since(offset)
+	if reader.Err() != nil {
+		return
+	}
+	switch u.Type {
+	case "foo":
+		reader.Fail(core.ReadJSONProperty(data, "foo", func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				u.Foo = new(Foo)
+				u.Foo.ReadJSON(reader)
+			}
+		}))
+	case "bar":
+		reader.Fail(core.ReadJSONProperty(data, "bar", func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				u.Bar = new(Bar)
+				u.Bar.ReadJSON(reader)
+			}
+		}))
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+}
+
+// WriteJSON writes the UnionWithDiscriminant to the given core.JSONWriter.
+func (u *UnionWithDiscriminant) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			writer.Raw(u._unknown)
+			return
+		}
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.Type, *u))
+	case "foo":
+		writer.BeginObject()
+		writer.Key("_type")
+		writer.String(u.Type)
+		if u.Foo != nil {
+			writer.Key("foo")
+			u.Foo.WriteJSON(writer)
+		}
+		writer.EndObject()
+	case "bar":
+		writer.BeginObject()
+		writer.Key("_type")
+		writer.String(u.Type)
+		if u.Bar != nil {
+			writer.Key("bar")
+			u.Bar.WriteJSON(writer)
+		}
+		writer.EndObject()
+	}
+}
+
+type UnionWithDiscriminantVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithDiscriminant) Accept(visitor UnionWithDiscriminantVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the UnionWithDiscriminant's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithDiscriminant) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		if u.Foo == nil {
+			validation.Required("foo")
+		}
+		validation.Add("foo", u.Foo.Validate())
+	case "bar":
+		if u.Bar == nil {
+			validation.Required("bar")
+		}
+		validation.Add("bar", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithLiteral struct {
+	Type     string
+	Tags     *core.Optional[[]string]
+	Label    *core.Optional[string]
+	fern     string
+	extended string
+	base     string
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithLiteralWithFern() *UnionWithLiteral {
+	return &UnionWithLiteral{Type: "fern", fern: "fern"}
+}
+
+func (u *UnionWithLiteral) Extended() string {
+	return u.extended
+}
+
+func (u *UnionWithLiteral) Base() string {
+	return u.base
+}
+
+func (u *UnionWithLiteral) Fern() string {
+	return u.fern
+}
+
+// Equal reports whether the UnionWithLiteral is equal to the other UnionWithLiteral.
+func (u *UnionWithLiteral) Equal(other *UnionWithLiteral) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if (u.Tags == nil) != (other.Tags == nil) {
+		return false
+	}
+	if u.Tags != nil {
+		if u.Tags.Null != other.Tags.Null {
+			return false
+		}
+		if len(u.Tags.Value) != len(other.Tags.Value) {
+			return false
+		}
+		for index, value := range u.Tags.Value {
+			if value != other.Tags.Value[index] {
+				return false
+			}
+		}
+	}
+	if (u.Label == nil) != (other.Label == nil) {
+		return false
+	}
+	if u.Label != nil {
+		if u.Label.Null != other.Label.Null {
+			return false
+		}
+		if u.Label.Value != other.Label.Value {
+			return false
+		}
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithLiteral.
+func (u *UnionWithLiteral) DeepCopy() *UnionWithLiteral {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	if u.Tags != nil {
+		copiedValue := *u.Tags
+		if u.Tags.Value != nil {
+			copiedValue.Value = make([]string, len(u.Tags.Value))
+			copy(copiedValue.Value, u.Tags.Value)
+		}
+		copied.Tags = &copiedValue
+	}
+	if u.Label != nil {
+		copiedValue := *u.Label
+		copied.Label = &copiedValue
+	}
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *u
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*u = value
+	return nil
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the UnionWithLiteral from the given core.JSONReader.
+func (u *UnionWithLiteral) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	offset := reader.Offset()
+	*u = UnionWithLiteral{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("type", "tags", "label") {
+		case "type":
+			u.Type = reader.ReadString()
+		case "tags":
+			if reader.ReadNull() {
+				u.Tags = &core.Optional[[]string]{Null: true}
+			} else {
+				u.Tags = new(core.Optional[[]string])
+				if !reader.ReadNull() {
+					u.Tags.Value = make([]string, 0)
+					reader.BeginArray()
+					for reader.NextElement() {
+						var value string
+						value = reader.ReadString()
+						u.Tags.Value = append(u.Tags.Value, value)
+					}
+				}
+			}
+		case "label":
+			if reader.ReadNull() {
+				u.Label = &core.Optional[string]{Null: true}
+			} else {
+				u.Label = new(core.Optional[string])
+				u.Label.Value = reader.ReadString()
+			}
+		default:
+			reader.Skip()
+		}
+	}
+	data := reader.Since(offset)
+	if reader.Err() != nil {
+		return
+	}
+	u.extended = "extended"
+	u.base = "base"
+	switch u.Type {
+	case "fern":
+		u.fern = "fern"
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+}
+
+// WriteJSON writes the UnionWithLiteral to the given core.JSONWriter.
+func (u *UnionWithLiteral) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			writer.Raw(u._unknown)
+			return
+		}
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.Type, *u))
+	case "fern":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Tags != nil {
+			writer.Key("tags")
+			if u.Tags.Null {
+				writer.Null()
+			} else {
+				if u.Tags.Value == nil {
+					writer.Null()
+				} else {
+					writer.BeginArray()
+					for _, value := range u.Tags.Value {
+						writer.String(value)
+					}
+					writer.EndArray()
+				}
+			}
+		}
+		if u.Label != nil {
+			writer.Key("label")
+			if u.Label.Null {
+				writer.Null()
+			} else {
+				writer.String(u.Label.Value)
+			}
+		}
+		writer.Key("extended")
+		writer.String("extended")
+		writer.Key("base")
+		writer.String("base")
+		writer.Key("value")
+		writer.String("fern")
+		writer.EndObject()
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitFern(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		return visitor.VisitFern(u.fern)
+	}
+}
+
+// Validate reports all of the UnionWithLiteral's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithLiteral) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "fern":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithPrimitive struct {
+	Type    string
+	Boolean bool
+	String  string
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithPrimitiveFromBoolean(value bool) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "boolean", Boolean: value}
+}
+
+func NewUnionWithPrimitiveFromString(value string) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "string", String: value}
+}
+
+// Equal reports whether the UnionWithPrimitive is equal to the other UnionWithPrimitive.
+func (u *UnionWithPrimitive) Equal(other *UnionWithPrimitive) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if u.Boolean != other.Boolean {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithPrimitive.
+func (u *UnionWithPrimitive) DeepCopy() *UnionWithPrimitive {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithPrimitive) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *u
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*u = value
+	return nil
+}
+
+func (u UnionWithPrimitive) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the UnionWithPrimitive from the given core.JSONReader.
+func (u *UnionWithPrimitive) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	offset := reader.Offset()
+	*u = UnionWithPrimitive{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("type") {
+		case "type":
+			u.Type = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+	data := reader.Since(offset)
+	if reader.Err() != nil {
+		return
+	}
+	switch u.Type {
+	case "boolean":
+		reader.Fail(core.ReadJSONProperty(data, "value", func(reader *core.JSONReader) {
+			u.Boolean = reader.ReadBool()
+		}))
+	case "string":
+		reader.Fail(core.ReadJSONProperty(data, "value", func(reader *core.JSONReader) {
+			u.String = reader.ReadString()
+		}))
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+}
+
+// WriteJSON writes the UnionWithPrimitive to the given core.JSONWriter.
+func (u *UnionWithPrimitive) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			writer.Raw(u._unknown)
+			return
+		}
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.Type, *u))
+	case "boolean":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Key("value")
+		writer.Bool(u.Boolean)
+		writer.EndObject()
+	case "string":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Key("value")
+		writer.String(u.String)
+		writer.EndObject()
+	}
+}
+
+type UnionWithPrimitiveVisitor interface {
+	VisitBoolean(bool) error
+	VisitString(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithPrimitive) Accept(visitor UnionWithPrimitiveVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		return visitor.VisitBoolean(u.Boolean)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
+
+// Validate reports all of the UnionWithPrimitive's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithPrimitive) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "boolean":
+	case "string":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithUnknown struct {
+	Type    string
+	Foo     *Foo
+	Unknown interface{}
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithUnknownFromFoo(value *Foo) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "foo", Foo: value}
+}
+
+func NewUnionWithUnknownFromUnknown(value interface{}) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "unknown", Unknown: value}
+}
+
+// Equal reports whether the UnionWithUnknown is equal to the other UnionWithUnknown.
+func (u *UnionWithUnknown) Equal(other *UnionWithUnknown) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !core.EqualValue(u.Unknown, other.Unknown) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithUnknown.
+func (u *UnionWithUnknown) DeepCopy() *UnionWithUnknown {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Unknown = core.DeepCopyValue(u.Unknown)
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithUnknown) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *u
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*u = value
+	return nil
+}
+
+func (u UnionWithUnknown) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the UnionWithUnknown from the given core.JSONReader.
+func (u *UnionWithUnknown) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	offset := reader.Offset()
+	*u = UnionWithUnknown{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("type") {
+		case "type":
+			u.Type = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+	data := reader.Since(offset)
+	if reader.Err() != nil {
+		return
+	}
+	switch u.Type {
+	case "foo":
+		value := new(Foo)
+		reader.Fail(value.UnmarshalJSON(data))
+		u.Foo = value
+	case "unknown":
+		valueReader := core.NewJSONReader(data)
+		u.Unknown = valueReader.ReadValue()
+		reader.Fail(valueReader.End())
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+}
+
+// WriteJSON writes the UnionWithUnknown to the given core.JSONWriter.
+func (u *UnionWithUnknown) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			writer.Raw(u._unknown)
+			return
+		}
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.Type, *u))
+	case "foo":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Inline()
+		u.Foo.WriteJSON(writer)
+		writer.EndObject()
+	case "unknown":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Unknown != nil {
+			writer.Key("unknown")
+			writer.Value(u.Unknown)
+		}
+		writer.EndObject()
+	}
+}
+
+type UnionWithUnknownVisitor interface {
+	VisitFoo(*Foo) error
+	VisitUnknown(interface{}) error
+	// VisitUnknownValue is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknownValue(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithUnknown) Accept(visitor UnionWithUnknownVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknownValue(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "unknown":
+		return visitor.VisitUnknown(u.Unknown)
+	}
+}
+
+// Validate reports all of the UnionWithUnknown's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithUnknown) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "unknown":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UnionWithoutKey struct {
+	Type string
+	Foo  *Foo
+	// This is a bar field.
+	Bar *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionWithoutKeyFromFoo(value *Foo) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "foo", Foo: value}
+}
+
+func NewUnionWithoutKeyFromBar(value *Bar) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "bar", Bar: value}
+}
+
+// Equal reports whether the UnionWithoutKey is equal to the other UnionWithoutKey.
+func (u *UnionWithoutKey) Equal(other *UnionWithoutKey) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithoutKey.
+func (u *UnionWithoutKey) DeepCopy() *UnionWithoutKey {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
+func (u *UnionWithoutKey) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *u
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*u = value
+	return nil
+}
+
+func (u UnionWithoutKey) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the UnionWithoutKey from the given core.JSONReader.
+func (u *UnionWithoutKey) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	offset := reader.Offset()
+	*u = UnionWithoutKey{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("type") {
+		case "type":
+			u.Type = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+	data := reader.Since(offset)
+	if reader.Err() != nil {
+		return
+	}
+	switch u.Type {
+	case "foo":
+		value := new(Foo)
+		reader.Fail(value.UnmarshalJSON(data))
+		u.Foo = value
+	case "bar":
+		value := new(Bar)
+		reader.Fail(value.UnmarshalJSON(data))
+		u.Bar = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+}
+
+// WriteJSON writes the UnionWithoutKey to the given core.JSONWriter.
+func (u *UnionWithoutKey) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			writer.Raw(u._unknown)
+			return
+		}
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.Type, *u))
+	case "foo":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Inline()
+		u.Foo.WriteJSON(writer)
+		writer.EndObject()
+	case "bar":
+		writer.BeginObject()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Inline()
+		u.Bar.WriteJSON(writer)
+		writer.EndObject()
+	}
+}
+
+type UnionWithoutKeyVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *UnionWithoutKey) Accept(visitor UnionWithoutKeyVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the UnionWithoutKey's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithoutKey) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
@@ -0,0 +1,1331 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-explicit-null/fixtures/core"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+	strings "strings"
+	testing "testing"
+	time "time"
+)
+
+func (b *Bar) reflectUnmarshalJSON(data []byte) error {
+	type unmarshaler Bar
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Bar(value)
+	return nil
+}
+
+func (b *Bar) reflectMarshalJSON() ([]byte, error) {
+	type embed Bar
+	var marshaler = struct {
+		embed
+	}{
+		embed: embed(*b),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testBarValues returns the sample Bar values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testBarValues(depth int) []*Bar {
+	if depth > 2 {
+		return []*Bar{{}}
+	}
+	return []*Bar{
+		{
+			Name: "fern <&> \"json\" é 🌿",
+		},
+		{},
+	}
+}
+
+func TestBarJSON(t *testing.T) {
+	for _, value := range testBarValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Bar)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Bar)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Bar)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Bar).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Bar).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Bar)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Bar)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkBarJSON(b *testing.B) {
+	value := testBarValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Bar).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Bar).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (b *Baz) reflectUnmarshalJSON(data []byte) error {
+	type unmarshaler Baz
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Baz(value)
+	nullProperties, err := core.NullProperties(data, "tags")
+	if err != nil {
+		return err
+	}
+	if nullProperties["tags"] {
+		b.Tags = &core.Optional[[]string]{Null: true}
+	}
+	b.extended = "extended"
+	return nil
+}
+
+func (b *Baz) reflectMarshalJSON() ([]byte, error) {
+	type embed Baz
+	var marshaler = struct {
+		embed
+		Extended string `json:"extended"`
+	}{
+		embed:    embed(*b),
+		Extended: "extended",
+	}
+	return json.Marshal(marshaler)
+}
+
+// testBazValues returns the sample Baz values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testBazValues(depth int) []*Baz {
+	if depth > 2 {
+		return []*Baz{{}}
+	}
+	return []*Baz{
+		{
+			Tags: &core.Optional[[]string]{Value: []string{"fern <&> \"json\" é 🌿"}},
+		},
+		{},
+		{
+			Tags: &core.Optional[[]string]{Null: true},
+		},
+	}
+}
+
+func TestBazJSON(t *testing.T) {
+	for _, value := range testBazValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Baz)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Baz)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Baz)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Baz).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Baz).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Baz)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Baz)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkBazJSON(b *testing.B) {
+	value := testBazValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Baz).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Baz).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (f *Foo) reflectUnmarshalJSON(data []byte) error {
+	type embed Foo
+	var unmarshaler = struct {
+		embed
+		CreatedAt *core.Optional[*core.DateTime] `json:"createdAt,omitempty"`
+		Birthday  *core.Optional[*core.Date]     `json:"birthday,omitempty"`
+	}{
+		embed: embed(*f),
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*f = Foo(unmarshaler.embed)
+	f.CreatedAt = core.MapOptional(unmarshaler.CreatedAt, (*core.DateTime).Time)
+	f.Birthday = core.MapOptional(unmarshaler.Birthday, (*core.Date).Time)
+	nullProperties, err := core.NullProperties(data, "description", "createdAt", "birthday")
+	if err != nil {
+		return err
+	}
+	if nullProperties["description"] {
+		f.Description = &core.Optional[string]{Null: true}
+	}
+	if nullProperties["createdAt"] {
+		f.CreatedAt = &core.Optional[time.Time]{Null: true}
+	}
+	if nullProperties["birthday"] {
+		f.Birthday = &core.Optional[time.Time]{Null: true}
+	}
+	return nil
+}
+
+func (f *Foo) reflectMarshalJSON() ([]byte, error) {
+	type embed Foo
+	var marshaler = struct {
+		embed
+		CreatedAt *core.Optional[*core.DateTime] `json:"createdAt,omitempty"`
+		Birthday  *core.Optional[*core.Date]     `json:"birthday,omitempty"`
+	}{
+		embed:     embed(*f),
+		CreatedAt: core.MapOptional(f.CreatedAt, core.NewDateTime),
+		Birthday:  core.MapOptional(f.Birthday, core.NewDate),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testFooValues returns the sample Foo values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testFooValues(depth int) []*Foo {
+	if depth > 2 {
+		return []*Foo{{}}
+	}
+	return []*Foo{
+		{
+			Name:        "fern <&> \"json\" é 🌿",
+			Description: &core.Optional[string]{Value: "fern <&> \"json\" é 🌿"},
+			CreatedAt:   &core.Optional[time.Time]{Value: time.Date(2024, time.January, 2, 3, 4, 5, 6000, time.UTC)},
+			Birthday:    &core.Optional[time.Time]{Value: time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		},
+		{},
+		{
+			Description: &core.Optional[string]{Null: true},
+			CreatedAt:   &core.Optional[time.Time]{Null: true},
+			Birthday:    &core.Optional[time.Time]{Null: true},
+		},
+	}
+}
+
+func TestFooJSON(t *testing.T) {
+	for _, value := range testFooValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Foo)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Foo)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Foo)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Foo).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Foo).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Foo)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Foo)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkFooJSON(b *testing.B) {
+	value := testFooValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Foo).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Foo).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *Union) reflectUnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u Union) reflectMarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+// testUnionValues returns the sample Union values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionValues(depth int) []*Union {
+	if depth > 2 {
+		return []*Union{nil}
+	}
+	return []*Union{
+		{
+			Type: "foo",
+			Foo:  testFooValues(depth + 1)[0],
+		},
+		{
+			Type: "bar",
+			Bar:  testBarValues(depth + 1)[0],
+		},
+	}
+}
+
+func TestUnionUnknown(t *testing.T) {
+	data := []byte("{\"type\":\"fern-unknown-type\"}")
+	value := new(Union)
+	require.NoError(t, value.UnmarshalJSON(data))
+	if err := value.Validate(); err != nil {
+		assert.NotContains(t, err.Error(), "invalid type")
+	}
+	marshaled, err := value.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(marshaled))
+
+	// A type that was never set is still invalid.
+	err = new(Union).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid type")
+}
+
+func TestUnionJSON(t *testing.T) {
+	for _, value := range testUnionValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Union)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Union)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Union)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Union).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Union).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Union)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Union)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkUnionJSON(b *testing.B) {
+	value := testUnionValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Union).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Union).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *UnionWithDiscriminant) reflectUnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"_type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithDiscriminant) reflectMarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"_type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"_type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+// testUnionWithDiscriminantValues returns the sample UnionWithDiscriminant values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionWithDiscriminantValues(depth int) []*UnionWithDiscriminant {
+	if depth > 2 {
+		return []*UnionWithDiscriminant{nil}
+	}
+	return []*UnionWithDiscriminant{
+		{
+			Type: "foo",
+			Foo:  testFooValues(depth + 1)[0],
+		},
+		{
+			Type: "bar",
+			Bar:  testBarValues(depth + 1)[0],
+		},
+	}
+}
+
+func TestUnionWithDiscriminantUnknown(t *testing.T) {
+	data := []byte("{\"_type\":\"fern-unknown-type\"}")
+	value := new(UnionWithDiscriminant)
+	require.NoError(t, value.UnmarshalJSON(data))
+	if err := value.Validate(); err != nil {
+		assert.NotContains(t, err.Error(), "invalid type")
+	}
+	marshaled, err := value.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(marshaled))
+
+	// A type that was never set is still invalid.
+	err = new(UnionWithDiscriminant).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid type")
+}
+
+func TestUnionWithDiscriminantJSON(t *testing.T) {
+	for _, value := range testUnionWithDiscriminantValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(UnionWithDiscriminant)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(UnionWithDiscriminant)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(UnionWithDiscriminant)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(UnionWithDiscriminant).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(UnionWithDiscriminant).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(UnionWithDiscriminant)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(UnionWithDiscriminant)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkUnionWithDiscriminantJSON(b *testing.B) {
+	value := testUnionWithDiscriminantValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithDiscriminant).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithDiscriminant).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *UnionWithLiteral) reflectUnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type  string                   `json:"type"`
+		Tags  *core.Optional[[]string] `json:"tags,omitempty"`
+		Label *core.Optional[string]   `json:"label,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	u.Tags = unmarshaler.Tags
+	u.Label = unmarshaler.Label
+	nullProperties, err := core.NullProperties(data, "tags", "label")
+	if err != nil {
+		return err
+	}
+	if nullProperties["tags"] {
+		u.Tags = &core.Optional[[]string]{Null: true}
+	}
+	if nullProperties["label"] {
+		u.Label = &core.Optional[string]{Null: true}
+	}
+	u.extended = "extended"
+	u.base = "base"
+	switch unmarshaler.Type {
+	case "fern":
+		u.fern = "fern"
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithLiteral) reflectMarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		var marshaler = struct {
+			Type     string                   `json:"type"`
+			Tags     *core.Optional[[]string] `json:"tags,omitempty"`
+			Label    *core.Optional[string]   `json:"label,omitempty"`
+			Extended string                   `json:"extended"`
+			Base     string                   `json:"base"`
+			Fern     string                   `json:"value,omitempty"`
+		}{
+			Type:     u.Type,
+			Tags:     u.Tags,
+			Label:    u.Label,
+			Extended: "extended",
+			Base:     "base",
+			Fern:     "fern",
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+// testUnionWithLiteralValues returns the sample UnionWithLiteral values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionWithLiteralValues(depth int) []*UnionWithLiteral {
+	if depth > 2 {
+		return []*UnionWithLiteral{nil}
+	}
+	return []*UnionWithLiteral{
+		{
+			Type:  "fern",
+			Tags:  &core.Optional[[]string]{Value: []string{"fern <&> \"json\" é 🌿"}},
+			Label: &core.Optional[string]{Value: "fern <&> \"json\" é 🌿"},
+			fern:  "fern",
+		},
+	}
+}
+
+func TestUnionWithLiteralUnknown(t *testing.T) {
+	data := []byte("{\"type\":\"fern-unknown-type\"}")
+	value := new(UnionWithLiteral)
+	require.NoError(t, value.UnmarshalJSON(data))
+	if err := value.Validate(); err != nil {
+		assert.NotContains(t, err.Error(), "invalid type")
+	}
+	marshaled, err := value.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(marshaled))
+
+	// A type that was never set is still invalid.
+	err = new(UnionWithLiteral).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid type")
+}
+
+func TestUnionWithLiteralJSON(t *testing.T) {
+	for _, value := range testUnionWithLiteralValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(UnionWithLiteral)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(UnionWithLiteral)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(UnionWithLiteral)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(UnionWithLiteral).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(UnionWithLiteral).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(UnionWithLiteral)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(UnionWithLiteral)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkUnionWithLiteralJSON(b *testing.B) {
+	value := testUnionWithLiteralValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithLiteral).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithLiteral).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *UnionWithPrimitive) reflectUnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "boolean":
+		var valueUnmarshaler struct {
+			Boolean bool `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Boolean = valueUnmarshaler.Boolean
+	case "string":
+		var valueUnmarshaler struct {
+			String string `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.String = valueUnmarshaler.String
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithPrimitive) reflectMarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		var marshaler = struct {
+			Type    string `json:"type"`
+			Boolean bool   `json:"value"`
+		}{
+			Type:    u.Type,
+			Boolean: u.Boolean,
+		}
+		return json.Marshal(marshaler)
+	case "string":
+		var marshaler = struct {
+			Type   string `json:"type"`
+			String string `json:"value"`
+		}{
+			Type:   u.Type,
+			String: u.String,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+// testUnionWithPrimitiveValues returns the sample UnionWithPrimitive values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionWithPrimitiveValues(depth int) []*UnionWithPrimitive {
+	if depth > 2 {
+		return []*UnionWithPrimitive{nil}
+	}
+	return []*UnionWithPrimitive{
+		{
+			Type:    "boolean",
+			Boolean: true,
+		},
+		{
+			Type:   "string",
+			String: "fern <&> \"json\" é 🌿",
+		},
+	}
+}
+
+func TestUnionWithPrimitiveUnknown(t *testing.T) {
+	data := []byte("{\"type\":\"fern-unknown-type\"}")
+	value := new(UnionWithPrimitive)
+	require.NoError(t, value.UnmarshalJSON(data))
+	if err := value.Validate(); err != nil {
+		assert.NotContains(t, err.Error(), "invalid type")
+	}
+	marshaled, err := value.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(marshaled))
+
+	// A type that was never set is still invalid.
+	err = new(UnionWithPrimitive).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid type")
+}
+
+func TestUnionWithPrimitiveJSON(t *testing.T) {
+	for _, value := range testUnionWithPrimitiveValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(UnionWithPrimitive)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(UnionWithPrimitive)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(UnionWithPrimitive)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(UnionWithPrimitive).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(UnionWithPrimitive).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(UnionWithPrimitive)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(UnionWithPrimitive)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkUnionWithPrimitiveJSON(b *testing.B) {
+	value := testUnionWithPrimitiveValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithPrimitive).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithPrimitive).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *UnionWithUnknown) reflectUnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Foo = value
+	case "unknown":
+		value := make(map[string]interface{})
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Unknown = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithUnknown) reflectMarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+		}{
+			Type: u.Type,
+		}
+		return core.MergeJSON(marshaler, u.Foo)
+	case "unknown":
+		var marshaler = struct {
+			Type    string      `json:"type"`
+			Unknown interface{} `json:"unknown,omitempty"`
+		}{
+			Type:    u.Type,
+			Unknown: u.Unknown,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+// testUnionWithUnknownValues returns the sample UnionWithUnknown values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionWithUnknownValues(depth int) []*UnionWithUnknown {
+	if depth > 2 {
+		return []*UnionWithUnknown{nil}
+	}
+	return []*UnionWithUnknown{
+		{
+			Type: "foo",
+			Foo:  testFooValues(depth + 1)[0],
+		},
+		{
+			Type: "unknown",
+		},
+	}
+}
+
+func TestUnionWithUnknownUnknown(t *testing.T) {
+	data := []byte("{\"type\":\"fern-unknown-type\"}")
+	value := new(UnionWithUnknown)
+	require.NoError(t, value.UnmarshalJSON(data))
+	if err := value.Validate(); err != nil {
+		assert.NotContains(t, err.Error(), "invalid type")
+	}
+	marshaled, err := value.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(marshaled))
+
+	// A type that was never set is still invalid.
+	err = new(UnionWithUnknown).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid type")
+}
+
+func TestUnionWithUnknownJSON(t *testing.T) {
+	for _, value := range testUnionWithUnknownValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(UnionWithUnknown)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(UnionWithUnknown)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(UnionWithUnknown)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(UnionWithUnknown).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(UnionWithUnknown).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(UnionWithUnknown)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(UnionWithUnknown)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkUnionWithUnknownJSON(b *testing.B) {
+	value := testUnionWithUnknownValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithUnknown).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithUnknown).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *UnionWithoutKey) reflectUnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Foo = value
+	case "bar":
+		value := new(Bar)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Bar = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithoutKey) reflectMarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+		}{
+			Type: u.Type,
+		}
+		return core.MergeJSON(marshaler, u.Foo)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+		}{
+			Type: u.Type,
+		}
+		return core.MergeJSON(marshaler, u.Bar)
+	}
+}
+
+// testUnionWithoutKeyValues returns the sample UnionWithoutKey values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionWithoutKeyValues(depth int) []*UnionWithoutKey {
+	if depth > 2 {
+		return []*UnionWithoutKey{nil}
+	}
+	return []*UnionWithoutKey{
+		{
+			Type: "foo",
+			Foo:  testFooValues(depth + 1)[0],
+		},
+		{
+			Type: "bar",
+			Bar:  testBarValues(depth + 1)[0],
+		},
+	}
+}
+
+func TestUnionWithoutKeyUnknown(t *testing.T) {
+	data := []byte("{\"type\":\"fern-unknown-type\"}")
+	value := new(UnionWithoutKey)
+	require.NoError(t, value.UnmarshalJSON(data))
+	if err := value.Validate(); err != nil {
+		assert.NotContains(t, err.Error(), "invalid type")
+	}
+	marshaled, err := value.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(marshaled))
+
+	// A type that was never set is still invalid.
+	err = new(UnionWithoutKey).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid type")
+}
+
+func TestUnionWithoutKeyJSON(t *testing.T) {
+	for _, value := range testUnionWithoutKeyValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(UnionWithoutKey)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(UnionWithoutKey)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(UnionWithoutKey)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(UnionWithoutKey).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(UnionWithoutKey).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(UnionWithoutKey)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(UnionWithoutKey)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkUnionWithoutKeyJSON(b *testing.B) {
+	value := testUnionWithoutKeyValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithoutKey).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithoutKey).reflectUnmarshalJSON(data)
+		}
+	})
+}
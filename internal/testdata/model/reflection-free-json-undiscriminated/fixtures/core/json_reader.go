@@ -0,0 +1,754 @@
+package core
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// JSONReader reads a single JSON value without reflection. It's used by the
+// generated ReadJSON methods, and accepts the same input as encoding/json.
+//
+// Errors are sticky; the first error is returned by Err (or End), and the
+// reader returns zero values after it.
+type JSONReader struct {
+	data   []byte
+	offset int
+	err    error
+
+	// key is the key of the current property.
+	key string
+
+	// first is set if the next key (or array element) is the first
+	// one in the current object (or array).
+	first bool
+}
+
+// NewJSONReader returns a new *JSONReader for the given JSON value.
+func NewJSONReader(data []byte) *JSONReader {
+	return &JSONReader{
+		data: data,
+	}
+}
+
+// ReadJSONProperty reads the given property of the JSON object with read,
+// and ignores all of the object's other properties.
+func ReadJSONProperty(data []byte, key string, read func(reader *JSONReader)) error {
+	reader := NewJSONReader(data)
+	if reader.ReadNull() {
+		return reader.End()
+	}
+	reader.BeginObject()
+	for reader.NextKey() {
+		if reader.MatchKey(key) == key {
+			read(reader)
+			continue
+		}
+		reader.Skip()
+	}
+	return reader.End()
+}
+
+// DecodeWith is equivalent to Decode, but decodes the JSON value into the given
+// value with read (e.g. a generated ReadJSON method) rather than encoding/json.
+func (u *UnionDecoder) DecodeWith(member *UnionMember, value interface{}, read func(reader *JSONReader), set func()) bool {
+	return u.try(member, value, func() error {
+		reader := NewJSONReader(u.data)
+		read(reader)
+		return reader.End()
+	}, set)
+}
+
+// Err returns the first error, if any.
+func (r *JSONReader) Err() error {
+	return r.err
+}
+
+// Fail records the given error, unless it's nil or an error was
+// already recorded.
+func (r *JSONReader) Fail(err error) {
+	if r.err == nil && err != nil {
+		r.err = err
+	}
+}
+
+// End returns the first error, if any, or an error if anything other than
+// whitespace follows the JSON value.
+func (r *JSONReader) End() error {
+	if r.err == nil && r.peek() != 0 {
+		r.syntaxError("after top-level value")
+	}
+	return r.err
+}
+
+// Offset returns the offset of the next JSON value, which can be passed
+// to Since to retrieve the value's raw JSON after it's read.
+func (r *JSONReader) Offset() int {
+	r.peek()
+	return r.offset
+}
+
+// Since returns the raw JSON read since the given offset, or nil if
+// there was an error.
+func (r *JSONReader) Since(offset int) json.RawMessage {
+	if r.err != nil || offset > r.offset {
+		return nil
+	}
+	return json.RawMessage(r.data[offset:r.offset])
+}
+
+// ReadNull reads a JSON null, and returns true if the next value is null
+// (or if there was an error, in which case there's nothing left to read).
+func (r *JSONReader) ReadNull() bool {
+	if r.err != nil {
+		return true
+	}
+	if r.peek() != 'n' {
+		return false
+	}
+	r.literal("null")
+	return true
+}
+
+// BeginObject begins reading a JSON object, whose properties are then
+// read with NextKey.
+func (r *JSONReader) BeginObject() {
+	if r.expect('{', "object") {
+		r.first = true
+	}
+}
+
+// NextKey reads the key of the object's next property, which is returned
+// by Key, and returns false once there are no properties left. The property's
+// value must be read (or skipped) before the next call to NextKey.
+func (r *JSONReader) NextKey() bool {
+	if !r.next('}') {
+		return false
+	}
+	if r.peek() != '"' {
+		r.syntaxError("looking for beginning of object key string")
+		return false
+	}
+	r.key = r.readString()
+	if r.peek() != ':' {
+		r.syntaxError("after object key")
+		return false
+	}
+	r.offset++
+	return r.err == nil
+}
+
+// Key returns the key of the current property.
+func (r *JSONReader) Key() string {
+	return r.key
+}
+
+// MatchKey returns the key of the current property if it's one of the given
+// keys. Otherwise, just like encoding/json, the first of the given keys that
+// matches case-insensitively is returned (or the key itself if none do).
+func (r *JSONReader) MatchKey(keys ...string) string {
+	for _, key := range keys {
+		if key == r.key {
+			return key
+		}
+	}
+	for _, key := range keys {
+		if strings.EqualFold(key, r.key) {
+			return key
+		}
+	}
+	return r.key
+}
+
+// BeginArray begins reading a JSON array, whose elements are then read
+// with NextElement.
+func (r *JSONReader) BeginArray() {
+	if r.expect('[', "array") {
+		r.first = true
+	}
+}
+
+// NextElement returns true if there's another element in the array, which
+// must be read (or skipped) before the next call to NextElement.
+func (r *JSONReader) NextElement() bool {
+	return r.next(']')
+}
+
+// ReadString reads a JSON string, or returns an empty string if it's null.
+func (r *JSONReader) ReadString() string {
+	if r.ReadNull() {
+		return ""
+	}
+	if r.peek() != '"' {
+		r.typeError("string")
+		return ""
+	}
+	return r.readString()
+}
+
+// ReadBool reads a JSON boolean, or returns false if it's null.
+func (r *JSONReader) ReadBool() bool {
+	if r.ReadNull() {
+		return false
+	}
+	switch r.peek() {
+	case 't':
+		r.literal("true")
+		return r.err == nil
+	case 'f':
+		r.literal("false")
+		return false
+	}
+	r.typeError("bool")
+	return false
+}
+
+// ReadInt reads a JSON number as an int, or returns zero if it's null.
+func (r *JSONReader) ReadInt() int {
+	value := r.readInteger("int", strconv.IntSize)
+	return int(value)
+}
+
+// ReadInt64 reads a JSON number as an int64, or returns zero if it's null.
+func (r *JSONReader) ReadInt64() int64 {
+	return r.readInteger("int64", 64)
+}
+
+// ReadFloat64 reads a JSON number as a float64, or returns zero if it's null.
+func (r *JSONReader) ReadFloat64() float64 {
+	if r.ReadNull() {
+		return 0
+	}
+	number, integer := r.readNumber("float64")
+	if r.err != nil {
+		return 0
+	}
+	if integer && len(number) < 16 {
+		// Integers with fewer than 16 digits are exactly representable,
+		// and don't need to be parsed as a float.
+		value, _ := parseInteger(number, 64)
+		return float64(value)
+	}
+	value, err := strconv.ParseFloat(string(number), 64)
+	if err != nil {
+		r.Fail(fmt.Errorf("json: cannot unmarshal number %s into Go value of type float64", number))
+		return 0
+	}
+	return value
+}
+
+// ReadBytes reads a base64-encoded JSON string, or returns nil if it's null.
+func (r *JSONReader) ReadBytes() []byte {
+	if r.ReadNull() {
+		return nil
+	}
+	if r.peek() != '"' {
+		r.typeError("[]uint8")
+		return nil
+	}
+	encoded := r.readRawString()
+	if r.err != nil {
+		return nil
+	}
+	value := make([]byte, base64.StdEncoding.DecodedLen(len(encoded)))
+	n, err := base64.StdEncoding.Decode(value, encoded)
+	if err != nil {
+		r.Fail(err)
+		return nil
+	}
+	return value[:n]
+}
+
+// ReadDate reads an RFC 3339 full-date (e.g. "2006-01-02"), or returns
+// the zero time if it's null.
+func (r *JSONReader) ReadDate() time.Time {
+	if r.ReadNull() {
+		return time.Time{}
+	}
+	raw := r.ReadString()
+	if r.err != nil {
+		return time.Time{}
+	}
+	value, err := parseDate(raw)
+	r.Fail(err)
+	return value
+}
+
+// ReadDateTime reads an RFC 3339 date-time (e.g. "2006-01-02T15:04:05Z"),
+// or returns the zero time if it's null.
+func (r *JSONReader) ReadDateTime() time.Time {
+	if r.ReadNull() {
+		return time.Time{}
+	}
+	raw := r.ReadString()
+	if r.err != nil {
+		return time.Time{}
+	}
+	value, err := parseDateTime(raw)
+	r.Fail(err)
+	return value
+}
+
+// ReadText reads a JSON string into the given encoding.TextUnmarshaler
+// (e.g. a uuid.UUID), which is left unchanged if it's null.
+func (r *JSONReader) ReadText(value encoding.TextUnmarshaler) {
+	if r.ReadNull() {
+		return
+	}
+	if r.peek() != '"' {
+		r.typeError("string")
+		return
+	}
+	text := r.readRawString()
+	if r.err != nil {
+		return
+	}
+	r.Fail(value.UnmarshalText(text))
+}
+
+// ReadRaw reads the next JSON value as-is. The returned json.RawMessage
+// shares its memory with the reader's data.
+func (r *JSONReader) ReadRaw() json.RawMessage {
+	offset := r.Offset()
+	r.Skip()
+	return r.Since(offset)
+}
+
+// ReadValue reads the next JSON value in the same way as encoding/json
+// would into an interface{} (i.e. numbers are read as a float64).
+func (r *JSONReader) ReadValue() interface{} {
+	return r.readValue(false)
+}
+
+// ReadValueUseNumber reads the next JSON value, and represents numbers as a
+// json.Number so that they're marshaled exactly as they were received.
+func (r *JSONReader) ReadValueUseNumber() interface{} {
+	return r.readValue(true)
+}
+
+// ReadInto reads the next JSON value into the given value with encoding/json,
+// which is used for values that don't have a reflection-free representation.
+func (r *JSONReader) ReadInto(value interface{}) {
+	raw := r.ReadRaw()
+	if r.err != nil {
+		return
+	}
+	r.Fail(json.Unmarshal(raw, value))
+}
+
+// Skip skips the next JSON value.
+func (r *JSONReader) Skip() {
+	if r.err != nil {
+		return
+	}
+	switch c := r.peek(); {
+	case c == '{':
+		r.BeginObject()
+		for r.NextKey() {
+			r.Skip()
+		}
+	case c == '[':
+		r.BeginArray()
+		for r.NextElement() {
+			r.Skip()
+		}
+	case c == '"':
+		r.readRawString()
+	case c == 't':
+		r.literal("true")
+	case c == 'f':
+		r.literal("false")
+	case c == 'n':
+		r.literal("null")
+	case c == '-' || (c >= '0' && c <= '9'):
+		r.readNumber("number")
+	default:
+		r.syntaxError("looking for beginning of value")
+	}
+}
+
+// readValue reads the next JSON value as an interface{}.
+func (r *JSONReader) readValue(useNumber bool) interface{} {
+	if r.err != nil {
+		return nil
+	}
+	switch c := r.peek(); {
+	case c == '{':
+		value := make(map[string]interface{})
+		r.BeginObject()
+		for r.NextKey() {
+			key := r.key
+			value[key] = r.readValue(useNumber)
+		}
+		return value
+	case c == '[':
+		value := make([]interface{}, 0)
+		r.BeginArray()
+		for r.NextElement() {
+			value = append(value, r.readValue(useNumber))
+		}
+		return value
+	case c == '"':
+		return r.readString()
+	case c == 't', c == 'f':
+		return r.ReadBool()
+	case c == 'n':
+		r.literal("null")
+		return nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		if useNumber {
+			number, _ := r.readNumber("number")
+			return json.Number(number)
+		}
+		return r.ReadFloat64()
+	}
+	r.syntaxError("looking for beginning of value")
+	return nil
+}
+
+// next consumes the separator before the next key (or element) in the current
+// object (or array), and returns false if the given closing delimiter is next.
+func (r *JSONReader) next(closing byte) bool {
+	if r.err != nil {
+		return false
+	}
+	c := r.peek()
+	if c == closing {
+		r.offset++
+		r.first = false
+		return false
+	}
+	if !r.first {
+		if c != ',' {
+			r.syntaxError("after object key:value pair or array element")
+			return false
+		}
+		r.offset++
+	}
+	r.first = false
+	return true
+}
+
+// readInteger reads a JSON number as an integer with the given bit size.
+func (r *JSONReader) readInteger(typeName string, bitSize int) int64 {
+	if r.ReadNull() {
+		return 0
+	}
+	number, integer := r.readNumber(typeName)
+	if r.err != nil {
+		return 0
+	}
+	value, ok := parseInteger(number, bitSize)
+	if !integer || !ok {
+		r.Fail(fmt.Errorf("json: cannot unmarshal number %s into Go value of type %s", number, typeName))
+		return 0
+	}
+	return value
+}
+
+// parseInteger parses the given JSON integer, and returns false if it
+// overflows the given bit size.
+func parseInteger(number []byte, bitSize int) (int64, bool) {
+	negative := number[0] == '-'
+	if negative {
+		number = number[1:]
+	}
+	limit := uint64(1)<<uint(bitSize-1) - 1
+	if negative {
+		limit++
+	}
+	var value uint64
+	for _, c := range number {
+		digit := uint64(c - '0')
+		if value > (limit-digit)/10 {
+			return 0, false
+		}
+		value = value*10 + digit
+	}
+	if negative {
+		return -int64(value-1) - 1, true
+	}
+	return int64(value), true
+}
+
+// readNumber reads a JSON number, and reports whether it's an integer
+// (i.e. it doesn't have a fraction or an exponent).
+func (r *JSONReader) readNumber(typeName string) ([]byte, bool) {
+	c := r.peek()
+	if c != '-' && (c < '0' || c > '9') {
+		r.typeError(typeName)
+		return nil, false
+	}
+	start := r.offset
+	integer := true
+	if c == '-' {
+		r.offset++
+	}
+	switch {
+	case r.at('0'):
+		r.offset++
+	case r.digits() == 0:
+		r.syntaxError("in numeric literal")
+		return nil, false
+	}
+	if r.at('.') {
+		integer = false
+		r.offset++
+		if r.digits() == 0 {
+			r.syntaxError("after decimal point in numeric literal")
+			return nil, false
+		}
+	}
+	if r.at('e') || r.at('E') {
+		integer = false
+		r.offset++
+		if r.at('+') || r.at('-') {
+			r.offset++
+		}
+		if r.digits() == 0 {
+			r.syntaxError("in exponent of numeric literal")
+			return nil, false
+		}
+	}
+	return r.data[start:r.offset], integer
+}
+
+// digits consumes a sequence of digits, and returns how many there were.
+func (r *JSONReader) digits() int {
+	start := r.offset
+	for r.offset < len(r.data) && r.data[r.offset] >= '0' && r.data[r.offset] <= '9' {
+		r.offset++
+	}
+	return r.offset - start
+}
+
+// readString reads a JSON string, which must be next.
+func (r *JSONReader) readString() string {
+	return string(r.readRawString())
+}
+
+// readRawString reads a JSON string, which must be next, and returns its
+// unescaped contents. The result shares its memory with the reader's data
+// if it doesn't contain any escape sequences.
+func (r *JSONReader) readRawString() []byte {
+	r.offset++ // The opening quote.
+	start := r.offset
+	for r.offset < len(r.data) {
+		c := r.data[r.offset]
+		switch {
+		case c == '"':
+			r.offset++
+			return r.data[start : r.offset-1]
+		case c == '\\' || c >= utf8.RuneSelf:
+			return r.readEscapedString(start)
+		case c < 0x20:
+			r.syntaxError("in string literal")
+			return nil
+		}
+		r.offset++
+	}
+	r.syntaxError("in string literal")
+	return nil
+}
+
+// readEscapedString reads the rest of a JSON string that started at the given
+// offset, which includes escape sequences (or non-ASCII characters).
+func (r *JSONReader) readEscapedString(start int) []byte {
+	value := make([]byte, r.offset-start, r.offset-start+16)
+	copy(value, r.data[start:r.offset])
+	for r.offset < len(r.data) {
+		c := r.data[r.offset]
+		switch {
+		case c == '"':
+			r.offset++
+			return value
+		case c < 0x20:
+			r.syntaxError("in string literal")
+			return nil
+		case c == '\\':
+			if r.offset+1 >= len(r.data) {
+				r.syntaxError("in string escape code")
+				return nil
+			}
+			escaped := r.data[r.offset+1]
+			r.offset += 2
+			switch escaped {
+			case '"', '\\', '/':
+				value = append(value, escaped)
+			case 'b':
+				value = append(value, '\b')
+			case 'f':
+				value = append(value, '\f')
+			case 'n':
+				value = append(value, '\n')
+			case 'r':
+				value = append(value, '\r')
+			case 't':
+				value = append(value, '\t')
+			case 'u':
+				rr := r.readHex()
+				if r.err != nil {
+					return nil
+				}
+				if utf16.IsSurrogate(rr) {
+					// Surrogates are only valid as part of a complete pair.
+					high := rr
+					rr = utf8.RuneError
+					if r.offset+1 < len(r.data) && r.data[r.offset] == '\\' && r.data[r.offset+1] == 'u' {
+						offset := r.offset
+						r.offset += 2
+						low := r.readHex()
+						if r.err != nil {
+							return nil
+						}
+						if decoded := utf16.DecodeRune(high, low); decoded != utf8.RuneError {
+							rr = decoded
+						} else {
+							r.offset = offset
+						}
+					}
+				}
+				value = append(value, string(rr)...)
+			default:
+				r.syntaxError("in string escape code")
+				return nil
+			}
+		case c < utf8.RuneSelf:
+			value = append(value, c)
+			r.offset++
+		default:
+			rr, size := utf8.DecodeRune(r.data[r.offset:])
+			r.offset += size
+			if rr == utf8.RuneError && size == 1 {
+				value = append(value, "\ufffd"...)
+				continue
+			}
+			value = append(value, r.data[r.offset-size:r.offset]...)
+		}
+	}
+	r.syntaxError("in string literal")
+	return nil
+}
+
+// readHex reads the four hexadecimal digits of a \u escape sequence.
+func (r *JSONReader) readHex() rune {
+	if r.offset+4 > len(r.data) {
+		r.syntaxError("in \\u hexadecimal character escape")
+		return 0
+	}
+	var value rune
+	for _, c := range r.data[r.offset : r.offset+4] {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+		case c >= 'a' && c <= 'f':
+			c = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			r.syntaxError("in \\u hexadecimal character escape")
+			return 0
+		}
+		value = value*16 + rune(c)
+	}
+	r.offset += 4
+	return value
+}
+
+// literal reads the given literal (e.g. true), which must be next.
+func (r *JSONReader) literal(literal string) {
+	for i := 0; i < len(literal); i++ {
+		if r.offset >= len(r.data) || r.data[r.offset] != literal[i] {
+			r.syntaxError("in literal " + literal + " (expecting '" + literal[i:i+1] + "')")
+			return
+		}
+		r.offset++
+	}
+}
+
+// expect reads the given delimiter, which must be next, and returns false
+// (with an error) if it isn't.
+func (r *JSONReader) expect(delimiter byte, typeName string) bool {
+	if r.err != nil {
+		return false
+	}
+	if r.peek() != delimiter {
+		if r.peek() == 0 {
+			r.syntaxError("looking for beginning of value")
+			return false
+		}
+		r.typeError(typeName)
+		return false
+	}
+	r.offset++
+	return true
+}
+
+// at returns true if the given byte is next.
+func (r *JSONReader) at(c byte) bool {
+	return r.offset < len(r.data) && r.data[r.offset] == c
+}
+
+// peek skips any whitespace, and returns the next byte, or zero if there's
+// nothing left to read.
+func (r *JSONReader) peek() byte {
+	for r.offset < len(r.data) {
+		switch c := r.data[r.offset]; c {
+		case ' ', '\t', '\n', '\r':
+			r.offset++
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+// typeError records an error for a value that can't be read as the given type.
+func (r *JSONReader) typeError(typeName string) {
+	var kind string
+	switch c := r.peek(); {
+	case c == '{':
+		kind = "object"
+	case c == '[':
+		kind = "array"
+	case c == '"':
+		kind = "string"
+	case c == 't' || c == 'f':
+		kind = "bool"
+	case c == '-' || (c >= '0' && c <= '9'):
+		kind = "number"
+	default:
+		r.syntaxError("looking for beginning of value")
+		return
+	}
+	r.Fail(fmt.Errorf("json: cannot unmarshal %s into Go value of type %s", kind, typeName))
+}
+
+// syntaxError records a syntax error at the current offset.
+func (r *JSONReader) syntaxError(context string) {
+	if r.offset >= len(r.data) {
+		r.Fail(fmt.Errorf("unexpected end of JSON input"))
+		return
+	}
+	c := r.data[r.offset]
+	r.Fail(fmt.Errorf("invalid character %s %s (at offset %d)", quoteCharacter(c), context, r.offset))
+}
+
+// quoteCharacter formats the character in the same way as encoding/json.
+func quoteCharacter(c byte) string {
+	switch {
+	case c == '\'':
+		return `'\''`
+	case c == '"':
+		return `'"'`
+	case c < 0x20 || c >= utf8.RuneSelf:
+		return strconv.Quote(string(rune(c)))
+	}
+	return "'" + string(rune(c)) + "'"
+}
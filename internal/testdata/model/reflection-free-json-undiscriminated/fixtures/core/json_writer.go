@@ -0,0 +1,334 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// JSONWriter writes a single JSON value without reflection. It's used by
+// the generated WriteJSON methods, and produces the same output as
+// encoding/json (e.g. HTML characters are escaped).
+//
+// Errors are sticky; the first error is returned by Bytes, and everything
+// written after it is discarded.
+type JSONWriter struct {
+	buffer []byte
+	err    error
+
+	// comma is set if the next key (or array element) must be
+	// preceded by a comma.
+	comma bool
+
+	// inline is set if the next object's properties are written into
+	// the current object, and inlined records which of the open objects
+	// were inlined.
+	inline  bool
+	inlined []bool
+}
+
+// NewJSONWriter returns a new, empty *JSONWriter.
+func NewJSONWriter() *JSONWriter {
+	return &JSONWriter{
+		buffer: make([]byte, 0, 128),
+	}
+}
+
+// Bytes returns the JSON written so far, or the first error, if any.
+func (w *JSONWriter) Bytes() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buffer, nil
+}
+
+// Err returns the first error, if any.
+func (w *JSONWriter) Err() error {
+	return w.err
+}
+
+// Fail records the given error, unless it's nil or an error was
+// already recorded.
+func (w *JSONWriter) Fail(err error) {
+	if w.err == nil && err != nil {
+		w.err = err
+	}
+}
+
+// BeginObject begins a JSON object.
+func (w *JSONWriter) BeginObject() {
+	if w.inline {
+		w.inline = false
+		w.inlined = append(w.inlined, true)
+		return
+	}
+	w.separate()
+	w.buffer = append(w.buffer, '{')
+	w.comma = false
+	w.inlined = append(w.inlined, false)
+}
+
+// EndObject ends the current JSON object.
+func (w *JSONWriter) EndObject() {
+	last := len(w.inlined) - 1
+	if last < 0 {
+		w.Fail(fmt.Errorf("json: EndObject called without a matching BeginObject"))
+		return
+	}
+	inlined := w.inlined[last]
+	w.inlined = w.inlined[:last]
+	if inlined {
+		return
+	}
+	w.buffer = append(w.buffer, '}')
+	w.comma = true
+}
+
+// Inline writes the properties of the next object into the current
+// object, rather than as a separate object. Nothing is written if the
+// next value is null.
+func (w *JSONWriter) Inline() {
+	w.inline = true
+}
+
+// BeginArray begins a JSON array.
+func (w *JSONWriter) BeginArray() {
+	w.separate()
+	w.buffer = append(w.buffer, '[')
+	w.comma = false
+}
+
+// EndArray ends the current JSON array.
+func (w *JSONWriter) EndArray() {
+	w.buffer = append(w.buffer, ']')
+	w.comma = true
+}
+
+// Key writes the key of the next property in the current object.
+func (w *JSONWriter) Key(key string) {
+	w.separate()
+	w.buffer = appendString(w.buffer, key)
+	w.buffer = append(w.buffer, ':')
+	w.comma = false
+}
+
+// Null writes a JSON null.
+func (w *JSONWriter) Null() {
+	if w.inline {
+		// A null object doesn't have any properties to inline.
+		w.inline = false
+		return
+	}
+	w.separate()
+	w.buffer = append(w.buffer, "null"...)
+	w.comma = true
+}
+
+// String writes a JSON string.
+func (w *JSONWriter) String(value string) {
+	w.separate()
+	w.buffer = appendString(w.buffer, value)
+	w.comma = true
+}
+
+// Bool writes a JSON boolean.
+func (w *JSONWriter) Bool(value bool) {
+	w.separate()
+	w.buffer = strconv.AppendBool(w.buffer, value)
+	w.comma = true
+}
+
+// Int writes a JSON number.
+func (w *JSONWriter) Int(value int) {
+	w.Int64(int64(value))
+}
+
+// Int64 writes a JSON number.
+func (w *JSONWriter) Int64(value int64) {
+	w.separate()
+	w.buffer = strconv.AppendInt(w.buffer, value, 10)
+	w.comma = true
+}
+
+// Float64 writes a JSON number. NaN and infinite values aren't
+// supported, as they can't be represented in JSON.
+func (w *JSONWriter) Float64(value float64) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		w.Fail(fmt.Errorf("json: unsupported value: %s", strconv.FormatFloat(value, 'g', -1, 64)))
+		return
+	}
+	w.separate()
+	w.buffer = appendFloat(w.buffer, value)
+	w.comma = true
+}
+
+// Date writes the time as an RFC 3339 full-date (e.g. "2006-01-02").
+func (w *JSONWriter) Date(value time.Time) {
+	w.separate()
+	w.buffer = append(w.buffer, '"')
+	w.buffer = value.AppendFormat(w.buffer, dateFormat)
+	w.buffer = append(w.buffer, '"')
+	w.comma = true
+}
+
+// DateTime writes the time as an RFC 3339 date-time (e.g. "2006-01-02T15:04:05Z").
+func (w *JSONWriter) DateTime(value time.Time) {
+	w.separate()
+	w.buffer = append(w.buffer, '"')
+	w.buffer = value.AppendFormat(w.buffer, time.RFC3339Nano)
+	w.buffer = append(w.buffer, '"')
+	w.comma = true
+}
+
+// Base64 writes the bytes as a base64-encoded JSON string,
+// or null if they're nil.
+func (w *JSONWriter) Base64(value []byte) {
+	if value == nil {
+		w.Null()
+		return
+	}
+	w.separate()
+	w.buffer = append(w.buffer, '"')
+	start := len(w.buffer)
+	w.buffer = append(w.buffer, make([]byte, base64.StdEncoding.EncodedLen(len(value)))...)
+	base64.StdEncoding.Encode(w.buffer[start:], value)
+	w.buffer = append(w.buffer, '"')
+	w.comma = true
+}
+
+// Raw writes the given JSON value as-is.
+func (w *JSONWriter) Raw(value json.RawMessage) {
+	if len(value) == 0 {
+		w.Null()
+		return
+	}
+	w.separate()
+	w.buffer = append(w.buffer, value...)
+	w.comma = true
+}
+
+// Value writes the given value with encoding/json, which is used for values
+// that don't have a reflection-free representation (e.g. unknown values).
+func (w *JSONWriter) Value(value interface{}) {
+	if w.err != nil {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		w.Fail(err)
+		return
+	}
+	w.Raw(data)
+}
+
+// ExtraProperties writes each of the extra properties into the current object,
+// sorted by key. An error is recorded if any of the extra properties has the
+// same key as one of the object's known properties.
+func (w *JSONWriter) ExtraProperties(extraProperties map[string]interface{}, knownProperties ...string) {
+	if len(extraProperties) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(extraProperties))
+	for key := range extraProperties {
+		for _, knownProperty := range knownProperties {
+			if key == knownProperty {
+				w.Fail(fmt.Errorf("cannot add extra property %q: it's already defined", key))
+				return
+			}
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		w.Key(key)
+		w.Value(extraProperties[key])
+	}
+}
+
+// separate writes a comma if the next value follows another value.
+func (w *JSONWriter) separate() {
+	if w.comma {
+		w.buffer = append(w.buffer, ',')
+	}
+}
+
+// appendFloat appends the float in the same format as encoding/json, i.e.
+// exponents are only used for very small or very large values.
+func appendFloat(dst []byte, value float64) []byte {
+	format := byte('f')
+	if abs := math.Abs(value); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	dst = strconv.AppendFloat(dst, value, format, -1, 64)
+	if format == 'e' {
+		// Clean up e-09 to e-9.
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendString appends the string as a quoted JSON string, which is escaped
+// in the same way as encoding/json (i.e. including HTML characters, and with
+// invalid UTF-8 replaced by U+FFFD).
+func appendString(dst []byte, value string) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(value); {
+		if b := value[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, value[start:i]...)
+			switch b {
+			case '"', '\\':
+				dst = append(dst, '\\', b)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hexDigits[b>>4], hexDigits[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(value[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, value[start:i]...)
+			dst = append(dst, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			// These are valid JSON, but not valid JavaScript.
+			dst = append(dst, value[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, value[start:]...)
+	return append(dst, '"')
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONWriter(t *testing.T) {
+	writer := NewJSONWriter()
+	writer.BeginObject()
+	writer.Key("name")
+	writer.String("fern")
+	writer.Key("count")
+	writer.Int(3)
+	writer.Key("tags")
+	writer.BeginArray()
+	writer.String("a")
+	writer.BeginObject()
+	writer.EndObject()
+	writer.Null()
+	writer.EndArray()
+	writer.Key("inlined")
+	writer.Bool(true)
+	writer.Inline()
+	writer.BeginObject()
+	writer.Key("id")
+	writer.Int64(-42)
+	writer.EndObject()
+	writer.Inline()
+	writer.Null()
+	writer.Key("raw")
+	writer.Raw(json.RawMessage(`{"a":[1,2]}`))
+	writer.ExtraProperties(map[string]interface{}{"b": 2, "a": "1"}, "name")
+	writer.EndObject()
+
+	data, err := writer.Bytes()
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"name":"fern","count":3,"tags":["a",{},null],"inlined":true,"id":-42,"raw":{"a":[1,2]},"a":"1","b":2}`,
+		string(data),
+	)
+}
+
+func TestJSONWriterString(t *testing.T) {
+	values := []string{
+		"",
+		"fern",
+		`"quoted" and \escaped\`,
+		"<html> & 'quotes'",
+		"control \b\f\n\r\t\x00\x1f",
+		"unicode: é 🌿    ",
+		"invalid: \xff\xfe",
+	}
+	for _, value := range values {
+		expected, err := json.Marshal(value)
+		require.NoError(t, err)
+		writer := NewJSONWriter()
+		writer.String(value)
+		data, err := writer.Bytes()
+		require.NoError(t, err)
+		// Control characters are escaped differently by older versions
+		// of encoding/json, so only compare the values they represent.
+		var decoded string
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		var expectedDecoded string
+		require.NoError(t, json.Unmarshal(expected, &expectedDecoded))
+		assert.Equal(t, expectedDecoded, decoded)
+		if value != "control \b\f\n\r\t\x00\x1f" {
+			assert.Equal(t, string(expected), string(data))
+		}
+	}
+}
+
+func TestJSONWriterFloat64(t *testing.T) {
+	values := []float64{0, 1, -1.5, 3.14159, 1e-7, 1e20, 1e21, 123456789.123, math.MaxFloat64, math.SmallestNonzeroFloat64}
+	for _, value := range values {
+		expected, err := json.Marshal(value)
+		require.NoError(t, err)
+		writer := NewJSONWriter()
+		writer.Float64(value)
+		data, err := writer.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(data))
+	}
+
+	writer := NewJSONWriter()
+	writer.Float64(math.NaN())
+	_, err := writer.Bytes()
+	assert.EqualError(t, err, "json: unsupported value: NaN")
+}
+
+func TestJSONWriterValues(t *testing.T) {
+	dateTime := time.Date(2024, time.January, 2, 3, 4, 5, 6000, time.UTC)
+
+	writer := NewJSONWriter()
+	writer.BeginArray()
+	writer.Date(dateTime)
+	writer.DateTime(dateTime)
+	writer.Base64([]byte("fern"))
+	writer.Base64(nil)
+	writer.Value(map[string]interface{}{"key": []int{1}})
+	writer.EndArray()
+
+	data, err := writer.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, `["2024-01-02","2024-01-02T03:04:05.000006Z","ZmVybg==",null,{"key":[1]}]`, string(data))
+}
+
+func TestJSONWriterExtraProperties(t *testing.T) {
+	writer := NewJSONWriter()
+	writer.BeginObject()
+	writer.ExtraProperties(map[string]interface{}{"name": "fern"}, "name")
+	writer.EndObject()
+	_, err := writer.Bytes()
+	assert.EqualError(t, err, `cannot add extra property "name": it's already defined`)
+}
@@ -0,0 +1,1120 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-undiscriminated/fixtures/core"
+	sort "sort"
+)
+
+type AnotherUnion struct {
+	typeName          string
+	String            string
+	fernStringLiteral string
+	Foo               *Foo
+}
+
+func NewAnotherUnionFromString(value string) *AnotherUnion {
+	return &AnotherUnion{typeName: "string", String: value}
+}
+
+func NewAnotherUnionWithFernStringLiteral() *AnotherUnion {
+	return &AnotherUnion{typeName: "fernStringLiteral", fernStringLiteral: "fern"}
+}
+
+func NewAnotherUnionFromFoo(value *Foo) *AnotherUnion {
+	return &AnotherUnion{typeName: "foo", Foo: value}
+}
+
+func (a *AnotherUnion) FernStringLiteral() string {
+	return a.fernStringLiteral
+}
+
+// Equal reports whether the AnotherUnion is equal to the other AnotherUnion.
+func (a *AnotherUnion) Equal(other *AnotherUnion) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.typeName != other.typeName {
+		return false
+	}
+	if a.String != other.String {
+		return false
+	}
+	if !a.Foo.Equal(other.Foo) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AnotherUnion.
+func (a *AnotherUnion) DeepCopy() *AnotherUnion {
+	if a == nil {
+		return nil
+	}
+	copied := *a
+	copied.Foo = a.Foo.DeepCopy()
+	return &copied
+}
+
+func (a *AnotherUnion) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	var valueString string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func(reader *core.JSONReader) {
+			valueString = reader.ReadString()
+		},
+		func() {
+			a.typeName = "string"
+			a.String = valueString
+		},
+	) {
+		return nil
+	}
+	var valueFernStringLiteral string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func(reader *core.JSONReader) {
+			valueFernStringLiteral = reader.ReadString()
+		},
+		func() {
+			a.typeName = "fernStringLiteral"
+			a.fernStringLiteral = valueFernStringLiteral
+		},
+	) {
+		return nil
+	}
+	valueFoo := new(Foo)
+	if decoder.DecodeWith(
+		&core.UnionMember{
+			Name: "Foo",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueFoo,
+		func(reader *core.JSONReader) {
+			valueFoo.ReadJSON(reader)
+		},
+		func() {
+			a.typeName = "foo"
+			a.Foo = valueFoo
+		},
+	) {
+		return nil
+	}
+	return decoder.Fallback(a)
+}
+
+func (a AnotherUnion) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	a.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the AnotherUnion from the given core.JSONReader.
+func (a *AnotherUnion) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	data := reader.ReadRaw()
+	if reader.Err() != nil {
+		return
+	}
+	reader.Fail(a.UnmarshalJSON(data))
+}
+
+// WriteJSON writes the AnotherUnion to the given core.JSONWriter.
+func (a *AnotherUnion) WriteJSON(writer *core.JSONWriter) {
+	if a == nil {
+		writer.Null()
+		return
+	}
+	switch a.typeName {
+	default:
+		writer.Fail(fmt.Errorf("invalid type %s in %T", a.typeName, *a))
+	case "string":
+		writer.String(a.String)
+	case "fernStringLiteral":
+		writer.String("fern")
+	case "foo":
+		a.Foo.WriteJSON(writer)
+	}
+}
+
+type AnotherUnionVisitor interface {
+	VisitString(string) error
+	VisitFernStringLiteral(string) error
+	VisitFoo(*Foo) error
+}
+
+func (a *AnotherUnion) Accept(visitor AnotherUnionVisitor) error {
+	switch a.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return visitor.VisitString(a.String)
+	case "fernStringLiteral":
+		return visitor.VisitFernStringLiteral(a.fernStringLiteral)
+	case "foo":
+		return visitor.VisitFoo(a.Foo)
+	}
+}
+
+// Validate reports all of the AnotherUnion's invalid fields (e.g. missing
+// required fields), if any.
+func (a *AnotherUnion) Validate() error {
+	if a == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch a.typeName {
+	case "foo":
+		validation.Add("", a.Foo.Validate())
+	}
+	return validation.Err()
+}
+
+type Bar struct {
+	Name string `json:"name" url:"name"`
+}
+
+// Equal reports whether the Bar is equal to the other Bar.
+func (b *Bar) Equal(other *Bar) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Bar.
+func (b *Bar) DeepCopy() *Bar {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
+}
+
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *b
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*b = value
+	return nil
+}
+
+func (b *Bar) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Bar from the given core.JSONReader.
+func (b *Bar) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*b = Bar{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("name") {
+		case "name":
+			b.Name = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+}
+
+// WriteJSON writes the Bar to the given core.JSONWriter.
+func (b *Bar) WriteJSON(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	writer.Key("name")
+	writer.String(b.Name)
+	writer.EndObject()
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	return nil
+}
+
+type Baz struct {
+	Id string `json:"id" url:"id"`
+}
+
+// Equal reports whether the Baz is equal to the other Baz.
+func (b *Baz) Equal(other *Baz) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Baz.
+func (b *Baz) DeepCopy() *Baz {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
+}
+
+func (b *Baz) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *b
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*b = value
+	return nil
+}
+
+func (b *Baz) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Baz from the given core.JSONReader.
+func (b *Baz) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*b = Baz{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("id") {
+		case "id":
+			b.Id = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+}
+
+// WriteJSON writes the Baz to the given core.JSONWriter.
+func (b *Baz) WriteJSON(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	writer.Key("id")
+	writer.String(b.Id)
+	writer.EndObject()
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Validate reports all of the Baz's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Baz) Validate() error {
+	return nil
+}
+
+type Foo struct {
+	Name string `json:"name" url:"name"`
+}
+
+// Equal reports whether the Foo is equal to the other Foo.
+func (f *Foo) Equal(other *Foo) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if f.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Foo.
+func (f *Foo) DeepCopy() *Foo {
+	if f == nil {
+		return nil
+	}
+	copied := *f
+	return &copied
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	value := *f
+	value.ReadJSON(reader)
+	if err := reader.End(); err != nil {
+		return err
+	}
+	*f = value
+	return nil
+}
+
+func (f *Foo) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	f.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Foo from the given core.JSONReader.
+func (f *Foo) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	*f = Foo{}
+	reader.BeginObject()
+	for reader.NextKey() {
+		switch reader.MatchKey("name") {
+		case "name":
+			f.Name = reader.ReadString()
+		default:
+			reader.Skip()
+		}
+	}
+}
+
+// WriteJSON writes the Foo to the given core.JSONWriter.
+func (f *Foo) WriteJSON(writer *core.JSONWriter) {
+	if f == nil {
+		writer.Null()
+		return
+	}
+	writer.BeginObject()
+	writer.Key("name")
+	writer.String(f.Name)
+	writer.EndObject()
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
+
+type Union struct {
+	typeName             string
+	Foo                  *Foo
+	Bar                  *Bar
+	Baz                  *Baz
+	String               string
+	IntegerOptional      *int
+	StringBooleanMap     map[string]bool
+	StringList           []string
+	StringListList       [][]string
+	DoubleSet            []float64
+	fernStringLiteral    string
+	anotherStringLiteral string
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{typeName: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{typeName: "bar", Bar: value}
+}
+
+func NewUnionFromBaz(value *Baz) *Union {
+	return &Union{typeName: "baz", Baz: value}
+}
+
+func NewUnionFromString(value string) *Union {
+	return &Union{typeName: "string", String: value}
+}
+
+func NewUnionFromIntegerOptional(value *int) *Union {
+	return &Union{typeName: "integerOptional", IntegerOptional: value}
+}
+
+func NewUnionFromStringBooleanMap(value map[string]bool) *Union {
+	return &Union{typeName: "stringBooleanMap", StringBooleanMap: value}
+}
+
+func NewUnionFromStringList(value []string) *Union {
+	return &Union{typeName: "stringList", StringList: value}
+}
+
+func NewUnionFromStringListList(value [][]string) *Union {
+	return &Union{typeName: "stringListList", StringListList: value}
+}
+
+func NewUnionFromDoubleSet(value []float64) *Union {
+	return &Union{typeName: "doubleSet", DoubleSet: value}
+}
+
+func NewUnionWithFernStringLiteral() *Union {
+	return &Union{typeName: "fernStringLiteral", fernStringLiteral: "fern"}
+}
+
+func NewUnionWithAnotherStringLiteral() *Union {
+	return &Union{typeName: "anotherStringLiteral", anotherStringLiteral: "another"}
+}
+
+func (u *Union) FernStringLiteral() string {
+	return u.fernStringLiteral
+}
+
+func (u *Union) AnotherStringLiteral() string {
+	return u.anotherStringLiteral
+}
+
+// Equal reports whether the Union is equal to the other Union.
+func (u *Union) Equal(other *Union) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.typeName != other.typeName {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !u.Baz.Equal(other.Baz) {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	if (u.IntegerOptional == nil) != (other.IntegerOptional == nil) {
+		return false
+	}
+	if u.IntegerOptional != nil {
+		if *u.IntegerOptional != *other.IntegerOptional {
+			return false
+		}
+	}
+	if len(u.StringBooleanMap) != len(other.StringBooleanMap) {
+		return false
+	}
+	for key, value := range u.StringBooleanMap {
+		otherValue, ok := other.StringBooleanMap[key]
+		if !ok {
+			return false
+		}
+		if value != otherValue {
+			return false
+		}
+	}
+	if len(u.StringList) != len(other.StringList) {
+		return false
+	}
+	for index, value := range u.StringList {
+		if value != other.StringList[index] {
+			return false
+		}
+	}
+	if len(u.StringListList) != len(other.StringListList) {
+		return false
+	}
+	for index, value := range u.StringListList {
+		if len(value) != len(other.StringListList[index]) {
+			return false
+		}
+		for index1, value1 := range value {
+			if value1 != other.StringListList[index][index1] {
+				return false
+			}
+		}
+	}
+	if len(u.DoubleSet) != len(other.DoubleSet) {
+		return false
+	}
+	for index, value := range u.DoubleSet {
+		if value != other.DoubleSet[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Union.
+func (u *Union) DeepCopy() *Union {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	copied.Baz = u.Baz.DeepCopy()
+	if u.IntegerOptional != nil {
+		copiedValue := *u.IntegerOptional
+		copied.IntegerOptional = &copiedValue
+	}
+	if u.StringBooleanMap != nil {
+		copied.StringBooleanMap = make(map[string]bool, len(u.StringBooleanMap))
+		for key, value := range u.StringBooleanMap {
+			copied.StringBooleanMap[key] = value
+		}
+	}
+	if u.StringList != nil {
+		copied.StringList = make([]string, len(u.StringList))
+		copy(copied.StringList, u.StringList)
+	}
+	if u.StringListList != nil {
+		copied.StringListList = make([][]string, len(u.StringListList))
+		copy(copied.StringListList, u.StringListList)
+		for index, value := range u.StringListList {
+			if value != nil {
+				copied.StringListList[index] = make([]string, len(value))
+				copy(copied.StringListList[index], value)
+			}
+		}
+	}
+	if u.DoubleSet != nil {
+		copied.DoubleSet = make([]float64, len(u.DoubleSet))
+		copy(copied.DoubleSet, u.DoubleSet)
+	}
+	return &copied
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	valueFoo := new(Foo)
+	if decoder.DecodeWith(
+		&core.UnionMember{
+			Name: "Foo",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueFoo,
+		func(reader *core.JSONReader) {
+			valueFoo.ReadJSON(reader)
+		},
+		func() {
+			u.typeName = "foo"
+			u.Foo = valueFoo
+		},
+	) {
+		return nil
+	}
+	valueBar := new(Bar)
+	if decoder.DecodeWith(
+		&core.UnionMember{
+			Name: "Bar",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueBar,
+		func(reader *core.JSONReader) {
+			valueBar.ReadJSON(reader)
+		},
+		func() {
+			u.typeName = "bar"
+			u.Bar = valueBar
+		},
+	) {
+		return nil
+	}
+	valueBaz := new(Baz)
+	if decoder.DecodeWith(
+		&core.UnionMember{
+			Name: "Baz",
+			Object: &core.UnionObject{
+				Required: []string{"id"},
+			},
+		},
+		valueBaz,
+		func(reader *core.JSONReader) {
+			valueBaz.ReadJSON(reader)
+		},
+		func() {
+			u.typeName = "baz"
+			u.Baz = valueBaz
+		},
+	) {
+		return nil
+	}
+	var valueString string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func(reader *core.JSONReader) {
+			valueString = reader.ReadString()
+		},
+		func() {
+			u.typeName = "string"
+			u.String = valueString
+		},
+	) {
+		return nil
+	}
+	var valueIntegerOptional *int
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "IntegerOptional"},
+		&valueIntegerOptional,
+		func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				var value int
+				value = reader.ReadInt()
+				valueIntegerOptional = &value
+			}
+		},
+		func() {
+			u.typeName = "integerOptional"
+			u.IntegerOptional = valueIntegerOptional
+		},
+	) {
+		return nil
+	}
+	var valueStringBooleanMap map[string]bool
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "StringBooleanMap"},
+		&valueStringBooleanMap,
+		func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				valueStringBooleanMap = make(map[string]bool)
+				reader.BeginObject()
+				for reader.NextKey() {
+					key := reader.Key()
+					var value bool
+					value = reader.ReadBool()
+					valueStringBooleanMap[key] = value
+				}
+			}
+		},
+		func() {
+			u.typeName = "stringBooleanMap"
+			u.StringBooleanMap = valueStringBooleanMap
+		},
+	) {
+		return nil
+	}
+	var valueStringList []string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "StringList"},
+		&valueStringList,
+		func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				valueStringList = make([]string, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value string
+					value = reader.ReadString()
+					valueStringList = append(valueStringList, value)
+				}
+			}
+		},
+		func() {
+			u.typeName = "stringList"
+			u.StringList = valueStringList
+		},
+	) {
+		return nil
+	}
+	var valueStringListList [][]string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "StringListList"},
+		&valueStringListList,
+		func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				valueStringListList = make([][]string, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value []string
+					if !reader.ReadNull() {
+						value = make([]string, 0)
+						reader.BeginArray()
+						for reader.NextElement() {
+							var value1 string
+							value1 = reader.ReadString()
+							value = append(value, value1)
+						}
+					}
+					valueStringListList = append(valueStringListList, value)
+				}
+			}
+		},
+		func() {
+			u.typeName = "stringListList"
+			u.StringListList = valueStringListList
+		},
+	) {
+		return nil
+	}
+	var valueDoubleSet []float64
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "DoubleSet"},
+		&valueDoubleSet,
+		func(reader *core.JSONReader) {
+			if !reader.ReadNull() {
+				valueDoubleSet = make([]float64, 0)
+				reader.BeginArray()
+				for reader.NextElement() {
+					var value float64
+					value = reader.ReadFloat64()
+					valueDoubleSet = append(valueDoubleSet, value)
+				}
+			}
+		},
+		func() {
+			u.typeName = "doubleSet"
+			u.DoubleSet = valueDoubleSet
+		},
+	) {
+		return nil
+	}
+	var valueFernStringLiteral string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func(reader *core.JSONReader) {
+			valueFernStringLiteral = reader.ReadString()
+		},
+		func() {
+			u.typeName = "fernStringLiteral"
+			u.fernStringLiteral = valueFernStringLiteral
+		},
+	) {
+		return nil
+	}
+	var valueAnotherStringLiteral string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "anotherStringLiteral", Literal: "another"},
+		&valueAnotherStringLiteral,
+		func(reader *core.JSONReader) {
+			valueAnotherStringLiteral = reader.ReadString()
+		},
+		func() {
+			u.typeName = "anotherStringLiteral"
+			u.anotherStringLiteral = valueAnotherStringLiteral
+		},
+	) {
+		return nil
+	}
+	return decoder.Fallback(u)
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the Union from the given core.JSONReader.
+func (u *Union) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	data := reader.ReadRaw()
+	if reader.Err() != nil {
+		return
+	}
+	reader.Fail(u.UnmarshalJSON(data))
+}
+
+// WriteJSON writes the Union to the given core.JSONWriter.
+func (u *Union) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.typeName {
+	default:
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.typeName, *u))
+	case "foo":
+		u.Foo.WriteJSON(writer)
+	case "bar":
+		u.Bar.WriteJSON(writer)
+	case "baz":
+		u.Baz.WriteJSON(writer)
+	case "string":
+		writer.String(u.String)
+	case "integerOptional":
+		if u.IntegerOptional == nil {
+			writer.Null()
+		} else {
+			writer.Int(*u.IntegerOptional)
+		}
+	case "stringBooleanMap":
+		if u.StringBooleanMap == nil {
+			writer.Null()
+		} else {
+			keys := make([]string, 0, len(u.StringBooleanMap))
+			for key := range u.StringBooleanMap {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			writer.BeginObject()
+			for _, key := range keys {
+				writer.Key(key)
+				writer.Bool(u.StringBooleanMap[key])
+			}
+			writer.EndObject()
+		}
+	case "stringList":
+		if u.StringList == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range u.StringList {
+				writer.String(value)
+			}
+			writer.EndArray()
+		}
+	case "stringListList":
+		if u.StringListList == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range u.StringListList {
+				if value == nil {
+					writer.Null()
+				} else {
+					writer.BeginArray()
+					for _, value1 := range value {
+						writer.String(value1)
+					}
+					writer.EndArray()
+				}
+			}
+			writer.EndArray()
+		}
+	case "doubleSet":
+		if u.DoubleSet == nil {
+			writer.Null()
+		} else {
+			writer.BeginArray()
+			for _, value := range u.DoubleSet {
+				writer.Float64(value)
+			}
+			writer.EndArray()
+		}
+	case "fernStringLiteral":
+		writer.String("fern")
+	case "anotherStringLiteral":
+		writer.String("another")
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitBaz(*Baz) error
+	VisitString(string) error
+	VisitIntegerOptional(*int) error
+	VisitStringBooleanMap(map[string]bool) error
+	VisitStringList([]string) error
+	VisitStringListList([][]string) error
+	VisitDoubleSet([]float64) error
+	VisitFernStringLiteral(string) error
+	VisitAnotherStringLiteral(string) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	case "baz":
+		return visitor.VisitBaz(u.Baz)
+	case "string":
+		return visitor.VisitString(u.String)
+	case "integerOptional":
+		return visitor.VisitIntegerOptional(u.IntegerOptional)
+	case "stringBooleanMap":
+		return visitor.VisitStringBooleanMap(u.StringBooleanMap)
+	case "stringList":
+		return visitor.VisitStringList(u.StringList)
+	case "stringListList":
+		return visitor.VisitStringListList(u.StringListList)
+	case "doubleSet":
+		return visitor.VisitDoubleSet(u.DoubleSet)
+	case "fernStringLiteral":
+		return visitor.VisitFernStringLiteral(u.fernStringLiteral)
+	case "anotherStringLiteral":
+		return visitor.VisitAnotherStringLiteral(u.anotherStringLiteral)
+	}
+}
+
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.typeName {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	case "baz":
+		validation.Add("", u.Baz.Validate())
+	}
+	return validation.Err()
+}
+
+type UnionWithLiteral struct {
+	typeName          string
+	fernStringLiteral string
+	String            string
+}
+
+func NewUnionWithLiteralWithFernStringLiteral() *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "fernStringLiteral", fernStringLiteral: "fern"}
+}
+
+func NewUnionWithLiteralFromString(value string) *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "string", String: value}
+}
+
+func (u *UnionWithLiteral) FernStringLiteral() string {
+	return u.fernStringLiteral
+}
+
+// Equal reports whether the UnionWithLiteral is equal to the other UnionWithLiteral.
+func (u *UnionWithLiteral) Equal(other *UnionWithLiteral) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.typeName != other.typeName {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithLiteral.
+func (u *UnionWithLiteral) DeepCopy() *UnionWithLiteral {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	return &copied
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	var valueFernStringLiteral string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func(reader *core.JSONReader) {
+			valueFernStringLiteral = reader.ReadString()
+		},
+		func() {
+			u.typeName = "fernStringLiteral"
+			u.fernStringLiteral = valueFernStringLiteral
+		},
+	) {
+		return nil
+	}
+	var valueString string
+	if decoder.DecodeWith(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func(reader *core.JSONReader) {
+			valueString = reader.ReadString()
+		},
+		func() {
+			u.typeName = "string"
+			u.String = valueString
+		},
+	) {
+		return nil
+	}
+	return decoder.Fallback(u)
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.WriteJSON(writer)
+	return writer.Bytes()
+}
+
+// ReadJSON reads the UnionWithLiteral from the given core.JSONReader.
+func (u *UnionWithLiteral) ReadJSON(reader *core.JSONReader) {
+	if reader.ReadNull() {
+		return
+	}
+	data := reader.ReadRaw()
+	if reader.Err() != nil {
+		return
+	}
+	reader.Fail(u.UnmarshalJSON(data))
+}
+
+// WriteJSON writes the UnionWithLiteral to the given core.JSONWriter.
+func (u *UnionWithLiteral) WriteJSON(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	switch u.typeName {
+	default:
+		writer.Fail(fmt.Errorf("invalid type %s in %T", u.typeName, *u))
+	case "fernStringLiteral":
+		writer.String("fern")
+	case "string":
+		writer.String(u.String)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitFernStringLiteral(string) error
+	VisitString(string) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "fernStringLiteral":
+		return visitor.VisitFernStringLiteral(u.fernStringLiteral)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
+
+// Validate reports all of the UnionWithLiteral's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithLiteral) Validate() error {
+	return nil
+}
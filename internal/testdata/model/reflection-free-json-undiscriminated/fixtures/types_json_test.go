@@ -0,0 +1,797 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/reflection-free-json-undiscriminated/fixtures/core"
+	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+	strings "strings"
+	testing "testing"
+)
+
+func (a *AnotherUnion) reflectUnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	var valueString string
+	if decoder.Decode(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func() {
+			a.typeName = "string"
+			a.String = valueString
+		},
+	) {
+		return nil
+	}
+	var valueFernStringLiteral string
+	if decoder.Decode(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func() {
+			a.typeName = "fernStringLiteral"
+			a.fernStringLiteral = valueFernStringLiteral
+		},
+	) {
+		return nil
+	}
+	valueFoo := new(Foo)
+	if decoder.Decode(
+		&core.UnionMember{
+			Name: "Foo",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueFoo,
+		func() {
+			a.typeName = "foo"
+			a.Foo = valueFoo
+		},
+	) {
+		return nil
+	}
+	return decoder.Fallback(a)
+}
+
+func (a AnotherUnion) reflectMarshalJSON() ([]byte, error) {
+	switch a.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return json.Marshal(a.String)
+	case "fernStringLiteral":
+		return json.Marshal("fern")
+	case "foo":
+		return json.Marshal(a.Foo)
+	}
+}
+
+// testAnotherUnionValues returns the sample AnotherUnion values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testAnotherUnionValues(depth int) []*AnotherUnion {
+	if depth > 2 {
+		return []*AnotherUnion{nil}
+	}
+	return []*AnotherUnion{
+		{typeName: "string", String: "fern <&> \"json\" é 🌿"},
+		{typeName: "fernStringLiteral", fernStringLiteral: "fern"},
+		{typeName: "foo", Foo: testFooValues(depth + 1)[0]},
+	}
+}
+
+func TestAnotherUnionJSON(t *testing.T) {
+	for _, value := range testAnotherUnionValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(AnotherUnion)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(AnotherUnion)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(AnotherUnion)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(AnotherUnion).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(AnotherUnion).Equal(invalidDecoded), string(invalid))
+	}
+}
+
+func BenchmarkAnotherUnionJSON(b *testing.B) {
+	value := testAnotherUnionValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(AnotherUnion).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(AnotherUnion).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (b *Bar) reflectUnmarshalJSON(data []byte) error {
+	type unmarshaler Bar
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Bar(value)
+	return nil
+}
+
+func (b *Bar) reflectMarshalJSON() ([]byte, error) {
+	type embed Bar
+	var marshaler = struct {
+		embed
+	}{
+		embed: embed(*b),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testBarValues returns the sample Bar values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testBarValues(depth int) []*Bar {
+	if depth > 2 {
+		return []*Bar{{}}
+	}
+	return []*Bar{
+		{
+			Name: "fern <&> \"json\" é 🌿",
+		},
+		{},
+	}
+}
+
+func TestBarJSON(t *testing.T) {
+	for _, value := range testBarValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Bar)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Bar)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Bar)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Bar).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Bar).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Bar)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Bar)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkBarJSON(b *testing.B) {
+	value := testBarValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Bar).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Bar).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (b *Baz) reflectUnmarshalJSON(data []byte) error {
+	type unmarshaler Baz
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Baz(value)
+	return nil
+}
+
+func (b *Baz) reflectMarshalJSON() ([]byte, error) {
+	type embed Baz
+	var marshaler = struct {
+		embed
+	}{
+		embed: embed(*b),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testBazValues returns the sample Baz values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testBazValues(depth int) []*Baz {
+	if depth > 2 {
+		return []*Baz{{}}
+	}
+	return []*Baz{
+		{
+			Id: "fern <&> \"json\" é 🌿",
+		},
+		{},
+	}
+}
+
+func TestBazJSON(t *testing.T) {
+	for _, value := range testBazValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Baz)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Baz)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Baz)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Baz).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Baz).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Baz)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Baz)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkBazJSON(b *testing.B) {
+	value := testBazValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Baz).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Baz).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (f *Foo) reflectUnmarshalJSON(data []byte) error {
+	type unmarshaler Foo
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Foo(value)
+	return nil
+}
+
+func (f *Foo) reflectMarshalJSON() ([]byte, error) {
+	type embed Foo
+	var marshaler = struct {
+		embed
+	}{
+		embed: embed(*f),
+	}
+	return json.Marshal(marshaler)
+}
+
+// testFooValues returns the sample Foo values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testFooValues(depth int) []*Foo {
+	if depth > 2 {
+		return []*Foo{{}}
+	}
+	return []*Foo{
+		{
+			Name: "fern <&> \"json\" é 🌿",
+		},
+		{},
+	}
+}
+
+func TestFooJSON(t *testing.T) {
+	for _, value := range testFooValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Foo)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Foo)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Foo)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Foo).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Foo).Equal(invalidDecoded), string(invalid))
+
+		var properties map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &properties))
+		upperCase := make(map[string]json.RawMessage, len(properties))
+		for key, property := range properties {
+			upperCase[strings.ToUpper(key)] = property
+		}
+		upperCaseData, err := json.Marshal(upperCase)
+		require.NoError(t, err)
+		decoded = new(Foo)
+		require.NoError(t, decoded.UnmarshalJSON(upperCaseData))
+		expectedDecoded = new(Foo)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(upperCaseData))
+		assert.True(t, expectedDecoded.Equal(decoded), string(upperCaseData))
+	}
+}
+
+func BenchmarkFooJSON(b *testing.B) {
+	value := testFooValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Foo).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Foo).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *Union) reflectUnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	valueFoo := new(Foo)
+	if decoder.Decode(
+		&core.UnionMember{
+			Name: "Foo",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueFoo,
+		func() {
+			u.typeName = "foo"
+			u.Foo = valueFoo
+		},
+	) {
+		return nil
+	}
+	valueBar := new(Bar)
+	if decoder.Decode(
+		&core.UnionMember{
+			Name: "Bar",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueBar,
+		func() {
+			u.typeName = "bar"
+			u.Bar = valueBar
+		},
+	) {
+		return nil
+	}
+	valueBaz := new(Baz)
+	if decoder.Decode(
+		&core.UnionMember{
+			Name: "Baz",
+			Object: &core.UnionObject{
+				Required: []string{"id"},
+			},
+		},
+		valueBaz,
+		func() {
+			u.typeName = "baz"
+			u.Baz = valueBaz
+		},
+	) {
+		return nil
+	}
+	var valueString string
+	if decoder.Decode(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func() {
+			u.typeName = "string"
+			u.String = valueString
+		},
+	) {
+		return nil
+	}
+	var valueIntegerOptional *int
+	if decoder.Decode(
+		&core.UnionMember{Name: "IntegerOptional"},
+		&valueIntegerOptional,
+		func() {
+			u.typeName = "integerOptional"
+			u.IntegerOptional = valueIntegerOptional
+		},
+	) {
+		return nil
+	}
+	var valueStringBooleanMap map[string]bool
+	if decoder.Decode(
+		&core.UnionMember{Name: "StringBooleanMap"},
+		&valueStringBooleanMap,
+		func() {
+			u.typeName = "stringBooleanMap"
+			u.StringBooleanMap = valueStringBooleanMap
+		},
+	) {
+		return nil
+	}
+	var valueStringList []string
+	if decoder.Decode(
+		&core.UnionMember{Name: "StringList"},
+		&valueStringList,
+		func() {
+			u.typeName = "stringList"
+			u.StringList = valueStringList
+		},
+	) {
+		return nil
+	}
+	var valueStringListList [][]string
+	if decoder.Decode(
+		&core.UnionMember{Name: "StringListList"},
+		&valueStringListList,
+		func() {
+			u.typeName = "stringListList"
+			u.StringListList = valueStringListList
+		},
+	) {
+		return nil
+	}
+	var valueDoubleSet []float64
+	if decoder.Decode(
+		&core.UnionMember{Name: "DoubleSet"},
+		&valueDoubleSet,
+		func() {
+			u.typeName = "doubleSet"
+			u.DoubleSet = valueDoubleSet
+		},
+	) {
+		return nil
+	}
+	var valueFernStringLiteral string
+	if decoder.Decode(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func() {
+			u.typeName = "fernStringLiteral"
+			u.fernStringLiteral = valueFernStringLiteral
+		},
+	) {
+		return nil
+	}
+	var valueAnotherStringLiteral string
+	if decoder.Decode(
+		&core.UnionMember{Name: "anotherStringLiteral", Literal: "another"},
+		&valueAnotherStringLiteral,
+		func() {
+			u.typeName = "anotherStringLiteral"
+			u.anotherStringLiteral = valueAnotherStringLiteral
+		},
+	) {
+		return nil
+	}
+	return decoder.Fallback(u)
+}
+
+func (u Union) reflectMarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return json.Marshal(u.Foo)
+	case "bar":
+		return json.Marshal(u.Bar)
+	case "baz":
+		return json.Marshal(u.Baz)
+	case "string":
+		return json.Marshal(u.String)
+	case "integerOptional":
+		return json.Marshal(u.IntegerOptional)
+	case "stringBooleanMap":
+		return json.Marshal(u.StringBooleanMap)
+	case "stringList":
+		return json.Marshal(u.StringList)
+	case "stringListList":
+		return json.Marshal(u.StringListList)
+	case "doubleSet":
+		return json.Marshal(u.DoubleSet)
+	case "fernStringLiteral":
+		return json.Marshal("fern")
+	case "anotherStringLiteral":
+		return json.Marshal("another")
+	}
+}
+
+// testUnionValues returns the sample Union values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionValues(depth int) []*Union {
+	if depth > 2 {
+		return []*Union{nil}
+	}
+	return []*Union{
+		{typeName: "foo", Foo: testFooValues(depth + 1)[0]},
+		{typeName: "bar", Bar: testBarValues(depth + 1)[0]},
+		{typeName: "baz", Baz: testBazValues(depth + 1)[0]},
+		{typeName: "string", String: "fern <&> \"json\" é 🌿"},
+		{typeName: "integerOptional", IntegerOptional: &[]int{1}[0]},
+		{typeName: "stringBooleanMap", StringBooleanMap: map[string]bool{"fern <&> \"json\" é 🌿": true}},
+		{typeName: "stringList", StringList: []string{"fern <&> \"json\" é 🌿"}},
+		{typeName: "stringListList", StringListList: [][]string{[]string{"fern <&> \"json\" é 🌿"}}},
+		{typeName: "doubleSet", DoubleSet: []float64{1.5}},
+		{typeName: "fernStringLiteral", fernStringLiteral: "fern"},
+		{typeName: "anotherStringLiteral", anotherStringLiteral: "another"},
+	}
+}
+
+func TestUnionJSON(t *testing.T) {
+	for _, value := range testUnionValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(Union)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(Union)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(Union)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(Union).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(Union).Equal(invalidDecoded), string(invalid))
+	}
+}
+
+func BenchmarkUnionJSON(b *testing.B) {
+	value := testUnionValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Union).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(Union).reflectUnmarshalJSON(data)
+		}
+	})
+}
+
+func (u *UnionWithLiteral) reflectUnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	var valueFernStringLiteral string
+	if decoder.Decode(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func() {
+			u.typeName = "fernStringLiteral"
+			u.fernStringLiteral = valueFernStringLiteral
+		},
+	) {
+		return nil
+	}
+	var valueString string
+	if decoder.Decode(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func() {
+			u.typeName = "string"
+			u.String = valueString
+		},
+	) {
+		return nil
+	}
+	return decoder.Fallback(u)
+}
+
+func (u UnionWithLiteral) reflectMarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "fernStringLiteral":
+		return json.Marshal("fern")
+	case "string":
+		return json.Marshal(u.String)
+	}
+}
+
+// testUnionWithLiteralValues returns the sample UnionWithLiteral values used by the JSON
+// tests, whose nested values are generated at the given depth.
+func testUnionWithLiteralValues(depth int) []*UnionWithLiteral {
+	if depth > 2 {
+		return []*UnionWithLiteral{nil}
+	}
+	return []*UnionWithLiteral{
+		{typeName: "fernStringLiteral", fernStringLiteral: "fern"},
+		{typeName: "string", String: "fern <&> \"json\" é 🌿"},
+	}
+}
+
+func TestUnionWithLiteralJSON(t *testing.T) {
+	for _, value := range testUnionWithLiteralValues(0) {
+		data, err := value.MarshalJSON()
+		require.NoError(t, err)
+		expected, err := value.reflectMarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(data))
+
+		decoded := new(UnionWithLiteral)
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		expectedDecoded := new(UnionWithLiteral)
+		require.NoError(t, expectedDecoded.reflectUnmarshalJSON(data))
+		assert.True(t, expectedDecoded.Equal(decoded), string(data))
+
+		invalid := append(data[:len(data):len(data)], ',')
+		if last := data[len(data)-1]; last == '}' || last == ']' {
+			invalid = append(data[:len(data)-1:len(data)-1], ',', last)
+		}
+		invalidDecoded := new(UnionWithLiteral)
+		assert.Error(t, invalidDecoded.UnmarshalJSON(invalid))
+		assert.Error(t, new(UnionWithLiteral).reflectUnmarshalJSON(invalid))
+		assert.True(t, new(UnionWithLiteral).Equal(invalidDecoded), string(invalid))
+	}
+}
+
+func BenchmarkUnionWithLiteralJSON(b *testing.B) {
+	value := testUnionWithLiteralValues(0)[0]
+	data, err := value.MarshalJSON()
+	require.NoError(b, err)
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.MarshalJSON()
+		}
+	})
+	b.Run("MarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = value.reflectMarshalJSON()
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithLiteral).UnmarshalJSON(data)
+		}
+	})
+	b.Run("UnmarshalReflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = new(UnionWithLiteral).reflectUnmarshalJSON(data)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ExtractExtraProperties returns all of the given JSON object's properties that
+// aren't one of the known properties, if any.
+//
+// Numbers are represented as a json.Number so that they're marshaled exactly
+// as they were received.
+func ExtractExtraProperties(data []byte, knownProperties ...string) (map[string]interface{}, error) {
+	var properties map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&properties); err != nil {
+		return nil, err
+	}
+	DeleteExtraProperties(properties, knownProperties...)
+	if len(properties) == 0 {
+		return nil, nil
+	}
+	return properties, nil
+}
+
+// DeleteExtraProperties deletes the given known properties from the extra
+// properties. Just like encoding/json, the keys are matched case-insensitively.
+func DeleteExtraProperties(extraProperties map[string]interface{}, knownProperties ...string) {
+	for key := range extraProperties {
+		for _, property := range knownProperties {
+			if strings.EqualFold(key, property) {
+				delete(extraProperties, key)
+				break
+			}
+		}
+	}
+}
+
+// MarshalJSONWithExtraProperties marshals the given value as a JSON object,
+// and includes all of the given extra properties. An error is returned if
+// any of the extra properties is already defined by the value.
+func MarshalJSONWithExtraProperties(marshaler interface{}, extraProperties map[string]interface{}) ([]byte, error) {
+	data, err := json.Marshal(marshaler)
+	if err != nil {
+		return nil, err
+	}
+	if len(extraProperties) == 0 {
+		return data, nil
+	}
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, err
+	}
+	for property := range extraProperties {
+		if _, ok := properties[property]; ok {
+			return nil, fmt.Errorf("cannot add extra property %q: it's already defined", property)
+		}
+	}
+	return MergeJSON(json.RawMessage(data), extraProperties)
+}
@@ -8,8 +8,8 @@ import (
 )
 
 type Movie struct {
-	Id    string `json:"id"`
-	Title string `json:"title"`
+	Id    string `json:"id" url:"id"`
+	Title string `json:"title" url:"title"`
 }
 
 func (m *Movie) String() string {
@@ -18,3 +18,9 @@ func (m *Movie) String() string {
 	}
 	return fmt.Sprintf("%#v", m)
 }
+
+// Validate reports all of the Movie's invalid fields (e.g. missing
+// required fields), if any.
+func (m *Movie) Validate() error {
+	return nil
+}
@@ -0,0 +1,39 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MergeJSON marshals each of the given values as a JSON object, and merges
+// all of their properties into a single JSON object. Nil values are ignored.
+//
+// This is used to serialize types that embed a value with its own custom
+// json.Marshaler implementation, which would otherwise be promoted to the
+// type and replace its serialization altogether.
+func MergeJSON(values ...interface{}) ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	for _, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if string(data) == "null" {
+			continue
+		}
+		if len(data) < 2 || data[0] != '{' || data[len(data)-1] != '}' {
+			return nil, fmt.Errorf("cannot merge %s into a JSON object", data)
+		}
+		properties := data[1 : len(data)-1]
+		if len(properties) == 0 {
+			continue
+		}
+		if buffer.Len() > 1 {
+			buffer.WriteByte(',')
+		}
+		buffer.Write(properties)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
@@ -31,28 +31,74 @@ func (a *AnotherUnion) FernStringLiteral() string {
 	return a.fernStringLiteral
 }
 
+// Equal reports whether the AnotherUnion is equal to the other AnotherUnion.
+func (a *AnotherUnion) Equal(other *AnotherUnion) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.typeName != other.typeName {
+		return false
+	}
+	if a.String != other.String {
+		return false
+	}
+	if !a.Foo.Equal(other.Foo) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the AnotherUnion.
+func (a *AnotherUnion) DeepCopy() *AnotherUnion {
+	if a == nil {
+		return nil
+	}
+	copied := *a
+	copied.Foo = a.Foo.DeepCopy()
+	return &copied
+}
+
 func (a *AnotherUnion) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
 	var valueString string
-	if err := json.Unmarshal(data, &valueString); err == nil {
-		a.typeName = "string"
-		a.String = valueString
+	if decoder.Decode(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func() {
+			a.typeName = "string"
+			a.String = valueString
+		},
+	) {
 		return nil
 	}
 	var valueFernStringLiteral string
-	if err := json.Unmarshal(data, &valueFernStringLiteral); err == nil {
-		if valueFernStringLiteral == "fern" {
+	if decoder.Decode(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func() {
 			a.typeName = "fernStringLiteral"
 			a.fernStringLiteral = valueFernStringLiteral
-			return nil
-		}
+		},
+	) {
+		return nil
 	}
 	valueFoo := new(Foo)
-	if err := json.Unmarshal(data, &valueFoo); err == nil {
-		a.typeName = "foo"
-		a.Foo = valueFoo
+	if decoder.Decode(
+		&core.UnionMember{
+			Name: "Foo",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueFoo,
+		func() {
+			a.typeName = "foo"
+			a.Foo = valueFoo
+		},
+	) {
 		return nil
 	}
-	return fmt.Errorf("%s cannot be deserialized as a %T", data, a)
+	return decoder.Fallback(a)
 }
 
 func (a AnotherUnion) MarshalJSON() ([]byte, error) {
@@ -87,8 +133,42 @@ func (a *AnotherUnion) Accept(visitor AnotherUnionVisitor) error {
 	}
 }
 
+// Validate reports all of the AnotherUnion's invalid fields (e.g. missing
+// required fields), if any.
+func (a *AnotherUnion) Validate() error {
+	if a == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch a.typeName {
+	case "foo":
+		validation.Add("", a.Foo.Validate())
+	}
+	return validation.Err()
+}
+
 type Bar struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
+}
+
+// Equal reports whether the Bar is equal to the other Bar.
+func (b *Bar) Equal(other *Bar) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Bar.
+func (b *Bar) DeepCopy() *Bar {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
 }
 
 func (b *Bar) String() string {
@@ -98,8 +178,34 @@ func (b *Bar) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	return nil
+}
+
 type Baz struct {
-	Id string `json:"id"`
+	Id string `json:"id" url:"id"`
+}
+
+// Equal reports whether the Baz is equal to the other Baz.
+func (b *Baz) Equal(other *Baz) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Baz.
+func (b *Baz) DeepCopy() *Baz {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
 }
 
 func (b *Baz) String() string {
@@ -109,8 +215,34 @@ func (b *Baz) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Baz's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Baz) Validate() error {
+	return nil
+}
+
 type Foo struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
+}
+
+// Equal reports whether the Foo is equal to the other Foo.
+func (f *Foo) Equal(other *Foo) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if f.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Foo.
+func (f *Foo) DeepCopy() *Foo {
+	if f == nil {
+		return nil
+	}
+	copied := *f
+	return &copied
 }
 
 func (f *Foo) String() string {
@@ -120,6 +252,12 @@ func (f *Foo) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
+
 type Union struct {
 	typeName             string
 	Foo                  *Foo
@@ -187,78 +325,257 @@ func (u *Union) AnotherStringLiteral() string {
 	return u.anotherStringLiteral
 }
 
+// Equal reports whether the Union is equal to the other Union.
+func (u *Union) Equal(other *Union) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.typeName != other.typeName {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !u.Baz.Equal(other.Baz) {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	if (u.IntegerOptional == nil) != (other.IntegerOptional == nil) {
+		return false
+	}
+	if u.IntegerOptional != nil {
+		if *u.IntegerOptional != *other.IntegerOptional {
+			return false
+		}
+	}
+	if len(u.StringBooleanMap) != len(other.StringBooleanMap) {
+		return false
+	}
+	for key, value := range u.StringBooleanMap {
+		otherValue, ok := other.StringBooleanMap[key]
+		if !ok {
+			return false
+		}
+		if value != otherValue {
+			return false
+		}
+	}
+	if len(u.StringList) != len(other.StringList) {
+		return false
+	}
+	for index, value := range u.StringList {
+		if value != other.StringList[index] {
+			return false
+		}
+	}
+	if len(u.StringListList) != len(other.StringListList) {
+		return false
+	}
+	for index, value := range u.StringListList {
+		if len(value) != len(other.StringListList[index]) {
+			return false
+		}
+		for index1, value1 := range value {
+			if value1 != other.StringListList[index][index1] {
+				return false
+			}
+		}
+	}
+	if len(u.DoubleSet) != len(other.DoubleSet) {
+		return false
+	}
+	for index, value := range u.DoubleSet {
+		if value != other.DoubleSet[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Union.
+func (u *Union) DeepCopy() *Union {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	copied.Baz = u.Baz.DeepCopy()
+	if u.IntegerOptional != nil {
+		copiedValue := *u.IntegerOptional
+		copied.IntegerOptional = &copiedValue
+	}
+	if u.StringBooleanMap != nil {
+		copied.StringBooleanMap = make(map[string]bool, len(u.StringBooleanMap))
+		for key, value := range u.StringBooleanMap {
+			copied.StringBooleanMap[key] = value
+		}
+	}
+	if u.StringList != nil {
+		copied.StringList = make([]string, len(u.StringList))
+		copy(copied.StringList, u.StringList)
+	}
+	if u.StringListList != nil {
+		copied.StringListList = make([][]string, len(u.StringListList))
+		copy(copied.StringListList, u.StringListList)
+		for index, value := range u.StringListList {
+			if value != nil {
+				copied.StringListList[index] = make([]string, len(value))
+				copy(copied.StringListList[index], value)
+			}
+		}
+	}
+	if u.DoubleSet != nil {
+		copied.DoubleSet = make([]float64, len(u.DoubleSet))
+		copy(copied.DoubleSet, u.DoubleSet)
+	}
+	return &copied
+}
+
 func (u *Union) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
 	valueFoo := new(Foo)
-	if err := json.Unmarshal(data, &valueFoo); err == nil {
-		u.typeName = "foo"
-		u.Foo = valueFoo
+	if decoder.Decode(
+		&core.UnionMember{
+			Name: "Foo",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueFoo,
+		func() {
+			u.typeName = "foo"
+			u.Foo = valueFoo
+		},
+	) {
 		return nil
 	}
 	valueBar := new(Bar)
-	if err := json.Unmarshal(data, &valueBar); err == nil {
-		u.typeName = "bar"
-		u.Bar = valueBar
+	if decoder.Decode(
+		&core.UnionMember{
+			Name: "Bar",
+			Object: &core.UnionObject{
+				Required: []string{"name"},
+			},
+		},
+		valueBar,
+		func() {
+			u.typeName = "bar"
+			u.Bar = valueBar
+		},
+	) {
 		return nil
 	}
 	valueBaz := new(Baz)
-	if err := json.Unmarshal(data, &valueBaz); err == nil {
-		u.typeName = "baz"
-		u.Baz = valueBaz
+	if decoder.Decode(
+		&core.UnionMember{
+			Name: "Baz",
+			Object: &core.UnionObject{
+				Required: []string{"id"},
+			},
+		},
+		valueBaz,
+		func() {
+			u.typeName = "baz"
+			u.Baz = valueBaz
+		},
+	) {
 		return nil
 	}
 	var valueString string
-	if err := json.Unmarshal(data, &valueString); err == nil {
-		u.typeName = "string"
-		u.String = valueString
+	if decoder.Decode(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func() {
+			u.typeName = "string"
+			u.String = valueString
+		},
+	) {
 		return nil
 	}
 	var valueIntegerOptional *int
-	if err := json.Unmarshal(data, &valueIntegerOptional); err == nil {
-		u.typeName = "integerOptional"
-		u.IntegerOptional = valueIntegerOptional
+	if decoder.Decode(
+		&core.UnionMember{Name: "IntegerOptional"},
+		&valueIntegerOptional,
+		func() {
+			u.typeName = "integerOptional"
+			u.IntegerOptional = valueIntegerOptional
+		},
+	) {
 		return nil
 	}
 	var valueStringBooleanMap map[string]bool
-	if err := json.Unmarshal(data, &valueStringBooleanMap); err == nil {
-		u.typeName = "stringBooleanMap"
-		u.StringBooleanMap = valueStringBooleanMap
+	if decoder.Decode(
+		&core.UnionMember{Name: "StringBooleanMap"},
+		&valueStringBooleanMap,
+		func() {
+			u.typeName = "stringBooleanMap"
+			u.StringBooleanMap = valueStringBooleanMap
+		},
+	) {
 		return nil
 	}
 	var valueStringList []string
-	if err := json.Unmarshal(data, &valueStringList); err == nil {
-		u.typeName = "stringList"
-		u.StringList = valueStringList
+	if decoder.Decode(
+		&core.UnionMember{Name: "StringList"},
+		&valueStringList,
+		func() {
+			u.typeName = "stringList"
+			u.StringList = valueStringList
+		},
+	) {
 		return nil
 	}
 	var valueStringListList [][]string
-	if err := json.Unmarshal(data, &valueStringListList); err == nil {
-		u.typeName = "stringListList"
-		u.StringListList = valueStringListList
+	if decoder.Decode(
+		&core.UnionMember{Name: "StringListList"},
+		&valueStringListList,
+		func() {
+			u.typeName = "stringListList"
+			u.StringListList = valueStringListList
+		},
+	) {
 		return nil
 	}
 	var valueDoubleSet []float64
-	if err := json.Unmarshal(data, &valueDoubleSet); err == nil {
-		u.typeName = "doubleSet"
-		u.DoubleSet = valueDoubleSet
+	if decoder.Decode(
+		&core.UnionMember{Name: "DoubleSet"},
+		&valueDoubleSet,
+		func() {
+			u.typeName = "doubleSet"
+			u.DoubleSet = valueDoubleSet
+		},
+	) {
 		return nil
 	}
 	var valueFernStringLiteral string
-	if err := json.Unmarshal(data, &valueFernStringLiteral); err == nil {
-		if valueFernStringLiteral == "fern" {
+	if decoder.Decode(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func() {
 			u.typeName = "fernStringLiteral"
 			u.fernStringLiteral = valueFernStringLiteral
-			return nil
-		}
+		},
+	) {
+		return nil
 	}
 	var valueAnotherStringLiteral string
-	if err := json.Unmarshal(data, &valueAnotherStringLiteral); err == nil {
-		if valueAnotherStringLiteral == "another" {
+	if decoder.Decode(
+		&core.UnionMember{Name: "anotherStringLiteral", Literal: "another"},
+		&valueAnotherStringLiteral,
+		func() {
 			u.typeName = "anotherStringLiteral"
 			u.anotherStringLiteral = valueAnotherStringLiteral
-			return nil
-		}
+		},
+	) {
+		return nil
 	}
-	return fmt.Errorf("%s cannot be deserialized as a %T", data, u)
+	return decoder.Fallback(u)
 }
 
 func (u Union) MarshalJSON() ([]byte, error) {
@@ -333,6 +650,24 @@ func (u *Union) Accept(visitor UnionVisitor) error {
 	}
 }
 
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.typeName {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	case "baz":
+		validation.Add("", u.Baz.Validate())
+	}
+	return validation.Err()
+}
+
 type UnionWithLiteral struct {
 	typeName          string
 	fernStringLiteral string
@@ -351,22 +686,54 @@ func (u *UnionWithLiteral) FernStringLiteral() string {
 	return u.fernStringLiteral
 }
 
+// Equal reports whether the UnionWithLiteral is equal to the other UnionWithLiteral.
+func (u *UnionWithLiteral) Equal(other *UnionWithLiteral) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.typeName != other.typeName {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithLiteral.
+func (u *UnionWithLiteral) DeepCopy() *UnionWithLiteral {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	return &copied
+}
+
 func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
 	var valueFernStringLiteral string
-	if err := json.Unmarshal(data, &valueFernStringLiteral); err == nil {
-		if valueFernStringLiteral == "fern" {
+	if decoder.Decode(
+		&core.UnionMember{Name: "fernStringLiteral", Literal: "fern"},
+		&valueFernStringLiteral,
+		func() {
 			u.typeName = "fernStringLiteral"
 			u.fernStringLiteral = valueFernStringLiteral
-			return nil
-		}
+		},
+	) {
+		return nil
 	}
 	var valueString string
-	if err := json.Unmarshal(data, &valueString); err == nil {
-		u.typeName = "string"
-		u.String = valueString
+	if decoder.Decode(
+		&core.UnionMember{Name: "String"},
+		&valueString,
+		func() {
+			u.typeName = "string"
+			u.String = valueString
+		},
+	) {
 		return nil
 	}
-	return fmt.Errorf("%s cannot be deserialized as a %T", data, u)
+	return decoder.Fallback(u)
 }
 
 func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
@@ -395,3 +762,9 @@ func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
 		return visitor.VisitString(u.String)
 	}
 }
+
+// Validate reports all of the UnionWithLiteral's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithLiteral) Validate() error {
+	return nil
+}
@@ -0,0 +1,196 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UnionMember describes a single member of an undiscriminated union, which
+// determines how strictly a JSON value is matched against it.
+type UnionMember struct {
+	// Name identifies the member in errors (e.g. "string" or "User").
+	Name string
+
+	// Literal is the member's expected value, if the member is a literal.
+	Literal interface{}
+
+	// Object describes the member's properties, if the member is an object.
+	Object *UnionObject
+}
+
+// UnionObject describes the properties of an object that's a member of an
+// undiscriminated union.
+type UnionObject struct {
+	Required []string
+	Optional []string
+
+	// Literals maps each of the object's literal properties to its value.
+	Literals map[string]interface{}
+
+	// ExtraProperties is set if the object captures its extra properties,
+	// in which case unknown properties are permitted.
+	ExtraProperties bool
+}
+
+// UnionDecoder decodes an undiscriminated union by matching its JSON value
+// against each of the union's members in order.
+//
+// A member matches strictly if the value can be decoded as the member, and
+// the value is valid (e.g. objects must include all of their required properties,
+// and no unknown properties). Otherwise, the member that matched best (e.g.
+// an object with the fewest unknown properties) is used as a fallback.
+type UnionDecoder struct {
+	data       []byte
+	properties map[string]json.RawMessage
+	isObject   bool
+	decoded    bool
+
+	errs      []string
+	best      func()
+	bestScore int
+}
+
+// NewUnionDecoder returns a new *UnionDecoder for the given JSON value.
+func NewUnionDecoder(data []byte) *UnionDecoder {
+	return &UnionDecoder{
+		data: data,
+	}
+}
+
+// Decode decodes the JSON value into the given value, and calls set if it strictly
+// matches the given member. Otherwise, set is retained for the fallback if the
+// member is the best match so far.
+func (u *UnionDecoder) Decode(member *UnionMember, value interface{}, set func()) bool {
+	return u.try(member, value, func() error {
+		return json.Unmarshal(u.data, value)
+	}, set)
+}
+
+// try decodes the JSON value with the given function, and calls set if it strictly
+// matches the given member (see Decode).
+func (u *UnionDecoder) try(member *UnionMember, value interface{}, decode func() error, set func()) bool {
+	score, err := u.match(member, value, decode)
+	if err != nil {
+		u.errs = append(u.errs, fmt.Sprintf("%s: %v", member.Name, err))
+		return false
+	}
+	if score == 0 {
+		set()
+		return true
+	}
+	if u.best == nil || score > u.bestScore {
+		u.best = set
+		u.bestScore = score
+	}
+	return false
+}
+
+// Fallback calls set for the member that matched best, if any. Otherwise,
+// it returns an error that describes why each of the union's members
+// didn't match.
+func (u *UnionDecoder) Fallback(union interface{}) error {
+	if u.best != nil {
+		u.best()
+		return nil
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T: %s", u.data, union, strings.Join(u.errs, "; "))
+}
+
+// match decodes the JSON value into the given value with the given function, and returns its score. A score of
+// zero is a strict match, whereas a negative score is a partial match (the higher the
+// score, the better the match).
+func (u *UnionDecoder) match(member *UnionMember, value interface{}, decode func() error) (int, error) {
+	if member.Literal != nil {
+		var literal interface{}
+		if err := json.Unmarshal(u.data, &literal); err != nil {
+			return 0, err
+		}
+		if literal != member.Literal {
+			return 0, fmt.Errorf("expected literal %v", member.Literal)
+		}
+	}
+	if member.Object != nil {
+		if err := u.decodeObject(); err != nil {
+			return 0, err
+		}
+		if !u.isObject {
+			return 0, fmt.Errorf("expected an object")
+		}
+		for property, literal := range member.Object.Literals {
+			var propertyValue interface{}
+			if raw, ok := u.properties[property]; ok {
+				_ = json.Unmarshal(raw, &propertyValue)
+			}
+			if propertyValue != literal {
+				return 0, fmt.Errorf("expected literal %v for property %q", literal, property)
+			}
+		}
+	}
+	if err := decode(); err != nil {
+		return 0, err
+	}
+	score := 0
+	if member.Object != nil {
+		score -= u.missingProperties(member.Object) + u.unknownProperties(member.Object)
+	}
+	if validator, ok := value.(Validator); ok && validator.Validate() != nil {
+		score--
+	}
+	return score, nil
+}
+
+// decodeObject decodes the properties of the JSON value, if it's an object.
+func (u *UnionDecoder) decodeObject() error {
+	if u.decoded {
+		return nil
+	}
+	u.decoded = true
+	trimmed := bytes.TrimSpace(u.data)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil
+	}
+	if err := json.Unmarshal(trimmed, &u.properties); err != nil {
+		return err
+	}
+	u.isObject = true
+	return nil
+}
+
+// missingProperties returns the number of the object's required properties
+// that aren't specified in the JSON object.
+func (u *UnionDecoder) missingProperties(object *UnionObject) int {
+	var missing int
+	for _, property := range object.Required {
+		if _, ok := u.properties[property]; !ok {
+			missing++
+		}
+	}
+	return missing
+}
+
+// unknownProperties returns the number of properties in the JSON object
+// that aren't recognized by the object.
+func (u *UnionDecoder) unknownProperties(object *UnionObject) int {
+	if object.ExtraProperties {
+		return 0
+	}
+	known := make(map[string]struct{}, len(object.Required)+len(object.Optional)+len(object.Literals))
+	for _, property := range object.Required {
+		known[property] = struct{}{}
+	}
+	for _, property := range object.Optional {
+		known[property] = struct{}{}
+	}
+	for property := range object.Literals {
+		known[property] = struct{}{}
+	}
+	var unknown int
+	for property := range u.properties {
+		if _, ok := known[property]; !ok {
+			unknown++
+		}
+	}
+	return unknown
+}
@@ -3,13 +3,41 @@
 package api
 
 import (
+	bytes "bytes"
 	json "encoding/json"
 	fmt "fmt"
 	core "github.com/fern-api/fern-go/internal/testdata/model/union/fixtures/core"
 )
 
 type Bar struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+// Equal reports whether the Bar is equal to the other Bar.
+func (b *Bar) Equal(other *Bar) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Bar.
+func (b *Bar) DeepCopy() *Bar {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
 }
 
 func (b *Bar) String() string {
@@ -19,6 +47,12 @@ func (b *Bar) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	return nil
+}
+
 type Baz struct {
 	extended string
 }
@@ -27,6 +61,23 @@ func (b *Baz) Extended() string {
 	return b.extended
 }
 
+// Equal reports whether the Baz is equal to the other Baz.
+func (b *Baz) Equal(other *Baz) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Baz.
+func (b *Baz) DeepCopy() *Baz {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
+}
+
 func (b *Baz) UnmarshalJSON(data []byte) error {
 	type unmarshaler Baz
 	var value unmarshaler
@@ -57,8 +108,41 @@ func (b *Baz) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Baz's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Baz) Validate() error {
+	return nil
+}
+
 type Foo struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+// Equal reports whether the Foo is equal to the other Foo.
+func (f *Foo) Equal(other *Foo) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if f.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Foo.
+func (f *Foo) DeepCopy() *Foo {
+	if f == nil {
+		return nil
+	}
+	copied := *f
+	return &copied
 }
 
 func (f *Foo) String() string {
@@ -68,11 +152,19 @@ func (f *Foo) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
+
 // This is a simple union.
 type Union struct {
 	Type string
 	Foo  *Foo
 	Bar  *Bar
+
+	_unknown json.RawMessage
 }
 
 func NewUnionFromFoo(value *Foo) *Union {
@@ -83,6 +175,62 @@ func NewUnionFromBar(value *Bar) *Union {
 	return &Union{Type: "bar", Bar: value}
 }
 
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+// Equal reports whether the Union is equal to the other Union.
+func (u *Union) Equal(other *Union) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the Union.
+func (u *Union) DeepCopy() *Union {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
 func (u *Union) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -108,6 +256,8 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.Bar = valueUnmarshaler.Bar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -115,6 +265,9 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 func (u Union) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		var marshaler = struct {
@@ -140,11 +293,17 @@ func (u Union) MarshalJSON() ([]byte, error) {
 type UnionVisitor interface {
 	VisitFoo(*Foo) error
 	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *Union) Accept(visitor UnionVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		return visitor.VisitFoo(u.Foo)
@@ -153,11 +312,39 @@ func (u *Union) Accept(visitor UnionVisitor) error {
 	}
 }
 
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		if u.Foo == nil {
+			validation.Required("foo")
+		}
+		validation.Add("foo", u.Foo.Validate())
+	case "bar":
+		if u.Bar == nil {
+			validation.Required("bar")
+		}
+		validation.Add("bar", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type UnionWithDiscriminant struct {
 	Type string
 	// This is a Foo field.
 	Foo *Foo
 	Bar *Bar
+
+	_unknown json.RawMessage
 }
 
 func NewUnionWithDiscriminantFromFoo(value *Foo) *UnionWithDiscriminant {
@@ -168,6 +355,62 @@ func NewUnionWithDiscriminantFromBar(value *Bar) *UnionWithDiscriminant {
 	return &UnionWithDiscriminant{Type: "bar", Bar: value}
 }
 
+func (u *UnionWithDiscriminant) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithDiscriminant) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithDiscriminant) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+// Equal reports whether the UnionWithDiscriminant is equal to the other UnionWithDiscriminant.
+func (u *UnionWithDiscriminant) Equal(other *UnionWithDiscriminant) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithDiscriminant.
+func (u *UnionWithDiscriminant) DeepCopy() *UnionWithDiscriminant {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
 func (u *UnionWithDiscriminant) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"_type"`
@@ -193,6 +436,8 @@ func (u *UnionWithDiscriminant) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.Bar = valueUnmarshaler.Bar
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -200,6 +445,9 @@ func (u *UnionWithDiscriminant) UnmarshalJSON(data []byte) error {
 func (u UnionWithDiscriminant) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		var marshaler = struct {
@@ -225,11 +473,17 @@ func (u UnionWithDiscriminant) MarshalJSON() ([]byte, error) {
 type UnionWithDiscriminantVisitor interface {
 	VisitFoo(*Foo) error
 	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *UnionWithDiscriminant) Accept(visitor UnionWithDiscriminantVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		return visitor.VisitFoo(u.Foo)
@@ -238,17 +492,52 @@ func (u *UnionWithDiscriminant) Accept(visitor UnionWithDiscriminantVisitor) err
 	}
 }
 
+// Validate reports all of the UnionWithDiscriminant's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithDiscriminant) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		if u.Foo == nil {
+			validation.Required("foo")
+		}
+		validation.Add("foo", u.Foo.Validate())
+	case "bar":
+		if u.Bar == nil {
+			validation.Required("bar")
+		}
+		validation.Add("bar", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("_type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type UnionWithLiteral struct {
 	Type     string
 	fern     string
 	extended string
 	base     string
+
+	_unknown json.RawMessage
 }
 
 func NewUnionWithLiteralWithFern() *UnionWithLiteral {
 	return &UnionWithLiteral{Type: "fern", fern: "fern"}
 }
 
+func (u *UnionWithLiteral) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
 func (u *UnionWithLiteral) Extended() string {
 	return u.extended
 }
@@ -261,6 +550,33 @@ func (u *UnionWithLiteral) Fern() string {
 	return u.fern
 }
 
+// Equal reports whether the UnionWithLiteral is equal to the other UnionWithLiteral.
+func (u *UnionWithLiteral) Equal(other *UnionWithLiteral) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithLiteral.
+func (u *UnionWithLiteral) DeepCopy() *UnionWithLiteral {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
 func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -274,6 +590,8 @@ func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
 	switch unmarshaler.Type {
 	case "fern":
 		u.fern = "fern"
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -281,6 +599,9 @@ func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
 func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "fern":
 		var marshaler = struct {
@@ -300,21 +621,46 @@ func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
 
 type UnionWithLiteralVisitor interface {
 	VisitFern(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "fern":
 		return visitor.VisitFern(u.fern)
 	}
 }
 
+// Validate reports all of the UnionWithLiteral's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithLiteral) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "fern":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type UnionWithPrimitive struct {
 	Type    string
 	Boolean bool
 	String  string
+
+	_unknown json.RawMessage
 }
 
 func NewUnionWithPrimitiveFromBoolean(value bool) *UnionWithPrimitive {
@@ -325,6 +671,60 @@ func NewUnionWithPrimitiveFromString(value string) *UnionWithPrimitive {
 	return &UnionWithPrimitive{Type: "string", String: value}
 }
 
+func (u *UnionWithPrimitive) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithPrimitive) GetBoolean() bool {
+	if u == nil {
+		return false
+	}
+	return u.Boolean
+}
+
+func (u *UnionWithPrimitive) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+// Equal reports whether the UnionWithPrimitive is equal to the other UnionWithPrimitive.
+func (u *UnionWithPrimitive) Equal(other *UnionWithPrimitive) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if u.Boolean != other.Boolean {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithPrimitive.
+func (u *UnionWithPrimitive) DeepCopy() *UnionWithPrimitive {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
 func (u *UnionWithPrimitive) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -350,6 +750,8 @@ func (u *UnionWithPrimitive) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.String = valueUnmarshaler.String
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -357,6 +759,9 @@ func (u *UnionWithPrimitive) UnmarshalJSON(data []byte) error {
 func (u UnionWithPrimitive) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "boolean":
 		var marshaler = struct {
@@ -382,11 +787,17 @@ func (u UnionWithPrimitive) MarshalJSON() ([]byte, error) {
 type UnionWithPrimitiveVisitor interface {
 	VisitBoolean(bool) error
 	VisitString(string) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *UnionWithPrimitive) Accept(visitor UnionWithPrimitiveVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "boolean":
 		return visitor.VisitBoolean(u.Boolean)
@@ -395,10 +806,30 @@ func (u *UnionWithPrimitive) Accept(visitor UnionWithPrimitiveVisitor) error {
 	}
 }
 
+// Validate reports all of the UnionWithPrimitive's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithPrimitive) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "boolean":
+	case "string":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type UnionWithUnknown struct {
 	Type    string
 	Foo     *Foo
 	Unknown interface{}
+
+	_unknown json.RawMessage
 }
 
 func NewUnionWithUnknownFromFoo(value *Foo) *UnionWithUnknown {
@@ -409,6 +840,62 @@ func NewUnionWithUnknownFromUnknown(value interface{}) *UnionWithUnknown {
 	return &UnionWithUnknown{Type: "unknown", Unknown: value}
 }
 
+func (u *UnionWithUnknown) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithUnknown) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithUnknown) GetUnknown() interface{} {
+	if u == nil {
+		return nil
+	}
+	return u.Unknown
+}
+
+// Equal reports whether the UnionWithUnknown is equal to the other UnionWithUnknown.
+func (u *UnionWithUnknown) Equal(other *UnionWithUnknown) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !core.EqualValue(u.Unknown, other.Unknown) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithUnknown.
+func (u *UnionWithUnknown) DeepCopy() *UnionWithUnknown {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Unknown = core.DeepCopyValue(u.Unknown)
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
 func (u *UnionWithUnknown) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -430,6 +917,8 @@ func (u *UnionWithUnknown) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.Unknown = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -437,6 +926,9 @@ func (u *UnionWithUnknown) UnmarshalJSON(data []byte) error {
 func (u UnionWithUnknown) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		var marshaler = struct {
@@ -462,11 +954,17 @@ func (u UnionWithUnknown) MarshalJSON() ([]byte, error) {
 type UnionWithUnknownVisitor interface {
 	VisitFoo(*Foo) error
 	VisitUnknown(interface{}) error
+	// VisitUnknownValue is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknownValue(discriminant string, data json.RawMessage) error
 }
 
 func (u *UnionWithUnknown) Accept(visitor UnionWithUnknownVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknownValue(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		return visitor.VisitFoo(u.Foo)
@@ -475,11 +973,32 @@ func (u *UnionWithUnknown) Accept(visitor UnionWithUnknownVisitor) error {
 	}
 }
 
+// Validate reports all of the UnionWithUnknown's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithUnknown) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "unknown":
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type UnionWithoutKey struct {
 	Type string
 	Foo  *Foo
 	// This is a bar field.
 	Bar *Bar
+
+	_unknown json.RawMessage
 }
 
 func NewUnionWithoutKeyFromFoo(value *Foo) *UnionWithoutKey {
@@ -490,6 +1009,62 @@ func NewUnionWithoutKeyFromBar(value *Bar) *UnionWithoutKey {
 	return &UnionWithoutKey{Type: "bar", Bar: value}
 }
 
+func (u *UnionWithoutKey) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithoutKey) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithoutKey) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+// Equal reports whether the UnionWithoutKey is equal to the other UnionWithoutKey.
+func (u *UnionWithoutKey) Equal(other *UnionWithoutKey) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.Type != other.Type {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !bytes.Equal(u._unknown, other._unknown) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns a deep copy of the UnionWithoutKey.
+func (u *UnionWithoutKey) DeepCopy() *UnionWithoutKey {
+	if u == nil {
+		return nil
+	}
+	copied := *u
+	copied.Foo = u.Foo.DeepCopy()
+	copied.Bar = u.Bar.DeepCopy()
+	if u._unknown != nil {
+		copied._unknown = make(json.RawMessage, len(u._unknown))
+		copy(copied._unknown, u._unknown)
+	}
+	return &copied
+}
+
 func (u *UnionWithoutKey) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -511,6 +1086,8 @@ func (u *UnionWithoutKey) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.Bar = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -518,6 +1095,9 @@ func (u *UnionWithoutKey) UnmarshalJSON(data []byte) error {
 func (u UnionWithoutKey) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		var marshaler = struct {
@@ -543,11 +1123,17 @@ func (u UnionWithoutKey) MarshalJSON() ([]byte, error) {
 type UnionWithoutKeyVisitor interface {
 	VisitFoo(*Foo) error
 	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *UnionWithoutKey) Accept(visitor UnionWithoutKeyVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		return visitor.VisitFoo(u.Foo)
@@ -555,3 +1141,23 @@ func (u *UnionWithoutKey) Accept(visitor UnionWithoutKeyVisitor) error {
 		return visitor.VisitBar(u.Bar)
 	}
 }
+
+// Validate reports all of the UnionWithoutKey's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UnionWithoutKey) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
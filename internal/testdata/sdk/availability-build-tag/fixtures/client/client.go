@@ -0,0 +1,42 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package client
+
+import (
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/core"
+	option "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/option"
+	user "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/user"
+	http "net/http"
+)
+
+type Client struct {
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
+
+	User *user.Client
+}
+
+func NewClient(opts ...option.RequestOption) *Client {
+	options := core.NewRequestOptions(opts...)
+	return &Client{
+		baseURL: options.BaseURL,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
+			},
+			options.RateLimiter,
+		),
+		header:     options.ToHeader(),
+		validation: options.Validation,
+		User:       user.NewClient(opts...),
+	}
+}
@@ -0,0 +1,63 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package client
+
+import (
+	context "context"
+	errors "errors"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/core"
+	option "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/option"
+	strings "strings"
+)
+
+// Do calls the endpoint at the given path (relative to the base URL) with the
+// client's headers, authentication, and retries. This is useful for endpoints
+// that aren't supported by the SDK yet.
+//
+// The request, if any, is encoded as JSON unless it's an io.Reader, and the
+// response, if any, is decoded from JSON unless it's an io.Writer. A non-2xx
+// response is returned as a *core.APIError.
+func (c *Client) Do(
+	ctx context.Context,
+	method string,
+	path string,
+	request interface{},
+	response interface{},
+	opts ...option.RequestOption,
+) error {
+	options := core.NewRequestOptions(opts...)
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	if baseURL == "" {
+		err := errors.New("missing the base URL; set it with option.WithBaseURL")
+		return err
+	}
+	endpointURL := strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(path, "/")
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+
+	return c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             method,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           response,
+			ResponseIsOptional: true,
+		},
+	)
+}
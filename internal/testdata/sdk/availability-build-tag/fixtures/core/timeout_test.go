@@ -0,0 +1,150 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallTimeout(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			},
+		),
+	)
+	defer server.Close()
+
+	tests := []struct {
+		desc         string
+		giveParams   *CallerParams
+		giveTimeout  time.Duration
+		wantDuration time.Duration
+	}{
+		{
+			desc:         "client",
+			giveParams:   &CallerParams{Timeout: 10 * time.Millisecond},
+			wantDuration: 10 * time.Millisecond,
+		},
+		{
+			desc:         "request",
+			giveParams:   &CallerParams{Timeout: time.Minute},
+			giveTimeout:  20 * time.Millisecond,
+			wantDuration: 20 * time.Millisecond,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			test.giveParams.Client = server.Client()
+			caller := NewCaller(test.giveParams, nil)
+			err := caller.Call(
+				context.Background(),
+				&CallParams{
+					URL:     server.URL,
+					Method:  http.MethodGet,
+					Timeout: test.giveTimeout,
+				},
+			)
+			var timeoutErr *TimeoutError
+			require.True(t, errors.As(err, &timeoutErr), "unexpected error: %v", err)
+			assert.Equal(t, test.wantDuration, timeoutErr.Duration)
+			assert.False(t, timeoutErr.PerAttempt)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		})
+	}
+}
+
+func TestCallAttemptTimeout(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) == 1 {
+					// Only the first attempt times out.
+					<-r.Context().Done()
+					return
+				}
+				_, err := w.Write([]byte(`{"id":"123"}`))
+				require.NoError(t, err)
+			},
+		),
+	)
+	defer server.Close()
+
+	caller := NewCaller(
+		&CallerParams{
+			Client:         server.Client(),
+			AttemptTimeout: 10 * time.Millisecond,
+		},
+		nil,
+	)
+	var response *Response
+	err := caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:      server.URL,
+			Method:   http.MethodGet,
+			Response: &response,
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, &Response{Id: "123"}, response)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+
+	// The first attempt times out, and it isn't retried.
+	atomic.StoreInt32(&attempts, 0)
+	err = caller.Call(
+		context.Background(),
+		&CallParams{
+			URL:         server.URL,
+			Method:      http.MethodGet,
+			MaxAttempts: 1,
+			Response:    &response,
+		},
+	)
+	var timeoutErr *TimeoutError
+	require.True(t, errors.As(err, &timeoutErr), "unexpected error: %v", err)
+	assert.Equal(t, 10*time.Millisecond, timeoutErr.Duration)
+	assert.True(t, timeoutErr.PerAttempt)
+}
+
+func TestCallCancelled(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			},
+		),
+	)
+	defer server.Close()
+
+	caller := NewCaller(
+		&CallerParams{
+			Client:  server.Client(),
+			Timeout: time.Minute,
+		},
+		nil,
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	err := caller.Call(
+		ctx,
+		&CallParams{
+			URL:    server.URL,
+			Method: http.MethodGet,
+		},
+	)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled), "unexpected error: %v", err)
+
+	var timeoutErr *TimeoutError
+	assert.False(t, errors.As(err, &timeoutErr))
+}
@@ -0,0 +1,290 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/core"
+	option "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/option"
+	http "net/http"
+)
+
+type Client struct {
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
+}
+
+func NewClient(opts ...option.RequestOption) *Client {
+	options := core.NewRequestOptions(opts...)
+	return &Client{
+		baseURL: options.BaseURL,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
+			},
+			options.RateLimiter,
+		),
+		header:     options.ToHeader(),
+		validation: options.Validation,
+	}
+}
+
+// Sets the user's name.
+//
+// Deprecated: Use SetNameV2 instead.
+func (c *Client) SetName(
+	ctx context.Context,
+	userId string,
+	request string,
+	opts ...option.RequestOption,
+) (string, error) {
+	options := core.NewRequestOptions(opts...)
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/set-name", userId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV3Optional(
+	ctx context.Context,
+	userId string,
+	request *fixtures.SetNameRequestV3Optional,
+	opts ...option.RequestOption,
+) (*fixtures.SetNameRequestV3Body, error) {
+	options := core.NewRequestOptions(opts...)
+
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return nil, err
+		}
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/set-name-v3-optional", userId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response *fixtures.SetNameRequestV3Body
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+			ResponseIsOptional: true,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV4(
+	ctx context.Context,
+	userId string,
+	request *fixtures.SetNameRequestV4,
+	opts ...option.RequestOption,
+) (string, error) {
+	options := core.NewRequestOptions(opts...)
+
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/set-name-v4", userId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV5(
+	ctx context.Context,
+	userId string,
+	request *fixtures.SetNameRequestV5,
+	opts ...option.RequestOption,
+) (string, error) {
+	options := core.NewRequestOptions(opts...)
+
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/set-name-v5", userId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) Update(
+	ctx context.Context,
+	userId string,
+	request *fixtures.UpdateRequest,
+	opts ...option.RequestOption,
+) (string, error) {
+	options := core.NewRequestOptions(opts...)
+
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/update", userId)
+
+	queryParams, err := core.QueryValues(request)
+	if err != nil {
+		return "", err
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
@@ -0,0 +1,111 @@
+// This file was auto-generated by Fern from our API Definition.
+
+//go:build beta
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/core"
+	option "github.com/fern-api/fern-go/internal/testdata/sdk/availability-build-tag/fixtures/option"
+	http "net/http"
+)
+
+func (c *Client) SetNameV2(
+	ctx context.Context,
+	userId string,
+	request *fixtures.SetNameRequest,
+	opts ...option.RequestOption,
+) (string, error) {
+	options := core.NewRequestOptions(opts...)
+
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/set-name-v2", userId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV3(
+	ctx context.Context,
+	userId string,
+	request *fixtures.SetNameRequestV3,
+	opts ...option.RequestOption,
+) (*fixtures.SetNameRequestV3Body, error) {
+	options := core.NewRequestOptions(opts...)
+
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return nil, err
+		}
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/set-name-v3", userId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response *fixtures.SetNameRequestV3Body
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
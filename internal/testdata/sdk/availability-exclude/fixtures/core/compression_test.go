@@ -0,0 +1,201 @@
+package core
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressRequestBody(t *testing.T) {
+	large := strings.Repeat("fern", compressionThreshold)
+	tests := []struct {
+		desc           string
+		giveBody       io.Reader
+		giveEncoding   string
+		wantCompressed bool
+	}{
+		{
+			desc:     "without an encoding",
+			giveBody: strings.NewReader(large),
+		},
+		{
+			desc:         "below the threshold",
+			giveBody:     strings.NewReader("fern"),
+			giveEncoding: encodingGzip,
+		},
+		{
+			desc:           "gzip",
+			giveBody:       strings.NewReader(large),
+			giveEncoding:   encodingGzip,
+			wantCompressed: true,
+		},
+		{
+			desc:           "deflate",
+			giveBody:       bytes.NewBufferString(large),
+			giveEncoding:   encodingDeflate,
+			wantCompressed: true,
+		},
+		{
+			desc:           "zstd",
+			giveBody:       bytes.NewReader([]byte(large)),
+			giveEncoding:   encodingZstd,
+			wantCompressed: true,
+		},
+		{
+			desc:           "unknown size",
+			giveBody:       io.MultiReader(strings.NewReader("fern")),
+			giveEncoding:   encodingGzip,
+			wantCompressed: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			want, err := io.ReadAll(test.giveBody)
+			require.NoError(t, err)
+
+			body, compressed, err := compressRequestBody(bytesReaderLike(test.giveBody, want), test.giveEncoding)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantCompressed, compressed)
+
+			if compressed {
+				reader, closer, err := newDecompressor(body, test.giveEncoding)
+				require.NoError(t, err)
+				defer closer()
+				body = reader
+			}
+			got, err := io.ReadAll(body)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got))
+		})
+	}
+}
+
+func TestCompressRequestBodyError(t *testing.T) {
+	_, _, err := compressRequestBody(strings.NewReader("fern"), "br")
+	assert.EqualError(t, err, `unsupported request compression "br": expected one of "gzip", "deflate", or "zstd"`)
+}
+
+func TestCallCompression(t *testing.T) {
+	large := strings.Repeat("fern", compressionThreshold)
+	tests := []struct {
+		desc         string
+		giveEncoding string
+		giveBody     []byte
+	}{
+		{
+			desc:     "identity",
+			giveBody: []byte(`{"id":"123"}`),
+		},
+		{
+			desc:         "gzip",
+			giveEncoding: encodingGzip,
+			giveBody:     compressTestBody(t, encodingGzip, `{"id":"123"}`),
+		},
+		{
+			desc:         "deflate",
+			giveEncoding: encodingDeflate,
+			giveBody:     compressTestBody(t, encodingDeflate, `{"id":"123"}`),
+		},
+		{
+			desc:         "zstd",
+			giveEncoding: encodingZstd,
+			giveBody:     compressTestBody(t, encodingZstd, `{"id":"123"}`),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						// The request is always compressed with gzip.
+						assert.Equal(t, encodingGzip, r.Header.Get(contentEncodingHeader))
+						reader, err := gzip.NewReader(r.Body)
+						require.NoError(t, err)
+						bytes, err := io.ReadAll(reader)
+						require.NoError(t, err)
+						assert.Equal(t, `"`+large+`"`, string(bytes))
+
+						if test.giveEncoding != "" {
+							w.Header().Set(contentEncodingHeader, test.giveEncoding)
+						}
+						_, err = w.Write(test.giveBody)
+						require.NoError(t, err)
+					},
+				),
+			)
+			defer server.Close()
+
+			caller := NewCaller(
+				&CallerParams{
+					Client:             server.Client(),
+					RequestCompression: encodingGzip,
+				},
+				nil,
+			)
+			var response *Response
+			err := caller.Call(
+				context.Background(),
+				&CallParams{
+					URL:      server.URL,
+					Method:   http.MethodPost,
+					Request:  large,
+					Response: &response,
+				},
+			)
+			require.NoError(t, err)
+			assert.Equal(t, &Response{Id: "123"}, response)
+		})
+	}
+}
+
+func TestDecompressResponseEmpty(t *testing.T) {
+	response := &http.Response{
+		Header: http.Header{contentEncodingHeader: []string{encodingGzip}},
+		Body:   io.NopCloser(bytes.NewReader(nil)),
+	}
+	decompressResponse(response)
+	assert.Empty(t, response.Header.Get(contentEncodingHeader))
+
+	_, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.NoError(t, response.Body.Close())
+}
+
+// bytesReaderLike returns a reader of the given bytes that has the same
+// size semantics as the original reader (i.e. whether it implements Len).
+func bytesReaderLike(original io.Reader, data []byte) io.Reader {
+	if _, ok := original.(lener); ok {
+		return bytes.NewReader(data)
+	}
+	return io.MultiReader(bytes.NewReader(data))
+}
+
+// compressTestBody compresses the given body with the given encoding.
+func compressTestBody(t *testing.T, encoding string, body string) []byte {
+	buffer := bytes.NewBuffer(nil)
+	var writer io.WriteCloser
+	switch encoding {
+	case encodingGzip:
+		writer = gzip.NewWriter(buffer)
+	case encodingDeflate:
+		writer = zlib.NewWriter(buffer)
+	case encodingZstd:
+		encoder, err := zstd.NewWriter(buffer)
+		require.NoError(t, err)
+		writer = encoder
+	}
+	_, err := writer.Write([]byte(body))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buffer.Bytes()
+}
@@ -0,0 +1,124 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package option
+
+import (
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/availability-exclude/fixtures/core"
+	http "net/http"
+	url "net/url"
+	time "time"
+)
+
+// RequestOption adapts the behavior of an indivdual request.
+type RequestOption = core.RequestOption
+
+// WithBaseURL sets the base URL, overriding the default
+// environment, if any.
+func WithBaseURL(baseURL string) *core.BaseURLOption {
+	return &core.BaseURLOption{
+		BaseURL: baseURL,
+	}
+}
+
+// WithHTTPClient uses the given HTTPClient to issue the request.
+func WithHTTPClient(httpClient core.HTTPClient) *core.HTTPClientOption {
+	return &core.HTTPClientOption{
+		HTTPClient: httpClient,
+	}
+}
+
+// WithHTTPHeader adds the given http.Header to the request.
+func WithHTTPHeader(httpHeader http.Header) *core.HTTPHeaderOption {
+	return &core.HTTPHeaderOption{
+		// Clone the headers so they can't be modified after the option call.
+		HTTPHeader: httpHeader.Clone(),
+	}
+}
+
+// WithMaxAttempts configures the maximum number of retry attempts.
+func WithMaxAttempts(attempts uint) *core.MaxAttemptsOption {
+	return &core.MaxAttemptsOption{
+		MaxAttempts: attempts,
+	}
+}
+
+// WithRequestCompression compresses the request body with the given encoding
+// (i.e. gzip, deflate, or zstd) if it's larger than 1KB, and sets the
+// Content-Encoding header accordingly.
+func WithRequestCompression(encoding string) *core.RequestCompressionOption {
+	return &core.RequestCompressionOption{
+		RequestCompression: encoding,
+	}
+}
+
+// WithTimeout bounds the request by the given timeout, which covers every
+// retry attempt and reading the response. Streaming requests are only
+// bounded until the stream is established. A *core.TimeoutError is
+// returned if the timeout is exceeded.
+func WithTimeout(timeout time.Duration) *core.TimeoutOption {
+	return &core.TimeoutOption{
+		Timeout: timeout,
+	}
+}
+
+// WithAttemptTimeout bounds each of the request's attempts by the given
+// timeout. An attempt that exceeds it is retried, as long as the maximum
+// number of attempts hasn't been reached.
+func WithAttemptTimeout(timeout time.Duration) *core.AttemptTimeoutOption {
+	return &core.AttemptTimeoutOption{
+		AttemptTimeout: timeout,
+	}
+}
+
+// WithQueryParameters adds the given query parameters to the request's URL,
+// overriding any parameters of the same name. This is useful for parameters
+// that aren't supported by the SDK yet.
+func WithQueryParameters(queryParameters url.Values) *core.QueryParametersOption {
+	// Copy the parameters so they can't be modified after the option call.
+	copied := make(url.Values, len(queryParameters))
+	for key, values := range queryParameters {
+		copied[key] = append([]string(nil), values...)
+	}
+	return &core.QueryParametersOption{
+		QueryParameters: copied,
+	}
+}
+
+// WithBodyProperties adds the given properties to the request's JSON body,
+// overriding any properties of the same name. This is useful for properties
+// that aren't supported by the SDK yet.
+func WithBodyProperties(bodyProperties map[string]interface{}) *core.BodyPropertiesOption {
+	// Copy the properties so they can't be modified after the option call.
+	copied := make(map[string]interface{}, len(bodyProperties))
+	for key, value := range bodyProperties {
+		copied[key] = value
+	}
+	return &core.BodyPropertiesOption{
+		BodyProperties: copied,
+	}
+}
+
+// WithCache stores the responses of GET endpoints in the given cache (e.g.
+// core.NewLRUCache). A fresh response is served without contacting the server,
+// and a stale one is revalidated with the If-None-Match and If-Modified-Since
+// headers, so that a 304 (Not Modified) response is served from the cache.
+func WithCache(cache core.Cache) *core.CacheOption {
+	return &core.CacheOption{
+		Cache: cache,
+	}
+}
+
+// WithValidation validates the request (e.g. its required fields and
+// enum values) before it's sent.
+func WithValidation() *core.ValidationOption {
+	return &core.ValidationOption{
+		Validation: true,
+	}
+}
+
+// WithRateLimiter will provide a rate limiter for the client.
+func WithRateLimiter(rateLimiter *core.RateLimiter) *core.RateLimiterOption {
+	return &core.RateLimiterOption{
+		RateLimiter: rateLimiter,
+	}
+}
@@ -0,0 +1,310 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/availability-exclude/fixtures/core"
+)
+
+type SetNameRequestV3Optional struct {
+	XEndpointHeader string                `json:"-"`
+	Body            *SetNameRequestV3Body `json:"-"`
+}
+
+// Validate reports all of the SetNameRequestV3Optional's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV3Optional) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.Body == nil {
+		validation.Required("body")
+	}
+	validation.Add("body", s.Body.Validate())
+	return validation.Err()
+}
+
+func (s *SetNameRequestV3Optional) UnmarshalJSON(data []byte) error {
+	body := new(SetNameRequestV3Body)
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV3Optional) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Body)
+}
+
+type SetNameRequestV4 struct {
+	// Deprecated: This may be removed in a future release.
+	XEndpointHeader string   `json:"-"`
+	Body            []string `json:"-"`
+}
+
+// Validate reports all of the SetNameRequestV4's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV4) Validate() error {
+	return nil
+}
+
+func (s *SetNameRequestV4) UnmarshalJSON(data []byte) error {
+	var body []string
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV4) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Body)
+}
+
+type SetNameRequestV5 struct {
+	XEndpointHeader string `json:"-"`
+	Body            string `json:"-"`
+}
+
+// Validate reports all of the SetNameRequestV5's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV5) Validate() error {
+	return nil
+}
+
+func (s *SetNameRequestV5) UnmarshalJSON(data []byte) error {
+	var body string
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	if body != "fern" {
+		return fmt.Errorf("expected literal %q, but found %q", "fern", body)
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV5) MarshalJSON() ([]byte, error) {
+	return json.Marshal("fern")
+}
+
+// Filters the users.
+//
+// Deprecated: This may be removed in a future release.
+type Filter struct {
+	Tag string `json:"tag" url:"tag"`
+
+	_rawJSON json.RawMessage
+}
+
+func (f *Filter) UnmarshalJSON(data []byte) error {
+	type unmarshaler Filter
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Filter(value)
+	f._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (f *Filter) String() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Validate reports all of the Filter's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Filter) Validate() error {
+	return nil
+}
+
+type SetNameRequestV3Body struct {
+	// Deprecated: Use the path parameter instead.
+	UserName string `json:"userName" url:"userName"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SetNameRequestV3Body) UnmarshalJSON(data []byte) error {
+	type unmarshaler SetNameRequestV3Body
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SetNameRequestV3Body(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SetNameRequestV3Body) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+// Validate reports all of the SetNameRequestV3Body's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV3Body) Validate() error {
+	return nil
+}
+
+type Union struct {
+	Type string
+	Foo  *Foo
+	Bar  *Bar
+
+	_unknown json.RawMessage
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Foo = value
+	case "bar":
+		value := new(Bar)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Bar = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Foo
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Bar
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
+type UpdateRequest struct {
+	Tag string `json:"-" url:"tag"`
+	// Deprecated: This may be removed in a future release.
+	Extra          *string `json:"-" url:"extra,omitempty"`
+	Union          *Union  `json:"union,omitempty"`
+	Filter         *Filter `json:"filter,omitempty"`
+	OptionalUnion  *Union  `json:"optionalUnion,omitempty"`
+	OptionalFilter *Filter `json:"optionalFilter,omitempty"`
+}
+
+// Validate reports all of the UpdateRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UpdateRequest) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	if u.Union == nil {
+		validation.Required("union")
+	}
+	validation.Add("union", u.Union.Validate())
+	if u.Filter == nil {
+		validation.Required("filter")
+	}
+	validation.Add("filter", u.Filter.Validate())
+	validation.Add("optionalUnion", u.OptionalUnion.Validate())
+	validation.Add("optionalFilter", u.OptionalFilter.Validate())
+	return validation.Err()
+}
@@ -3,9 +3,13 @@
 package client
 
 import (
+	context "context"
+	json "encoding/json"
 	option "github.com/fern-api/fern-go/internal/testdata/sdk/bearer-token-name/fixtures/option"
 	assert "github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
 	http "net/http"
+	httptest "net/http/httptest"
 	testing "testing"
 	time "time"
 )
@@ -43,3 +47,40 @@ func TestNewClient(t *testing.T) {
 		assert.Equal(t, "test", c.header.Get("X-API-Tenancy"))
 	})
 }
+
+func TestClientDo(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/users/123", r.URL.Path)
+				assert.Equal(t, "test", r.Header.Get("X-API-Tenancy"))
+
+				var request map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+				assert.Equal(t, map[string]interface{}{"name": "fern"}, request)
+
+				_, err := w.Write([]byte(`{"id":"123"}`))
+				require.NoError(t, err)
+			},
+		),
+	)
+	defer server.Close()
+
+	header := make(http.Header)
+	header.Set("X-API-Tenancy", "test")
+	c := NewClient(
+		option.WithBaseURL(server.URL+"/"),
+		option.WithHTTPHeader(header),
+	)
+	var response map[string]interface{}
+	err := c.Do(
+		context.Background(),
+		http.MethodPost,
+		"/users/123",
+		map[string]interface{}{"name": "fern"},
+		&response,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "123"}, response)
+}
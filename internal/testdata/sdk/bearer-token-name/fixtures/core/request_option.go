@@ -4,6 +4,8 @@ package core
 
 import (
 	http "net/http"
+	url "net/url"
+	time "time"
 )
 
 // RequestOption adapts the behavior of the client or an individual request.
@@ -16,11 +18,19 @@ type RequestOption interface {
 // This type is primarily used by the generated code and is not meant
 // to be used directly; use the option package instead.
 type RequestOptions struct {
-	BaseURL     string
-	HTTPClient  HTTPClient
-	HTTPHeader  http.Header
-	MaxAttempts uint
-	ApiKey      string
+	BaseURL            string
+	HTTPClient         HTTPClient
+	HTTPHeader         http.Header
+	MaxAttempts        uint
+	RequestCompression string
+	Timeout            time.Duration
+	AttemptTimeout     time.Duration
+	QueryParameters    url.Values
+	BodyProperties     map[string]interface{}
+	Cache              Cache
+	Validation         bool
+	ApiKey             string
+	RateLimiter        *RateLimiter
 }
 
 // NewRequestOptions returns a new *RequestOptions value.
@@ -87,6 +97,69 @@ func (m *MaxAttemptsOption) applyRequestOptions(opts *RequestOptions) {
 	opts.MaxAttempts = m.MaxAttempts
 }
 
+// RequestCompressionOption implements the RequestOption interface.
+type RequestCompressionOption struct {
+	RequestCompression string
+}
+
+func (r *RequestCompressionOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RequestCompression = r.RequestCompression
+}
+
+// TimeoutOption implements the RequestOption interface.
+type TimeoutOption struct {
+	Timeout time.Duration
+}
+
+func (t *TimeoutOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Timeout = t.Timeout
+}
+
+// AttemptTimeoutOption implements the RequestOption interface.
+type AttemptTimeoutOption struct {
+	AttemptTimeout time.Duration
+}
+
+func (a *AttemptTimeoutOption) applyRequestOptions(opts *RequestOptions) {
+	opts.AttemptTimeout = a.AttemptTimeout
+}
+
+// QueryParametersOption implements the RequestOption interface.
+type QueryParametersOption struct {
+	QueryParameters url.Values
+}
+
+func (q *QueryParametersOption) applyRequestOptions(opts *RequestOptions) {
+	opts.QueryParameters = q.QueryParameters
+}
+
+// BodyPropertiesOption implements the RequestOption interface.
+type BodyPropertiesOption struct {
+	BodyProperties map[string]interface{}
+}
+
+func (b *BodyPropertiesOption) applyRequestOptions(opts *RequestOptions) {
+	opts.BodyProperties = b.BodyProperties
+}
+
+// CacheOption implements the RequestOption interface.
+type CacheOption struct {
+	Cache Cache
+}
+
+func (c *CacheOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Cache = c.Cache
+}
+
+// ValidationOption implements the RequestOption interface.
+type ValidationOption struct {
+	Validation bool
+}
+
+func (v *ValidationOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Validation = v.Validation
+}
+
 // ApiKeyOption implements the RequestOption interface.
 type ApiKeyOption struct {
 	ApiKey string
@@ -95,3 +168,12 @@ type ApiKeyOption struct {
 func (a *ApiKeyOption) applyRequestOptions(opts *RequestOptions) {
 	opts.ApiKey = a.ApiKey
 }
+
+// RateLimiterOption implements the RequestOption interface.
+type RateLimiterOption struct {
+	RateLimiter *RateLimiter
+}
+
+func (r *RateLimiterOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RateLimiter = r.RateLimiter
+}
@@ -10,9 +10,10 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -21,11 +22,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -50,12 +59,17 @@ func (c *Client) Get(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user.get",
 		},
 	); err != nil {
 		return "", err
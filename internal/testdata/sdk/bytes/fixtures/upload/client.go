@@ -14,9 +14,10 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -25,11 +26,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -48,7 +57,7 @@ func (c *Client) Upload(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"upload/%v", id)
+	endpointURL := core.EncodeURL(baseURL+"/"+"upload/%v", id)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 	headers.Set("Content-Type", "application/custom-format")
@@ -59,13 +68,18 @@ func (c *Client) Upload(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodPost,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Request:     requestBuffer,
-			Response:    &response,
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            requestBuffer,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
 		},
 	); err != nil {
 		return false, err
@@ -88,7 +102,7 @@ func (c *Client) UploadOptional(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"upload/optional/%v", id)
+	endpointURL := core.EncodeURL(baseURL+"/"+"upload/optional/%v", id)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 	headers.Set("Content-Type", "application/custom-format")
@@ -102,13 +116,18 @@ func (c *Client) UploadOptional(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodPost,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Request:     requestBuffer,
-			Response:    &response,
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            requestBuffer,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
 		},
 	); err != nil {
 		return false, err
@@ -124,6 +143,12 @@ func (c *Client) UploadWithHeader(
 ) (bool, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return false, err
+		}
+	}
+
 	baseURL := ""
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -131,7 +156,7 @@ func (c *Client) UploadWithHeader(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"upload_with_header/%v", id)
+	endpointURL := core.EncodeURL(baseURL+"/"+"upload_with_header/%v", id)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 	headers.Add("X-Upload-File-Size", fmt.Sprintf("%v", request.XUploadFileSize))
@@ -143,13 +168,18 @@ func (c *Client) UploadWithHeader(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodPost,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Request:     requestBuffer,
-			Response:    &response,
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            requestBuffer,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
 		},
 	); err != nil {
 		return false, err
@@ -165,6 +195,12 @@ func (c *Client) UploadOptionalWithHeader(
 ) (bool, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return false, err
+		}
+	}
+
 	baseURL := ""
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -172,7 +208,7 @@ func (c *Client) UploadOptionalWithHeader(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"upload_with_header/optional/%v", id)
+	endpointURL := core.EncodeURL(baseURL+"/"+"upload_with_header/optional/%v", id)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 	headers.Add("X-Upload-File-Size", fmt.Sprintf("%v", request.XUploadFileSize))
@@ -187,13 +223,18 @@ func (c *Client) UploadOptionalWithHeader(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodPost,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Request:     requestBuffer,
-			Response:    &response,
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            requestBuffer,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
 		},
 	); err != nil {
 		return false, err
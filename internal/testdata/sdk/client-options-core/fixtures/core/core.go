@@ -7,14 +7,29 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
+	"math"
+	"mime"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
 	// contentType specifies the JSON Content-Type header value.
 	contentType       = "application/json"
 	contentTypeHeader = "Content-Type"
+
+	// formContentType specifies the form-urlencoded Content-Type header value.
+	formContentType = "application/x-www-form-urlencoded"
+
+	// ndjsonContentType specifies the newline-delimited JSON Content-Type header value.
+	ndjsonContentType = "application/x-ndjson"
 )
 
 // HTTPClient is an interface for a subset of the *http.Client.
@@ -37,6 +52,36 @@ func MergeHeaders(left, right http.Header) http.Header {
 	return left
 }
 
+// EncodeURL replaces each %v in the given URL template with the corresponding
+// path parameter, which is escaped so that it's always a single path segment
+// (e.g. "a/b" is encoded as "a%2Fb"). Values that need a specific format (e.g.
+// dates) should already be formatted as a string.
+func EncodeURL(template string, params ...interface{}) string {
+	segments := strings.Split(template, "%v")
+	var builder strings.Builder
+	for i, segment := range segments {
+		builder.WriteString(segment)
+		if i < len(params) && i < len(segments)-1 {
+			builder.WriteString(escapePathParameter(fmt.Sprint(params[i])))
+		}
+	}
+	return builder.String()
+}
+
+// escapePathParameter escapes the given path parameter. The dot segments (i.e.
+// "." and "..") are escaped as well, because url.PathEscape leaves them as-is,
+// and they would otherwise be removed (along with the preceding segment) when
+// the URL is resolved.
+func escapePathParameter(value string) string {
+	switch value {
+	case ".":
+		return "%2E"
+	case "..":
+		return "%2E%2E"
+	}
+	return url.PathEscape(value)
+}
+
 // WriteMultipartJSON writes the given value as a JSON part.
 // This is used to serialize non-primitive multipart properties
 // (i.e. lists, objects, etc).
@@ -48,63 +93,85 @@ func WriteMultipartJSON(writer *multipart.Writer, field string, value interface{
 	return writer.WriteField(field, string(bytes))
 }
 
-// APIError is a lightweight wrapper around the standard error
-// interface that preserves the status code from the RPC, if any.
-type APIError struct {
-	err error
+// ErrorDecoder decodes *http.Response errors and returns a
+// typed API error (e.g. *APIError).
+type ErrorDecoder func(statusCode int, body io.Reader) error
 
-	StatusCode int `json:"-"`
+type RateLimiter struct {
+	mutex sync.Mutex
+	// TODO: replace this with a wait until...
+	wait bool
 }
 
-// NewAPIError constructs a new API error.
-func NewAPIError(statusCode int, err error) *APIError {
-	return &APIError{
-		err:        err,
-		StatusCode: statusCode,
-	}
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{}
 }
 
-// Unwrap returns the underlying error. This also makes the error compatible
-// with errors.As and errors.Is.
-func (a *APIError) Unwrap() error {
-	if a == nil {
-		return nil
+func (r *RateLimiter) Block() {
+	// return early if already blocked
+	if r == nil || r.wait {
+		return
 	}
-	return a.err
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.wait = true
 }
 
-// Error returns the API error's message.
-func (a *APIError) Error() string {
-	if a == nil || (a.err == nil && a.StatusCode == 0) {
-		return ""
-	}
-	if a.err == nil {
-		return fmt.Sprintf("%d", a.StatusCode)
+func (r *RateLimiter) UnBlock() {
+	// return early if already unblocked
+	if r == nil || !r.wait {
+		return
 	}
-	if a.StatusCode == 0 {
-		return a.err.Error()
-	}
-	return fmt.Sprintf("%d: %s", a.StatusCode, a.err.Error())
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.wait = false
 }
 
-// ErrorDecoder decodes *http.Response errors and returns a
-// typed API error (e.g. *APIError).
-type ErrorDecoder func(statusCode int, body io.Reader) error
+func (r *RateLimiter) Wait() {
+	if r != nil {
+		for {
+			r.mutex.Lock()
+			if r.wait {
+				r.mutex.Unlock()
+				log.Println("Waiting for rate limit to reset")
+				time.Sleep(time.Second)
+			} else {
+				r.mutex.Unlock()
+				return
+			}
+		}
+	}
+}
 
 // Caller calls APIs and deserializes their response, if any.
 type Caller struct {
-	client  HTTPClient
-	retrier *Retrier
+	client             HTTPClient
+	retrier            *Retrier
+	rateLimiter        *RateLimiter
+	requestCompression string
+	timeout            time.Duration
+	attemptTimeout     time.Duration
+	queryParameters    url.Values
+	bodyProperties     map[string]interface{}
+	cache              Cache
 }
 
 // CallerParams represents the parameters used to constrcut a new *Caller.
 type CallerParams struct {
-	Client      HTTPClient
-	MaxAttempts uint
+	Client             HTTPClient
+	MaxAttempts        uint
+	RequestCompression string
+	Timeout            time.Duration
+	AttemptTimeout     time.Duration
+	QueryParameters    url.Values
+	BodyProperties     map[string]interface{}
+	Cache              Cache
 }
 
 // NewCaller returns a new *Caller backed by the given parameters.
-func NewCaller(params *CallerParams) *Caller {
+func NewCaller(params *CallerParams, rateLimiter *RateLimiter) *Caller {
 	var httpClient HTTPClient = http.DefaultClient
 	if params.Client != nil {
 		httpClient = params.Client
@@ -114,8 +181,15 @@ func NewCaller(params *CallerParams) *Caller {
 		retryOptions = append(retryOptions, WithMaxAttempts(params.MaxAttempts))
 	}
 	return &Caller{
-		client:  httpClient,
-		retrier: NewRetrier(retryOptions...),
+		client:             httpClient,
+		retrier:            NewRetrier(retryOptions...),
+		rateLimiter:        rateLimiter,
+		requestCompression: params.RequestCompression,
+		timeout:            params.Timeout,
+		attemptTimeout:     params.AttemptTimeout,
+		queryParameters:    params.QueryParameters,
+		bodyProperties:     params.BodyProperties,
+		cache:              params.Cache,
 	}
 }
 
@@ -130,11 +204,54 @@ type CallParams struct {
 	Response           interface{}
 	ResponseIsOptional bool
 	ErrorDecoder       ErrorDecoder
+	RequestCompression string
+	Timeout            time.Duration
+	AttemptTimeout     time.Duration
+	QueryParameters    url.Values
+	BodyProperties     map[string]interface{}
+	Cache              Cache
+
+	// CacheScope identifies the endpoint whose response is cached, if a
+	// cache is configured. Responses are only cached for the endpoints that
+	// have a scope (i.e. GET endpoints).
+	CacheScope string
 }
 
 // Call issues an API call according to the given call parameters.
+//
+// The call is bounded by its timeout, if any, which covers every attempt and
+// reading the response. A *TimeoutError is returned if it's exceeded, whereas
+// the context's error is returned if it's cancelled.
 func (c *Caller) Call(ctx context.Context, params *CallParams) error {
-	req, err := newRequest(ctx, params.URL, params.Method, params.Headers, params.Request)
+	timeout := c.timeout
+	if params.Timeout > 0 {
+		// Use the timeout scoped to the request.
+		timeout = params.Timeout
+	}
+	ctx, callTimeout := newTimeout(ctx, timeout, false)
+	defer callTimeout.release()
+	return callTimeout.wrap(c.call(ctx, params))
+}
+
+// call issues an API call according to the given call parameters, without
+// regard for the call's timeout.
+func (c *Caller) call(ctx context.Context, params *CallParams) error {
+	requestCompression := c.requestCompression
+	if params.RequestCompression != "" {
+		// Use the compression scoped to the request.
+		requestCompression = params.RequestCompression
+	}
+	req, err := newRequest(
+		ctx,
+		params.URL,
+		params.Method,
+		params.Headers,
+		params.Request,
+		mergeQueryParameters(c.queryParameters, params.QueryParameters),
+		c.bodyProperties,
+		params.BodyProperties,
+		requestCompression,
+	)
 	if err != nil {
 		return err
 	}
@@ -150,13 +267,28 @@ func (c *Caller) Call(ctx context.Context, params *CallParams) error {
 		client = params.Client
 	}
 
+	attemptTimeout := c.attemptTimeout
+	if params.AttemptTimeout > 0 {
+		// Use the attempt timeout scoped to the request.
+		attemptTimeout = params.AttemptTimeout
+	}
+
+	cache := c.cache
+	if params.Cache != nil {
+		// Use the cache scoped to the request.
+		cache = params.Cache
+	}
+
 	var retryOptions []RetryOption
 	if params.MaxAttempts > 0 {
 		retryOptions = append(retryOptions, WithMaxAttempts(params.MaxAttempts))
 	}
 
+	// Wait for rate limiter if needed
+	c.rateLimiter.Wait()
+
 	resp, err := c.retrier.Run(
-		client.Do,
+		withCache(withAttemptTimeout(withResponseDecompression(client.Do), attemptTimeout, false), cache, params.CacheScope),
 		req,
 		params.ErrorDecoder,
 		retryOptions...,
@@ -174,6 +306,46 @@ func (c *Caller) Call(ctx context.Context, params *CallParams) error {
 		return err
 	}
 
+	// If we get a 429 (Too many requests) response code or 502 and have a rate limiter setup, block other request and retry
+	if c.rateLimiter != nil && (resp.StatusCode == 429 || resp.StatusCode == 502) {
+		// block other requests until we can finish processing this one
+		c.rateLimiter.Block()
+		defer c.rateLimiter.UnBlock()
+
+		attemptLimit := 3
+		var attemptCount int
+		for resp.StatusCode == 429 || resp.StatusCode == 502 {
+			// close the previous response body, the defer will catch whatever we are left with after looping
+			resp.Body.Close()
+			var sleepTime int
+			if resp.StatusCode == 502 {
+				sleepTime = 30
+			} else if sleepTimeStr := resp.Header.Get("Retry-After"); sleepTimeStr != "" {
+				// Ideally we will have a "Retry-After" header to tell us how long to wait if it is a 429
+				sleepTime, err = strconv.Atoi(sleepTimeStr)
+				if err != nil {
+					return fmt.Errorf("found a 'Retry-After' header and atttempted to parse it to an integer but failed. err: %v", err)
+				}
+			} else {
+				// Without a header we will just do an exponential backoff
+				if attemptCount > attemptLimit {
+					// Give up after we hit the attempt limit
+					break
+				}
+				attemptCount++
+				sleepTime = int(math.Pow(2, float64(attemptCount)))
+			}
+			log.Printf("Waiting %vs for rate limit to recover...", sleepTime)
+			time.Sleep(time.Duration(sleepTime) * time.Second)
+
+			// re-make the request
+			resp, err = withAttemptTimeout(withResponseDecompression(c.client.Do), attemptTimeout, false)(req)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return decodeError(resp, params.ErrorDecoder)
 	}
@@ -202,15 +374,37 @@ func (c *Caller) Call(ctx context.Context, params *CallParams) error {
 }
 
 // newRequest returns a new *http.Request with all of the fields
-// required to issue the call.
+// required to issue the call. The given query parameters and body
+// properties (e.g. option.WithQueryParameters) are merged into the
+// URL and request body, and the request body is compressed with the
+// given encoding (e.g. gzip), if any.
 func newRequest(
 	ctx context.Context,
 	url string,
 	method string,
 	endpointHeaders http.Header,
 	request interface{},
+	queryParameters url.Values,
+	clientBodyProperties map[string]interface{},
+	requestBodyProperties map[string]interface{},
+	requestCompression string,
 ) (*http.Request, error) {
-	requestBody, err := newRequestBody(request)
+	url, err := withQueryParameters(url, queryParameters)
+	if err != nil {
+		return nil, err
+	}
+	// The endpoint's Content-Type (e.g. application/x-www-form-urlencoded)
+	// determines how the request is encoded.
+	requestBody, err := newRequestBody(
+		request,
+		endpointHeaders.Get(contentTypeHeader),
+		clientBodyProperties,
+		requestBodyProperties,
+	)
+	if err != nil {
+		return nil, err
+	}
+	requestBody, compressed, err := compressRequestBody(requestBody, requestCompression)
 	if err != nil {
 		return nil, err
 	}
@@ -219,19 +413,67 @@ func newRequest(
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	req.Header.Set(contentTypeHeader, contentType)
+	if requestBody != nil {
+		// Requests without a body (e.g. most GET and DELETE requests) don't
+		// specify a Content-Type.
+		req.Header.Set(contentTypeHeader, contentType)
+	}
 	for name, values := range endpointHeaders {
 		req.Header[name] = values
 	}
+	if compressed {
+		req.Header.Set(contentEncodingHeader, requestCompression)
+	}
 	return req, nil
 }
 
-// newRequestBody returns a new io.Reader that represents the HTTP request body.
-func newRequestBody(request interface{}) (io.Reader, error) {
+// newRequestBody returns a new io.Reader that represents the HTTP request body,
+// which is encoded according to the given Content-Type. Form-urlencoded requests
+// are encoded with FormValues, newline-delimited JSON requests are encoded with
+// a line for each of the list's elements, and every other request (e.g.
+// application/json, application/merge-patch+json, or a vendor-specific JSON
+// type) is encoded as JSON.
+//
+// The given body properties, if any, are merged into the JSON object, where the
+// request's properties take precedence over the client's. The client's properties
+// are skipped for the requests they can't be merged into (e.g. requests without a
+// body, file uploads, or form-urlencoded bodies), since they apply to every
+// request, whereas the request's own properties are rejected with an error.
+func newRequestBody(
+	request interface{},
+	requestContentType string,
+	clientBodyProperties map[string]interface{},
+	requestBodyProperties map[string]interface{},
+) (io.Reader, error) {
+	if !hasJSONBody(request, requestContentType) {
+		if len(requestBodyProperties) > 0 {
+			return nil, errors.New("body properties can only be set for JSON request bodies")
+		}
+		clientBodyProperties = nil
+	}
+	if len(clientBodyProperties) > 0 || len(requestBodyProperties) > 0 {
+		requestBytes, err := marshalWithBodyProperties(request, clientBodyProperties, requestBodyProperties)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(requestBytes), nil
+	}
 	var requestBody io.Reader
 	if request != nil {
 		if body, ok := request.(io.Reader); ok {
 			requestBody = body
+		} else if isFormContentType(requestContentType) {
+			values, err := FormValues(request)
+			if err != nil {
+				return nil, err
+			}
+			requestBody = strings.NewReader(values.Encode())
+		} else if mediaTypeOf(requestContentType) == ndjsonContentType {
+			requestBytes, err := marshalNDJSON(request)
+			if err != nil {
+				return nil, err
+			}
+			requestBody = bytes.NewReader(requestBytes)
 		} else {
 			requestBytes, err := json.Marshal(request)
 			if err != nil {
@@ -243,6 +485,124 @@ func newRequestBody(request interface{}) (io.Reader, error) {
 	return requestBody, nil
 }
 
+// hasJSONBody returns true if the given request is sent as a JSON body, which
+// is the only kind of body that body properties can be merged into.
+func hasJSONBody(request interface{}, requestContentType string) bool {
+	if request == nil {
+		return false
+	}
+	if _, isReader := request.(io.Reader); isReader {
+		return false
+	}
+	return !isFormContentType(requestContentType) && mediaTypeOf(requestContentType) != ndjsonContentType
+}
+
+// marshalWithBodyProperties marshals the given request as a JSON object that
+// includes the given body properties, which take precedence over the request's
+// own properties. The request's body properties take precedence over the
+// client's, which are skipped if the request isn't a JSON object (e.g. a list).
+func marshalWithBodyProperties(
+	request interface{},
+	clientBodyProperties map[string]interface{},
+	requestBodyProperties map[string]interface{},
+) ([]byte, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(requestBytes, &object); err != nil {
+		if len(requestBodyProperties) == 0 {
+			return requestBytes, nil
+		}
+		return nil, fmt.Errorf("body properties can only be set for JSON object request bodies, but the request is a %T", request)
+	}
+	bodyProperties := mergeBodyProperties(clientBodyProperties, requestBodyProperties)
+	if object == nil {
+		// The request is null (e.g. an optional request that isn't set).
+		object = make(map[string]json.RawMessage, len(bodyProperties))
+	}
+	for key, value := range bodyProperties {
+		valueBytes, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		object[key] = valueBytes
+	}
+	return json.Marshal(object)
+}
+
+// mergeQueryParameters returns the union of the client's and the request's query
+// parameters, where the request's parameters take precedence.
+func mergeQueryParameters(clientParameters url.Values, requestParameters url.Values) url.Values {
+	if len(clientParameters) == 0 {
+		return requestParameters
+	}
+	merged := make(url.Values, len(clientParameters)+len(requestParameters))
+	for key, values := range clientParameters {
+		merged[key] = values
+	}
+	for key, values := range requestParameters {
+		merged[key] = values
+	}
+	return merged
+}
+
+// mergeBodyProperties returns the union of the client's and the request's body
+// properties, where the request's properties take precedence.
+func mergeBodyProperties(clientProperties map[string]interface{}, requestProperties map[string]interface{}) map[string]interface{} {
+	if len(clientProperties) == 0 {
+		return requestProperties
+	}
+	merged := make(map[string]interface{}, len(clientProperties)+len(requestProperties))
+	for key, value := range clientProperties {
+		merged[key] = value
+	}
+	for key, value := range requestProperties {
+		merged[key] = value
+	}
+	return merged
+}
+
+// isFormContentType returns true if the given Content-Type is form-urlencoded,
+// ignoring any parameters (e.g. charset=utf-8).
+func isFormContentType(requestContentType string) bool {
+	return mediaTypeOf(requestContentType) == formContentType
+}
+
+// mediaTypeOf returns the media type of the given Content-Type, without any
+// parameters (e.g. charset=utf-8).
+func mediaTypeOf(requestContentType string) string {
+	mediaType, _, err := mime.ParseMediaType(requestContentType)
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// marshalNDJSON encodes each of the given list's elements as a separate line of
+// JSON. Any other value is encoded as a single line.
+func marshalNDJSON(request interface{}) ([]byte, error) {
+	value := reflect.ValueOf(request)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	buffer := bytes.NewBuffer(nil)
+	encoder := json.NewEncoder(buffer)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		if err := encoder.Encode(request); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	}
+	for i := 0; i < value.Len(); i++ {
+		if err := encoder.Encode(value.Index(i).Interface()); err != nil {
+			return nil, err
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
 // decodeError decodes the error from the given HTTP response. Note that
 // it's the caller's responsibility to close the response body.
 func decodeError(response *http.Response, errorDecoder ErrorDecoder) error {
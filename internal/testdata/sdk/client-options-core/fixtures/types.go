@@ -9,7 +9,7 @@ import (
 )
 
 type WithAuthToken struct {
-	Value string `json:"value"`
+	Value string `json:"value" url:"value"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (w *WithAuthToken) String() string {
 	}
 	return fmt.Sprintf("%#v", w)
 }
+
+// Validate reports all of the WithAuthToken's invalid fields (e.g. missing
+// required fields), if any.
+func (w *WithAuthToken) Validate() error {
+	return nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type ClientOptions struct {
-	Value string `json:"value"`
+	Value string `json:"value" url:"value"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (c *ClientOptions) String() string {
 	}
 	return fmt.Sprintf("%#v", c)
 }
+
+// Validate reports all of the ClientOptions's invalid fields (e.g. missing
+// required fields), if any.
+func (c *ClientOptions) Validate() error {
+	return nil
+}
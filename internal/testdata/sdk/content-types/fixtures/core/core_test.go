@@ -0,0 +1,576 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCase represents a single test case.
+type TestCase struct {
+	description string
+
+	// Server-side assertions.
+	giveMethod             string
+	giveResponseIsOptional bool
+	giveHeader             http.Header
+	giveErrorDecoder       ErrorDecoder
+	giveRequest            *Request
+
+	// Client-side assertions.
+	wantResponse *Response
+	wantError    error
+}
+
+// Request a simple request body.
+type Request struct {
+	Id string `json:"id"`
+}
+
+// Response a simple response body.
+type Response struct {
+	Id string `json:"id"`
+}
+
+// NotFoundError represents a 404.
+type NotFoundError struct {
+	*APIError
+
+	Message string `json:"message"`
+}
+
+func TestCall(t *testing.T) {
+	tests := []*TestCase{
+		{
+			description: "GET success",
+			giveMethod:  http.MethodGet,
+			giveHeader: http.Header{
+				"X-API-Status": []string{"success"},
+			},
+			giveRequest: &Request{
+				Id: "123",
+			},
+			wantResponse: &Response{
+				Id: "123",
+			},
+		},
+		{
+			description: "GET not found",
+			giveMethod:  http.MethodGet,
+			giveHeader: http.Header{
+				"X-API-Status": []string{"fail"},
+			},
+			giveRequest: &Request{
+				Id: strconv.Itoa(http.StatusNotFound),
+			},
+			giveErrorDecoder: newTestErrorDecoder(t),
+			wantError: &NotFoundError{
+				APIError: NewAPIError(
+					http.StatusNotFound,
+					errors.New(`{"message":"ID \"404\" not found"}`),
+				),
+			},
+		},
+		{
+			description: "POST optional response",
+			giveMethod:  http.MethodPost,
+			giveHeader: http.Header{
+				"X-API-Status": []string{"success"},
+			},
+			giveRequest: &Request{
+				Id: "123",
+			},
+			giveResponseIsOptional: true,
+		},
+		{
+			description: "POST API error",
+			giveMethod:  http.MethodPost,
+			giveHeader: http.Header{
+				"X-API-Status": []string{"fail"},
+			},
+			giveRequest: &Request{
+				Id: strconv.Itoa(http.StatusInternalServerError),
+			},
+			wantError: NewAPIError(
+				http.StatusInternalServerError,
+				errors.New("failed to process request"),
+			),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			var (
+				server = newTestServer(t, test)
+				client = server.Client()
+			)
+			caller := NewCaller(
+				&CallerParams{
+					Client: client,
+				},
+				nil,
+			)
+			var response *Response
+			err := caller.Call(
+				context.Background(),
+				&CallParams{
+					URL:                server.URL,
+					Method:             test.giveMethod,
+					Headers:            test.giveHeader,
+					Request:            test.giveRequest,
+					Response:           &response,
+					ResponseIsOptional: test.giveResponseIsOptional,
+					ErrorDecoder:       test.giveErrorDecoder,
+				},
+			)
+			if test.wantError != nil {
+				assert.EqualError(t, err, test.wantError.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.wantResponse, response)
+		})
+	}
+}
+
+func TestMergeHeaders(t *testing.T) {
+	t.Run("both empty", func(t *testing.T) {
+		merged := MergeHeaders(make(http.Header), make(http.Header))
+		assert.Empty(t, merged)
+	})
+
+	t.Run("empty left", func(t *testing.T) {
+		left := make(http.Header)
+
+		right := make(http.Header)
+		right.Set("X-API-Version", "0.0.1")
+
+		merged := MergeHeaders(left, right)
+		assert.Equal(t, "0.0.1", merged.Get("X-API-Version"))
+	})
+
+	t.Run("empty right", func(t *testing.T) {
+		left := make(http.Header)
+		left.Set("X-API-Version", "0.0.1")
+
+		right := make(http.Header)
+
+		merged := MergeHeaders(left, right)
+		assert.Equal(t, "0.0.1", merged.Get("X-API-Version"))
+	})
+
+	t.Run("single value override", func(t *testing.T) {
+		left := make(http.Header)
+		left.Set("X-API-Version", "0.0.0")
+
+		right := make(http.Header)
+		right.Set("X-API-Version", "0.0.1")
+
+		merged := MergeHeaders(left, right)
+		assert.Equal(t, []string{"0.0.1"}, merged.Values("X-API-Version"))
+	})
+
+	t.Run("multiple value override", func(t *testing.T) {
+		left := make(http.Header)
+		left.Set("X-API-Versions", "0.0.0")
+
+		right := make(http.Header)
+		right.Add("X-API-Versions", "0.0.1")
+		right.Add("X-API-Versions", "0.0.2")
+
+		merged := MergeHeaders(left, right)
+		assert.Equal(t, []string{"0.0.1", "0.0.2"}, merged.Values("X-API-Versions"))
+	})
+
+	t.Run("disjoint merge", func(t *testing.T) {
+		left := make(http.Header)
+		left.Set("X-API-Tenancy", "test")
+
+		right := make(http.Header)
+		right.Set("X-API-Version", "0.0.1")
+
+		merged := MergeHeaders(left, right)
+		assert.Equal(t, []string{"test"}, merged.Values("X-API-Tenancy"))
+		assert.Equal(t, []string{"0.0.1"}, merged.Values("X-API-Version"))
+	})
+}
+
+// newTestServer returns a new *httptest.Server configured with the
+// given test parameters.
+func newTestServer(t *testing.T, tc *TestCase) *httptest.Server {
+	return httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.giveMethod, r.Method)
+				assert.Equal(t, contentType, r.Header.Get(contentTypeHeader))
+				for header, value := range tc.giveHeader {
+					assert.Equal(t, value, r.Header.Values(header))
+				}
+
+				bytes, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				request := new(Request)
+				require.NoError(t, json.Unmarshal(bytes, request))
+
+				switch request.Id {
+				case strconv.Itoa(http.StatusNotFound):
+					notFoundError := &NotFoundError{
+						APIError: &APIError{
+							StatusCode: http.StatusNotFound,
+						},
+						Message: fmt.Sprintf("ID %q not found", request.Id),
+					}
+					bytes, err = json.Marshal(notFoundError)
+					require.NoError(t, err)
+
+					w.WriteHeader(http.StatusNotFound)
+					_, err = w.Write(bytes)
+					require.NoError(t, err)
+					return
+
+				case strconv.Itoa(http.StatusInternalServerError):
+					w.WriteHeader(http.StatusInternalServerError)
+					_, err = w.Write([]byte("failed to process request"))
+					require.NoError(t, err)
+					return
+				}
+
+				if tc.giveResponseIsOptional {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+
+				response := &Response{
+					Id: request.Id,
+				}
+				bytes, err = json.Marshal(response)
+				require.NoError(t, err)
+
+				_, err = w.Write(bytes)
+				require.NoError(t, err)
+			},
+		),
+	)
+}
+
+// newTestErrorDecoder returns an error decoder suitable for tests.
+func newTestErrorDecoder(t *testing.T) func(int, io.Reader) error {
+	return func(statusCode int, body io.Reader) error {
+		raw, err := io.ReadAll(body)
+		require.NoError(t, err)
+
+		var (
+			apiError = NewAPIError(statusCode, errors.New(string(raw)))
+			decoder  = json.NewDecoder(bytes.NewReader(raw))
+		)
+		switch statusCode {
+		case 404:
+			value := new(NotFoundError)
+			value.APIError = apiError
+			require.NoError(t, decoder.Decode(value))
+
+			return value
+		}
+		return apiError
+	}
+}
+
+func TestEncodeURL(t *testing.T) {
+	tests := []struct {
+		desc       string
+		giveURL    string
+		giveParams []interface{}
+		wantURL    string
+	}{
+		{
+			desc:    "no parameters",
+			giveURL: "https://api.example.com/users",
+			wantURL: "https://api.example.com/users",
+		},
+		{
+			desc:       "single parameter",
+			giveURL:    "https://api.example.com/users/%v",
+			giveParams: []interface{}{"fern"},
+			wantURL:    "https://api.example.com/users/fern",
+		},
+		{
+			desc:       "multiple parameters",
+			giveURL:    "https://api.example.com/users/%v/info/%v",
+			giveParams: []interface{}{"fern", 42},
+			wantURL:    "https://api.example.com/users/fern/info/42",
+		},
+		{
+			desc:       "reserved characters",
+			giveURL:    "https://api.example.com/users/%v/info",
+			giveParams: []interface{}{"../a/b?c#d e"},
+			wantURL:    "https://api.example.com/users/..%2Fa%2Fb%3Fc%23d%20e/info",
+		},
+		{
+			desc:       "dot segments",
+			giveURL:    "https://api.example.com/users/%v/info/%v",
+			giveParams: []interface{}{".", ".."},
+			wantURL:    "https://api.example.com/users/%2E/info/%2E%2E",
+		},
+		{
+			desc:       "dots within a segment",
+			giveURL:    "https://api.example.com/users/%v",
+			giveParams: []interface{}{"..."},
+			wantURL:    "https://api.example.com/users/...",
+		},
+		{
+			desc:       "escaped base url",
+			giveURL:    "https://api.example.com/a%20b/users/%v",
+			giveParams: []interface{}{"fern"},
+			wantURL:    "https://api.example.com/a%20b/users/fern",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, test.wantURL, EncodeURL(test.giveURL, test.giveParams...))
+		})
+	}
+}
+
+func TestEncodeURLRequestURI(t *testing.T) {
+	tests := []struct {
+		desc           string
+		giveParams     []interface{}
+		wantRequestURI string
+	}{
+		{
+			desc:           "reserved characters",
+			giveParams:     []interface{}{"a/b?c#d e", "fern"},
+			wantRequestURI: "/users/a%2Fb%3Fc%23d%20e/info/fern",
+		},
+		{
+			desc:           "dot segments",
+			giveParams:     []interface{}{".", ".."},
+			wantRequestURI: "/users/%2E/info/%2E%2E",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			requestURIs := make(chan string, 1)
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						requestURIs <- r.RequestURI
+					},
+				),
+			)
+			defer server.Close()
+
+			req, err := newRequest(
+				context.Background(),
+				EncodeURL(server.URL+"/users/%v/info/%v", test.giveParams...),
+				http.MethodGet,
+				make(http.Header),
+				nil,
+				nil,
+				nil,
+				nil,
+				"",
+			)
+			require.NoError(t, err)
+			resp, err := server.Client().Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.Equal(t, test.wantRequestURI, <-requestURIs)
+		})
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		desc                      string
+		giveMethod                string
+		giveHeader                http.Header
+		giveRequest               interface{}
+		giveQueryParameters       url.Values
+		giveClientBodyProperties  map[string]interface{}
+		giveRequestBodyProperties map[string]interface{}
+		wantURL                   string
+		wantContentType           string
+		wantBody                  string
+	}{
+		{
+			desc:       "without a body",
+			giveMethod: http.MethodGet,
+			giveHeader: make(http.Header),
+		},
+		{
+			desc:            "json",
+			giveMethod:      http.MethodPost,
+			giveHeader:      make(http.Header),
+			giveRequest:     &Request{Id: "123"},
+			wantContentType: contentType,
+			wantBody:        `{"id":"123"}`,
+		},
+		{
+			desc:            "merge patch",
+			giveMethod:      http.MethodPatch,
+			giveHeader:      http.Header{contentTypeHeader: []string{"application/merge-patch+json"}},
+			giveRequest:     &Request{Id: "123"},
+			wantContentType: "application/merge-patch+json",
+			wantBody:        `{"id":"123"}`,
+		},
+		{
+			desc:            "ndjson",
+			giveMethod:      http.MethodPost,
+			giveHeader:      http.Header{contentTypeHeader: []string{"application/x-ndjson"}},
+			giveRequest:     []*Request{{Id: "123"}, {Id: "456"}},
+			wantContentType: "application/x-ndjson",
+			wantBody:        "{\"id\":\"123\"}\n{\"id\":\"456\"}\n",
+		},
+		{
+			desc:       "form",
+			giveMethod: http.MethodPost,
+			giveHeader: http.Header{contentTypeHeader: []string{"application/x-www-form-urlencoded; charset=utf-8"}},
+			giveRequest: map[string]interface{}{
+				"id":     "a b",
+				"tags":   []string{"a", "b"},
+				"filter": map[string]interface{}{"name": "fern"},
+			},
+			wantContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			wantBody:        "filter%5Bname%5D=fern&id=a+b&tags=a&tags=b",
+		},
+		{
+			desc:                "query parameters",
+			giveMethod:          http.MethodGet,
+			giveHeader:          make(http.Header),
+			giveQueryParameters: url.Values{"page": []string{"2"}, "beta": []string{"true"}},
+			wantURL:             "https://api.example.com?beta=true&page=2",
+		},
+		{
+			desc:                      "body properties",
+			giveMethod:                http.MethodPost,
+			giveHeader:                make(http.Header),
+			giveRequest:               &Request{Id: "123"},
+			giveClientBodyProperties:  map[string]interface{}{"beta": false, "env": "test"},
+			giveRequestBodyProperties: map[string]interface{}{"id": "456", "beta": true},
+			wantContentType:           contentType,
+			wantBody:                  `{"beta":true,"env":"test","id":"456"}`,
+		},
+		{
+			desc:                     "client body properties without a body",
+			giveMethod:               http.MethodGet,
+			giveHeader:               make(http.Header),
+			giveClientBodyProperties: map[string]interface{}{"beta": true},
+		},
+		{
+			desc:                     "client body properties with a reader",
+			giveMethod:               http.MethodPost,
+			giveHeader:               make(http.Header),
+			giveRequest:              strings.NewReader("fern"),
+			giveClientBodyProperties: map[string]interface{}{"beta": true},
+			wantContentType:          contentType,
+			wantBody:                 "fern",
+		},
+		{
+			desc:                     "client body properties with a form",
+			giveMethod:               http.MethodPost,
+			giveHeader:               http.Header{contentTypeHeader: []string{"application/x-www-form-urlencoded"}},
+			giveRequest:              map[string]interface{}{"id": "123"},
+			giveClientBodyProperties: map[string]interface{}{"beta": true},
+			wantContentType:          "application/x-www-form-urlencoded",
+			wantBody:                 "id=123",
+		},
+		{
+			desc:                     "client body properties with a list",
+			giveMethod:               http.MethodPost,
+			giveHeader:               make(http.Header),
+			giveRequest:              []string{"fern"},
+			giveClientBodyProperties: map[string]interface{}{"beta": true},
+			wantContentType:          contentType,
+			wantBody:                 `["fern"]`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			req, err := newRequest(
+				context.Background(),
+				"https://api.example.com?page=1",
+				test.giveMethod,
+				test.giveHeader,
+				test.giveRequest,
+				test.giveQueryParameters,
+				test.giveClientBodyProperties,
+				test.giveRequestBodyProperties,
+				"",
+			)
+			require.NoError(t, err)
+			if test.wantURL != "" {
+				assert.Equal(t, test.wantURL, req.URL.String())
+			}
+			assert.Equal(t, test.wantContentType, req.Header.Get(contentTypeHeader))
+			if test.wantBody == "" {
+				assert.Nil(t, req.Body)
+				return
+			}
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantBody, string(body))
+		})
+	}
+}
+
+func TestNewRequestBodyPropertiesError(t *testing.T) {
+	tests := []struct {
+		desc        string
+		giveHeader  http.Header
+		giveRequest interface{}
+		wantError   string
+	}{
+		{
+			desc:       "without a body",
+			giveHeader: make(http.Header),
+			wantError:  "body properties can only be set for JSON request bodies",
+		},
+		{
+			desc:        "reader",
+			giveHeader:  make(http.Header),
+			giveRequest: strings.NewReader("fern"),
+			wantError:   "body properties can only be set for JSON request bodies",
+		},
+		{
+			desc:        "form",
+			giveHeader:  http.Header{contentTypeHeader: []string{"application/x-www-form-urlencoded"}},
+			giveRequest: &Request{Id: "123"},
+			wantError:   "body properties can only be set for JSON request bodies",
+		},
+		{
+			desc:        "list",
+			giveHeader:  make(http.Header),
+			giveRequest: []string{"fern"},
+			wantError:   "body properties can only be set for JSON object request bodies, but the request is a []string",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := newRequest(
+				context.Background(),
+				"https://api.example.com",
+				http.MethodPost,
+				test.giveHeader,
+				test.giveRequest,
+				nil,
+				nil,
+				map[string]interface{}{"beta": true},
+				"",
+			)
+			assert.EqualError(t, err, test.wantError)
+		})
+	}
+}
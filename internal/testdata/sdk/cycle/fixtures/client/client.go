@@ -10,9 +10,10 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 
 	User *userclient.Client
 }
@@ -23,11 +24,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
-		User:   userclient.NewClient(opts...),
+		header:     options.ToHeader(),
+		validation: options.Validation,
+		User:       userclient.NewClient(opts...),
 	}
 }
@@ -9,7 +9,7 @@ import (
 )
 
 type Username struct {
-	Value string `json:"value"`
+	Value string `json:"value" url:"value"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (u *Username) String() string {
 	}
 	return fmt.Sprintf("%#v", u)
 }
+
+// Validate reports all of the Username's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Username) Validate() error {
+	return nil
+}
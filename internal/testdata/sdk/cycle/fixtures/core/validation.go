@@ -0,0 +1,106 @@
+package core
+
+import (
+	"errors"
+	"strings"
+)
+
+// Validator is implemented by all of the generated types that
+// validate their fields (e.g. required fields and enum values).
+type Validator interface {
+	Validate() error
+}
+
+// Validate validates the given value if it implements the Validator
+// interface, and is a no-op otherwise.
+func Validate(value interface{}) error {
+	if validator, ok := value.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// ValidationError describes a single invalid field.
+type ValidationError struct {
+	// Field is the path to the invalid field, e.g. "users[0].name".
+	Field   string
+	Message string
+}
+
+func (v *ValidationError) Error() string {
+	if v.Field == "" {
+		return v.Message
+	}
+	return v.Field + ": " + v.Message
+}
+
+// ValidationErrors aggregates all of the invalid fields found
+// while validating a single value.
+type ValidationErrors []*ValidationError
+
+func (v ValidationErrors) Error() string {
+	messages := make([]string, len(v))
+	for i, err := range v {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validation accumulates the errors reported by a generated Validate method.
+//
+// This type is primarily used by the generated code and is not meant
+// to be used directly.
+type Validation struct {
+	errors ValidationErrors
+}
+
+// Required records that the given required field is missing.
+func (v *Validation) Required(field string) {
+	v.errors = append(v.errors, &ValidationError{Field: field, Message: "is required"})
+}
+
+// Add records the given error (if any) for the given field. The errors
+// reported by nested values are relative to the given field.
+func (v *Validation) Add(field string, err error) {
+	if err == nil {
+		return
+	}
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		v.errors = append(v.errors, &ValidationError{Field: field, Message: err.Error()})
+		return
+	}
+	for _, validationError := range validationErrors {
+		v.errors = append(
+			v.errors,
+			&ValidationError{
+				Field:   joinValidationField(field, validationError.Field),
+				Message: validationError.Message,
+			},
+		)
+	}
+}
+
+// Err returns all of the recorded errors as ValidationErrors,
+// or nil if there aren't any.
+func (v *Validation) Err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return v.errors
+}
+
+// joinValidationField returns the path to the nested field relative
+// to its parent, e.g. "users" and "[0].name".
+func joinValidationField(parent string, field string) string {
+	if parent == "" {
+		return field
+	}
+	if field == "" {
+		return parent
+	}
+	if strings.HasPrefix(field, "[") {
+		return parent + field
+	}
+	return parent + "." + field
+}
@@ -10,7 +10,7 @@ import (
 )
 
 type Token struct {
-	Username *user.Username `json:"username,omitempty"`
+	Username *user.Username `json:"username,omitempty" url:"username"`
 
 	_rawJSON json.RawMessage
 }
@@ -37,3 +37,17 @@ func (t *Token) String() string {
 	}
 	return fmt.Sprintf("%#v", t)
 }
+
+// Validate reports all of the Token's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Token) Validate() error {
+	if t == nil {
+		return nil
+	}
+	var validation core.Validation
+	if t.Username == nil {
+		validation.Required("username")
+	}
+	validation.Add("username", t.Username.Validate())
+	return validation.Err()
+}
@@ -11,8 +11,8 @@ import (
 )
 
 type User struct {
-	Id       identity.Id    `json:"id"`
-	Username *user.Username `json:"username,omitempty"`
+	Id       identity.Id    `json:"id" url:"id"`
+	Username *user.Username `json:"username,omitempty" url:"username"`
 
 	_rawJSON json.RawMessage
 }
@@ -39,3 +39,17 @@ func (u *User) String() string {
 	}
 	return fmt.Sprintf("%#v", u)
 }
+
+// Validate reports all of the User's invalid fields (e.g. missing
+// required fields), if any.
+func (u *User) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	if u.Username == nil {
+		validation.Required("username")
+	}
+	validation.Add("username", u.Username.Validate())
+	return validation.Err()
+}
@@ -11,9 +11,10 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -22,11 +23,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -51,12 +60,17 @@ func (c *Client) List(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user/user.list",
 		},
 	); err != nil {
 		return nil, err
@@ -5,7 +5,6 @@ package file
 import (
 	bytes "bytes"
 	context "context"
-	fmt "fmt"
 	io "io"
 	http "net/http"
 	core "sdk/core"
@@ -13,9 +12,10 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -24,11 +24,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -46,7 +54,7 @@ func (c *Client) Download(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"file/%v/download", filename)
+	endpointURL := core.EncodeURL(baseURL+"/"+"file/%v/download", filename)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -54,12 +62,15 @@ func (c *Client) Download(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        response,
 		},
 	); err != nil {
 		return nil, err
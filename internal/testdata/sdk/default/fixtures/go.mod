@@ -3,6 +3,7 @@ module sdk
 go 1.13
 
 require (
+	github.com/klauspost/compress v1.17.0
 	github.com/stretchr/testify v1.7.0
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -10,7 +10,7 @@ import (
 
 // A user.
 type User struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
 
 	_rawJSON json.RawMessage
 }
@@ -37,3 +37,9 @@ func (u *User) String() string {
 	}
 	return fmt.Sprintf("%#v", u)
 }
+
+// Validate reports all of the User's invalid fields (e.g. missing
+// required fields), if any.
+func (u *User) Validate() error {
+	return nil
+}
@@ -6,5 +6,11 @@ type GetNameRequest struct {
 	// Specifies the endpoint key.
 	XEndpointHeader string `json:"-"`
 	// Filters the username.
-	Filter string `json:"-"`
+	Filter string `json:"-" url:"filter"`
+}
+
+// Validate reports all of the GetNameRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetNameRequest) Validate() error {
+	return nil
 }
@@ -9,13 +9,13 @@ import (
 	core "github.com/fern-api/fern-go/internal/testdata/sdk/docs/fixtures/core"
 	option "github.com/fern-api/fern-go/internal/testdata/sdk/docs/fixtures/option"
 	http "net/http"
-	url "net/url"
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -24,11 +24,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -42,6 +50,12 @@ func (c *Client) GetName(
 ) (string, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
 	baseURL := ""
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -49,10 +63,12 @@ func (c *Client) GetName(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/get-name", userId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/get-name", userId)
 
-	queryParams := make(url.Values)
-	queryParams.Add("filter", fmt.Sprintf("%v", request.Filter))
+	queryParams, err := core.QueryValues(request)
+	if err != nil {
+		return "", err
+	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
@@ -64,12 +80,17 @@ func (c *Client) GetName(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user.getName",
 		},
 	); err != nil {
 		return "", err
@@ -27,3 +27,9 @@ func NewEnvironmentsFromString(s string) (Environments, error) {
 func (e Environments) Ptr() *Environments {
 	return &e
 }
+
+// Validate returns an error if the Environments isn't one of its known values.
+func (e Environments) Validate() error {
+	_, err := NewEnvironmentsFromString(string(e))
+	return err
+}
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type QueryFilter struct {
+	Name    string     `json:"name" url:"name"`
+	Tags    []string   `json:"tags,omitempty" url:"tags,omitempty"`
+	Created *time.Time `json:"created,omitempty" url:"created,omitempty"`
+}
+
+type QueryUnion struct {
+	typeName string
+	String   string
+	Filter   *QueryFilter
+}
+
+func (q *QueryUnion) MarshalJSON() ([]byte, error) {
+	if q.typeName == "string" {
+		return json.Marshal(q.String)
+	}
+	return json.Marshal(q.Filter)
+}
+
+type QueryStringer [2]byte
+
+func (q QueryStringer) String() string {
+	return "stringer"
+}
+
+type QueryRequest struct {
+	Id        string             `json:"-" url:"id"`
+	Limit     *int               `json:"-" url:"limit,omitempty"`
+	Ascending bool               `json:"-" url:"ascending"`
+	Date      time.Time          `json:"-" url:"date,date"`
+	DateTime  time.Time          `json:"-" url:"datetime"`
+	Bytes     []byte             `json:"-" url:"bytes,omitempty"`
+	Stringer  *QueryStringer     `json:"-" url:"stringer,omitempty"`
+	Tags      []string           `json:"-" url:"tag,omitempty"`
+	Ids       []string           `json:"-" url:"ids,omitempty,comma"`
+	Filter    *QueryFilter       `json:"-" url:"filter,omitempty"`
+	Flat      *QueryFilter       `json:"-" url:"flat,omitempty,form"`
+	Metadata  map[string]string  `json:"-" url:"metadata,omitempty"`
+	Union     *QueryUnion        `json:"-" url:"union,omitempty"`
+	Body      string             `json:"body"`
+	Unknown   interface{}        `json:"-" url:"unknown,omitempty"`
+	Nested    map[string][]int64 `json:"-" url:"nested,omitempty"`
+}
+
+func TestQueryValues(t *testing.T) {
+	var (
+		date  = time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+		limit = 10
+	)
+	tests := []struct {
+		desc        string
+		giveRequest interface{}
+		wantQuery   string
+	}{
+		{
+			desc:        "nil",
+			giveRequest: (*QueryRequest)(nil),
+			wantQuery:   "",
+		},
+		{
+			desc:        "required fields",
+			giveRequest: &QueryRequest{Id: "a/b c"},
+			wantQuery:   "ascending=false&date=0001-01-01&datetime=0001-01-01T00%3A00%3A00Z&id=a%2Fb+c",
+		},
+		{
+			desc: "scalars",
+			giveRequest: &QueryRequest{
+				Id:        "id",
+				Limit:     &limit,
+				Ascending: true,
+				Date:      date,
+				DateTime:  date,
+				Bytes:     []byte("fern"),
+				Stringer:  &QueryStringer{},
+			},
+			wantQuery: "ascending=true&bytes=ZmVybg%3D%3D&date=2024-01-02&datetime=2024-01-02T03%3A04%3A05Z&id=id&limit=10&stringer=stringer",
+		},
+		{
+			desc: "lists",
+			giveRequest: &QueryRequest{
+				Tags: []string{"a", "b"},
+				Ids:  []string{"c", "d"},
+			},
+			wantQuery: "ascending=false&date=0001-01-01&datetime=0001-01-01T00%3A00%3A00Z&id=&ids=c%2Cd&tag=a&tag=b",
+		},
+		{
+			desc: "objects and maps",
+			giveRequest: &QueryRequest{
+				Filter:   &QueryFilter{Name: "fern", Tags: []string{"a", "b"}, Created: &date},
+				Flat:     &QueryFilter{Name: "flat"},
+				Metadata: map[string]string{"b": "2", "a": "1"},
+				Nested:   map[string][]int64{"n": {1, 2}},
+			},
+			wantQuery: "ascending=false&date=0001-01-01&datetime=0001-01-01T00%3A00%3A00Z&filter%5Bcreated%5D=2024-01-02T03%3A04%3A05Z&filter%5Bname%5D=fern&filter%5Btags%5D=a&filter%5Btags%5D=b&id=&metadata%5Ba%5D=1&metadata%5Bb%5D=2&name=flat&nested%5Bn%5D=1&nested%5Bn%5D=2",
+		},
+		{
+			desc: "unions and unknowns",
+			giveRequest: &QueryRequest{
+				Union:   &QueryUnion{typeName: "filter", Filter: &QueryFilter{Name: "fern", Tags: []string{"a"}}},
+				Unknown: map[string]interface{}{"key": true},
+			},
+			wantQuery: "ascending=false&date=0001-01-01&datetime=0001-01-01T00%3A00%3A00Z&id=&union%5Bname%5D=fern&union%5Btags%5D=a&unknown%5Bkey%5D=true",
+		},
+		{
+			desc: "string union",
+			giveRequest: &QueryRequest{
+				Union: &QueryUnion{typeName: "string", String: "fern"},
+			},
+			wantQuery: "ascending=false&date=0001-01-01&datetime=0001-01-01T00%3A00%3A00Z&id=&union=fern",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			values, err := QueryValues(test.giveRequest)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantQuery, values.Encode())
+		})
+	}
+}
+
+func TestQueryValuesError(t *testing.T) {
+	_, err := QueryValues("not a struct")
+	assert.Error(t, err)
+
+	_, err = QueryValues(
+		&struct {
+			Filters []*QueryFilter `url:"filters,comma"`
+		}{
+			Filters: []*QueryFilter{{Name: "fern"}},
+		},
+	)
+	assert.Error(t, err)
+}
+
+func TestQueryValuesEmpty(t *testing.T) {
+	values, err := QueryValues(nil)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{}, values)
+}
+
+func TestFormValues(t *testing.T) {
+	date := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+	values, err := FormValues(
+		&QueryFilter{
+			Name:    "a b",
+			Tags:    []string{"a", "b"},
+			Created: &date,
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "created=2024-01-02T03%3A04%3A05Z&name=a+b&tags=a&tags=b", values.Encode())
+
+	values, err = FormValues(
+		&QueryUnion{
+			typeName: "filter",
+			Filter:   &QueryFilter{Name: "fern"},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "name=fern", values.Encode())
+
+	values, err = FormValues(nil)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{}, values)
+
+	_, err = FormValues([]string{"a"})
+	assert.Error(t, err)
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type OrganizationNotFoundErrorBody struct {
-	RequestedOrganizationId string `json:"requestedOrganizationId"`
+	RequestedOrganizationId string `json:"requestedOrganizationId" url:"requestedOrganizationId"`
 
 	_rawJSON json.RawMessage
 }
@@ -37,8 +37,14 @@ func (o *OrganizationNotFoundErrorBody) String() string {
 	return fmt.Sprintf("%#v", o)
 }
 
+// Validate reports all of the OrganizationNotFoundErrorBody's invalid fields (e.g. missing
+// required fields), if any.
+func (o *OrganizationNotFoundErrorBody) Validate() error {
+	return nil
+}
+
 type UserNotFoundErrorBody struct {
-	RequestedUserId string `json:"requestedUserId"`
+	RequestedUserId string `json:"requestedUserId" url:"requestedUserId"`
 
 	_rawJSON json.RawMessage
 }
@@ -65,3 +71,9 @@ func (u *UserNotFoundErrorBody) String() string {
 	}
 	return fmt.Sprintf("%#v", u)
 }
+
+// Validate reports all of the UserNotFoundErrorBody's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UserNotFoundErrorBody) Validate() error {
+	return nil
+}
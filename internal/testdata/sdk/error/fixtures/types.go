@@ -9,7 +9,7 @@ import (
 )
 
 type UserNotFoundErrorBody struct {
-	RequestedUserId string `json:"requestedUserId"`
+	RequestedUserId string `json:"requestedUserId" url:"requestedUserId"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (u *UserNotFoundErrorBody) String() string {
 	}
 	return fmt.Sprintf("%#v", u)
 }
+
+// Validate reports all of the UserNotFoundErrorBody's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UserNotFoundErrorBody) Validate() error {
+	return nil
+}
@@ -31,6 +31,12 @@ func WithMaxAttempts(attempts uint) *core.MaxAttemptsOption {
 	return option.WithMaxAttempts(attempts)
 }
 
+// WithValidation validates the request (e.g. its required fields and
+// enum values) before it's sent.
+func WithValidation() *core.ValidationOption {
+	return option.WithValidation()
+}
+
 // WithCustom sets the custom auth request header.
 func WithCustom(custom *[]byte) *core.CustomOption {
 	return option.WithCustom(custom)
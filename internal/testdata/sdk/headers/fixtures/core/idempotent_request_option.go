@@ -20,6 +20,7 @@ type IdempotentRequestOptions struct {
 	*RequestOptions
 
 	IdempotencyKey string
+	RateLimiter    *RateLimiter
 }
 
 // NewIdempotentRequestOptions returns a new *IdempotentRequestOptions value.
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	uuid "github.com/google/uuid"
 	http "net/http"
+	url "net/url"
 	time "time"
 )
 
@@ -24,6 +25,13 @@ type RequestOptions struct {
 	HTTPClient           HTTPClient
 	HTTPHeader           http.Header
 	MaxAttempts          uint
+	RequestCompression   string
+	Timeout              time.Duration
+	AttemptTimeout       time.Duration
+	QueryParameters      url.Values
+	BodyProperties       map[string]interface{}
+	Cache                Cache
+	Validation           bool
 	Custom               *[]byte
 	XApiName             string
 	XApiId               uuid.UUID
@@ -35,6 +43,7 @@ type RequestOptions struct {
 	XApiOptionalDatetime *time.Time
 	XApiOptionalDate     *time.Time
 	XApiOptionalBytes    *[]byte
+	RateLimiter          *RateLimiter
 }
 
 // NewRequestOptions returns a new *RequestOptions value.
@@ -60,8 +69,8 @@ func (r *RequestOptions) ToHeader() http.Header {
 	}
 	header.Set("X-API-Name", fmt.Sprintf("%v", r.XApiName))
 	header.Set("X-API-ID", fmt.Sprintf("%v", r.XApiId))
-	header.Set("X-API-Datetime", fmt.Sprintf("%v", r.XApiDatetime.Format(time.RFC3339)))
-	header.Set("X-API-Date", fmt.Sprintf("%v", r.XApiDate.Format("2006-01-02")))
+	header.Set("X-API-Datetime", fmt.Sprintf("%v", NewDateTime(r.XApiDatetime).String()))
+	header.Set("X-API-Date", fmt.Sprintf("%v", NewDate(r.XApiDate).String()))
 	header.Set("X-API-Bytes", fmt.Sprintf("%v", base64.StdEncoding.EncodeToString(r.XApiBytes)))
 	if r.XApiOptionalName != nil {
 		header.Set("X-API-Optional-Name", fmt.Sprintf("%v", *r.XApiOptionalName))
@@ -70,10 +79,10 @@ func (r *RequestOptions) ToHeader() http.Header {
 		header.Set("X-API-Optional-ID", fmt.Sprintf("%v", *r.XApiOptionalId))
 	}
 	if r.XApiOptionalDatetime != nil {
-		header.Set("X-API-Optional-Datetime", fmt.Sprintf("%v", r.XApiOptionalDatetime.Format(time.RFC3339)))
+		header.Set("X-API-Optional-Datetime", fmt.Sprintf("%v", NewDateTime(*r.XApiOptionalDatetime).String()))
 	}
 	if r.XApiOptionalDate != nil {
-		header.Set("X-API-Optional-Date", fmt.Sprintf("%v", r.XApiOptionalDate.Format("2006-01-02")))
+		header.Set("X-API-Optional-Date", fmt.Sprintf("%v", NewDate(*r.XApiOptionalDate).String()))
 	}
 	if r.XApiOptionalBytes != nil {
 		header.Set("X-API-Optional-Bytes", fmt.Sprintf("%v", base64.StdEncoding.EncodeToString(*r.XApiOptionalBytes)))
@@ -138,6 +147,97 @@ func (m *MaxAttemptsOption) applyIdempotentRequestOptions(opts *IdempotentReques
 	opts.MaxAttempts = m.MaxAttempts
 }
 
+// RequestCompressionOption implements the RequestOption interface.
+type RequestCompressionOption struct {
+	RequestCompression string
+}
+
+func (r *RequestCompressionOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RequestCompression = r.RequestCompression
+}
+
+func (r *RequestCompressionOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
+	opts.RequestCompression = r.RequestCompression
+}
+
+// TimeoutOption implements the RequestOption interface.
+type TimeoutOption struct {
+	Timeout time.Duration
+}
+
+func (t *TimeoutOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Timeout = t.Timeout
+}
+
+func (t *TimeoutOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
+	opts.Timeout = t.Timeout
+}
+
+// AttemptTimeoutOption implements the RequestOption interface.
+type AttemptTimeoutOption struct {
+	AttemptTimeout time.Duration
+}
+
+func (a *AttemptTimeoutOption) applyRequestOptions(opts *RequestOptions) {
+	opts.AttemptTimeout = a.AttemptTimeout
+}
+
+func (a *AttemptTimeoutOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
+	opts.AttemptTimeout = a.AttemptTimeout
+}
+
+// QueryParametersOption implements the RequestOption interface.
+type QueryParametersOption struct {
+	QueryParameters url.Values
+}
+
+func (q *QueryParametersOption) applyRequestOptions(opts *RequestOptions) {
+	opts.QueryParameters = q.QueryParameters
+}
+
+func (q *QueryParametersOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
+	opts.QueryParameters = q.QueryParameters
+}
+
+// BodyPropertiesOption implements the RequestOption interface.
+type BodyPropertiesOption struct {
+	BodyProperties map[string]interface{}
+}
+
+func (b *BodyPropertiesOption) applyRequestOptions(opts *RequestOptions) {
+	opts.BodyProperties = b.BodyProperties
+}
+
+func (b *BodyPropertiesOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
+	opts.BodyProperties = b.BodyProperties
+}
+
+// CacheOption implements the RequestOption interface.
+type CacheOption struct {
+	Cache Cache
+}
+
+func (c *CacheOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Cache = c.Cache
+}
+
+func (c *CacheOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
+	opts.Cache = c.Cache
+}
+
+// ValidationOption implements the RequestOption interface.
+type ValidationOption struct {
+	Validation bool
+}
+
+func (v *ValidationOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Validation = v.Validation
+}
+
+func (v *ValidationOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
+	opts.Validation = v.Validation
+}
+
 // CustomOption implements the RequestOption interface.
 type CustomOption struct {
 	Custom *[]byte
@@ -280,3 +380,16 @@ func (x *XApiOptionalBytesOption) applyRequestOptions(opts *RequestOptions) {
 func (x *XApiOptionalBytesOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
 	opts.XApiOptionalBytes = x.XApiOptionalBytes
 }
+
+// RateLimiterOption implements the RequestOption interface.
+type RateLimiterOption struct {
+	RateLimiter *RateLimiter
+}
+
+func (r *RateLimiterOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RateLimiter = r.RateLimiter
+}
+
+func (r *RateLimiterOption) applyIdempotentRequestOptions(opts *IdempotentRequestOptions) {
+	opts.RateLimiter = r.RateLimiter
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"crypto/rand"
+	"errors"
 	"math/big"
 	"net/http"
 	"time"
@@ -99,7 +100,26 @@ func (r *Retrier) run(
 
 	response, err := fn(request)
 	if err != nil {
-		return nil, err
+		if !isAttemptTimeout(err) {
+			return nil, err
+		}
+		// The attempt timed out, so it's retried like any other
+		// retriable failure.
+		delay, delayErr := r.retryDelay(retryAttempt)
+		if delayErr != nil {
+			return nil, delayErr
+		}
+
+		time.Sleep(delay)
+
+		return r.run(
+			fn,
+			request,
+			errorDecoder,
+			maxRetryAttempts,
+			retryAttempt+1,
+			err,
+		)
 	}
 
 	if r.shouldRetry(response) {
@@ -134,6 +154,13 @@ func (r *Retrier) shouldRetry(response *http.Response) bool {
 		response.StatusCode >= http.StatusInternalServerError
 }
 
+// isAttemptTimeout returns true if the given error was caused by a timeout of
+// an individual attempt (i.e. option.WithAttemptTimeout).
+func isAttemptTimeout(err error) bool {
+	var timeoutErr *TimeoutError
+	return errors.As(err, &timeoutErr) && timeoutErr.PerAttempt
+}
+
 // retryDelay calculates the delay time in milliseconds based on the retry attempt.
 func (r *Retrier) retryDelay(retryAttempt uint) (time.Duration, error) {
 	// Apply exponential backoff.
@@ -25,6 +25,18 @@ func (s *SetNameRequest) XEndpointFernHeader() string {
 	return s.xEndpointFernHeader
 }
 
+// Validate reports all of the SetNameRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequest) Validate() error {
+	return nil
+}
+
 type UpdateNameRequest struct {
 	XEndpointHeader string `json:"-"`
 }
+
+// Validate reports all of the UpdateNameRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UpdateNameRequest) Validate() error {
+	return nil
+}
@@ -10,13 +10,13 @@ import (
 	core "github.com/fern-api/fern-go/internal/testdata/sdk/headers/fixtures/core"
 	option "github.com/fern-api/fern-go/internal/testdata/sdk/headers/fixtures/option"
 	http "net/http"
-	time "time"
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -25,11 +25,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -41,6 +49,12 @@ func (c *Client) SetName(
 ) (string, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
 	baseURL := ""
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -48,13 +62,13 @@ func (c *Client) SetName(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name", userId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/set-name", userId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
 	headers.Add("X-Endpoint-ID-Header", fmt.Sprintf("%v", request.XEndpointIdHeader))
-	headers.Add("X-Endpoint-Date-Header", fmt.Sprintf("%v", request.XEndpointDateHeader.Format("2006-01-02")))
-	headers.Add("X-Endpoint-Datetime-Header", fmt.Sprintf("%v", request.XEndpointDatetimeHeader.Format(time.RFC3339)))
+	headers.Add("X-Endpoint-Date-Header", fmt.Sprintf("%v", core.NewDate(request.XEndpointDateHeader).String()))
+	headers.Add("X-Endpoint-Datetime-Header", fmt.Sprintf("%v", core.NewDateTime(request.XEndpointDatetimeHeader).String()))
 	headers.Add("X-Endpoint-Bytes-Header", fmt.Sprintf("%v", base64.StdEncoding.EncodeToString(request.XEndpointBytesHeader)))
 	if request.XEndpointOptionalHeader != nil {
 		headers.Add("X-Endpoint-Optional-Header", fmt.Sprintf("%v", *request.XEndpointOptionalHeader))
@@ -63,10 +77,10 @@ func (c *Client) SetName(
 		headers.Add("X-Endpoint-Optional-ID-Header", fmt.Sprintf("%v", *request.XEndpointOptionalIdHeader))
 	}
 	if request.XEndpointOptionalDateHeader != nil {
-		headers.Add("X-Endpoint-Optional-Date-Header", fmt.Sprintf("%v", request.XEndpointOptionalDateHeader.Format("2006-01-02")))
+		headers.Add("X-Endpoint-Optional-Date-Header", fmt.Sprintf("%v", core.NewDate(*request.XEndpointOptionalDateHeader).String()))
 	}
 	if request.XEndpointOptionalDatetimeHeader != nil {
-		headers.Add("X-Endpoint-Optional-Datetime-Header", fmt.Sprintf("%v", request.XEndpointOptionalDatetimeHeader.Format(time.RFC3339)))
+		headers.Add("X-Endpoint-Optional-Datetime-Header", fmt.Sprintf("%v", core.NewDateTime(*request.XEndpointOptionalDatetimeHeader).String()))
 	}
 	if request.XEndpointOptionalBytesHeader != nil {
 		headers.Add("X-Endpoint-Optional-Bytes-Header", fmt.Sprintf("%v", base64.StdEncoding.EncodeToString(*request.XEndpointOptionalBytesHeader)))
@@ -77,12 +91,15 @@ func (c *Client) SetName(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodPost,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodPost,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
 		},
 	); err != nil {
 		return "", err
@@ -98,6 +115,12 @@ func (c *Client) UpdateName(
 ) (string, error) {
 	options := core.NewIdempotentRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
 	baseURL := ""
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -105,7 +128,7 @@ func (c *Client) UpdateName(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/update-name", userId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/update-name", userId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
@@ -114,12 +137,15 @@ func (c *Client) UpdateName(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodPut,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodPut,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
 		},
 	); err != nil {
 		return "", err
@@ -7,7 +7,6 @@ import (
 	context "context"
 	json "encoding/json"
 	errors "errors"
-	fmt "fmt"
 	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/mergent/fixtures"
 	core "github.com/fern-api/fern-go/internal/testdata/sdk/mergent/fixtures/core"
 	option "github.com/fern-api/fern-go/internal/testdata/sdk/mergent/fixtures/option"
@@ -16,9 +15,10 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -27,11 +27,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -56,12 +64,17 @@ func (c *Client) GetTasks(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_.getTasks",
 		},
 	); err != nil {
 		return nil, err
@@ -76,6 +89,12 @@ func (c *Client) PostTasks(
 ) (*fixtures.Task, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return nil, err
+		}
+	}
+
 	baseURL := "https://api.mergent.co/v2"
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -117,14 +136,19 @@ func (c *Client) PostTasks(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodPost,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Request:      request,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+			ErrorDecoder:       errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -147,7 +171,7 @@ func (c *Client) GetTasksTaskId(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"tasks/%v", taskId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"tasks/%v", taskId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -174,13 +198,18 @@ func (c *Client) GetTasksTaskId(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodGet,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_.getTasksTaskId",
+			ErrorDecoder:    errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -197,6 +226,12 @@ func (c *Client) PatchTasksTaskId(
 ) (*fixtures.Task, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return nil, err
+		}
+	}
+
 	baseURL := "https://api.mergent.co/v2"
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -204,7 +239,7 @@ func (c *Client) PatchTasksTaskId(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"tasks/%v", taskId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"tasks/%v", taskId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -245,14 +280,19 @@ func (c *Client) PatchTasksTaskId(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodPatch,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Request:      request,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:                endpointURL,
+			Method:             http.MethodPatch,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+			ErrorDecoder:       errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -275,7 +315,7 @@ func (c *Client) DeleteTasksTaskId(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"tasks/%v", taskId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"tasks/%v", taskId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -301,12 +341,15 @@ func (c *Client) DeleteTasksTaskId(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodDelete,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			ErrorDecoder: errorDecoder,
+			URL:             endpointURL,
+			Method:          http.MethodDelete,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			ErrorDecoder:    errorDecoder,
 		},
 	); err != nil {
 		return err
@@ -330,7 +373,7 @@ func (c *Client) PostTasksTaskIdRun(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"tasks/%v/run", taskId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"tasks/%v/run", taskId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -364,13 +407,16 @@ func (c *Client) PostTasksTaskIdRun(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodPost,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:             endpointURL,
+			Method:          http.MethodPost,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			ErrorDecoder:    errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -444,14 +490,19 @@ func (c *Client) PostTasksBatchCreate(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodPost,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Request:      request,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+			ErrorDecoder:       errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -510,13 +561,18 @@ func (c *Client) PostTasksBatchDelete(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodPost,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Request:      request,
-			ErrorDecoder: errorDecoder,
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			ErrorDecoder:       errorDecoder,
 		},
 	); err != nil {
 		return err
@@ -545,12 +601,17 @@ func (c *Client) GetSchedules(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_.getSchedules",
 		},
 	); err != nil {
 		return nil, err
@@ -565,6 +626,12 @@ func (c *Client) PostSchedules(
 ) (*fixtures.Schedule, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return nil, err
+		}
+	}
+
 	baseURL := "https://api.mergent.co/v2"
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -599,14 +666,19 @@ func (c *Client) PostSchedules(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodPost,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Request:      request,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+			ErrorDecoder:       errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -629,7 +701,7 @@ func (c *Client) GetSchedulesScheduleId(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"schedules/%v", scheduleId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"schedules/%v", scheduleId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -656,13 +728,18 @@ func (c *Client) GetSchedulesScheduleId(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodGet,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_.getSchedulesScheduleId",
+			ErrorDecoder:    errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -679,6 +756,12 @@ func (c *Client) PatchSchedulesScheduleId(
 ) (*fixtures.Schedule, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return nil, err
+		}
+	}
+
 	baseURL := "https://api.mergent.co/v2"
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -686,7 +769,7 @@ func (c *Client) PatchSchedulesScheduleId(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"schedules/%v", scheduleId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"schedules/%v", scheduleId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -720,14 +803,19 @@ func (c *Client) PatchSchedulesScheduleId(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodPatch,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Request:      request,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:                endpointURL,
+			Method:             http.MethodPatch,
+			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
+			Headers:            headers,
+			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
+			Request:            request,
+			RequestCompression: options.RequestCompression,
+			BodyProperties:     options.BodyProperties,
+			Response:           &response,
+			ErrorDecoder:       errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -750,7 +838,7 @@ func (c *Client) DeleteSchedulesScheduleId(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"schedules/%v", scheduleId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"schedules/%v", scheduleId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -776,12 +864,15 @@ func (c *Client) DeleteSchedulesScheduleId(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodDelete,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			ErrorDecoder: errorDecoder,
+			URL:             endpointURL,
+			Method:          http.MethodDelete,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			ErrorDecoder:    errorDecoder,
 		},
 	); err != nil {
 		return err
@@ -804,7 +895,7 @@ func (c *Client) GetSchedulesScheduleIdTasks(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"schedules/%v/tasks", scheduleId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"schedules/%v/tasks", scheduleId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -831,13 +922,18 @@ func (c *Client) GetSchedulesScheduleIdTasks(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:          endpointURL,
-			Method:       http.MethodGet,
-			MaxAttempts:  options.MaxAttempts,
-			Headers:      headers,
-			Client:       options.HTTPClient,
-			Response:     &response,
-			ErrorDecoder: errorDecoder,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_.getSchedulesScheduleIdTasks",
+			ErrorDecoder:    errorDecoder,
 		},
 	); err != nil {
 		return nil, err
@@ -32,13 +32,24 @@ type ScheduleNew struct {
 	CreatedAt *CreatedAt `json:"created_at,omitempty"`
 }
 
+// Validate reports all of the ScheduleNew's invalid fields (e.g. missing
+// required fields), if any.
+func (s *ScheduleNew) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	validation.Add("request", s.Request.Validate())
+	return validation.Err()
+}
+
 type Error struct {
 	// A human-readable message providing more details about the error(s).
-	Message *string `json:"message,omitempty"`
+	Message *string `json:"message,omitempty" url:"message,omitempty"`
 	// If the error is parameter-specific, the parameter related to the error.
-	Param *string `json:"param,omitempty"`
+	Param *string `json:"param,omitempty" url:"param,omitempty"`
 	// If multiple errors occured (e.g., with param validation), the list of errors that occured.
-	Errors []*Error `json:"errors,omitempty"`
+	Errors []*Error `json:"errors,omitempty" url:"errors,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -66,18 +77,31 @@ func (e *Error) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the Error's invalid fields (e.g. missing
+// required fields), if any.
+func (e *Error) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range e.Errors {
+		validation.Add(fmt.Sprintf("errors[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type Request struct {
 	// The URL that the POST request will be sent to.
 	// For localhost development, use something like ngrok to get a publicly
 	// accessible URL for your local service. See https://docs.mergent.co for
 	// more info.
-	Url string `json:"url"`
+	Url string `json:"url" url:"url"`
 	// The headers that will accompany any Task's HTTP request. For
 	// example, you can use this to set Content-Type to "application/json"
 	// or "application/octet-stream".
-	Headers map[string]interface{} `json:"headers,omitempty"`
+	Headers map[string]interface{} `json:"headers,omitempty" url:"headers,omitempty"`
 	// The HTTP request body as a string.
-	Body *string `json:"body,omitempty"`
+	Body *string `json:"body,omitempty" url:"body,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -105,28 +129,34 @@ func (r *Request) String() string {
 	return fmt.Sprintf("%#v", r)
 }
 
+// Validate reports all of the Request's invalid fields (e.g. missing
+// required fields), if any.
+func (r *Request) Validate() error {
+	return nil
+}
+
 type Schedule struct {
-	Id *Id `json:"id,omitempty"`
+	Id *Id `json:"id,omitempty" url:"id,omitempty"`
 	// An optional name of the Schedule. This string must not contain more than 100 characters.
-	Name *string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
 	// The name of the of the queue to schedule the Task on. This string must not contain more than 100 characters.
-	Queue *string `json:"queue,omitempty"`
+	Queue *string `json:"queue,omitempty" url:"queue,omitempty"`
 	// An optional description of the Schedule. This string must not contain more than 500 characters.
-	Description *string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty" url:"description,omitempty"`
 	// A [cron expression](https://crontab.guru/examples.html) describing the
 	// Schedule on which Tasks will run (UTC).
 	// Note: execution n + 1 of a Task will not begin until execution n has
 	// completed successfully.
 	// You must pass either `cron` or `rrule` when creating a new Schedule.
-	Cron *string `json:"cron,omitempty"`
+	Cron *string `json:"cron,omitempty" url:"cron,omitempty"`
 	// An [iCal RRule expression](https://icalendar.org/iCalendar-RFC-5545/3-8-5-3-recurrence-rule.html) describing the Schedule on which Tasks will run (UTC). The time of Schedule creation will be used as the start of the recurrence interval (i.e. `DTSTART`). Note: execution n + 1 of a Task will not begin until execution n has completed successfully. You must pass either `cron` or `rrule` when creating a new Schedule.
-	Rrule *string `json:"rrule,omitempty"`
+	Rrule *string `json:"rrule,omitempty" url:"rrule,omitempty"`
 	// The [ISO 8601 timestamp](https://en.wikipedia.org/wiki/ISO_8601#Combined_date_and_time_representations) denoting the start of an RRULE schedule. Example: "2021-10-01T15:53:05Z". When not set, it will be set to the current time, and the first Task will be scheduled immediately. Ignored for `cron`-type Schedules.
-	Dtstart *string `json:"dtstart,omitempty"`
+	Dtstart *string `json:"dtstart,omitempty" url:"dtstart,omitempty"`
 	// If `true`, the Schedule will be paused immediately. If `false`, a paused Schedule will be resumed.
-	Paused    *bool      `json:"paused,omitempty"`
-	Request   *Request   `json:"request,omitempty"`
-	CreatedAt *CreatedAt `json:"created_at,omitempty"`
+	Paused    *bool      `json:"paused,omitempty" url:"paused,omitempty"`
+	Request   *Request   `json:"request,omitempty" url:"request,omitempty"`
+	CreatedAt *CreatedAt `json:"created_at,omitempty" url:"created_at,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -154,20 +184,31 @@ func (s *Schedule) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the Schedule's invalid fields (e.g. missing
+// required fields), if any.
+func (s *Schedule) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	validation.Add("request", s.Request.Validate())
+	return validation.Err()
+}
+
 type Task struct {
-	Id *Id `json:"id,omitempty"`
+	Id *Id `json:"id,omitempty" url:"id,omitempty"`
 	// An optional name of the Task. This string must not contain more than 100 characters.
-	Name *string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
 	// The name of the of the Task queue. This string must not contain more than 100 characters.
-	Queue *string `json:"queue,omitempty"`
+	Queue *string `json:"queue,omitempty" url:"queue,omitempty"`
 	// The status of this Task.
-	Status  *TaskStatus `json:"status,omitempty"`
-	Request *Request    `json:"request,omitempty"`
+	Status  *TaskStatus `json:"status,omitempty" url:"status,omitempty"`
+	Request *Request    `json:"request,omitempty" url:"request,omitempty"`
 	// The [ISO 8601 timestamp](https://en.wikipedia.org/wiki/ISO_8601#Combined_date_and_time_representations) for when the Task is scheduled to be executed. Example: "2021-10-01T15:53:05Z". When not present, the Task will be scheduled for immediate execution.
-	ScheduledFor *string `json:"scheduled_for,omitempty"`
+	ScheduledFor *string `json:"scheduled_for,omitempty" url:"scheduled_for,omitempty"`
 	// A duration string containing numbers and a unit suffix of "s" for seconds, "m" for minutes, and "h" for hours. Examples: "5s"; "1.5h"; "2h45m" When both `delay` and `scheduled_for` are present, `delay` will be added to `scheduled_for`.
-	Delay     *string    `json:"delay,omitempty"`
-	CreatedAt *CreatedAt `json:"created_at,omitempty"`
+	Delay     *string    `json:"delay,omitempty" url:"delay,omitempty"`
+	CreatedAt *CreatedAt `json:"created_at,omitempty" url:"created_at,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -195,20 +236,34 @@ func (t *Task) String() string {
 	return fmt.Sprintf("%#v", t)
 }
 
+// Validate reports all of the Task's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Task) Validate() error {
+	if t == nil {
+		return nil
+	}
+	var validation core.Validation
+	if t.Status != nil {
+		validation.Add("status", t.Status.Validate())
+	}
+	validation.Add("request", t.Request.Validate())
+	return validation.Err()
+}
+
 type TaskNew struct {
-	Id *Id `json:"id,omitempty"`
+	Id *Id `json:"id,omitempty" url:"id,omitempty"`
 	// An optional name of the Task. This string must not contain more than 100 characters.
-	Name *string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty" url:"name,omitempty"`
 	// The name of the of the Task queue. This string must not contain more than 100 characters.
-	Queue *string `json:"queue,omitempty"`
+	Queue *string `json:"queue,omitempty" url:"queue,omitempty"`
 	// The status of this Task.
-	Status  *TaskStatus `json:"status,omitempty"`
-	Request *Request    `json:"request,omitempty"`
+	Status  *TaskStatus `json:"status,omitempty" url:"status,omitempty"`
+	Request *Request    `json:"request,omitempty" url:"request,omitempty"`
 	// The [ISO 8601 timestamp](https://en.wikipedia.org/wiki/ISO_8601#Combined_date_and_time_representations) for when the Task is scheduled to be executed. Example: "2021-10-01T15:53:05Z". When not present, the Task will be scheduled for immediate execution.
-	ScheduledFor *string `json:"scheduled_for,omitempty"`
+	ScheduledFor *string `json:"scheduled_for,omitempty" url:"scheduled_for,omitempty"`
 	// A duration string containing numbers and a unit suffix of "s" for seconds, "m" for minutes, and "h" for hours. Examples: "5s"; "1.5h"; "2h45m" When both `delay` and `scheduled_for` are present, `delay` will be added to `scheduled_for`.
-	Delay     *string    `json:"delay,omitempty"`
-	CreatedAt *CreatedAt `json:"created_at,omitempty"`
+	Delay     *string    `json:"delay,omitempty" url:"delay,omitempty"`
+	CreatedAt *CreatedAt `json:"created_at,omitempty" url:"created_at,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -236,6 +291,20 @@ func (t *TaskNew) String() string {
 	return fmt.Sprintf("%#v", t)
 }
 
+// Validate reports all of the TaskNew's invalid fields (e.g. missing
+// required fields), if any.
+func (t *TaskNew) Validate() error {
+	if t == nil {
+		return nil
+	}
+	var validation core.Validation
+	if t.Status != nil {
+		validation.Add("status", t.Status.Validate())
+	}
+	validation.Add("request", t.Request.Validate())
+	return validation.Err()
+}
+
 // The status of this Task.
 type TaskStatus string
 
@@ -265,6 +334,12 @@ func (t TaskStatus) Ptr() *TaskStatus {
 	return &t
 }
 
+// Validate returns an error if the TaskStatus isn't one of its known values.
+func (t TaskStatus) Validate() error {
+	_, err := NewTaskStatusFromString(string(t))
+	return err
+}
+
 // The [ISO 8601 timestamp](https://en.wikipedia.org/wiki/ISO_8601#Combined_date_and_time_representations) representing when the object was created.
 type CreatedAt = string
 
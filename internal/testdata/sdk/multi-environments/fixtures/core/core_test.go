@@ -9,7 +9,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -117,6 +119,7 @@ func TestCall(t *testing.T) {
 				&CallerParams{
 					Client: client,
 				},
+				nil,
 			)
 			var response *Response
 			err := caller.Call(
@@ -283,3 +286,291 @@ func newTestErrorDecoder(t *testing.T) func(int, io.Reader) error {
 		return apiError
 	}
 }
+
+func TestEncodeURL(t *testing.T) {
+	tests := []struct {
+		desc       string
+		giveURL    string
+		giveParams []interface{}
+		wantURL    string
+	}{
+		{
+			desc:    "no parameters",
+			giveURL: "https://api.example.com/users",
+			wantURL: "https://api.example.com/users",
+		},
+		{
+			desc:       "single parameter",
+			giveURL:    "https://api.example.com/users/%v",
+			giveParams: []interface{}{"fern"},
+			wantURL:    "https://api.example.com/users/fern",
+		},
+		{
+			desc:       "multiple parameters",
+			giveURL:    "https://api.example.com/users/%v/info/%v",
+			giveParams: []interface{}{"fern", 42},
+			wantURL:    "https://api.example.com/users/fern/info/42",
+		},
+		{
+			desc:       "reserved characters",
+			giveURL:    "https://api.example.com/users/%v/info",
+			giveParams: []interface{}{"../a/b?c#d e"},
+			wantURL:    "https://api.example.com/users/..%2Fa%2Fb%3Fc%23d%20e/info",
+		},
+		{
+			desc:       "dot segments",
+			giveURL:    "https://api.example.com/users/%v/info/%v",
+			giveParams: []interface{}{".", ".."},
+			wantURL:    "https://api.example.com/users/%2E/info/%2E%2E",
+		},
+		{
+			desc:       "dots within a segment",
+			giveURL:    "https://api.example.com/users/%v",
+			giveParams: []interface{}{"..."},
+			wantURL:    "https://api.example.com/users/...",
+		},
+		{
+			desc:       "escaped base url",
+			giveURL:    "https://api.example.com/a%20b/users/%v",
+			giveParams: []interface{}{"fern"},
+			wantURL:    "https://api.example.com/a%20b/users/fern",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, test.wantURL, EncodeURL(test.giveURL, test.giveParams...))
+		})
+	}
+}
+
+func TestEncodeURLRequestURI(t *testing.T) {
+	tests := []struct {
+		desc           string
+		giveParams     []interface{}
+		wantRequestURI string
+	}{
+		{
+			desc:           "reserved characters",
+			giveParams:     []interface{}{"a/b?c#d e", "fern"},
+			wantRequestURI: "/users/a%2Fb%3Fc%23d%20e/info/fern",
+		},
+		{
+			desc:           "dot segments",
+			giveParams:     []interface{}{".", ".."},
+			wantRequestURI: "/users/%2E/info/%2E%2E",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			requestURIs := make(chan string, 1)
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						requestURIs <- r.RequestURI
+					},
+				),
+			)
+			defer server.Close()
+
+			req, err := newRequest(
+				context.Background(),
+				EncodeURL(server.URL+"/users/%v/info/%v", test.giveParams...),
+				http.MethodGet,
+				make(http.Header),
+				nil,
+				nil,
+				nil,
+				nil,
+				"",
+			)
+			require.NoError(t, err)
+			resp, err := server.Client().Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.Equal(t, test.wantRequestURI, <-requestURIs)
+		})
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		desc                      string
+		giveMethod                string
+		giveHeader                http.Header
+		giveRequest               interface{}
+		giveQueryParameters       url.Values
+		giveClientBodyProperties  map[string]interface{}
+		giveRequestBodyProperties map[string]interface{}
+		wantURL                   string
+		wantContentType           string
+		wantBody                  string
+	}{
+		{
+			desc:       "without a body",
+			giveMethod: http.MethodGet,
+			giveHeader: make(http.Header),
+		},
+		{
+			desc:            "json",
+			giveMethod:      http.MethodPost,
+			giveHeader:      make(http.Header),
+			giveRequest:     &Request{Id: "123"},
+			wantContentType: contentType,
+			wantBody:        `{"id":"123"}`,
+		},
+		{
+			desc:            "merge patch",
+			giveMethod:      http.MethodPatch,
+			giveHeader:      http.Header{contentTypeHeader: []string{"application/merge-patch+json"}},
+			giveRequest:     &Request{Id: "123"},
+			wantContentType: "application/merge-patch+json",
+			wantBody:        `{"id":"123"}`,
+		},
+		{
+			desc:            "ndjson",
+			giveMethod:      http.MethodPost,
+			giveHeader:      http.Header{contentTypeHeader: []string{"application/x-ndjson"}},
+			giveRequest:     []*Request{{Id: "123"}, {Id: "456"}},
+			wantContentType: "application/x-ndjson",
+			wantBody:        "{\"id\":\"123\"}\n{\"id\":\"456\"}\n",
+		},
+		{
+			desc:       "form",
+			giveMethod: http.MethodPost,
+			giveHeader: http.Header{contentTypeHeader: []string{"application/x-www-form-urlencoded; charset=utf-8"}},
+			giveRequest: map[string]interface{}{
+				"id":     "a b",
+				"tags":   []string{"a", "b"},
+				"filter": map[string]interface{}{"name": "fern"},
+			},
+			wantContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			wantBody:        "filter%5Bname%5D=fern&id=a+b&tags=a&tags=b",
+		},
+		{
+			desc:                "query parameters",
+			giveMethod:          http.MethodGet,
+			giveHeader:          make(http.Header),
+			giveQueryParameters: url.Values{"page": []string{"2"}, "beta": []string{"true"}},
+			wantURL:             "https://api.example.com?beta=true&page=2",
+		},
+		{
+			desc:                      "body properties",
+			giveMethod:                http.MethodPost,
+			giveHeader:                make(http.Header),
+			giveRequest:               &Request{Id: "123"},
+			giveClientBodyProperties:  map[string]interface{}{"beta": false, "env": "test"},
+			giveRequestBodyProperties: map[string]interface{}{"id": "456", "beta": true},
+			wantContentType:           contentType,
+			wantBody:                  `{"beta":true,"env":"test","id":"456"}`,
+		},
+		{
+			desc:                     "client body properties without a body",
+			giveMethod:               http.MethodGet,
+			giveHeader:               make(http.Header),
+			giveClientBodyProperties: map[string]interface{}{"beta": true},
+		},
+		{
+			desc:                     "client body properties with a reader",
+			giveMethod:               http.MethodPost,
+			giveHeader:               make(http.Header),
+			giveRequest:              strings.NewReader("fern"),
+			giveClientBodyProperties: map[string]interface{}{"beta": true},
+			wantContentType:          contentType,
+			wantBody:                 "fern",
+		},
+		{
+			desc:                     "client body properties with a form",
+			giveMethod:               http.MethodPost,
+			giveHeader:               http.Header{contentTypeHeader: []string{"application/x-www-form-urlencoded"}},
+			giveRequest:              map[string]interface{}{"id": "123"},
+			giveClientBodyProperties: map[string]interface{}{"beta": true},
+			wantContentType:          "application/x-www-form-urlencoded",
+			wantBody:                 "id=123",
+		},
+		{
+			desc:                     "client body properties with a list",
+			giveMethod:               http.MethodPost,
+			giveHeader:               make(http.Header),
+			giveRequest:              []string{"fern"},
+			giveClientBodyProperties: map[string]interface{}{"beta": true},
+			wantContentType:          contentType,
+			wantBody:                 `["fern"]`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			req, err := newRequest(
+				context.Background(),
+				"https://api.example.com?page=1",
+				test.giveMethod,
+				test.giveHeader,
+				test.giveRequest,
+				test.giveQueryParameters,
+				test.giveClientBodyProperties,
+				test.giveRequestBodyProperties,
+				"",
+			)
+			require.NoError(t, err)
+			if test.wantURL != "" {
+				assert.Equal(t, test.wantURL, req.URL.String())
+			}
+			assert.Equal(t, test.wantContentType, req.Header.Get(contentTypeHeader))
+			if test.wantBody == "" {
+				assert.Nil(t, req.Body)
+				return
+			}
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantBody, string(body))
+		})
+	}
+}
+
+func TestNewRequestBodyPropertiesError(t *testing.T) {
+	tests := []struct {
+		desc        string
+		giveHeader  http.Header
+		giveRequest interface{}
+		wantError   string
+	}{
+		{
+			desc:       "without a body",
+			giveHeader: make(http.Header),
+			wantError:  "body properties can only be set for JSON request bodies",
+		},
+		{
+			desc:        "reader",
+			giveHeader:  make(http.Header),
+			giveRequest: strings.NewReader("fern"),
+			wantError:   "body properties can only be set for JSON request bodies",
+		},
+		{
+			desc:        "form",
+			giveHeader:  http.Header{contentTypeHeader: []string{"application/x-www-form-urlencoded"}},
+			giveRequest: &Request{Id: "123"},
+			wantError:   "body properties can only be set for JSON request bodies",
+		},
+		{
+			desc:        "list",
+			giveHeader:  make(http.Header),
+			giveRequest: []string{"fern"},
+			wantError:   "body properties can only be set for JSON object request bodies, but the request is a []string",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := newRequest(
+				context.Background(),
+				"https://api.example.com",
+				http.MethodPost,
+				test.giveHeader,
+				test.giveRequest,
+				nil,
+				nil,
+				map[string]interface{}{"beta": true},
+				"",
+			)
+			assert.EqualError(t, err, test.wantError)
+		})
+	}
+}
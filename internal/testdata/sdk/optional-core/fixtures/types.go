@@ -9,7 +9,7 @@ import (
 )
 
 type Optional struct {
-	Value string `json:"value"`
+	Value string `json:"value" url:"value"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (o *Optional) String() string {
 	}
 	return fmt.Sprintf("%#v", o)
 }
+
+// Validate reports all of the Optional's invalid fields (e.g. missing
+// required fields), if any.
+func (o *Optional) Validate() error {
+	return nil
+}
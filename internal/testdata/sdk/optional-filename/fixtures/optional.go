@@ -9,7 +9,7 @@ import (
 )
 
 type Request struct {
-	Value string `json:"value"`
+	Value string `json:"value" url:"value"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (r *Request) String() string {
 	}
 	return fmt.Sprintf("%#v", r)
 }
+
+// Validate reports all of the Request's invalid fields (e.g. missing
+// required fields), if any.
+func (r *Request) Validate() error {
+	return nil
+}
@@ -0,0 +1,390 @@
+package core
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// queryStyleForm adds a value for each of a list's elements (e.g. tag=a&tag=b),
+	// and each of an object's properties (e.g. name=a). This is the default style
+	// for lists.
+	queryStyleForm = "form"
+
+	// queryStyleDeepObject adds a value for each of an object's properties and a
+	// map's entries with a bracketed key (e.g. filter[name]=a). This is the default
+	// style for objects, maps, and unions.
+	queryStyleDeepObject = "deepObject"
+
+	// queryStyleComma joins a list's elements with a comma (e.g. tag=a,b).
+	queryStyleComma = "comma"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	bytesType         = reflect.TypeOf([]byte(nil))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	stringerType      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// queryOptions are the options set by a field's url tag, e.g.
+//
+//	Tags []string `url:"tags,omitempty,comma"`
+type queryOptions struct {
+	omitEmpty bool
+	date      bool
+	style     string
+}
+
+// QueryValues encodes the fields of the given struct (typically a request type)
+// as query parameters. Only the fields with a url tag are encoded, and each field
+// is encoded with the style set by its tag's options (i.e. form, deepObject, or
+// comma), e.g.
+//
+//	Filter *Filter   `url:"filter,omitempty"`
+//	Tags   []string  `url:"tags,comma"`
+//	Since  time.Time `url:"since,date"`
+//
+// Nil fields are always omitted, and so are empty fields with the omitempty option.
+// Dates are formatted as an RFC 3339 full-date with the date option, and as an RFC
+// 3339 date-time otherwise. Objects are encoded by their own url-tagged fields, and
+// any other value that implements json.Marshaler (e.g. a union) is encoded by its
+// JSON representation.
+func QueryValues(v interface{}) (url.Values, error) {
+	values := make(url.Values)
+	if v == nil {
+		return values, nil
+	}
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return values, nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot encode %s as query parameters: expected a struct", value.Type())
+	}
+	if err := encodeQueryFields(values, "", value, queryStyleForm); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// withQueryParameters returns the given URL with the given query parameters,
+// which take precedence over the URL's own parameters of the same name.
+func withQueryParameters(rawURL string, queryParameters url.Values) (string, error) {
+	if len(queryParameters) == 0 {
+		return rawURL, nil
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	query := parsed.Query()
+	for key, values := range queryParameters {
+		query[key] = values
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+// FormValues encodes the given request body as application/x-www-form-urlencoded
+// values. Unlike QueryValues, the body is encoded by its JSON representation (i.e.
+// its json tags), so that it's sent the same way as a JSON body would be. Each of
+// the body's properties is sent as a separate value, lists are repeated (e.g.
+// tag=a&tag=b), and nested objects are sent with bracketed keys (e.g. filter[name]=a).
+func FormValues(v interface{}) (url.Values, error) {
+	values := make(url.Values)
+	if v == nil {
+		return values, nil
+	}
+	decoded, err := decodeJSONRepresentation(v)
+	if err != nil {
+		return nil, err
+	}
+	switch decoded.(type) {
+	case nil:
+		return values, nil
+	case map[string]interface{}:
+		encodeQueryJSONValue(values, "", decoded, queryStyleForm)
+		return values, nil
+	}
+	return nil, fmt.Errorf("cannot encode %T as form values: expected an object", v)
+}
+
+// encodeQueryFields encodes all of the given struct's url-tagged fields, which are
+// nested in the given key unless the style is form.
+func encodeQueryFields(values url.Values, key string, value reflect.Value, style string) error {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if field.PkgPath != "" {
+			// Unexported fields (e.g. literals) are skipped.
+			continue
+		}
+		tag, ok := field.Tag.Lookup("url")
+		if !ok || tag == "-" {
+			continue
+		}
+		name, options := parseQueryTag(tag)
+		if name == "" {
+			name = field.Name
+		}
+		fieldValue := value.Field(i)
+		if options.omitEmpty && fieldValue.IsZero() {
+			continue
+		}
+		fieldKey := name
+		if key != "" && style != queryStyleForm {
+			fieldKey = key + "[" + name + "]"
+		}
+		if err := encodeQueryValue(values, fieldKey, fieldValue, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeQueryValue encodes the given value with the given key.
+func encodeQueryValue(values url.Values, key string, value reflect.Value, options *queryOptions) error {
+	value, ok := indirectQueryValue(value)
+	if !ok {
+		return nil
+	}
+	if s, ok := formatQueryScalar(value, options); ok {
+		values.Add(key, s)
+		return nil
+	}
+	style := options.style
+	switch value.Kind() {
+	case reflect.Struct:
+		if style == "" {
+			style = queryStyleDeepObject
+		}
+		if hasQueryFields(value.Type()) {
+			return encodeQueryFields(values, key, value, style)
+		}
+		if value.Type().Implements(jsonMarshalerType) || reflect.PtrTo(value.Type()).Implements(jsonMarshalerType) {
+			return encodeQueryJSON(values, key, value, style)
+		}
+		values.Add(key, fmt.Sprint(value.Interface()))
+	case reflect.Map:
+		if style == "" {
+			style = queryStyleDeepObject
+		}
+		entries := make(map[string]reflect.Value, value.Len())
+		for _, mapKey := range value.MapKeys() {
+			entries[fmt.Sprint(mapKey.Interface())] = value.MapIndex(mapKey)
+		}
+		for _, entryKey := range sortedQueryKeys(entries) {
+			nestedKey := entryKey
+			if style != queryStyleForm {
+				nestedKey = key + "[" + entryKey + "]"
+			}
+			if err := encodeQueryValue(values, nestedKey, entries[entryKey], &queryOptions{date: options.date}); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if style == queryStyleComma {
+			elements := make([]string, 0, value.Len())
+			for i := 0; i < value.Len(); i++ {
+				element, ok := indirectQueryValue(value.Index(i))
+				if !ok {
+					continue
+				}
+				s, ok := formatQueryScalar(element, options)
+				if !ok {
+					return fmt.Errorf("cannot encode %s as a comma-delimited query parameter", element.Type())
+				}
+				elements = append(elements, s)
+			}
+			if len(elements) > 0 {
+				values.Add(key, strings.Join(elements, ","))
+			}
+			return nil
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := encodeQueryValue(values, key, value.Index(i), &queryOptions{date: options.date}); err != nil {
+				return err
+			}
+		}
+	default:
+		values.Add(key, fmt.Sprint(value.Interface()))
+	}
+	return nil
+}
+
+// encodeQueryJSON encodes the given value by its JSON representation, which is
+// used for the types that don't have any url-tagged fields (e.g. unions).
+func encodeQueryJSON(values url.Values, key string, value reflect.Value, style string) error {
+	if value.CanAddr() {
+		// The json.Marshaler might be implemented by the pointer receiver.
+		value = value.Addr()
+	}
+	decoded, err := decodeJSONRepresentation(value.Interface())
+	if err != nil {
+		return err
+	}
+	encodeQueryJSONValue(values, key, decoded, style)
+	return nil
+}
+
+// decodeJSONRepresentation returns the generic JSON representation of the given
+// value (i.e. maps, slices, and scalars), where numbers are preserved as-is.
+func decodeJSONRepresentation(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// encodeQueryJSONValue encodes the given decoded JSON value with the given key.
+func encodeQueryJSONValue(values url.Values, key string, value interface{}, style string) {
+	switch typed := value.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		keys := make([]string, 0, len(typed))
+		for property := range typed {
+			keys = append(keys, property)
+		}
+		sort.Strings(keys)
+		for _, property := range keys {
+			nestedKey := property
+			if style != queryStyleForm {
+				nestedKey = key + "[" + property + "]"
+			}
+			encodeQueryJSONValue(values, nestedKey, typed[property], queryStyleDeepObject)
+		}
+	case []interface{}:
+		if style == queryStyleComma {
+			elements := make([]string, 0, len(typed))
+			for _, element := range typed {
+				elements = append(elements, fmt.Sprint(element))
+			}
+			values.Add(key, strings.Join(elements, ","))
+			return
+		}
+		for _, element := range typed {
+			encodeQueryJSONValue(values, key, element, queryStyleDeepObject)
+		}
+	case bool:
+		values.Add(key, strconv.FormatBool(typed))
+	default:
+		// The remaining values are either strings or json.Numbers.
+		values.Add(key, fmt.Sprint(typed))
+	}
+}
+
+// indirectQueryValue dereferences the given value's pointers and interfaces, as
+// well as any Optional it wraps. False is returned if the value is nil or null.
+func indirectQueryValue(value reflect.Value) (reflect.Value, bool) {
+	for {
+		if !value.IsValid() {
+			return value, false
+		}
+		if optional, ok := value.Interface().(interface{ IsSet() bool }); ok && value.Kind() == reflect.Ptr {
+			// An Optional is encoded as its value, unless it's null.
+			if !optional.IsSet() {
+				return value, false
+			}
+			value = value.Elem().FieldByName("Value")
+			continue
+		}
+		switch value.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if value.IsNil() {
+				return value, false
+			}
+			value = value.Elem()
+		case reflect.Slice, reflect.Map:
+			return value, !value.IsNil()
+		default:
+			return value, true
+		}
+	}
+}
+
+// formatQueryScalar formats the given value if it's represented as a single
+// query parameter value (e.g. a string, date, or UUID).
+func formatQueryScalar(value reflect.Value, options *queryOptions) (string, bool) {
+	switch value.Type() {
+	case timeType:
+		// These are equivalent to the Date and DateTime formats.
+		if options.date {
+			return value.Interface().(time.Time).Format("2006-01-02"), true
+		}
+		return value.Interface().(time.Time).Format(time.RFC3339Nano), true
+	case bytesType:
+		return base64.StdEncoding.EncodeToString(value.Bytes()), true
+	}
+	switch value.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice:
+		return "", false
+	case reflect.Array:
+		// Arrays are only formatted as a single value if they implement
+		// fmt.Stringer (e.g. a UUID).
+		if !value.Type().Implements(stringerType) {
+			return "", false
+		}
+	}
+	if stringer, ok := value.Interface().(fmt.Stringer); ok {
+		return stringer.String(), true
+	}
+	return fmt.Sprint(value.Interface()), true
+}
+
+// hasQueryFields returns true if the given struct type has any url-tagged fields.
+func hasQueryFields(structType reflect.Type) bool {
+	for i := 0; i < structType.NumField(); i++ {
+		if _, ok := structType.Field(i).Tag.Lookup("url"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseQueryTag returns the name and options specified by the given url tag.
+func parseQueryTag(tag string) (string, *queryOptions) {
+	parts := strings.Split(tag, ",")
+	options := new(queryOptions)
+	for _, option := range parts[1:] {
+		switch option {
+		case "omitempty":
+			options.omitEmpty = true
+		case "date":
+			options.date = true
+		case queryStyleForm, queryStyleDeepObject, queryStyleComma:
+			options.style = option
+		}
+	}
+	return parts[0], options
+}
+
+// sortedQueryKeys returns the keys of the given map in sorted order, so that
+// the encoded query parameters are deterministic.
+func sortedQueryKeys(entries map[string]reflect.Value) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
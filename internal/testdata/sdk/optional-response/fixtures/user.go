@@ -9,7 +9,7 @@ import (
 )
 
 type User struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (u *User) String() string {
 	}
 	return fmt.Sprintf("%#v", u)
 }
+
+// Validate reports all of the User's invalid fields (e.g. missing
+// required fields), if any.
+func (u *User) Validate() error {
+	return nil
+}
@@ -4,7 +4,6 @@ package user
 
 import (
 	context "context"
-	fmt "fmt"
 	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/optional-response/fixtures"
 	core "github.com/fern-api/fern-go/internal/testdata/sdk/optional-response/fixtures/core"
 	option "github.com/fern-api/fern-go/internal/testdata/sdk/optional-response/fixtures/option"
@@ -12,9 +11,10 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -23,11 +23,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -45,7 +53,7 @@ func (c *Client) GetName(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/name", userId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/name", userId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -56,10 +64,15 @@ func (c *Client) GetName(
 			URL:                endpointURL,
 			Method:             http.MethodGet,
 			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
 			Headers:            headers,
 			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
 			Response:           &response,
 			ResponseIsOptional: true,
+			Cache:              options.Cache,
+			CacheScope:         "endpoint_user.getName",
 		},
 	); err != nil {
 		return nil, err
@@ -81,7 +94,7 @@ func (c *Client) GetUser(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v", userId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v", userId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -92,10 +105,15 @@ func (c *Client) GetUser(
 			URL:                endpointURL,
 			Method:             http.MethodGet,
 			MaxAttempts:        options.MaxAttempts,
+			Timeout:            options.Timeout,
+			AttemptTimeout:     options.AttemptTimeout,
 			Headers:            headers,
 			Client:             options.HTTPClient,
+			QueryParameters:    options.QueryParameters,
 			Response:           &response,
 			ResponseIsOptional: true,
+			Cache:              options.Cache,
+			CacheScope:         "endpoint_user.getUser",
 		},
 	); err != nil {
 		return nil, err
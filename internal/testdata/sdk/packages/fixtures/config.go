@@ -5,3 +5,9 @@ package api
 type CreateConfigRequest struct {
 	Id string `json:"id"`
 }
+
+// Validate reports all of the CreateConfigRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (c *CreateConfigRequest) Validate() error {
+	return nil
+}
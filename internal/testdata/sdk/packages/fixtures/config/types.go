@@ -9,7 +9,7 @@ import (
 )
 
 type Config struct {
-	Id string `json:"id"`
+	Id string `json:"id" url:"id"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (c *Config) String() string {
 	}
 	return fmt.Sprintf("%#v", c)
 }
+
+// Validate reports all of the Config's invalid fields (e.g. missing
+// required fields), if any.
+func (c *Config) Validate() error {
+	return nil
+}
@@ -9,8 +9,8 @@ import (
 )
 
 type Organization struct {
-	Id   string `json:"id"`
-	Name string `json:"name"`
+	Id   string `json:"id" url:"id"`
+	Name string `json:"name" url:"name"`
 
 	_rawJSON json.RawMessage
 }
@@ -37,3 +37,9 @@ func (o *Organization) String() string {
 	}
 	return fmt.Sprintf("%#v", o)
 }
+
+// Validate reports all of the Organization's invalid fields (e.g. missing
+// required fields), if any.
+func (o *Organization) Validate() error {
+	return nil
+}
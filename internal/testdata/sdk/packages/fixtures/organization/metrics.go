@@ -7,3 +7,9 @@ type CreateMetricsTagRequest struct {
 	String  *string `json:"string,omitempty"`
 	Boolean *bool   `json:"boolean,omitempty"`
 }
+
+// Validate reports all of the CreateMetricsTagRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (c *CreateMetricsTagRequest) Validate() error {
+	return nil
+}
@@ -5,6 +5,7 @@ package metrics
 import (
 	json "encoding/json"
 	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/core"
 )
 
 type Tag struct {
@@ -12,6 +13,8 @@ type Tag struct {
 	Number  int
 	String  string
 	Boolean bool
+
+	_unknown json.RawMessage
 }
 
 func NewTagFromNumber(value int) *Tag {
@@ -59,6 +62,8 @@ func (t *Tag) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		t.Boolean = valueUnmarshaler.Boolean
+	default:
+		t._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -66,6 +71,9 @@ func (t *Tag) UnmarshalJSON(data []byte) error {
 func (t Tag) MarshalJSON() ([]byte, error) {
 	switch t.Type {
 	default:
+		if t._unknown != nil {
+			return t._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", t.Type, t)
 	case "number":
 		var marshaler = struct {
@@ -101,11 +109,17 @@ type TagVisitor interface {
 	VisitNumber(int) error
 	VisitString(string) error
 	VisitBoolean(bool) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (t *Tag) Accept(visitor TagVisitor) error {
 	switch t.Type {
 	default:
+		if t._unknown != nil {
+			return visitor.VisitUnknown(t.Type, t._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", t.Type, t)
 	case "number":
 		return visitor.VisitNumber(t.Number)
@@ -115,3 +129,22 @@ func (t *Tag) Accept(visitor TagVisitor) error {
 		return visitor.VisitBoolean(t.Boolean)
 	}
 }
+
+// Validate reports all of the Tag's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Tag) Validate() error {
+	if t == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch t.Type {
+	case "number":
+	case "string":
+	case "boolean":
+	default:
+		if t._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", t.Type))
+		}
+	}
+	return validation.Err()
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type Error struct {
-	Message string `json:"message"`
+	Message string `json:"message" url:"message"`
 
 	_rawJSON json.RawMessage
 }
@@ -37,9 +37,15 @@ func (e *Error) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the Error's invalid fields (e.g. missing
+// required fields), if any.
+func (e *Error) Validate() error {
+	return nil
+}
+
 type Foo struct {
-	Id   string `json:"id"`
-	Name string `json:"name"`
+	Id   string `json:"id" url:"id"`
+	Name string `json:"name" url:"name"`
 
 	_rawJSON json.RawMessage
 }
@@ -66,3 +72,9 @@ func (f *Foo) String() string {
 	}
 	return fmt.Sprintf("%#v", f)
 }
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
@@ -4,22 +4,22 @@ package client
 
 import (
 	context "context"
-	fmt "fmt"
 	core "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/core"
 	option "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/option"
 	fixturesuser "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/user"
 	notificationclient "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/user/notification/client"
-	useruser "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/user/user"
+	user "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/user/user"
 	http "net/http"
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 
 	Notification *notificationclient.Client
-	User         *useruser.Client
+	User         *user.Client
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -28,19 +28,27 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
 		header:       options.ToHeader(),
+		validation:   options.Validation,
 		Notification: notificationclient.NewClient(opts...),
-		User:         useruser.NewClient(opts...),
+		User:         user.NewClient(opts...),
 	}
 }
 
 func (c *Client) GetUser(
 	ctx context.Context,
-	user string,
+	_user string,
 	opts ...option.RequestOption,
 ) (*fixturesuser.User, error) {
 	options := core.NewRequestOptions(opts...)
@@ -52,7 +60,7 @@ func (c *Client) GetUser(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v", user)
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v", _user)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
@@ -60,12 +68,17 @@ func (c *Client) GetUser(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user.getUser",
 		},
 	); err != nil {
 		return nil, err
@@ -4,20 +4,20 @@ package client
 
 import (
 	context "context"
-	fmt "fmt"
 	core "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/core"
 	option "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/option"
-	notification "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/user/notification"
-	notificationnotification "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/user/notification/notification"
+	usernotification "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/user/notification"
+	notification "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures/user/notification/notification"
 	http "net/http"
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 
-	Notification *notificationnotification.Client
+	Notification *notification.Client
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -26,12 +26,20 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
 		header:       options.ToHeader(),
-		Notification: notificationnotification.NewClient(opts...),
+		validation:   options.Validation,
+		Notification: notification.NewClient(opts...),
 	}
 }
 
@@ -40,7 +48,7 @@ func (c *Client) GetUserNotification(
 	userId string,
 	notificationId string,
 	opts ...option.RequestOption,
-) (*notification.Notification, error) {
+) (*usernotification.Notification, error) {
 	options := core.NewRequestOptions(opts...)
 
 	baseURL := "https://api.foo.io/v1"
@@ -50,20 +58,25 @@ func (c *Client) GetUserNotification(
 	if options.BaseURL != "" {
 		baseURL = options.BaseURL
 	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/notifications/%v", userId, notificationId)
+	endpointURL := core.EncodeURL(baseURL+"/"+"users/%v/notifications/%v", userId, notificationId)
 
 	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
 
-	var response *notification.Notification
+	var response *usernotification.Notification
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user/notification.getUserNotification",
 		},
 	); err != nil {
 		return nil, err
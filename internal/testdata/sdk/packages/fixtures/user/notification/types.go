@@ -9,8 +9,8 @@ import (
 )
 
 type Notification struct {
-	Id      string `json:"id"`
-	Message string `json:"message"`
+	Id      string `json:"id" url:"id"`
+	Message string `json:"message" url:"message"`
 
 	_rawJSON json.RawMessage
 }
@@ -37,3 +37,9 @@ func (n *Notification) String() string {
 	}
 	return fmt.Sprintf("%#v", n)
 }
+
+// Validate reports all of the Notification's invalid fields (e.g. missing
+// required fields), if any.
+func (n *Notification) Validate() error {
+	return nil
+}
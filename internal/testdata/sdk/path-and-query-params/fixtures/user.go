@@ -3,5 +3,11 @@
 package api
 
 type GetUserRequest struct {
-	Shallow *bool `json:"-"`
+	Shallow *bool `json:"-" url:"shallow,omitempty"`
+}
+
+// Validate reports all of the GetUserRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetUserRequest) Validate() error {
+	return nil
 }
@@ -0,0 +1,282 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Set("a", &CachedResponse{ETag: `"a"`})
+	cache.Set("b", &CachedResponse{ETag: `"b"`})
+
+	// Using "a" makes "b" the least recently used response.
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+	cache.Set("c", &CachedResponse{ETag: `"c"`})
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	response, ok := cache.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, `"a"`, response.ETag)
+	response, ok = cache.Get("c")
+	require.True(t, ok)
+	assert.Equal(t, `"c"`, response.ETag)
+
+	cache.Delete("a")
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestCallCache(t *testing.T) {
+	tests := []struct {
+		desc              string
+		giveCacheControl  string
+		giveRequestHeader http.Header
+		giveScope         string
+		wantRequests      int32
+		wantNotModified   int32
+	}{
+		{
+			desc:            "revalidated",
+			giveScope:       "endpoint_user.get",
+			wantRequests:    3,
+			wantNotModified: 2,
+		},
+		{
+			desc:             "fresh",
+			giveCacheControl: "max-age=60",
+			giveScope:        "endpoint_user.get",
+			wantRequests:     1,
+		},
+		{
+			desc:              "no-cache request",
+			giveCacheControl:  "max-age=60",
+			giveRequestHeader: http.Header{"Cache-Control": []string{"no-cache"}},
+			giveScope:         "endpoint_user.get",
+			wantRequests:      3,
+			wantNotModified:   2,
+		},
+		{
+			desc:             "no-store response",
+			giveCacheControl: "no-store",
+			giveScope:        "endpoint_user.get",
+			wantRequests:     3,
+		},
+		{
+			desc:             "private response",
+			giveCacheControl: "private, max-age=60",
+			giveScope:        "endpoint_user.get",
+			wantRequests:     3,
+		},
+		{
+			desc:         "without a scope",
+			wantRequests: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var requests, notModified int32
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						atomic.AddInt32(&requests, 1)
+						if test.giveCacheControl != "" {
+							w.Header().Set(cacheControlHeader, test.giveCacheControl)
+						}
+						w.Header().Set(etagHeader, `"v1"`)
+						if r.Header.Get(ifNoneMatchHeader) == `"v1"` {
+							atomic.AddInt32(&notModified, 1)
+							w.WriteHeader(http.StatusNotModified)
+							return
+						}
+						_, err := w.Write([]byte(`{"id":"123"}`))
+						require.NoError(t, err)
+					},
+				),
+			)
+			defer server.Close()
+
+			caller := NewCaller(
+				&CallerParams{
+					Client: server.Client(),
+					Cache:  NewLRUCache(10),
+				},
+				nil,
+			)
+			for i := 0; i < 3; i++ {
+				var response *Response
+				err := caller.Call(
+					context.Background(),
+					&CallParams{
+						URL:        server.URL,
+						Method:     http.MethodGet,
+						Headers:    test.giveRequestHeader,
+						Response:   &response,
+						CacheScope: test.giveScope,
+					},
+				)
+				require.NoError(t, err)
+				assert.Equal(t, &Response{Id: "123"}, response)
+			}
+			assert.Equal(t, test.wantRequests, atomic.LoadInt32(&requests))
+			assert.Equal(t, test.wantNotModified, atomic.LoadInt32(&notModified))
+		})
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, "https://api.example.com/users/123", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "endpoint_user.get https://api.example.com/users/123", cacheKey("endpoint_user.get", request))
+
+	// Responses are never shared across credentials.
+	request.Header.Set("Authorization", "Bearer a")
+	keyA := cacheKey("endpoint_user.get", request)
+	request.Header.Set("Authorization", "Bearer b")
+	keyB := cacheKey("endpoint_user.get", request)
+	assert.NotEqual(t, keyA, keyB)
+
+	// The same applies to credentials sent with any other header.
+	request.Header.Set("X-API-Key", "a")
+	keyA = cacheKey("endpoint_user.get", request)
+	request.Header.Set("X-API-Key", "b")
+	keyB = cacheKey("endpoint_user.get", request)
+	assert.NotEqual(t, keyA, keyB)
+
+	// The headers that only control the cache don't change the key.
+	request.Header.Set(ifNoneMatchHeader, `"v1"`)
+	request.Header.Set(cacheControlHeader, "no-cache")
+	assert.Equal(t, keyB, cacheKey("endpoint_user.get", request))
+}
+
+func TestCallCacheNotModified(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				w.Header().Set(etagHeader, `"v1"`)
+				if r.Header.Get(ifNoneMatchHeader) == `"v1"` {
+					// The 304 refreshes the stored response's headers.
+					w.Header().Set(cacheControlHeader, "max-age=60")
+					w.Header().Set(lastModifiedHeader, "Tue, 02 Jan 2024 00:00:00 GMT")
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set(lastModifiedHeader, "Mon, 01 Jan 2024 00:00:00 GMT")
+				w.Header().Set("X-Request-Id", "1")
+				_, err := w.Write([]byte(`{"id":"123"}`))
+				require.NoError(t, err)
+			},
+		),
+	)
+	defer server.Close()
+
+	cache := &recordingCache{Cache: NewLRUCache(10)}
+	caller := NewCaller(
+		&CallerParams{
+			Client: server.Client(),
+			Cache:  cache,
+		},
+		nil,
+	)
+	for i := 0; i < 3; i++ {
+		var response *Response
+		err := caller.Call(
+			context.Background(),
+			&CallParams{
+				URL:        server.URL,
+				Method:     http.MethodGet,
+				Response:   &response,
+				CacheScope: "endpoint_user.get",
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, &Response{Id: "123"}, response)
+	}
+
+	// The response is fresh once it's revalidated, so the last call is served
+	// from the cache.
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	require.NotNil(t, cache.last)
+	assert.Equal(t, "Tue, 02 Jan 2024 00:00:00 GMT", cache.last.LastModified)
+	assert.Equal(t, "Tue, 02 Jan 2024 00:00:00 GMT", cache.last.Header.Get(lastModifiedHeader))
+	assert.Equal(t, "max-age=60", cache.last.Header.Get(cacheControlHeader))
+	assert.Equal(t, "1", cache.last.Header.Get("X-Request-Id"))
+	assert.Equal(t, `"v1"`, cache.last.ETag)
+	assert.False(t, cache.last.Expires.IsZero())
+}
+
+func TestIsStorable(t *testing.T) {
+	assert.True(t, isStorable(http.Header{cacheControlHeader: []string{"max-age=60"}}))
+	assert.True(t, isStorable(http.Header{varyHeader: []string{"Accept-Encoding"}}))
+	assert.False(t, isStorable(http.Header{cacheControlHeader: []string{"no-store"}}))
+	assert.False(t, isStorable(http.Header{cacheControlHeader: []string{"Private"}}))
+	assert.False(t, isStorable(http.Header{varyHeader: []string{"Accept, *"}}))
+}
+
+// recordingCache is a Cache that records the last response it stored.
+type recordingCache struct {
+	Cache
+
+	last *CachedResponse
+}
+
+func (r *recordingCache) Set(key string, response *CachedResponse) {
+	r.last = response
+	r.Cache.Set(key, response)
+}
+
+func TestExpiresFromHeader(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		desc       string
+		giveHeader http.Header
+		want       time.Time
+	}{
+		{
+			desc:       "without freshness",
+			giveHeader: http.Header{},
+		},
+		{
+			desc:       "max-age",
+			giveHeader: http.Header{"Cache-Control": []string{"public, max-age=60"}},
+			want:       now.Add(time.Minute),
+		},
+		{
+			desc:       "no-cache",
+			giveHeader: http.Header{"Cache-Control": []string{"no-cache, max-age=60"}},
+		},
+		{
+			desc:       "invalid max-age",
+			giveHeader: http.Header{"Cache-Control": []string{"max-age=soon"}},
+		},
+		{
+			desc:       "expires",
+			giveHeader: http.Header{"Expires": []string{"Mon, 01 Jan 2024 01:00:00 GMT"}},
+			want:       now.Add(time.Hour),
+		},
+		{
+			desc: "max-age takes precedence over expires",
+			giveHeader: http.Header{
+				"Cache-Control": []string{"max-age=60"},
+				"Expires":       []string{"Mon, 01 Jan 2024 01:00:00 GMT"},
+			},
+			want: now.Add(time.Minute),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.True(t, test.want.Equal(expiresFromHeader(test.giveHeader, now)))
+		})
+	}
+}
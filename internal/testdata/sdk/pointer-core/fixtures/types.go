@@ -9,7 +9,7 @@ import (
 )
 
 type String struct {
-	Value string `json:"value"`
+	Value string `json:"value" url:"value"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (s *String) String() string {
 	}
 	return fmt.Sprintf("%#v", s)
 }
+
+// Validate reports all of the String's invalid fields (e.g. missing
+// required fields), if any.
+func (s *String) Validate() error {
+	return nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type Pointer struct {
-	Name string `json:"name"`
+	Name string `json:"name" url:"name"`
 
 	_rawJSON json.RawMessage
 }
@@ -36,3 +36,9 @@ func (p *Pointer) String() string {
 	}
 	return fmt.Sprintf("%#v", p)
 }
+
+// Validate reports all of the Pointer's invalid fields (e.g. missing
+// required fields), if any.
+func (p *Pointer) Validate() error {
+	return nil
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -129,3 +130,154 @@ func TestOptional(t *testing.T) {
 		})
 	}
 }
+
+// OptionalResponse is equivalent to the generated code for an object
+// that represents its optional properties as a *Optional[T].
+type OptionalResponse struct {
+	Id        string               `json:"id"`
+	Filter    *Optional[string]    `json:"filter,omitempty"`
+	Reference *Optional[Reference] `json:"reference,omitempty"`
+}
+
+func (o *OptionalResponse) UnmarshalJSON(data []byte) error {
+	type unmarshaler OptionalResponse
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = OptionalResponse(value)
+	nullProperties, err := NullProperties(data, "filter", "reference")
+	if err != nil {
+		return err
+	}
+	if nullProperties["filter"] {
+		o.Filter = &Optional[string]{Null: true}
+	}
+	if nullProperties["reference"] {
+		o.Reference = &Optional[Reference]{Null: true}
+	}
+	return nil
+}
+
+func TestOptionalUnmarshal(t *testing.T) {
+	tests := []struct {
+		desc         string
+		giveBytes    []byte
+		wantResponse *OptionalResponse
+	}{
+		{
+			desc:      "set",
+			giveBytes: []byte(`{"id":"xyz","filter":"foo","reference":{"id":"abc","tags":["one"],"extra":"metadata"}}`),
+			wantResponse: &OptionalResponse{
+				Id: "xyz",
+				Filter: &Optional[string]{
+					Value: "foo",
+				},
+				Reference: &Optional[Reference]{
+					Value: Reference{
+						Id:   "abc",
+						Tags: []string{"one"},
+					},
+				},
+			},
+		},
+		{
+			desc:      "zero value",
+			giveBytes: []byte(`{"id":"xyz","filter":""}`),
+			wantResponse: &OptionalResponse{
+				Id: "xyz",
+				Filter: &Optional[string]{
+					Value: "",
+				},
+			},
+		},
+		{
+			desc:      "null",
+			giveBytes: []byte(`{"id":"xyz","filter":null,"reference":null}`),
+			wantResponse: &OptionalResponse{
+				Id: "xyz",
+				Filter: &Optional[string]{
+					Null: true,
+				},
+				Reference: &Optional[Reference]{
+					Null: true,
+				},
+			},
+		},
+		{
+			desc:      "absent",
+			giveBytes: []byte(`{"id":"xyz"}`),
+			wantResponse: &OptionalResponse{
+				Id: "xyz",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var response OptionalResponse
+			require.NoError(t, json.Unmarshal(test.giveBytes, &response))
+			assert.Equal(t, test.wantResponse, &response)
+
+			bytes, err := json.Marshal(&response)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(test.giveBytes), string(bytes))
+		})
+	}
+}
+
+func TestNullProperties(t *testing.T) {
+	nullProperties, err := NullProperties([]byte(`{"Filter":null,"reference":null,"other":null,"id":"xyz"}`), "filter", "reference")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"filter": true, "reference": true, "other": true}, nullProperties)
+}
+
+func TestOptionalUnmarshalValue(t *testing.T) {
+	var set Optional[string]
+	require.NoError(t, json.Unmarshal([]byte(`"foo"`), &set))
+	assert.True(t, set.IsSet())
+	assert.False(t, set.IsNull())
+	assert.Equal(t, "foo", set.Value)
+
+	var null Optional[string]
+	require.NoError(t, json.Unmarshal([]byte("null"), &null))
+	assert.False(t, null.IsSet())
+	assert.True(t, null.IsNull())
+
+	var absent *Optional[string]
+	assert.False(t, absent.IsSet())
+	assert.False(t, absent.IsNull())
+
+	var invalid Optional[string]
+	assert.Error(t, json.Unmarshal([]byte("42"), &invalid))
+}
+
+func TestMapOptional(t *testing.T) {
+	length := func(value string) int { return len(value) }
+	assert.Nil(t, MapOptional[string, int](nil, length))
+	assert.Equal(t, &Optional[int]{Null: true}, MapOptional(&Optional[string]{Null: true}, length))
+	assert.Equal(t, &Optional[int]{Value: 3}, MapOptional(&Optional[string]{Value: "foo"}, length))
+}
+
+func TestWrapOptional(t *testing.T) {
+	wrap := func(value interface{}) string { return fmt.Sprint(value) }
+	assert.Nil(t, WrapOptional[int, string](nil, wrap))
+	assert.Equal(t, &Optional[string]{Null: true}, WrapOptional(&Optional[int]{Null: true}, wrap))
+	assert.Equal(t, &Optional[string]{Value: "[1 2]"}, WrapOptional(&Optional[[]int]{Value: []int{1, 2}}, wrap))
+}
+
+func TestDecodeOptional(t *testing.T) {
+	decode := func(value string, target interface{}) error {
+		return json.Unmarshal([]byte(value), target)
+	}
+	target := &Optional[[]int]{Value: []int{1}}
+	require.NoError(t, DecodeOptional[[]int](nil, &target, decode))
+	assert.Nil(t, target)
+
+	require.NoError(t, DecodeOptional(&Optional[string]{Null: true}, &target, decode))
+	assert.Equal(t, &Optional[[]int]{Null: true}, target)
+
+	require.NoError(t, DecodeOptional(&Optional[string]{Value: "[1,2]"}, &target, decode))
+	assert.Equal(t, &Optional[[]int]{Value: []int{1, 2}}, target)
+
+	assert.Error(t, DecodeOptional(&Optional[string]{Value: "{}"}, &target, decode))
+}
@@ -2,7 +2,10 @@ module acme.io/sdk
 
 go 1.18
 
-require github.com/stretchr/testify v1.7.0
+require (
+	github.com/klauspost/compress v1.17.0
+	github.com/stretchr/testify v1.7.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
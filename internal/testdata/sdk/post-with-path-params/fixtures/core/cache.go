@@ -0,0 +1,339 @@
+package core
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	cacheControlHeader    = "Cache-Control"
+	contentLengthHeader   = "Content-Length"
+	etagHeader            = "ETag"
+	lastModifiedHeader    = "Last-Modified"
+	ifNoneMatchHeader     = "If-None-Match"
+	ifModifiedSinceHeader = "If-Modified-Since"
+	varyHeader            = "Vary"
+)
+
+// Cache stores the responses of cacheable endpoints (i.e. GET endpoints), keyed
+// by the endpoint, the request's URL, and its headers. Implementations must be
+// safe for concurrent use.
+//
+// A Cache may be shared by several clients, so responses marked private (i.e.
+// with Cache-Control: private) are never stored.
+type Cache interface {
+	// Get returns the response stored with the given key, if any.
+	Get(key string) (*CachedResponse, bool)
+
+	// Set stores the given response with the given key.
+	Set(key string, response *CachedResponse)
+
+	// Delete removes the response stored with the given key, if any.
+	Delete(key string)
+}
+
+// CachedResponse is a response stored in a Cache, along with the validators
+// used to revalidate it with a conditional request.
+type CachedResponse struct {
+	StatusCode   int
+	Header       http.Header
+	Body         []byte
+	ETag         string
+	LastModified string
+
+	// Expires is the time until which the response is fresh, so it's served
+	// without contacting the server. The response is always revalidated if
+	// it's zero.
+	Expires time.Time
+}
+
+// NewLRUCache returns a new in-memory Cache that holds up to the given number of
+// responses, and evicts the least recently used response once it's full.
+func NewLRUCache(capacity int) Cache {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &lruCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// lruCache is the in-memory Cache returned by NewLRUCache.
+type lruCache struct {
+	mutex    sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+// lruEntry is the value of each of the lruCache's list elements.
+type lruEntry struct {
+	key      string
+	response *CachedResponse
+}
+
+func (l *lruCache) Get(key string) (*CachedResponse, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	element, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(element)
+	return element.Value.(*lruEntry).response, true
+}
+
+func (l *lruCache) Set(key string, response *CachedResponse) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if element, ok := l.entries[key]; ok {
+		element.Value.(*lruEntry).response = response
+		l.order.MoveToFront(element)
+		return
+	}
+	l.entries[key] = l.order.PushFront(&lruEntry{key: key, response: response})
+	if l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+func (l *lruCache) Delete(key string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if element, ok := l.entries[key]; ok {
+		l.order.Remove(element)
+		delete(l.entries, key)
+	}
+}
+
+// withCache wraps the given RetryFunc so that the responses of the endpoint
+// identified by the given scope are stored in the given cache. A fresh response
+// is served from the cache without contacting the server, and a stale one is
+// revalidated with the If-None-Match and If-Modified-Since headers, so that a
+// 304 (Not Modified) response is served from the cache, too.
+//
+// Requests that aren't cacheable (e.g. a POST request, or an endpoint without a
+// scope) are issued as-is.
+func withCache(fn RetryFunc, cache Cache, scope string) RetryFunc {
+	if cache == nil || scope == "" {
+		return fn
+	}
+	return func(request *http.Request) (*http.Response, error) {
+		if request.Method != http.MethodGet {
+			return fn(request)
+		}
+		requestCacheControl := parseCacheControl(request.Header)
+		if _, ok := requestCacheControl["no-store"]; ok {
+			return fn(request)
+		}
+		key := cacheKey(scope, request)
+		cached, ok := cache.Get(key)
+		if ok {
+			_, noCache := requestCacheControl["no-cache"]
+			if !noCache && time.Now().Before(cached.Expires) {
+				return cached.toResponse(request), nil
+			}
+			// The response is stale, so it's revalidated with a conditional
+			// request. The request is cloned so that the validators are only
+			// sent with this attempt.
+			request = request.Clone(request.Context())
+			if cached.ETag != "" {
+				request.Header.Set(ifNoneMatchHeader, cached.ETag)
+			}
+			if cached.LastModified != "" {
+				request.Header.Set(ifModifiedSinceHeader, cached.LastModified)
+			}
+		}
+		response, err := fn(request)
+		if err != nil {
+			return nil, err
+		}
+		if ok && response.StatusCode == http.StatusNotModified {
+			_ = response.Body.Close()
+			// The server confirmed the cached response is still valid, so the
+			// stored headers are updated with the 304's (see RFC 9111 4.3.4),
+			// which refreshes its validators and freshness.
+			revalidated := *cached
+			revalidated.Header = mergeNotModifiedHeader(cached.Header, response.Header)
+			revalidated.ETag = revalidated.Header.Get(etagHeader)
+			revalidated.LastModified = revalidated.Header.Get(lastModifiedHeader)
+			revalidated.Expires = expiresFromHeader(revalidated.Header, time.Now())
+			if isStorable(revalidated.Header) {
+				cache.Set(key, &revalidated)
+			} else {
+				cache.Delete(key)
+			}
+			return revalidated.toResponse(request), nil
+		}
+		if response.StatusCode != http.StatusOK {
+			return response, nil
+		}
+		if !isStorable(response.Header) {
+			cache.Delete(key)
+			return response, nil
+		}
+		stored := &CachedResponse{
+			StatusCode:   response.StatusCode,
+			Header:       response.Header.Clone(),
+			ETag:         response.Header.Get(etagHeader),
+			LastModified: response.Header.Get(lastModifiedHeader),
+			Expires:      expiresFromHeader(response.Header, time.Now()),
+		}
+		if stored.ETag == "" && stored.LastModified == "" && stored.Expires.IsZero() {
+			// The response can't be revalidated, nor is it fresh, so there's
+			// no reason to store it.
+			return response, nil
+		}
+		body, err := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		stored.Body = body
+		cache.Set(key, stored)
+		response.Body = io.NopCloser(bytes.NewReader(body))
+		return response, nil
+	}
+}
+
+// toResponse returns a new *http.Response for the given request that's served
+// from the cached response.
+func (c *CachedResponse) toResponse(request *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(c.StatusCode) + " " + http.StatusText(c.StatusCode),
+		StatusCode:    c.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.Body)),
+		ContentLength: int64(len(c.Body)),
+		Request:       request,
+	}
+}
+
+// cacheKey returns the key of the given request's response, which is scoped to
+// the endpoint, the request's URL, and its headers (e.g. its credentials, whether
+// they're sent with the Authorization header or an API key header), so that a
+// cache shared by clients with different headers never serves one's response to
+// another. This also covers every header a response can vary on.
+//
+// The headers that only control the cache itself (e.g. If-None-Match) are
+// excluded.
+func cacheKey(scope string, request *http.Request) string {
+	key := scope + " " + request.URL.String()
+	names := make([]string, 0, len(request.Header))
+	for name := range request.Header {
+		switch http.CanonicalHeaderKey(name) {
+		case cacheControlHeader, ifNoneMatchHeader, ifModifiedSinceHeader:
+			continue
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return key
+	}
+	sort.Strings(names)
+	digest := sha256.New()
+	for _, name := range names {
+		for _, value := range request.Header[name] {
+			_, _ = io.WriteString(digest, http.CanonicalHeaderKey(name)+": "+value+"\n")
+		}
+	}
+	return key + " " + hex.EncodeToString(digest.Sum(nil))
+}
+
+// isStorable returns true if the response with the given header can be stored
+// in a (possibly shared) cache. Responses marked no-store or private aren't, nor
+// are responses that vary on something other than the request's headers (i.e.
+// Vary: *), which are all covered by the cache key.
+func isStorable(header http.Header) bool {
+	cacheControl := parseCacheControl(header)
+	if _, noStore := cacheControl["no-store"]; noStore {
+		return false
+	}
+	if _, private := cacheControl["private"]; private {
+		return false
+	}
+	for _, value := range header[varyHeader] {
+		for _, name := range strings.Split(value, ",") {
+			if strings.TrimSpace(name) == "*" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// mergeNotModifiedHeader returns the stored response's header, updated with the
+// header fields of the 304 (Not Modified) response that revalidated it. The 304's
+// Content-Length is ignored, since it never describes the stored body.
+func mergeNotModifiedHeader(stored http.Header, notModified http.Header) http.Header {
+	merged := stored.Clone()
+	if merged == nil {
+		merged = make(http.Header)
+	}
+	for name, values := range notModified {
+		if http.CanonicalHeaderKey(name) == contentLengthHeader {
+			continue
+		}
+		merged[http.CanonicalHeaderKey(name)] = values
+	}
+	return merged
+}
+
+// parseCacheControl returns the directives of the given Cache-Control header,
+// e.g. {"max-age": "60", "no-cache": ""}. Directive names are lowercase.
+func parseCacheControl(header http.Header) map[string]string {
+	directives := make(map[string]string)
+	for _, value := range header[cacheControlHeader] {
+		for _, directive := range strings.Split(value, ",") {
+			directive = strings.TrimSpace(directive)
+			if directive == "" {
+				continue
+			}
+			name, argument := directive, ""
+			if i := strings.Index(directive, "="); i >= 0 {
+				name, argument = directive[:i], strings.Trim(directive[i+1:], `"`)
+			}
+			directives[strings.ToLower(strings.TrimSpace(name))] = argument
+		}
+	}
+	return directives
+}
+
+// expiresFromHeader returns the time until which the response with the given
+// header is fresh, according to its Cache-Control max-age or its Expires
+// header. The zero time is returned if it must always be revalidated.
+func expiresFromHeader(header http.Header, now time.Time) time.Time {
+	cacheControl := parseCacheControl(header)
+	if _, noCache := cacheControl["no-cache"]; noCache {
+		return time.Time{}
+	}
+	if maxAge, ok := cacheControl["max-age"]; ok {
+		seconds, err := strconv.Atoi(maxAge)
+		if err != nil || seconds <= 0 {
+			return time.Time{}
+		}
+		return now.Add(time.Duration(seconds) * time.Second)
+	}
+	if expires := header.Get("Expires"); expires != "" {
+		if t, err := http.ParseTime(expires); err == nil && t.After(now) {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -12,11 +12,52 @@ type SetNameRequest struct {
 	UserName string `json:"userName"`
 }
 
+func (s *SetNameRequest) GetUserName() string {
+	if s == nil {
+		return ""
+	}
+	return s.UserName
+}
+
+// Validate reports all of the SetNameRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequest) Validate() error {
+	return nil
+}
+
 type SetNameRequestV3 struct {
 	XEndpointHeader string                `json:"-"`
 	Body            *SetNameRequestV3Body `json:"-"`
 }
 
+func (s *SetNameRequestV3) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV3) GetBody() *SetNameRequestV3Body {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+// Validate reports all of the SetNameRequestV3's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV3) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.Body == nil {
+		validation.Required("body")
+	}
+	validation.Add("body", s.Body.Validate())
+	return validation.Err()
+}
+
 func (s *SetNameRequestV3) UnmarshalJSON(data []byte) error {
 	body := new(SetNameRequestV3Body)
 	if err := json.Unmarshal(data, &body); err != nil {
@@ -35,6 +76,34 @@ type SetNameRequestV3Optional struct {
 	Body            *SetNameRequestV3Body `json:"-"`
 }
 
+func (s *SetNameRequestV3Optional) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV3Optional) GetBody() *SetNameRequestV3Body {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+// Validate reports all of the SetNameRequestV3Optional's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV3Optional) Validate() error {
+	if s == nil {
+		return nil
+	}
+	var validation core.Validation
+	if s.Body == nil {
+		validation.Required("body")
+	}
+	validation.Add("body", s.Body.Validate())
+	return validation.Err()
+}
+
 func (s *SetNameRequestV3Optional) UnmarshalJSON(data []byte) error {
 	body := new(SetNameRequestV3Body)
 	if err := json.Unmarshal(data, &body); err != nil {
@@ -53,6 +122,26 @@ type SetNameRequestV4 struct {
 	Body            []string `json:"-"`
 }
 
+func (s *SetNameRequestV4) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV4) GetBody() []string {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+// Validate reports all of the SetNameRequestV4's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV4) Validate() error {
+	return nil
+}
+
 func (s *SetNameRequestV4) UnmarshalJSON(data []byte) error {
 	var body []string
 	if err := json.Unmarshal(data, &body); err != nil {
@@ -71,6 +160,26 @@ type SetNameRequestV5 struct {
 	Body            string `json:"-"`
 }
 
+func (s *SetNameRequestV5) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV5) GetBody() string {
+	if s == nil {
+		return ""
+	}
+	return s.Body
+}
+
+// Validate reports all of the SetNameRequestV5's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV5) Validate() error {
+	return nil
+}
+
 func (s *SetNameRequestV5) UnmarshalJSON(data []byte) error {
 	var body string
 	if err := json.Unmarshal(data, &body); err != nil {
@@ -88,11 +197,18 @@ func (s *SetNameRequestV5) MarshalJSON() ([]byte, error) {
 }
 
 type Filter struct {
-	Tag string `json:"tag"`
+	Tag string `json:"tag" url:"tag"`
 
 	_rawJSON json.RawMessage
 }
 
+func (f *Filter) GetTag() string {
+	if f == nil {
+		return ""
+	}
+	return f.Tag
+}
+
 func (f *Filter) UnmarshalJSON(data []byte) error {
 	type unmarshaler Filter
 	var value unmarshaler
@@ -116,12 +232,25 @@ func (f *Filter) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the Filter's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Filter) Validate() error {
+	return nil
+}
+
 type SetNameRequestV3Body struct {
-	UserName string `json:"userName"`
+	UserName string `json:"userName" url:"userName"`
 
 	_rawJSON json.RawMessage
 }
 
+func (s *SetNameRequestV3Body) GetUserName() string {
+	if s == nil {
+		return ""
+	}
+	return s.UserName
+}
+
 func (s *SetNameRequestV3Body) UnmarshalJSON(data []byte) error {
 	type unmarshaler SetNameRequestV3Body
 	var value unmarshaler
@@ -145,10 +274,18 @@ func (s *SetNameRequestV3Body) String() string {
 	return fmt.Sprintf("%#v", s)
 }
 
+// Validate reports all of the SetNameRequestV3Body's invalid fields (e.g. missing
+// required fields), if any.
+func (s *SetNameRequestV3Body) Validate() error {
+	return nil
+}
+
 type Union struct {
 	Type string
 	Foo  *Foo
 	Bar  *Bar
+
+	_unknown json.RawMessage
 }
 
 func NewUnionFromFoo(value *Foo) *Union {
@@ -159,6 +296,27 @@ func NewUnionFromBar(value *Bar) *Union {
 	return &Union{Type: "bar", Bar: value}
 }
 
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
 func (u *Union) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -180,6 +338,8 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		u.Bar = value
+	default:
+		u._unknown = append(json.RawMessage(nil), data...)
 	}
 	return nil
 }
@@ -187,6 +347,9 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 func (u Union) MarshalJSON() ([]byte, error) {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return u._unknown, nil
+		}
 		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		var marshaler = struct {
@@ -212,11 +375,17 @@ func (u Union) MarshalJSON() ([]byte, error) {
 type UnionVisitor interface {
 	VisitFoo(*Foo) error
 	VisitBar(*Bar) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
 }
 
 func (u *Union) Accept(visitor UnionVisitor) error {
 	switch u.Type {
 	default:
+		if u._unknown != nil {
+			return visitor.VisitUnknown(u.Type, u._unknown)
+		}
 		return fmt.Errorf("invalid type %s in %T", u.Type, u)
 	case "foo":
 		return visitor.VisitFoo(u.Foo)
@@ -225,11 +394,93 @@ func (u *Union) Accept(visitor UnionVisitor) error {
 	}
 }
 
+// Validate reports all of the Union's invalid fields (e.g. missing
+// required fields), if any.
+func (u *Union) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch u.Type {
+	case "foo":
+		validation.Add("", u.Foo.Validate())
+	case "bar":
+		validation.Add("", u.Bar.Validate())
+	default:
+		if u._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", u.Type))
+		}
+	}
+	return validation.Err()
+}
+
 type UpdateRequest struct {
-	Tag            string  `json:"-"`
-	Extra          *string `json:"-"`
+	Tag            string  `json:"-" url:"tag"`
+	Extra          *string `json:"-" url:"extra,omitempty"`
 	Union          *Union  `json:"union,omitempty"`
 	Filter         *Filter `json:"filter,omitempty"`
 	OptionalUnion  *Union  `json:"optionalUnion,omitempty"`
 	OptionalFilter *Filter `json:"optionalFilter,omitempty"`
 }
+
+func (u *UpdateRequest) GetTag() string {
+	if u == nil {
+		return ""
+	}
+	return u.Tag
+}
+
+func (u *UpdateRequest) GetExtra() string {
+	if u == nil || u.Extra == nil {
+		return ""
+	}
+	return *u.Extra
+}
+
+func (u *UpdateRequest) GetUnion() *Union {
+	if u == nil {
+		return nil
+	}
+	return u.Union
+}
+
+func (u *UpdateRequest) GetFilter() *Filter {
+	if u == nil {
+		return nil
+	}
+	return u.Filter
+}
+
+func (u *UpdateRequest) GetOptionalUnion() *Union {
+	if u == nil {
+		return nil
+	}
+	return u.OptionalUnion
+}
+
+func (u *UpdateRequest) GetOptionalFilter() *Filter {
+	if u == nil {
+		return nil
+	}
+	return u.OptionalFilter
+}
+
+// Validate reports all of the UpdateRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UpdateRequest) Validate() error {
+	if u == nil {
+		return nil
+	}
+	var validation core.Validation
+	if u.Union == nil {
+		validation.Required("union")
+	}
+	validation.Add("union", u.Union.Validate())
+	if u.Filter == nil {
+		validation.Required("filter")
+	}
+	validation.Add("filter", u.Filter.Validate())
+	validation.Add("optionalUnion", u.OptionalUnion.Validate())
+	validation.Add("optionalFilter", u.OptionalFilter.Validate())
+	return validation.Err()
+}
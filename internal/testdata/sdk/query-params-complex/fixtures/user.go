@@ -11,19 +11,25 @@ import (
 )
 
 type GetUsersRequest struct {
-	Id               uuid.UUID  `json:"-"`
-	Date             time.Time  `json:"-"`
-	Deadline         time.Time  `json:"-"`
-	Bytes            []byte     `json:"-"`
-	OptionalId       *uuid.UUID `json:"-"`
-	OptionalDate     *time.Time `json:"-"`
-	OptionalDeadline *time.Time `json:"-"`
-	OptionalBytes    *[]byte    `json:"-"`
+	Id               uuid.UUID  `json:"-" url:"id"`
+	Date             time.Time  `json:"-" url:"date,date"`
+	Deadline         time.Time  `json:"-" url:"deadline"`
+	Bytes            []byte     `json:"-" url:"bytes"`
+	OptionalId       *uuid.UUID `json:"-" url:"optionalId,omitempty"`
+	OptionalDate     *time.Time `json:"-" url:"optionalDate,omitempty,date"`
+	OptionalDeadline *time.Time `json:"-" url:"optionalDeadline,omitempty"`
+	OptionalBytes    *[]byte    `json:"-" url:"optionalBytes,omitempty"`
+}
+
+// Validate reports all of the GetUsersRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetUsersRequest) Validate() error {
+	return nil
 }
 
 type User struct {
-	Name string   `json:"name"`
-	Tags []string `json:"tags,omitempty"`
+	Name string   `json:"name" url:"name"`
+	Tags []string `json:"tags,omitempty" url:"tags"`
 
 	_rawJSON json.RawMessage
 }
@@ -50,3 +56,9 @@ func (u *User) String() string {
 	}
 	return fmt.Sprintf("%#v", u)
 }
+
+// Validate reports all of the User's invalid fields (e.g. missing
+// required fields), if any.
+func (u *User) Validate() error {
+	return nil
+}
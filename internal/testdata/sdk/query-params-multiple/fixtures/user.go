@@ -4,8 +4,14 @@ package api
 
 type GetAllUsersRequest struct {
 	XEndpointHeader string   `json:"-"`
-	Tag             int      `json:"-"`
-	Limit           []*int   `json:"-"`
-	Filter          *string  `json:"-"`
-	Series          []string `json:"-"`
+	Tag             int      `json:"-" url:"tag"`
+	Limit           []*int   `json:"-" url:"limit,omitempty"`
+	Filter          *string  `json:"-" url:"filter,omitempty"`
+	Series          []string `json:"-" url:"series"`
+}
+
+// Validate reports all of the GetAllUsersRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetAllUsersRequest) Validate() error {
+	return nil
 }
@@ -9,13 +9,13 @@ import (
 	core "github.com/fern-api/fern-go/internal/testdata/sdk/query-params-multiple/fixtures/core"
 	option "github.com/fern-api/fern-go/internal/testdata/sdk/query-params-multiple/fixtures/option"
 	http "net/http"
-	url "net/url"
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
 }
 
 func NewClient(opts ...option.RequestOption) *Client {
@@ -24,11 +24,19 @@ func NewClient(opts ...option.RequestOption) *Client {
 		baseURL: options.BaseURL,
 		caller: core.NewCaller(
 			&core.CallerParams{
-				Client:      options.HTTPClient,
-				MaxAttempts: options.MaxAttempts,
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
 			},
+			options.RateLimiter,
 		),
-		header: options.ToHeader(),
+		header:     options.ToHeader(),
+		validation: options.Validation,
 	}
 }
 
@@ -39,6 +47,12 @@ func (c *Client) GetAllUsers(
 ) (string, error) {
 	options := core.NewRequestOptions(opts...)
 
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return "", err
+		}
+	}
+
 	baseURL := ""
 	if c.baseURL != "" {
 		baseURL = c.baseURL
@@ -48,16 +62,9 @@ func (c *Client) GetAllUsers(
 	}
 	endpointURL := baseURL + "/" + "users/all"
 
-	queryParams := make(url.Values)
-	queryParams.Add("tag", fmt.Sprintf("%v", request.Tag))
-	for _, value := range request.Limit {
-		queryParams.Add("limit", fmt.Sprintf("%v", *value))
-	}
-	if request.Filter != nil {
-		queryParams.Add("filter", fmt.Sprintf("%v", *request.Filter))
-	}
-	for _, value := range request.Series {
-		queryParams.Add("series", fmt.Sprintf("%v", value))
+	queryParams, err := core.QueryValues(request)
+	if err != nil {
+		return "", err
 	}
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
@@ -70,12 +77,17 @@ func (c *Client) GetAllUsers(
 	if err := c.caller.Call(
 		ctx,
 		&core.CallParams{
-			URL:         endpointURL,
-			Method:      http.MethodGet,
-			MaxAttempts: options.MaxAttempts,
-			Headers:     headers,
-			Client:      options.HTTPClient,
-			Response:    &response,
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user.getAllUsers",
 		},
 	); err != nil {
 		return "", err
@@ -4,10 +4,30 @@ package api
 
 type GetAllUsersRequest struct {
 	XEndpointHeader string `json:"-"`
-	Limit           *int   `json:"-"`
+	Limit           *int   `json:"-" url:"limit,omitempty"`
 	key             string
 }
 
+func (g *GetAllUsersRequest) GetXEndpointHeader() string {
+	if g == nil {
+		return ""
+	}
+	return g.XEndpointHeader
+}
+
+func (g *GetAllUsersRequest) GetLimit() int {
+	if g == nil || g.Limit == nil {
+		return 0
+	}
+	return *g.Limit
+}
+
 func (g *GetAllUsersRequest) Key() string {
 	return g.key
 }
+
+// Validate reports all of the GetAllUsersRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetAllUsersRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,216 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	errors "errors"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/root-path-params/fixtures/core"
+	option "github.com/fern-api/fern-go/internal/testdata/sdk/root-path-params/fixtures/option"
+	http "net/http"
+)
+
+type Client struct {
+	baseURL        string
+	caller         *core.Caller
+	header         http.Header
+	validation     bool
+	organizationId *string
+	tenantId       *string
+}
+
+func NewClient(opts ...option.RequestOption) *Client {
+	options := core.NewRequestOptions(opts...)
+	return &Client{
+		baseURL: options.BaseURL,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
+			},
+			options.RateLimiter,
+		),
+		header:         options.ToHeader(),
+		validation:     options.Validation,
+		organizationId: options.OrganizationId,
+		tenantId:       options.TenantId,
+	}
+}
+
+func (c *Client) GetUser(
+	ctx context.Context,
+	userId string,
+	opts ...option.RequestOption,
+) (string, error) {
+	options := core.NewRequestOptions(opts...)
+
+	tenantId := c.tenantId
+	if options.TenantId != nil {
+		tenantId = options.TenantId
+	}
+	if tenantId == nil {
+		err := errors.New("missing the tenantId path parameter; set it with option.WithTenantId")
+		return "", err
+	}
+	organizationId := c.organizationId
+	if options.OrganizationId != nil {
+		organizationId = options.OrganizationId
+	}
+	if organizationId == nil {
+		err := errors.New("missing the organizationId path parameter; set it with option.WithOrganizationId")
+		return "", err
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"%v/organizations/%v/users/%v", *tenantId, *organizationId, userId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user.getUser",
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) GetUserV2(
+	ctx context.Context,
+	userId string,
+	opts ...option.RequestOption,
+) (string, error) {
+	options := core.NewRequestOptions(opts...)
+
+	tenantId := c.tenantId
+	if options.TenantId != nil {
+		tenantId = options.TenantId
+	}
+	if tenantId == nil {
+		err := errors.New("missing the tenantId path parameter; set it with option.WithTenantId")
+		return "", err
+	}
+	organizationId := c.organizationId
+	if options.OrganizationId != nil {
+		organizationId = options.OrganizationId
+	}
+	if organizationId == nil {
+		err := errors.New("missing the organizationId path parameter; set it with option.WithOrganizationId")
+		return "", err
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"%v/organizations/%v/users/get/%v/info", *tenantId, *organizationId, userId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user.getUserV2",
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) GetUserV3(
+	ctx context.Context,
+	userId string,
+	infoId string,
+	opts ...option.RequestOption,
+) (string, error) {
+	options := core.NewRequestOptions(opts...)
+
+	tenantId := c.tenantId
+	if options.TenantId != nil {
+		tenantId = options.TenantId
+	}
+	if tenantId == nil {
+		err := errors.New("missing the tenantId path parameter; set it with option.WithTenantId")
+		return "", err
+	}
+	organizationId := c.organizationId
+	if options.OrganizationId != nil {
+		organizationId = options.OrganizationId
+	}
+	if organizationId == nil {
+		err := errors.New("missing the organizationId path parameter; set it with option.WithOrganizationId")
+		return "", err
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := core.EncodeURL(baseURL+"/"+"%v/organizations/%v/users/get/%v/info/%v", *tenantId, *organizationId, userId, infoId)
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user.getUserV3",
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
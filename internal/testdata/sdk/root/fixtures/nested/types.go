@@ -5,3 +5,9 @@ package nested
 type GetNestedRequest struct {
 	Name string `json:"name"`
 }
+
+// Validate reports all of the GetNestedRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetNestedRequest) Validate() error {
+	return nil
+}
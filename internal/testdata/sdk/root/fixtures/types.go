@@ -9,12 +9,12 @@ import (
 )
 
 type Bar struct {
-	Id      *Id      `json:"id,omitempty"`
-	Name    *string  `json:"name,omitempty"`
-	List    *string  `json:"list,omitempty"`
-	Type    *FooType `json:"type,omitempty"`
-	Request *Request `json:"request,omitempty"`
-	Delay   *string  `json:"delay,omitempty"`
+	Id      *Id      `json:"id,omitempty" url:"id,omitempty"`
+	Name    *string  `json:"name,omitempty" url:"name,omitempty"`
+	List    *string  `json:"list,omitempty" url:"list,omitempty"`
+	Type    *FooType `json:"type,omitempty" url:"type,omitempty"`
+	Request *Request `json:"request,omitempty" url:"request,omitempty"`
+	Delay   *string  `json:"delay,omitempty" url:"delay,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -42,15 +42,29 @@ func (b *Bar) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Bar's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Bar) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var validation core.Validation
+	if b.Type != nil {
+		validation.Add("type", b.Type.Validate())
+	}
+	validation.Add("request", b.Request.Validate())
+	return validation.Err()
+}
+
 type Baz struct {
-	Id          *Id     `json:"id,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	List        *string `json:"list,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Id          *Id     `json:"id,omitempty" url:"id,omitempty"`
+	Name        *string `json:"name,omitempty" url:"name,omitempty"`
+	List        *string `json:"list,omitempty" url:"list,omitempty"`
+	Description *string `json:"description,omitempty" url:"description,omitempty"`
 	// This field has documentation, so it should be rendered
 	// just above the field.
 	// Note: Newlines should be preserved.
-	HasDocs *string `json:"hasDocs,omitempty"`
+	HasDocs *string `json:"hasDocs,omitempty" url:"hasDocs,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -78,9 +92,15 @@ func (b *Baz) String() string {
 	return fmt.Sprintf("%#v", b)
 }
 
+// Validate reports all of the Baz's invalid fields (e.g. missing
+// required fields), if any.
+func (b *Baz) Validate() error {
+	return nil
+}
+
 type Error struct {
-	Message   *string  `json:"message,omitempty"`
-	Recursive []*Error `json:"recursive,omitempty"`
+	Message   *string  `json:"message,omitempty" url:"message,omitempty"`
+	Recursive []*Error `json:"recursive,omitempty" url:"recursive,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -108,13 +128,26 @@ func (e *Error) String() string {
 	return fmt.Sprintf("%#v", e)
 }
 
+// Validate reports all of the Error's invalid fields (e.g. missing
+// required fields), if any.
+func (e *Error) Validate() error {
+	if e == nil {
+		return nil
+	}
+	var validation core.Validation
+	for index, value := range e.Recursive {
+		validation.Add(fmt.Sprintf("recursive[%d]", index), value.Validate())
+	}
+	return validation.Err()
+}
+
 type Foo struct {
-	Id      *Id      `json:"id,omitempty"`
-	Name    *string  `json:"name,omitempty"`
-	List    *string  `json:"list,omitempty"`
-	Type    *FooType `json:"type,omitempty"`
-	Request *Request `json:"request,omitempty"`
-	Delay   *string  `json:"delay,omitempty"`
+	Id      *Id      `json:"id,omitempty" url:"id,omitempty"`
+	Name    *string  `json:"name,omitempty" url:"name,omitempty"`
+	List    *string  `json:"list,omitempty" url:"list,omitempty"`
+	Type    *FooType `json:"type,omitempty" url:"type,omitempty"`
+	Request *Request `json:"request,omitempty" url:"request,omitempty"`
+	Delay   *string  `json:"delay,omitempty" url:"delay,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -142,6 +175,20 @@ func (f *Foo) String() string {
 	return fmt.Sprintf("%#v", f)
 }
 
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	if f == nil {
+		return nil
+	}
+	var validation core.Validation
+	if f.Type != nil {
+		validation.Add("type", f.Type.Validate())
+	}
+	validation.Add("request", f.Request.Validate())
+	return validation.Err()
+}
+
 type FooType string
 
 const (
@@ -170,12 +217,18 @@ func (f FooType) Ptr() *FooType {
 	return &f
 }
 
+// Validate returns an error if the FooType isn't one of its known values.
+func (f FooType) Validate() error {
+	_, err := NewFooTypeFromString(string(f))
+	return err
+}
+
 type Request struct {
-	Url      string                 `json:"url"`
-	Headers  map[string]interface{} `json:"headers,omitempty"`
-	Body     *string                `json:"body,omitempty"`
-	Platform *string                `json:"platform,omitempty"`
-	Unknown  interface{}            `json:"unknown,omitempty"`
+	Url      string                 `json:"url" url:"url"`
+	Headers  map[string]interface{} `json:"headers,omitempty" url:"headers,omitempty"`
+	Body     *string                `json:"body,omitempty" url:"body,omitempty"`
+	Platform *string                `json:"platform,omitempty" url:"platform,omitempty"`
+	Unknown  interface{}            `json:"unknown,omitempty" url:"unknown,omitempty"`
 
 	_rawJSON json.RawMessage
 }
@@ -203,4 +256,10 @@ func (r *Request) String() string {
 	return fmt.Sprintf("%#v", r)
 }
 
+// Validate reports all of the Request's invalid fields (e.g. missing
+// required fields), if any.
+func (r *Request) Validate() error {
+	return nil
+}
+
 type Id = string
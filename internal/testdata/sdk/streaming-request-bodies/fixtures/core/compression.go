@@ -0,0 +1,173 @@
+package core
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// contentEncodingHeader is the header used to specify a body's encoding.
+	contentEncodingHeader = "Content-Encoding"
+
+	// compressionThreshold is the minimum size of a request body (in bytes)
+	// that's compressed. Smaller bodies are sent as-is, since the overhead of
+	// compression usually outweighs its benefit.
+	compressionThreshold = 1024
+
+	encodingGzip    = "gzip"
+	encodingDeflate = "deflate"
+	encodingZstd    = "zstd"
+)
+
+// lener is implemented by the in-memory request bodies (e.g. *bytes.Reader),
+// whose size is known before the request is sent.
+type lener interface {
+	Len() int
+}
+
+// compressRequestBody compresses the given request body with the given encoding
+// (i.e. gzip, deflate, or zstd), if any. In-memory bodies are only compressed if
+// they're larger than the compression threshold, and every other body (e.g. a
+// file) is always compressed as it's sent. False is returned if the body wasn't
+// compressed.
+func compressRequestBody(body io.Reader, encoding string) (io.Reader, bool, error) {
+	if body == nil || encoding == "" {
+		return body, false, nil
+	}
+	if _, err := newCompressor(io.Discard, encoding); err != nil {
+		return nil, false, err
+	}
+	sized, ok := body.(lener)
+	if !ok {
+		// The body's size isn't known, so it's compressed as it's read.
+		reader, writer := io.Pipe()
+		go func() {
+			compressor, _ := newCompressor(writer, encoding)
+			_, err := io.Copy(compressor, body)
+			if closeErr := compressor.Close(); err == nil {
+				err = closeErr
+			}
+			writer.CloseWithError(err)
+		}()
+		return reader, true, nil
+	}
+	if sized.Len() < compressionThreshold {
+		return body, false, nil
+	}
+	buffer := bytes.NewBuffer(nil)
+	compressor, _ := newCompressor(buffer, encoding)
+	if _, err := io.Copy(compressor, body); err != nil {
+		return nil, false, err
+	}
+	if err := compressor.Close(); err != nil {
+		return nil, false, err
+	}
+	return bytes.NewReader(buffer.Bytes()), true, nil
+}
+
+// newCompressor returns a new io.WriteCloser that compresses everything written
+// to it with the given encoding.
+func newCompressor(writer io.Writer, encoding string) (io.WriteCloser, error) {
+	switch strings.ToLower(encoding) {
+	case encodingGzip:
+		return gzip.NewWriter(writer), nil
+	case encodingDeflate:
+		return zlib.NewWriter(writer), nil
+	case encodingZstd:
+		return zstd.NewWriter(writer)
+	}
+	return nil, fmt.Errorf("unsupported request compression %q: expected one of %q, %q, or %q", encoding, encodingGzip, encodingDeflate, encodingZstd)
+}
+
+// withResponseDecompression wraps the given RetryFunc so that every response body
+// is transparently decompressed according to its Content-Encoding.
+func withResponseDecompression(fn RetryFunc) RetryFunc {
+	return func(request *http.Request) (*http.Response, error) {
+		response, err := fn(request)
+		if err != nil {
+			return nil, err
+		}
+		decompressResponse(response)
+		return response, nil
+	}
+}
+
+// decompressResponse replaces the given response's body with one that's decoded
+// according to its Content-Encoding (i.e. gzip, deflate, or zstd), if any. The
+// body is decoded lazily, so that responses without a body (e.g. a 204) are
+// handled the same way as they would be otherwise.
+func decompressResponse(response *http.Response) {
+	encoding := strings.ToLower(strings.TrimSpace(response.Header.Get(contentEncodingHeader)))
+	switch encoding {
+	case encodingGzip, encodingDeflate, encodingZstd:
+	default:
+		return
+	}
+	response.Body = &decompressedBody{
+		body:     response.Body,
+		encoding: encoding,
+	}
+	response.Header.Del(contentEncodingHeader)
+	response.Header.Del("Content-Length")
+	response.ContentLength = -1
+	response.Uncompressed = true
+}
+
+// decompressedBody is an io.ReadCloser that decompresses the underlying body.
+type decompressedBody struct {
+	body     io.ReadCloser
+	encoding string
+	reader   io.Reader
+	closer   func()
+	err      error
+}
+
+func (d *decompressedBody) Read(p []byte) (int, error) {
+	if d.reader == nil && d.err == nil {
+		d.reader, d.closer, d.err = newDecompressor(d.body, d.encoding)
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	return d.reader.Read(p)
+}
+
+func (d *decompressedBody) Close() error {
+	if d.closer != nil {
+		d.closer()
+	}
+	return d.body.Close()
+}
+
+// newDecompressor returns a new io.Reader that decompresses the given body with
+// the given encoding, along with a function that releases its resources.
+func newDecompressor(body io.Reader, encoding string) (io.Reader, func(), error) {
+	switch encoding {
+	case encodingGzip:
+		reader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return reader, func() { _ = reader.Close() }, nil
+	case encodingDeflate:
+		reader, err := zlib.NewReader(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return reader, func() { _ = reader.Close() }, nil
+	case encodingZstd:
+		decoder, err := zstd.NewReader(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decoder, decoder.Close, nil
+	}
+	return body, func() {}, nil
+}
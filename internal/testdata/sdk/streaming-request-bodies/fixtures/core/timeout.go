@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// TimeoutError is returned when a call exceeds the timeout configured with
+// option.WithTimeout (which covers every attempt) or option.WithAttemptTimeout
+// (which covers each individual attempt).
+//
+// It's distinct from the error returned when the call's context is cancelled
+// (i.e. context.Canceled), but it still matches context.DeadlineExceeded with
+// errors.Is.
+type TimeoutError struct {
+	// Duration is the timeout that was exceeded.
+	Duration time.Duration
+
+	// PerAttempt is true if an individual attempt timed out, rather than
+	// the call as a whole.
+	PerAttempt bool
+}
+
+func (t *TimeoutError) Error() string {
+	if t.PerAttempt {
+		return fmt.Sprintf("request attempt timed out after %v", t.Duration)
+	}
+	return fmt.Sprintf("request timed out after %v", t.Duration)
+}
+
+// Timeout is always true, so that the error is recognized as a timeout
+// in the same way as a net.Error.
+func (t *TimeoutError) Timeout() bool {
+	return true
+}
+
+// Unwrap returns context.DeadlineExceeded.
+func (t *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// timeout cancels a context once its duration elapses, and records that it
+// did so, which distinguishes it from a cancellation of the parent context.
+//
+// A nil *timeout represents the absence of a timeout.
+type timeout struct {
+	duration   time.Duration
+	perAttempt bool
+	cancel     context.CancelFunc
+	timer      *time.Timer
+	elapsed    int32
+}
+
+// newTimeout returns a context derived from the given one that's cancelled
+// once the given duration elapses, along with the *timeout that cancels it.
+// The given context is returned as-is if the duration isn't positive.
+func newTimeout(ctx context.Context, duration time.Duration, perAttempt bool) (context.Context, *timeout) {
+	if duration <= 0 {
+		return ctx, nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	t := &timeout{
+		duration:   duration,
+		perAttempt: perAttempt,
+		cancel:     cancel,
+	}
+	t.timer = time.AfterFunc(duration, func() {
+		atomic.StoreInt32(&t.elapsed, 1)
+		cancel()
+	})
+	return ctx, t
+}
+
+// stop stops the timer, so that the context is no longer cancelled once the
+// duration elapses (e.g. after a stream is established).
+func (t *timeout) stop() {
+	if t == nil {
+		return
+	}
+	t.timer.Stop()
+}
+
+// release cancels the context, releasing its resources.
+func (t *timeout) release() {
+	if t == nil {
+		return
+	}
+	t.timer.Stop()
+	t.cancel()
+}
+
+// wrap returns a *TimeoutError in place of the given error if the duration
+// elapsed, since the error is a consequence of the context's cancellation.
+func (t *timeout) wrap(err error) error {
+	if t == nil || err == nil || atomic.LoadInt32(&t.elapsed) == 0 {
+		return err
+	}
+	return &TimeoutError{
+		Duration:   t.duration,
+		PerAttempt: t.perAttempt,
+	}
+}
+
+// withAttemptTimeout wraps the given RetryFunc so that each attempt is bounded
+// by the given duration, if any. The timeout covers reading the response body,
+// unless it's stopped once the response is received (e.g. for streams, whose
+// lifetime isn't bounded by the timeout).
+func withAttemptTimeout(fn RetryFunc, duration time.Duration, stopOnResponse bool) RetryFunc {
+	if duration <= 0 {
+		return fn
+	}
+	return func(request *http.Request) (*http.Response, error) {
+		ctx, timeout := newTimeout(request.Context(), duration, true)
+		response, err := fn(request.WithContext(ctx))
+		if err != nil {
+			timeout.release()
+			return nil, timeout.wrap(err)
+		}
+		if stopOnResponse {
+			timeout.stop()
+		}
+		response.Body = &timeoutBody{
+			body:    response.Body,
+			timeout: timeout,
+		}
+		return response, nil
+	}
+}
+
+// timeoutBody is an io.ReadCloser that reports the errors caused by the given
+// timeout as a *TimeoutError, and releases the timeout once it's closed.
+type timeoutBody struct {
+	body    io.ReadCloser
+	timeout *timeout
+}
+
+func (t *timeoutBody) Read(p []byte) (int, error) {
+	n, err := t.body.Read(p)
+	if err != nil && err != io.EOF {
+		err = t.timeout.wrap(err)
+	}
+	return n, err
+}
+
+func (t *timeoutBody) Close() error {
+	defer t.timeout.release()
+	return t.body.Close()
+}
@@ -0,0 +1,163 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package core
+
+import (
+	http "net/http"
+	url "net/url"
+	time "time"
+)
+
+// RequestOption adapts the behavior of the client or an individual request.
+type RequestOption interface {
+	applyRequestOptions(*RequestOptions)
+}
+
+// RequestOptions defines all of the possible request options.
+//
+// This type is primarily used by the generated code and is not meant
+// to be used directly; use the option package instead.
+type RequestOptions struct {
+	BaseURL            string
+	HTTPClient         HTTPClient
+	HTTPHeader         http.Header
+	MaxAttempts        uint
+	RequestCompression string
+	Timeout            time.Duration
+	AttemptTimeout     time.Duration
+	QueryParameters    url.Values
+	BodyProperties     map[string]interface{}
+	Cache              Cache
+	Validation         bool
+	RateLimiter        *RateLimiter
+}
+
+// NewRequestOptions returns a new *RequestOptions value.
+//
+// This function is primarily used by the generated code and is not meant
+// to be used directly; use RequestOption instead.
+func NewRequestOptions(opts ...RequestOption) *RequestOptions {
+	options := &RequestOptions{
+		HTTPHeader: make(http.Header),
+	}
+	for _, opt := range opts {
+		opt.applyRequestOptions(options)
+	}
+	return options
+}
+
+// ToHeader maps the configured request options into a http.Header used
+// for the request(s).
+func (r *RequestOptions) ToHeader() http.Header { return r.cloneHeader() }
+
+func (r *RequestOptions) cloneHeader() http.Header {
+	return r.HTTPHeader.Clone()
+}
+
+// BaseURLOption implements the RequestOption interface.
+type BaseURLOption struct {
+	BaseURL string
+}
+
+func (b *BaseURLOption) applyRequestOptions(opts *RequestOptions) {
+	opts.BaseURL = b.BaseURL
+}
+
+// HTTPClientOption implements the RequestOption interface.
+type HTTPClientOption struct {
+	HTTPClient HTTPClient
+}
+
+func (h *HTTPClientOption) applyRequestOptions(opts *RequestOptions) {
+	opts.HTTPClient = h.HTTPClient
+}
+
+// HTTPHeaderOption implements the RequestOption interface.
+type HTTPHeaderOption struct {
+	HTTPHeader http.Header
+}
+
+func (h *HTTPHeaderOption) applyRequestOptions(opts *RequestOptions) {
+	opts.HTTPHeader = h.HTTPHeader
+}
+
+// MaxAttemptsOption implements the RequestOption interface.
+type MaxAttemptsOption struct {
+	MaxAttempts uint
+}
+
+func (m *MaxAttemptsOption) applyRequestOptions(opts *RequestOptions) {
+	opts.MaxAttempts = m.MaxAttempts
+}
+
+// RequestCompressionOption implements the RequestOption interface.
+type RequestCompressionOption struct {
+	RequestCompression string
+}
+
+func (r *RequestCompressionOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RequestCompression = r.RequestCompression
+}
+
+// TimeoutOption implements the RequestOption interface.
+type TimeoutOption struct {
+	Timeout time.Duration
+}
+
+func (t *TimeoutOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Timeout = t.Timeout
+}
+
+// AttemptTimeoutOption implements the RequestOption interface.
+type AttemptTimeoutOption struct {
+	AttemptTimeout time.Duration
+}
+
+func (a *AttemptTimeoutOption) applyRequestOptions(opts *RequestOptions) {
+	opts.AttemptTimeout = a.AttemptTimeout
+}
+
+// QueryParametersOption implements the RequestOption interface.
+type QueryParametersOption struct {
+	QueryParameters url.Values
+}
+
+func (q *QueryParametersOption) applyRequestOptions(opts *RequestOptions) {
+	opts.QueryParameters = q.QueryParameters
+}
+
+// BodyPropertiesOption implements the RequestOption interface.
+type BodyPropertiesOption struct {
+	BodyProperties map[string]interface{}
+}
+
+func (b *BodyPropertiesOption) applyRequestOptions(opts *RequestOptions) {
+	opts.BodyProperties = b.BodyProperties
+}
+
+// CacheOption implements the RequestOption interface.
+type CacheOption struct {
+	Cache Cache
+}
+
+func (c *CacheOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Cache = c.Cache
+}
+
+// ValidationOption implements the RequestOption interface.
+type ValidationOption struct {
+	Validation bool
+}
+
+func (v *ValidationOption) applyRequestOptions(opts *RequestOptions) {
+	opts.Validation = v.Validation
+}
+
+// RateLimiterOption implements the RequestOption interface.
+type RateLimiterOption struct {
+	RateLimiter *RateLimiter
+}
+
+func (r *RateLimiterOption) applyRequestOptions(opts *RequestOptions) {
+	opts.RateLimiter = r.RateLimiter
+}
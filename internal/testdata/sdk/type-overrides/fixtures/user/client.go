@@ -0,0 +1,95 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	json "encoding/json"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/type-overrides/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/type-overrides/fixtures/core"
+	option "github.com/fern-api/fern-go/internal/testdata/sdk/type-overrides/fixtures/option"
+	http "net/http"
+)
+
+type Client struct {
+	baseURL    string
+	caller     *core.Caller
+	header     http.Header
+	validation bool
+}
+
+func NewClient(opts ...option.RequestOption) *Client {
+	options := core.NewRequestOptions(opts...)
+	return &Client{
+		baseURL: options.BaseURL,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:             options.HTTPClient,
+				MaxAttempts:        options.MaxAttempts,
+				RequestCompression: options.RequestCompression,
+				Timeout:            options.Timeout,
+				AttemptTimeout:     options.AttemptTimeout,
+				QueryParameters:    options.QueryParameters,
+				BodyProperties:     options.BodyProperties,
+				Cache:              options.Cache,
+			},
+			options.RateLimiter,
+		),
+		header:     options.ToHeader(),
+		validation: options.Validation,
+	}
+}
+
+func (c *Client) GetUsername(
+	ctx context.Context,
+	request *fixtures.GetUsersRequest,
+	opts ...option.RequestOption,
+) (json.RawMessage, error) {
+	options := core.NewRequestOptions(opts...)
+
+	if c.validation || options.Validation {
+		if err := core.Validate(request); err != nil {
+			return *new(json.RawMessage), err
+		}
+	}
+
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	if options.BaseURL != "" {
+		baseURL = options.BaseURL
+	}
+	endpointURL := baseURL + "/" + "user"
+
+	queryParams, err := core.QueryValues(request)
+	if err != nil {
+		return *new(json.RawMessage), err
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+
+	headers := core.MergeHeaders(c.header.Clone(), options.ToHeader())
+
+	var response json.RawMessage
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:             endpointURL,
+			Method:          http.MethodGet,
+			MaxAttempts:     options.MaxAttempts,
+			Timeout:         options.Timeout,
+			AttemptTimeout:  options.AttemptTimeout,
+			Headers:         headers,
+			Client:          options.HTTPClient,
+			QueryParameters: options.QueryParameters,
+			Response:        &response,
+			Cache:           options.Cache,
+			CacheScope:      "endpoint_user.getUsername",
+		},
+	); err != nil {
+		return *new(json.RawMessage), err
+	}
+	return response, nil
+}
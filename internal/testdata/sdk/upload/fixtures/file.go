@@ -15,6 +15,12 @@ func (u *UploadRequest) Fern() string {
 	return u.fern
 }
 
+// Validate reports all of the UploadRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UploadRequest) Validate() error {
+	return nil
+}
+
 func (u *UploadRequest) UnmarshalJSON(data []byte) error {
 	type unmarshaler UploadRequest
 	var body unmarshaler
@@ -41,3 +47,9 @@ func (u *UploadRequest) MarshalJSON() ([]byte, error) {
 type UploadMultiRequest struct {
 	Status string `json:"status"`
 }
+
+// Validate reports all of the UploadMultiRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UploadMultiRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,274 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/availability/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/availability/fixtures/core"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	// Sets the user's name.
+	//
+	// Deprecated: Use SetNameV2 instead.
+	SetName(
+		ctx context.Context,
+		userId string,
+		request string,
+	) (string, error)
+	SetNameV2(
+		ctx context.Context,
+		userId string,
+		request *fixtures.SetNameRequest,
+	) (string, error)
+	SetNameV3(
+		ctx context.Context,
+		userId string,
+		request *fixtures.SetNameRequestV3,
+	) (*fixtures.SetNameRequestV3Body, error)
+	SetNameV3Optional(
+		ctx context.Context,
+		userId string,
+		request *fixtures.SetNameRequestV3Optional,
+	) (*fixtures.SetNameRequestV3Body, error)
+	SetNameV4(
+		ctx context.Context,
+		userId string,
+		request *fixtures.SetNameRequestV4,
+	) (string, error)
+	SetNameV5(
+		ctx context.Context,
+		userId string,
+		request *fixtures.SetNameRequestV5,
+	) (string, error)
+	Update(
+		ctx context.Context,
+		userId string,
+		request *fixtures.UpdateRequest,
+	) (string, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodPost, "/users/{userId}/set-name", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		var request string
+		if err := core.DecodeRequestBody(r.Body, &request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		response, err := service.SetName(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/users/{userId}/set-name-v2", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.SetNameRequest)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.SetNameV2(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/users/{userId}/set-name-v3", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.SetNameRequestV3)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Header"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.SetNameV3(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/users/{userId}/set-name-v3-optional", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.SetNameRequestV3Optional)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Header"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.SetNameV3Optional(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/users/{userId}/set-name-v4", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.SetNameRequestV4)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Header"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.SetNameV4(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/users/{userId}/set-name-v5", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.SetNameRequestV5)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Header"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.SetNameV5(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/users/{userId}/update", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.UpdateRequest)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		query := r.URL.Query()
+		if value := query.Get("tag"); value != "" {
+			if err := core.ParseParameter(value, &request.Tag); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "tag", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required query parameter %q", "tag"))
+			return
+		}
+		if value := query.Get("extra"); value != "" {
+			parsed := new(string)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "extra", err))
+				return
+			}
+			request.Extra = parsed
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.Update(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
@@ -0,0 +1,25 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+type UploadOptionalWithHeaderRequest struct {
+	XUploadFileSize int    `json:"-"`
+	Body            []byte `json:"-"`
+}
+
+// Validate reports all of the UploadOptionalWithHeaderRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UploadOptionalWithHeaderRequest) Validate() error {
+	return nil
+}
+
+type UploadWithHeaderRequest struct {
+	XUploadFileSize int    `json:"-"`
+	Body            []byte `json:"-"`
+}
+
+// Validate reports all of the UploadWithHeaderRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (u *UploadWithHeaderRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,162 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package upload
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/bytes/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/bytes/fixtures/core"
+	io "io"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Upload(
+		ctx context.Context,
+		id string,
+		request []byte,
+	) (bool, error)
+	UploadOptional(
+		ctx context.Context,
+		id string,
+		request []byte,
+	) (bool, error)
+	UploadWithHeader(
+		ctx context.Context,
+		id string,
+		request *fixtures.UploadWithHeaderRequest,
+	) (bool, error)
+	UploadOptionalWithHeader(
+		ctx context.Context,
+		id string,
+		request *fixtures.UploadOptionalWithHeaderRequest,
+	) (bool, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodPost, "/upload/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		var request []byte
+		if body, err := io.ReadAll(r.Body); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		} else if len(body) > 0 {
+			request = body
+		} else {
+			core.WriteError(w, core.NewBadRequestError("the request body is required"))
+			return
+		}
+		response, err := service.Upload(ctx, id, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/upload/optional/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		var request []byte
+		if body, err := io.ReadAll(r.Body); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		} else if len(body) > 0 {
+			request = body
+		}
+		response, err := service.UploadOptional(ctx, id, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/upload_with_header/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		request := new(fixtures.UploadWithHeaderRequest)
+		if body, err := io.ReadAll(r.Body); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		} else if len(body) > 0 {
+			request.Body = body
+		} else {
+			core.WriteError(w, core.NewBadRequestError("the request body is required"))
+			return
+		}
+		if value := r.Header.Get("X-Upload-File-Size"); value != "" {
+			if err := core.ParseParameter(value, &request.XUploadFileSize); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Upload-File-Size", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Upload-File-Size"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.UploadWithHeader(ctx, id, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/upload_with_header/optional/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		request := new(fixtures.UploadOptionalWithHeaderRequest)
+		if body, err := io.ReadAll(r.Body); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		} else if len(body) > 0 {
+			request.Body = body
+		}
+		if value := r.Header.Get("X-Upload-File-Size"); value != "" {
+			if err := core.ParseParameter(value, &request.XUploadFileSize); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Upload-File-Size", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Upload-File-Size"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.UploadOptionalWithHeader(ctx, id, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
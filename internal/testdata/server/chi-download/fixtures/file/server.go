@@ -0,0 +1,48 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package file
+
+import (
+	context "context"
+	core "github.com/fern-api/fern-go/internal/testdata/server/chi-download/fixtures/core"
+	chi "github.com/go-chi/chi/v5"
+	io "io"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Download(
+		ctx context.Context,
+		filename string,
+	) (io.Reader, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := chi.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given chi router.
+func Register(router chi.Router, service Service, opts ...core.HandlerOption) {
+	router.MethodFunc(http.MethodGet, "/file/{filename}/download", func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		var filename string
+		if err := core.ParseParameter(core.PathValue(r, chi.URLParam(r, "filename")), &filename); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "filename", err))
+			return
+		}
+		response, err := service.Download(ctx, filename)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		if closer, ok := response.(io.Closer); ok {
+			defer closer.Close()
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = io.Copy(w, response)
+	})
+}
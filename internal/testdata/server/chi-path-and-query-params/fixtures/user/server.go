@@ -0,0 +1,59 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/chi-path-and-query-params/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/chi-path-and-query-params/fixtures/core"
+	chi "github.com/go-chi/chi/v5"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetUser(
+		ctx context.Context,
+		userId string,
+		request *fixtures.GetUserRequest,
+	) (string, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := chi.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given chi router.
+func Register(router chi.Router, service Service, opts ...core.HandlerOption) {
+	router.MethodFunc(http.MethodGet, "/users/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(core.PathValue(r, chi.URLParam(r, "userId")), &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.GetUserRequest)
+		query := r.URL.Query()
+		if value := query.Get("shallow"); value != "" {
+			parsed := new(bool)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "shallow", err))
+				return
+			}
+			request.Shallow = parsed
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.GetUser(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
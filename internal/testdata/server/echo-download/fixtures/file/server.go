@@ -0,0 +1,44 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package file
+
+import (
+	context "context"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/server/echo-download/fixtures/core"
+	echo "github.com/labstack/echo/v4"
+	io "io"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Download(
+		ctx context.Context,
+		filename string,
+	) (io.Reader, error)
+}
+
+// Router is implemented by both the *echo.Echo and *echo.Group types.
+type Router interface {
+	Add(method, path string, handler echo.HandlerFunc, middleware ...echo.MiddlewareFunc) *echo.Route
+}
+
+// Register registers all of the Service's endpoints with the given Echo router.
+func Register(router Router, service Service, opts ...core.HandlerOption) {
+	router.Add(http.MethodGet, "/file/:filename/download", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		var filename string
+		if err := core.ParseParameter(core.PathValue(c.Request(), c.Param("filename")), &filename); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid path parameter %q: %v", "filename", err))
+		}
+		response, err := service.Download(ctx, filename)
+		if err != nil {
+			return err
+		}
+		if closer, ok := response.(io.Closer); ok {
+			defer closer.Close()
+		}
+		return c.Stream(http.StatusOK, echo.MIMEOctetStream, response)
+	})
+}
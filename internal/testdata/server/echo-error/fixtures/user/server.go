@@ -0,0 +1,91 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	errors "errors"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/echo-error/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/echo-error/fixtures/core"
+	echo "github.com/labstack/echo/v4"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Get(
+		ctx context.Context,
+		id string,
+	) (string, error)
+	Update(
+		ctx context.Context,
+		id string,
+		request string,
+	) (string, error)
+}
+
+// Router is implemented by both the *echo.Echo and *echo.Group types.
+type Router interface {
+	Add(method, path string, handler echo.HandlerFunc, middleware ...echo.MiddlewareFunc) *echo.Route
+}
+
+// Register registers all of the Service's endpoints with the given Echo router.
+func Register(router Router, service Service, opts ...core.HandlerOption) {
+	router.Add(http.MethodGet, "/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		var id string
+		if err := core.ParseParameter(core.PathValue(c.Request(), c.Param("id")), &id); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid path parameter %q: %v", "id", err))
+		}
+		response, err := service.Get(ctx, id)
+		if err != nil {
+			var userNotFoundError *fixtures.UserNotFoundError
+			if errors.As(err, &userNotFoundError) {
+				return c.JSON(404, userNotFoundError.Body)
+			}
+			var notImplementedError *fixtures.NotImplementedError
+			if errors.As(err, &notImplementedError) {
+				return c.JSON(501, notImplementedError.Body)
+			}
+			var teapotError *fixtures.TeapotError
+			if errors.As(err, &teapotError) {
+				return c.JSON(418, teapotError.Body)
+			}
+			var upgradeError *fixtures.UpgradeError
+			if errors.As(err, &upgradeError) {
+				return c.JSON(426, "upgrade")
+			}
+			var untypedError *fixtures.UntypedError
+			if errors.As(err, &untypedError) {
+				return c.NoContent(400)
+			}
+			return err
+		}
+		return c.JSON(http.StatusOK, response)
+	})
+	router.Add(http.MethodPost, "/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		var id string
+		if err := core.ParseParameter(core.PathValue(c.Request(), c.Param("id")), &id); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid path parameter %q: %v", "id", err))
+		}
+		var request string
+		if err := core.DecodeRequestBody(c.Request().Body, &request, false); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		}
+		response, err := service.Update(ctx, id, request)
+		if err != nil {
+			var upgradeError *fixtures.UpgradeError
+			if errors.As(err, &upgradeError) {
+				return c.JSON(426, "upgrade")
+			}
+			var untypedError *fixtures.UntypedError
+			if errors.As(err, &untypedError) {
+				return c.NoContent(400)
+			}
+			return err
+		}
+		return c.JSON(http.StatusOK, response)
+	})
+}
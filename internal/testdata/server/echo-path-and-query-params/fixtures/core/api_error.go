@@ -0,0 +1,42 @@
+package core
+
+import "fmt"
+
+// APIError is a lightweight wrapper around the standard error
+// interface that preserves the status code from the RPC, if any.
+type APIError struct {
+	err error
+
+	StatusCode int `json:"-"`
+}
+
+// NewAPIError constructs a new API error.
+func NewAPIError(statusCode int, err error) *APIError {
+	return &APIError{
+		err:        err,
+		StatusCode: statusCode,
+	}
+}
+
+// Unwrap returns the underlying error. This also makes the error compatible
+// with errors.As and errors.Is.
+func (a *APIError) Unwrap() error {
+	if a == nil {
+		return nil
+	}
+	return a.err
+}
+
+// Error returns the API error's message.
+func (a *APIError) Error() string {
+	if a == nil || (a.err == nil && a.StatusCode == 0) {
+		return ""
+	}
+	if a.err == nil {
+		return fmt.Sprintf("%d", a.StatusCode)
+	}
+	if a.StatusCode == 0 {
+		return a.err.Error()
+	}
+	return fmt.Sprintf("%d: %s", a.StatusCode, a.err.Error())
+}
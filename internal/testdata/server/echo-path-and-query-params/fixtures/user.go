@@ -0,0 +1,13 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+type GetUserRequest struct {
+	Shallow *bool `json:"-" query:"shallow"`
+}
+
+// Validate reports all of the GetUserRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetUserRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,54 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/echo-path-and-query-params/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/echo-path-and-query-params/fixtures/core"
+	echo "github.com/labstack/echo/v4"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetUser(
+		ctx context.Context,
+		userId string,
+		request *fixtures.GetUserRequest,
+	) (string, error)
+}
+
+// Router is implemented by both the *echo.Echo and *echo.Group types.
+type Router interface {
+	Add(method, path string, handler echo.HandlerFunc, middleware ...echo.MiddlewareFunc) *echo.Route
+}
+
+// Register registers all of the Service's endpoints with the given Echo router.
+func Register(router Router, service Service, opts ...core.HandlerOption) {
+	router.Add(http.MethodGet, "/users/:userId", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		var userId string
+		if err := core.ParseParameter(core.PathValue(c.Request(), c.Param("userId")), &userId); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid path parameter %q: %v", "userId", err))
+		}
+		request := new(fixtures.GetUserRequest)
+		query := c.QueryParams()
+		if value := query.Get("shallow"); value != "" {
+			parsed := new(bool)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid query parameter %q: %v", "shallow", err))
+			}
+			request.Shallow = parsed
+		}
+		if err := core.Validate(request); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+		}
+		response, err := service.GetUser(ctx, userId, request)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, response)
+	})
+}
@@ -0,0 +1,126 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package file
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/echo-upload/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/echo-upload/fixtures/core"
+	echo "github.com/labstack/echo/v4"
+	io "io"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Upload(
+		ctx context.Context,
+		file io.Reader,
+		request *fixtures.UploadRequest,
+	) (string, error)
+	UploadSimple(
+		ctx context.Context,
+		file io.Reader,
+	) (string, error)
+	UploadMultiple(
+		ctx context.Context,
+		file io.Reader,
+		optionalFile io.Reader,
+		request *fixtures.UploadMultiRequest,
+	) (string, error)
+}
+
+// Router is implemented by both the *echo.Echo and *echo.Group types.
+type Router interface {
+	Add(method, path string, handler echo.HandlerFunc, middleware ...echo.MiddlewareFunc) *echo.Route
+}
+
+// Register registers all of the Service's endpoints with the given Echo router.
+func Register(router Router, service Service, opts ...core.HandlerOption) {
+	router.Add(http.MethodPost, "/file/upload", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid file %q: %v", "file", err))
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid file %q: %v", "file", err))
+		}
+		defer file.Close()
+		request := new(fixtures.UploadRequest)
+		if value := c.FormValue("fern"); value != "" && value != "fern" {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid form field %q: expected %q", "fern", "fern"))
+		}
+		if value := c.FormValue("status"); value != "" {
+			if err := core.ParseParameter(value, &request.Status); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid form field %q: %v", "status", err))
+			}
+		} else {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("missing required form field %q", "status"))
+		}
+		if err := core.Validate(request); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+		}
+		response, err := service.Upload(ctx, file, request)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, response)
+	})
+	router.Add(http.MethodPost, "/file/upload-simple", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid file %q: %v", "file", err))
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid file %q: %v", "file", err))
+		}
+		defer file.Close()
+		response, err := service.UploadSimple(ctx, file)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, response)
+	})
+	router.Add(http.MethodPost, "/file/upload-multi", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid file %q: %v", "file", err))
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid file %q: %v", "file", err))
+		}
+		defer file.Close()
+		var optionalFile io.Reader
+		if optionalFileHeader, err := c.FormFile("optionalFile"); err == nil {
+			multipartFile, err := optionalFileHeader.Open()
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid file %q: %v", "optionalFile", err))
+			}
+			defer multipartFile.Close()
+			optionalFile = multipartFile
+		}
+		request := new(fixtures.UploadMultiRequest)
+		if value := c.FormValue("status"); value != "" {
+			if err := core.ParseParameter(value, &request.Status); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid form field %q: %v", "status", err))
+			}
+		} else {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("missing required form field %q", "status"))
+		}
+		if err := core.Validate(request); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+		}
+		response, err := service.UploadMultiple(ctx, file, optionalFile, request)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, response)
+	})
+}
@@ -0,0 +1,79 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	errors "errors"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/error-discrimination/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/error-discrimination/fixtures/core"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Get(
+		ctx context.Context,
+		id string,
+	) (string, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodGet, "/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		response, err := service.Get(ctx, id)
+		if err != nil {
+			var organizationNotFoundError *fixtures.OrganizationNotFoundError
+			if errors.As(err, &organizationNotFoundError) {
+				body := map[string]interface{}{
+					"errorName": "OrganizationNotFoundError",
+					"content":   organizationNotFoundError.Body,
+				}
+				core.WriteResponse(w, 404, body)
+				return
+			}
+			var userNotFoundError *fixtures.UserNotFoundError
+			if errors.As(err, &userNotFoundError) {
+				body := map[string]interface{}{
+					"errorName": "UserNotFoundError",
+					"content":   userNotFoundError.Body,
+				}
+				core.WriteResponse(w, 404, body)
+				return
+			}
+			var notFoundError *fixtures.NotFoundError
+			if errors.As(err, &notFoundError) {
+				body := map[string]interface{}{
+					"errorName": "NotFoundError",
+					"content":   notFoundError.Body,
+				}
+				core.WriteResponse(w, 404, body)
+				return
+			}
+			var untypedNotFoundError *fixtures.UntypedNotFoundError
+			if errors.As(err, &untypedNotFoundError) {
+				body := map[string]interface{}{
+					"errorName": "UntypedNotFoundError",
+				}
+				core.WriteResponse(w, 404, body)
+				return
+			}
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
@@ -0,0 +1,103 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	errors "errors"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/error/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/error/fixtures/core"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Get(
+		ctx context.Context,
+		id string,
+	) (string, error)
+	Update(
+		ctx context.Context,
+		id string,
+		request string,
+	) (string, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodGet, "/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		response, err := service.Get(ctx, id)
+		if err != nil {
+			var userNotFoundError *fixtures.UserNotFoundError
+			if errors.As(err, &userNotFoundError) {
+				core.WriteResponse(w, 404, userNotFoundError.Body)
+				return
+			}
+			var notImplementedError *fixtures.NotImplementedError
+			if errors.As(err, &notImplementedError) {
+				core.WriteResponse(w, 501, notImplementedError.Body)
+				return
+			}
+			var teapotError *fixtures.TeapotError
+			if errors.As(err, &teapotError) {
+				core.WriteResponse(w, 418, teapotError.Body)
+				return
+			}
+			var upgradeError *fixtures.UpgradeError
+			if errors.As(err, &upgradeError) {
+				core.WriteResponse(w, 426, "upgrade")
+				return
+			}
+			var untypedError *fixtures.UntypedError
+			if errors.As(err, &untypedError) {
+				w.WriteHeader(400)
+				return
+			}
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		var request string
+		if err := core.DecodeRequestBody(r.Body, &request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		response, err := service.Update(ctx, id, request)
+		if err != nil {
+			var upgradeError *fixtures.UpgradeError
+			if errors.As(err, &upgradeError) {
+				core.WriteResponse(w, 426, "upgrade")
+				return
+			}
+			var untypedError *fixtures.UntypedError
+			if errors.As(err, &untypedError) {
+				w.WriteHeader(400)
+				return
+			}
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
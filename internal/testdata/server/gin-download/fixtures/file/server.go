@@ -0,0 +1,48 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package file
+
+import (
+	context "context"
+	core "github.com/fern-api/fern-go/internal/testdata/server/gin-download/fixtures/core"
+	gin "github.com/gin-gonic/gin"
+	io "io"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Download(
+		ctx context.Context,
+		filename string,
+	) (io.Reader, error)
+}
+
+// Register registers all of the Service's endpoints with the given Gin router.
+//
+// Path parameters that contain escaped slashes (e.g. a%2Fb) are only matched if
+// the engine routes on the request's escaped path, so that's enabled here if the
+// router is a *gin.Engine. Otherwise, the engine's UseRawPath option must be set.
+func Register(router gin.IRoutes, service Service, opts ...core.HandlerOption) {
+	if engine, ok := router.(*gin.Engine); ok {
+		engine.UseRawPath = true
+		engine.UnescapePathValues = true
+	}
+	router.Handle(http.MethodGet, "/file/:filename/download", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		var filename string
+		if err := core.ParseParameter(c.Param("filename"), &filename); err != nil {
+			c.String(http.StatusBadRequest, "invalid path parameter %q: %v", "filename", err)
+			return
+		}
+		response, err := service.Download(ctx, filename)
+		if err != nil {
+			_ = c.AbortWithError(core.ErrorStatusCode(err), err)
+			return
+		}
+		if closer, ok := response.(io.Closer); ok {
+			defer closer.Close()
+		}
+		c.DataFromReader(http.StatusOK, -1, "application/octet-stream", response, nil)
+	})
+}
@@ -0,0 +1,105 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	errors "errors"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/gin-error/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/gin-error/fixtures/core"
+	gin "github.com/gin-gonic/gin"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Get(
+		ctx context.Context,
+		id string,
+	) (string, error)
+	Update(
+		ctx context.Context,
+		id string,
+		request string,
+	) (string, error)
+}
+
+// Register registers all of the Service's endpoints with the given Gin router.
+//
+// Path parameters that contain escaped slashes (e.g. a%2Fb) are only matched if
+// the engine routes on the request's escaped path, so that's enabled here if the
+// router is a *gin.Engine. Otherwise, the engine's UseRawPath option must be set.
+func Register(router gin.IRoutes, service Service, opts ...core.HandlerOption) {
+	if engine, ok := router.(*gin.Engine); ok {
+		engine.UseRawPath = true
+		engine.UnescapePathValues = true
+	}
+	router.Handle(http.MethodGet, "/:id", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		var id string
+		if err := core.ParseParameter(c.Param("id"), &id); err != nil {
+			c.String(http.StatusBadRequest, "invalid path parameter %q: %v", "id", err)
+			return
+		}
+		response, err := service.Get(ctx, id)
+		if err != nil {
+			var userNotFoundError *fixtures.UserNotFoundError
+			if errors.As(err, &userNotFoundError) {
+				c.JSON(404, userNotFoundError.Body)
+				return
+			}
+			var notImplementedError *fixtures.NotImplementedError
+			if errors.As(err, &notImplementedError) {
+				c.JSON(501, notImplementedError.Body)
+				return
+			}
+			var teapotError *fixtures.TeapotError
+			if errors.As(err, &teapotError) {
+				c.JSON(418, teapotError.Body)
+				return
+			}
+			var upgradeError *fixtures.UpgradeError
+			if errors.As(err, &upgradeError) {
+				c.JSON(426, "upgrade")
+				return
+			}
+			var untypedError *fixtures.UntypedError
+			if errors.As(err, &untypedError) {
+				c.Status(400)
+				return
+			}
+			_ = c.AbortWithError(core.ErrorStatusCode(err), err)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/:id", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		var id string
+		if err := core.ParseParameter(c.Param("id"), &id); err != nil {
+			c.String(http.StatusBadRequest, "invalid path parameter %q: %v", "id", err)
+			return
+		}
+		var request string
+		if err := core.DecodeRequestBody(c.Request.Body, &request, false); err != nil {
+			c.String(http.StatusBadRequest, "invalid request body: %v", err)
+			return
+		}
+		response, err := service.Update(ctx, id, request)
+		if err != nil {
+			var upgradeError *fixtures.UpgradeError
+			if errors.As(err, &upgradeError) {
+				c.JSON(426, "upgrade")
+				return
+			}
+			var untypedError *fixtures.UntypedError
+			if errors.As(err, &untypedError) {
+				c.Status(400)
+				return
+			}
+			_ = c.AbortWithError(core.ErrorStatusCode(err), err)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	})
+}
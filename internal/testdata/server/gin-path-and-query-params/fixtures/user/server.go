@@ -0,0 +1,60 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/gin-path-and-query-params/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/gin-path-and-query-params/fixtures/core"
+	gin "github.com/gin-gonic/gin"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetUser(
+		ctx context.Context,
+		userId string,
+		request *fixtures.GetUserRequest,
+	) (string, error)
+}
+
+// Register registers all of the Service's endpoints with the given Gin router.
+//
+// Path parameters that contain escaped slashes (e.g. a%2Fb) are only matched if
+// the engine routes on the request's escaped path, so that's enabled here if the
+// router is a *gin.Engine. Otherwise, the engine's UseRawPath option must be set.
+func Register(router gin.IRoutes, service Service, opts ...core.HandlerOption) {
+	if engine, ok := router.(*gin.Engine); ok {
+		engine.UseRawPath = true
+		engine.UnescapePathValues = true
+	}
+	router.Handle(http.MethodGet, "/users/:userId", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		var userId string
+		if err := core.ParseParameter(c.Param("userId"), &userId); err != nil {
+			c.String(http.StatusBadRequest, "invalid path parameter %q: %v", "userId", err)
+			return
+		}
+		request := new(fixtures.GetUserRequest)
+		query := c.Request.URL.Query()
+		if value := query.Get("shallow"); value != "" {
+			parsed := new(bool)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				c.String(http.StatusBadRequest, "invalid query parameter %q: %v", "shallow", err)
+				return
+			}
+			request.Shallow = parsed
+		}
+		if err := core.Validate(request); err != nil {
+			c.String(http.StatusBadRequest, "invalid request: %v", err)
+			return
+		}
+		response, err := service.GetUser(ctx, userId, request)
+		if err != nil {
+			_ = c.AbortWithError(core.ErrorStatusCode(err), err)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	})
+}
@@ -0,0 +1,145 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package file
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/gin-upload/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/gin-upload/fixtures/core"
+	gin "github.com/gin-gonic/gin"
+	io "io"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Upload(
+		ctx context.Context,
+		file io.Reader,
+		request *fixtures.UploadRequest,
+	) (string, error)
+	UploadSimple(
+		ctx context.Context,
+		file io.Reader,
+	) (string, error)
+	UploadMultiple(
+		ctx context.Context,
+		file io.Reader,
+		optionalFile io.Reader,
+		request *fixtures.UploadMultiRequest,
+	) (string, error)
+}
+
+// Register registers all of the Service's endpoints with the given Gin router.
+//
+// Path parameters that contain escaped slashes (e.g. a%2Fb) are only matched if
+// the engine routes on the request's escaped path, so that's enabled here if the
+// router is a *gin.Engine. Otherwise, the engine's UseRawPath option must be set.
+func Register(router gin.IRoutes, service Service, opts ...core.HandlerOption) {
+	if engine, ok := router.(*gin.Engine); ok {
+		engine.UseRawPath = true
+		engine.UnescapePathValues = true
+	}
+	router.Handle(http.MethodPost, "/file/upload", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			c.String(http.StatusBadRequest, "invalid file %q: %v", "file", err)
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.String(http.StatusBadRequest, "invalid file %q: %v", "file", err)
+			return
+		}
+		defer file.Close()
+		request := new(fixtures.UploadRequest)
+		if value := c.PostForm("fern"); value != "" && value != "fern" {
+			c.String(http.StatusBadRequest, "invalid form field %q: expected %q", "fern", "fern")
+			return
+		}
+		if value := c.PostForm("status"); value != "" {
+			if err := core.ParseParameter(value, &request.Status); err != nil {
+				c.String(http.StatusBadRequest, "invalid form field %q: %v", "status", err)
+				return
+			}
+		} else {
+			c.String(http.StatusBadRequest, "missing required form field %q", "status")
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			c.String(http.StatusBadRequest, "invalid request: %v", err)
+			return
+		}
+		response, err := service.Upload(ctx, file, request)
+		if err != nil {
+			_ = c.AbortWithError(core.ErrorStatusCode(err), err)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/file/upload-simple", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			c.String(http.StatusBadRequest, "invalid file %q: %v", "file", err)
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.String(http.StatusBadRequest, "invalid file %q: %v", "file", err)
+			return
+		}
+		defer file.Close()
+		response, err := service.UploadSimple(ctx, file)
+		if err != nil {
+			_ = c.AbortWithError(core.ErrorStatusCode(err), err)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/file/upload-multi", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			c.String(http.StatusBadRequest, "invalid file %q: %v", "file", err)
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.String(http.StatusBadRequest, "invalid file %q: %v", "file", err)
+			return
+		}
+		defer file.Close()
+		var optionalFile io.Reader
+		if optionalFileHeader, err := c.FormFile("optionalFile"); err == nil {
+			multipartFile, err := optionalFileHeader.Open()
+			if err != nil {
+				c.String(http.StatusBadRequest, "invalid file %q: %v", "optionalFile", err)
+				return
+			}
+			defer multipartFile.Close()
+			optionalFile = multipartFile
+		}
+		request := new(fixtures.UploadMultiRequest)
+		if value := c.PostForm("status"); value != "" {
+			if err := core.ParseParameter(value, &request.Status); err != nil {
+				c.String(http.StatusBadRequest, "invalid form field %q: %v", "status", err)
+				return
+			}
+		} else {
+			c.String(http.StatusBadRequest, "missing required form field %q", "status")
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			c.String(http.StatusBadRequest, "invalid request: %v", err)
+			return
+		}
+		response, err := service.UploadMultiple(ctx, file, optionalFile, request)
+		if err != nil {
+			_ = c.AbortWithError(core.ErrorStatusCode(err), err)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	})
+}
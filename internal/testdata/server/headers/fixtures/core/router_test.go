@@ -0,0 +1,101 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// RouterTestCase represents a single router test case.
+type RouterTestCase struct {
+	description string
+
+	giveMethod string
+	givePath   string
+
+	wantStatusCode int
+	wantBody       string
+}
+
+func TestRouter(t *testing.T) {
+	router := NewRouter()
+	router.Handle(http.MethodGet, "/users/{userId}", newTestRouterHandler("get"))
+	router.Handle(http.MethodGet, "/users/me", newTestRouterHandler("me"))
+	router.Handle(http.MethodDelete, "/users/{userId}", newTestRouterHandler("delete"))
+	router.Handle(http.MethodGet, "/files/{filename}.json", newTestRouterHandler("file"))
+
+	tests := []*RouterTestCase{
+		{
+			description:    "path parameter",
+			giveMethod:     http.MethodGet,
+			givePath:       "/users/123",
+			wantStatusCode: http.StatusOK,
+			wantBody:       "get:userId=123",
+		},
+		{
+			description:    "escaped path parameter",
+			giveMethod:     http.MethodGet,
+			givePath:       "/users/a%2Fb",
+			wantStatusCode: http.StatusOK,
+			wantBody:       "get:userId=a/b",
+		},
+		{
+			description:    "static segment takes precedence",
+			giveMethod:     http.MethodGet,
+			givePath:       "/users/me",
+			wantStatusCode: http.StatusOK,
+			wantBody:       "me:",
+		},
+		{
+			description:    "different method",
+			giveMethod:     http.MethodDelete,
+			givePath:       "/users/123",
+			wantStatusCode: http.StatusOK,
+			wantBody:       "delete:userId=123",
+		},
+		{
+			description:    "path parameter with suffix",
+			giveMethod:     http.MethodGet,
+			givePath:       "/files/report.json",
+			wantStatusCode: http.StatusOK,
+			wantBody:       "file:filename=report",
+		},
+		{
+			description:    "method not allowed",
+			giveMethod:     http.MethodPost,
+			givePath:       "/users/123",
+			wantStatusCode: http.StatusMethodNotAllowed,
+		},
+		{
+			description:    "not found",
+			giveMethod:     http.MethodGet,
+			givePath:       "/users/123/friends",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(test.giveMethod, test.givePath, nil))
+			assert.Equal(t, test.wantStatusCode, recorder.Code)
+			if test.wantBody != "" {
+				assert.Equal(t, test.wantBody, recorder.Body.String())
+			}
+		})
+	}
+}
+
+// newTestRouterHandler returns a HandlerFunc that writes the given name
+// alongside all of the matched path parameters.
+func newTestRouterHandler(name string) HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params PathParams) {
+		var elements []string
+		for key, value := range params {
+			elements = append(elements, key+"="+value)
+		}
+		_, _ = w.Write([]byte(name + ":" + strings.Join(elements, ",")))
+	}
+}
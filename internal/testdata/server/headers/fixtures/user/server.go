@@ -0,0 +1,173 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/headers/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/headers/fixtures/core"
+	uuid "github.com/google/uuid"
+	http "net/http"
+	time "time"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	SetName(
+		ctx context.Context,
+		userId string,
+		request *fixtures.SetNameRequest,
+	) (string, error)
+	UpdateName(
+		ctx context.Context,
+		userId string,
+		request *fixtures.UpdateNameRequest,
+	) (string, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodPost, "/users/{userId}/set-name", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.SetNameRequest)
+		if value := r.Header.Get("X-Endpoint-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Header"))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-ID-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointIdHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-ID-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-ID-Header"))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-Date-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointDateHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Date-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Date-Header"))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-Datetime-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointDatetimeHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Datetime-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Datetime-Header"))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-Bytes-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointBytesHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Bytes-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Bytes-Header"))
+			return
+		}
+		if value := r.Header.Get("X-Endpoint-Optional-Header"); value != "" {
+			parsed := new(string)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Optional-Header", err))
+				return
+			}
+			request.XEndpointOptionalHeader = parsed
+		}
+		if value := r.Header.Get("X-Endpoint-Optional-ID-Header"); value != "" {
+			parsed := new(uuid.UUID)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Optional-ID-Header", err))
+				return
+			}
+			request.XEndpointOptionalIdHeader = parsed
+		}
+		if value := r.Header.Get("X-Endpoint-Optional-Date-Header"); value != "" {
+			parsed := new(time.Time)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Optional-Date-Header", err))
+				return
+			}
+			request.XEndpointOptionalDateHeader = parsed
+		}
+		if value := r.Header.Get("X-Endpoint-Optional-Datetime-Header"); value != "" {
+			parsed := new(time.Time)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Optional-Datetime-Header", err))
+				return
+			}
+			request.XEndpointOptionalDatetimeHeader = parsed
+		}
+		if value := r.Header.Get("X-Endpoint-Optional-Bytes-Header"); value != "" {
+			parsed := new([]byte)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Optional-Bytes-Header", err))
+				return
+			}
+			request.XEndpointOptionalBytesHeader = parsed
+		}
+		if value := r.Header.Get("X-Endpoint-Fern-Header"); value != "" && value != "fern" {
+			core.WriteError(w, core.NewBadRequestError("invalid header %q: expected %q", "X-Endpoint-Fern-Header", "fern"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.SetName(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPut, "/users/{userId}/update-name", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		request := new(fixtures.UpdateNameRequest)
+		if value := r.Header.Get("X-Endpoint-Header"); value != "" {
+			if err := core.ParseParameter(value, &request.XEndpointHeader); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid header %q: %v", "X-Endpoint-Header", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required header %q", "X-Endpoint-Header"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.UpdateName(ctx, userId, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
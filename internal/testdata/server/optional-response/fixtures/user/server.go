@@ -0,0 +1,61 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/optional-response/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/optional-response/fixtures/core"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetName(
+		ctx context.Context,
+		userId string,
+	) (*string, error)
+	GetUser(
+		ctx context.Context,
+		userId string,
+	) (*fixtures.User, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodGet, "/users/{userId}/name", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		response, err := service.GetName(ctx, userId)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodGet, "/users/{userId}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		response, err := service.GetUser(ctx, userId)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
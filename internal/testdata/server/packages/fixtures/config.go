@@ -0,0 +1,13 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+type CreateConfigRequest struct {
+	Id string `json:"id"`
+}
+
+// Validate reports all of the CreateConfigRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (c *CreateConfigRequest) Validate() error {
+	return nil
+}
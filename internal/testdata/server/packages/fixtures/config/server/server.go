@@ -0,0 +1,60 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package server
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures"
+	config "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/config"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	CreateConfig(
+		ctx context.Context,
+		request *fixtures.CreateConfigRequest,
+	) (*config.Config, error)
+	GetConfig(
+		ctx context.Context,
+	) ([]*config.Config, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodPost, "/config", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		request := new(fixtures.CreateConfigRequest)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.CreateConfig(ctx, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodGet, "/config", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		response, err := service.GetConfig(ctx)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
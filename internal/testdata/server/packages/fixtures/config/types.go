@@ -0,0 +1,25 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package config
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+)
+
+type Config struct {
+	Id string `json:"id" url:"id"`
+}
+
+func (c *Config) String() string {
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+// Validate reports all of the Config's invalid fields (e.g. missing
+// required fields), if any.
+func (c *Config) Validate() error {
+	return nil
+}
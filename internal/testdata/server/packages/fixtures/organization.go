@@ -0,0 +1,26 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+)
+
+type Organization struct {
+	Id   string `json:"id" url:"id"`
+	Name string `json:"name" url:"name"`
+}
+
+func (o *Organization) String() string {
+	if value, err := core.StringifyJSON(o); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", o)
+}
+
+// Validate reports all of the Organization's invalid fields (e.g. missing
+// required fields), if any.
+func (o *Organization) Validate() error {
+	return nil
+}
@@ -0,0 +1,15 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package organization
+
+type CreateMetricsTagRequest struct {
+	Number  *int    `json:"number,omitempty"`
+	String  *string `json:"string,omitempty"`
+	Boolean *bool   `json:"boolean,omitempty"`
+}
+
+// Validate reports all of the CreateMetricsTagRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (c *CreateMetricsTagRequest) Validate() error {
+	return nil
+}
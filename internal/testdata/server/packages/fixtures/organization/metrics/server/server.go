@@ -0,0 +1,66 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package server
+
+import (
+	context "context"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+	organization "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/organization"
+	metrics "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/organization/metrics"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	CreateMetricsTag(
+		ctx context.Context,
+		request *organization.CreateMetricsTagRequest,
+	) (*metrics.Tag, error)
+	GetMetricsTag(
+		ctx context.Context,
+		id string,
+	) (*metrics.Tag, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodPost, "/metrics", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		request := new(organization.CreateMetricsTagRequest)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.CreateMetricsTag(ctx, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodGet, "/metrics/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		response, err := service.GetMetricsTag(ctx, id)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
@@ -0,0 +1,46 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package tag
+
+import (
+	context "context"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+	metrics "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/organization/metrics"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	PostTag(
+		ctx context.Context,
+		request *metrics.Tag,
+	) error
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodPost, "/metrics/tag", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		request := new(metrics.Tag)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		if err := service.PostTag(ctx, request); err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
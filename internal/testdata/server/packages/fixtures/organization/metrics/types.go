@@ -0,0 +1,150 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package metrics
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+)
+
+type Tag struct {
+	Type    string
+	Number  int
+	String  string
+	Boolean bool
+
+	_unknown json.RawMessage
+}
+
+func NewTagFromNumber(value int) *Tag {
+	return &Tag{Type: "number", Number: value}
+}
+
+func NewTagFromString(value string) *Tag {
+	return &Tag{Type: "string", String: value}
+}
+
+func NewTagFromBoolean(value bool) *Tag {
+	return &Tag{Type: "boolean", Boolean: value}
+}
+
+func (t *Tag) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	t.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "number":
+		var valueUnmarshaler struct {
+			Number int `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		t.Number = valueUnmarshaler.Number
+	case "string":
+		var valueUnmarshaler struct {
+			String string `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		t.String = valueUnmarshaler.String
+	case "boolean":
+		var valueUnmarshaler struct {
+			Boolean bool `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		t.Boolean = valueUnmarshaler.Boolean
+	default:
+		t._unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (t Tag) MarshalJSON() ([]byte, error) {
+	switch t.Type {
+	default:
+		if t._unknown != nil {
+			return t._unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", t.Type, t)
+	case "number":
+		var marshaler = struct {
+			Type   string `json:"type"`
+			Number int    `json:"value"`
+		}{
+			Type:   t.Type,
+			Number: t.Number,
+		}
+		return json.Marshal(marshaler)
+	case "string":
+		var marshaler = struct {
+			Type   string `json:"type"`
+			String string `json:"value"`
+		}{
+			Type:   t.Type,
+			String: t.String,
+		}
+		return json.Marshal(marshaler)
+	case "boolean":
+		var marshaler = struct {
+			Type    string `json:"type"`
+			Boolean bool   `json:"value"`
+		}{
+			Type:    t.Type,
+			Boolean: t.Boolean,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type TagVisitor interface {
+	VisitNumber(int) error
+	VisitString(string) error
+	VisitBoolean(bool) error
+	// VisitUnknown is called with the raw JSON of any type that was added
+	// to the API after this code was generated.
+	VisitUnknown(discriminant string, data json.RawMessage) error
+}
+
+func (t *Tag) Accept(visitor TagVisitor) error {
+	switch t.Type {
+	default:
+		if t._unknown != nil {
+			return visitor.VisitUnknown(t.Type, t._unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", t.Type, t)
+	case "number":
+		return visitor.VisitNumber(t.Number)
+	case "string":
+		return visitor.VisitString(t.String)
+	case "boolean":
+		return visitor.VisitBoolean(t.Boolean)
+	}
+}
+
+// Validate reports all of the Tag's invalid fields (e.g. missing
+// required fields), if any.
+func (t *Tag) Validate() error {
+	if t == nil {
+		return nil
+	}
+	var validation core.Validation
+	switch t.Type {
+	case "number":
+	case "string":
+	case "boolean":
+	default:
+		if t._unknown == nil {
+			validation.Add("type", fmt.Errorf("invalid type %q", t.Type))
+		}
+	}
+	return validation.Err()
+}
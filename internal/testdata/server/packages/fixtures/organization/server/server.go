@@ -0,0 +1,43 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package server
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Check(
+		ctx context.Context,
+		id string,
+	) (*fixtures.Organization, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodGet, "/organization/{id}", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var id string
+		if err := core.ParseParameter(params["id"], &id); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "id", err))
+			return
+		}
+		response, err := service.Check(ctx, id)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
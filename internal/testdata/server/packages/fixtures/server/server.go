@@ -0,0 +1,70 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package server
+
+import (
+	context "context"
+	errors "errors"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetFoo(
+		ctx context.Context,
+	) ([]*fixtures.Foo, error)
+	PostFoo(
+		ctx context.Context,
+		request *fixtures.Foo,
+	) (*fixtures.Foo, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodGet, "/foo", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		response, err := service.GetFoo(ctx)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/foo", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		request := new(fixtures.Foo)
+		if err := core.DecodeRequestBody(r.Body, request, false); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request body: %v", err))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.PostFoo(ctx, request)
+		if err != nil {
+			var conflictError *fixtures.ConflictError
+			if errors.As(err, &conflictError) {
+				core.WriteResponse(w, 409, conflictError.Body)
+				return
+			}
+			var unprocessableEntityError *fixtures.UnprocessableEntityError
+			if errors.As(err, &unprocessableEntityError) {
+				core.WriteResponse(w, 422, unprocessableEntityError.Body)
+				return
+			}
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
@@ -0,0 +1,43 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+)
+
+type Error struct {
+	Message string `json:"message" url:"message"`
+}
+
+func (e *Error) String() string {
+	if value, err := core.StringifyJSON(e); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", e)
+}
+
+// Validate reports all of the Error's invalid fields (e.g. missing
+// required fields), if any.
+func (e *Error) Validate() error {
+	return nil
+}
+
+type Foo struct {
+	Id   string `json:"id" url:"id"`
+	Name string `json:"name" url:"name"`
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Validate reports all of the Foo's invalid fields (e.g. missing
+// required fields), if any.
+func (f *Foo) Validate() error {
+	return nil
+}
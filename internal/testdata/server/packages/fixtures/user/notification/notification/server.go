@@ -0,0 +1,43 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package notification
+
+import (
+	context "context"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+	notification "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/user/notification"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	List(
+		ctx context.Context,
+		userId string,
+	) ([]*notification.Notification, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodGet, "/users/{userId}/notifications", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		var userId string
+		if err := core.ParseParameter(params["userId"], &userId); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid path parameter %q: %v", "userId", err))
+			return
+		}
+		response, err := service.List(ctx, userId)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
@@ -0,0 +1,26 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package notification
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+)
+
+type Notification struct {
+	Id      string `json:"id" url:"id"`
+	Message string `json:"message" url:"message"`
+}
+
+func (n *Notification) String() string {
+	if value, err := core.StringifyJSON(n); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", n)
+}
+
+// Validate reports all of the Notification's invalid fields (e.g. missing
+// required fields), if any.
+func (n *Notification) Validate() error {
+	return nil
+}
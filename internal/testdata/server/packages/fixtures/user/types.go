@@ -0,0 +1,26 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/server/packages/fixtures/core"
+)
+
+type User struct {
+	Id   string `json:"id" url:"id"`
+	Name string `json:"name" url:"name"`
+}
+
+func (u *User) String() string {
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
+
+// Validate reports all of the User's invalid fields (e.g. missing
+// required fields), if any.
+func (u *User) Validate() error {
+	return nil
+}
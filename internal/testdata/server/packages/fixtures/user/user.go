@@ -0,0 +1,13 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+type CreateUserRequest struct {
+	Name string `json:"name"`
+}
+
+// Validate reports all of the CreateUserRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (c *CreateUserRequest) Validate() error {
+	return nil
+}
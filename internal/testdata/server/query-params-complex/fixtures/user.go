@@ -0,0 +1,45 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/server/query-params-complex/fixtures/core"
+	uuid "github.com/google/uuid"
+	time "time"
+)
+
+type GetUsersRequest struct {
+	Id               uuid.UUID  `json:"-" url:"id"`
+	Date             time.Time  `json:"-" url:"date,date"`
+	Deadline         time.Time  `json:"-" url:"deadline"`
+	Bytes            []byte     `json:"-" url:"bytes"`
+	OptionalId       *uuid.UUID `json:"-" url:"optionalId,omitempty"`
+	OptionalDate     *time.Time `json:"-" url:"optionalDate,omitempty,date"`
+	OptionalDeadline *time.Time `json:"-" url:"optionalDeadline,omitempty"`
+	OptionalBytes    *[]byte    `json:"-" url:"optionalBytes,omitempty"`
+}
+
+// Validate reports all of the GetUsersRequest's invalid fields (e.g. missing
+// required fields), if any.
+func (g *GetUsersRequest) Validate() error {
+	return nil
+}
+
+type User struct {
+	Name string   `json:"name" url:"name"`
+	Tags []string `json:"tags,omitempty" url:"tags"`
+}
+
+func (u *User) String() string {
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
+
+// Validate reports all of the User's invalid fields (e.g. missing
+// required fields), if any.
+func (u *User) Validate() error {
+	return nil
+}
@@ -0,0 +1,114 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/query-params-complex/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/query-params-complex/fixtures/core"
+	uuid "github.com/google/uuid"
+	http "net/http"
+	time "time"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	GetUsername(
+		ctx context.Context,
+		request *fixtures.GetUsersRequest,
+	) (*fixtures.User, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodGet, "/user", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		request := new(fixtures.GetUsersRequest)
+		query := r.URL.Query()
+		if value := query.Get("id"); value != "" {
+			if err := core.ParseParameter(value, &request.Id); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "id", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required query parameter %q", "id"))
+			return
+		}
+		if value := query.Get("date"); value != "" {
+			if err := core.ParseParameter(value, &request.Date); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "date", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required query parameter %q", "date"))
+			return
+		}
+		if value := query.Get("deadline"); value != "" {
+			if err := core.ParseParameter(value, &request.Deadline); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "deadline", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required query parameter %q", "deadline"))
+			return
+		}
+		if value := query.Get("bytes"); value != "" {
+			if err := core.ParseParameter(value, &request.Bytes); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "bytes", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required query parameter %q", "bytes"))
+			return
+		}
+		if value := query.Get("optionalId"); value != "" {
+			parsed := new(uuid.UUID)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "optionalId", err))
+				return
+			}
+			request.OptionalId = parsed
+		}
+		if value := query.Get("optionalDate"); value != "" {
+			parsed := new(time.Time)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "optionalDate", err))
+				return
+			}
+			request.OptionalDate = parsed
+		}
+		if value := query.Get("optionalDeadline"); value != "" {
+			parsed := new(time.Time)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "optionalDeadline", err))
+				return
+			}
+			request.OptionalDeadline = parsed
+		}
+		if value := query.Get("optionalBytes"); value != "" {
+			parsed := new([]byte)
+			if err := core.ParseParameter(value, parsed); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid query parameter %q: %v", "optionalBytes", err))
+				return
+			}
+			request.OptionalBytes = parsed
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.GetUsername(ctx, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}
@@ -0,0 +1,151 @@
+package core
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// PathParams holds the path parameters matched by the Router, keyed
+// by the name used in the route's path template (e.g. {userId}).
+type PathParams map[string]string
+
+// HandlerFunc handles a single request routed by the Router.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request, params PathParams)
+
+// Router is a minimal http.Handler that dispatches requests based on
+// their method and path template (e.g. /users/{userId}).
+type Router struct {
+	routes []*route
+}
+
+// NewRouter returns a new, empty *Router.
+func NewRouter() *Router {
+	return new(Router)
+}
+
+// Handle registers the handler for the given method and path template.
+func (r *Router) Handle(method string, pattern string, handler HandlerFunc) {
+	r.routes = append(
+		r.routes,
+		&route{
+			method:   method,
+			segments: newRouteSegments(pattern),
+			handler:  handler,
+		},
+	)
+	// Prefer the most specific routes (i.e. those with the fewest path
+	// parameters) so that /users/me takes precedence over /users/{userId}.
+	sort.SliceStable(r.routes, func(i, j int) bool {
+		return r.routes[i].numParams() < r.routes[j].numParams()
+	})
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var allowed []string
+	for _, route := range r.routes {
+		params, ok := route.match(req.URL.EscapedPath())
+		if !ok {
+			continue
+		}
+		if route.method != req.Method {
+			allowed = append(allowed, route.method)
+			continue
+		}
+		route.handler(w, req, params)
+		return
+	}
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		WriteError(w, NewAPIError(http.StatusMethodNotAllowed, nil))
+		return
+	}
+	WriteError(w, NewAPIError(http.StatusNotFound, nil))
+}
+
+type route struct {
+	method   string
+	segments []*routeSegment
+	handler  HandlerFunc
+}
+
+func (r *route) numParams() int {
+	var count int
+	for _, segment := range r.segments {
+		if segment.param != "" {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *route) match(path string) (PathParams, bool) {
+	elements := splitPath(path)
+	if len(elements) != len(r.segments) {
+		return nil, false
+	}
+	params := make(PathParams)
+	for i, segment := range r.segments {
+		element := elements[i]
+		if segment.param == "" {
+			if element != segment.prefix {
+				return nil, false
+			}
+			continue
+		}
+		if !strings.HasPrefix(element, segment.prefix) || !strings.HasSuffix(element, segment.suffix) {
+			return nil, false
+		}
+		raw := element[len(segment.prefix) : len(element)-len(segment.suffix)]
+		if raw == "" {
+			return nil, false
+		}
+		value, err := url.PathUnescape(raw)
+		if err != nil {
+			return nil, false
+		}
+		params[segment.param] = value
+	}
+	return params, true
+}
+
+// routeSegment represents a single element of a path template. Each
+// segment holds at most one path parameter, which can be surrounded
+// by a static prefix and suffix (e.g. {filename}.json).
+type routeSegment struct {
+	prefix string
+	param  string
+	suffix string
+}
+
+func newRouteSegments(pattern string) []*routeSegment {
+	elements := splitPath(pattern)
+	segments := make([]*routeSegment, 0, len(elements))
+	for _, element := range elements {
+		start := strings.Index(element, "{")
+		end := strings.LastIndex(element, "}")
+		if start < 0 || end < start {
+			segments = append(segments, &routeSegment{prefix: element})
+			continue
+		}
+		segments = append(
+			segments,
+			&routeSegment{
+				prefix: element[:start],
+				param:  element[start+1 : end],
+				suffix: element[end+1:],
+			},
+		)
+	}
+	return segments
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
@@ -0,0 +1,143 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package file
+
+import (
+	context "context"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/server/upload/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/server/upload/fixtures/core"
+	io "io"
+	http "net/http"
+)
+
+// Service defines the server-side implementation of this package's endpoints.
+type Service interface {
+	Upload(
+		ctx context.Context,
+		file io.Reader,
+		request *fixtures.UploadRequest,
+	) (string, error)
+	UploadSimple(
+		ctx context.Context,
+		file io.Reader,
+	) (string, error)
+	UploadMultiple(
+		ctx context.Context,
+		file io.Reader,
+		optionalFile io.Reader,
+		request *fixtures.UploadMultiRequest,
+	) (string, error)
+}
+
+// NewHandler returns an http.Handler that routes requests to the given Service.
+func NewHandler(service Service, opts ...core.HandlerOption) http.Handler {
+	router := core.NewRouter()
+	Register(router, service, opts...)
+	return router
+}
+
+// Register registers all of the Service's endpoints with the given router.
+func Register(router *core.Router, service Service, opts ...core.HandlerOption) {
+	router.Handle(http.MethodPost, "/file/upload", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		fileHeader, err := core.FormFile(r, "file")
+		if err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid file %q: %v", "file", err))
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid file %q: %v", "file", err))
+			return
+		}
+		defer file.Close()
+		request := new(fixtures.UploadRequest)
+		if value := r.FormValue("fern"); value != "" && value != "fern" {
+			core.WriteError(w, core.NewBadRequestError("invalid form field %q: expected %q", "fern", "fern"))
+			return
+		}
+		if value := r.FormValue("status"); value != "" {
+			if err := core.ParseParameter(value, &request.Status); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid form field %q: %v", "status", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required form field %q", "status"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.Upload(ctx, file, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/file/upload-simple", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		fileHeader, err := core.FormFile(r, "file")
+		if err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid file %q: %v", "file", err))
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid file %q: %v", "file", err))
+			return
+		}
+		defer file.Close()
+		response, err := service.UploadSimple(ctx, file)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+	router.Handle(http.MethodPost, "/file/upload-multi", func(w http.ResponseWriter, r *http.Request, params core.PathParams) {
+		ctx := r.Context()
+		fileHeader, err := core.FormFile(r, "file")
+		if err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid file %q: %v", "file", err))
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid file %q: %v", "file", err))
+			return
+		}
+		defer file.Close()
+		var optionalFile io.Reader
+		if optionalFileHeader, err := core.FormFile(r, "optionalFile"); err == nil {
+			multipartFile, err := optionalFileHeader.Open()
+			if err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid file %q: %v", "optionalFile", err))
+				return
+			}
+			defer multipartFile.Close()
+			optionalFile = multipartFile
+		}
+		request := new(fixtures.UploadMultiRequest)
+		if value := r.FormValue("status"); value != "" {
+			if err := core.ParseParameter(value, &request.Status); err != nil {
+				core.WriteError(w, core.NewBadRequestError("invalid form field %q: %v", "status", err))
+				return
+			}
+		} else {
+			core.WriteError(w, core.NewBadRequestError("missing required form field %q", "status"))
+			return
+		}
+		if err := core.Validate(request); err != nil {
+			core.WriteError(w, core.NewBadRequestError("invalid request: %v", err))
+			return
+		}
+		response, err := service.UploadMultiple(ctx, file, optionalFile, request)
+		if err != nil {
+			core.WriteError(w, err)
+			return
+		}
+		core.WriteResponse(w, http.StatusOK, response)
+	})
+}